@@ -16,12 +16,18 @@ import (
 
 // Dialect names for external usage.
 const (
-	MySQL    = "mysql"
-	SQLite   = "sqlite3"
-	Postgres = "postgres"
-	Gremlin  = "gremlin"
+	MySQL     = "mysql"
+	SQLite    = "sqlite3"
+	Postgres  = "postgres"
+	SQLServer = "sqlserver"
+	Gremlin   = "gremlin"
 )
 
+// SQLiteDriver is the name of the pure-Go SQLite driver (modernc.org/sqlite). Unlike the
+// default driver (github.com/mattn/go-sqlite3), it does not require cgo, and databases
+// that are opened with it use the SQLite dialect.
+const SQLiteDriver = "sqlite"
+
 // ExecQuerier wraps the 2 database operations.
 type ExecQuerier interface {
 	// Exec executes a query that does not return records. For example, in SQL, INSERT or UPDATE.
@@ -206,3 +212,39 @@ func (d *DebugTx) Rollback() error {
 	d.log(d.ctx, fmt.Sprintf("Tx(%s): rollbacked", d.id))
 	return d.Tx.Rollback()
 }
+
+// Savepoint logs its params and calls the underlying transaction Savepoint method if it is supported.
+func (d *DebugTx) Savepoint(ctx context.Context, name string) error {
+	drv, ok := d.Tx.(interface {
+		Savepoint(context.Context, string) error
+	})
+	if !ok {
+		return fmt.Errorf("Tx.Savepoint is not supported")
+	}
+	d.log(ctx, fmt.Sprintf("Tx(%s).Savepoint: name=%v", d.id, name))
+	return drv.Savepoint(ctx, name)
+}
+
+// RollbackTo logs its params and calls the underlying transaction RollbackTo method if it is supported.
+func (d *DebugTx) RollbackTo(ctx context.Context, name string) error {
+	drv, ok := d.Tx.(interface {
+		RollbackTo(context.Context, string) error
+	})
+	if !ok {
+		return fmt.Errorf("Tx.RollbackTo is not supported")
+	}
+	d.log(ctx, fmt.Sprintf("Tx(%s).RollbackTo: name=%v", d.id, name))
+	return drv.RollbackTo(ctx, name)
+}
+
+// Release logs its params and calls the underlying transaction Release method if it is supported.
+func (d *DebugTx) Release(ctx context.Context, name string) error {
+	drv, ok := d.Tx.(interface {
+		Release(context.Context, string) error
+	})
+	if !ok {
+		return fmt.Errorf("Tx.Release is not supported")
+	}
+	d.log(ctx, fmt.Sprintf("Tx(%s).Release: name=%v", d.id, name))
+	return drv.Release(ctx, name)
+}
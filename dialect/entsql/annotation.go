@@ -89,6 +89,35 @@ type Annotation struct {
 	//
 	DefaultExprs map[string]string `json:"default_exprs,omitempty"`
 
+	// GeneratedExpr defines the column as a generated column, whose value is computed
+	// by the database from the given expression. Generated fields are read-only, and
+	// no setters are generated for them in the create and update builders.
+	//
+	//	entsql.Annotation{
+	//		GeneratedExpr: "price * quantity",
+	//		GeneratedType: entsql.Stored,
+	//	}
+	//
+	GeneratedExpr string `json:"generated_expr,omitempty"`
+
+	// GeneratedExprs defines the generation expression of a column per dialect.
+	// See, GeneratedExpr for full doc.
+	//
+	//	entsql.Annotation{
+	//		GeneratedExprs: map[string]string{
+	//			dialect.MySQL:    "concat(first_name, ' ', last_name)",
+	//			dialect.Postgres: "first_name || ' ' || last_name",
+	//		},
+	//		GeneratedType: entsql.Stored,
+	//	}
+	//
+	GeneratedExprs map[string]string `json:"generated_exprs,omitempty"`
+
+	// GeneratedType defines whether the value of a generated column is computed
+	// when the row is written (STORED), or when it is read (VIRTUAL). Defaults to
+	// VIRTUAL in MySQL and SQLite. Note that PostgreSQL supports only STORED columns.
+	GeneratedType GeneratedType `json:"generated_type,omitempty"`
+
 	// Options defines the additional table options. For example:
 	//
 	//	entsql.Annotation{
@@ -193,6 +222,83 @@ type Annotation struct {
 	//	}
 	ViewFor map[string]string `json:"view_for,omitempty"`
 
+	// SoftDelete indicates that the rows of the schema are soft-deleted. i.e. delete
+	// operations mark rows as deleted by setting their "deleted_at" column instead of
+	// removing them, and queries filter such rows out. Note, this option requires the
+	// "sql/softdelete" feature-flag to be enabled.
+	//
+	//	entsql.Annotation{
+	//		SoftDelete: true,
+	//	}
+	//
+	SoftDelete bool `json:"soft_delete,omitempty"`
+
+	// History indicates that the changes of the schema rows are recorded in a companion
+	// "<T>History" table. i.e. every create, update and delete operation is stored along
+	// with its old and new values. Note, this option requires the "sql/history"
+	// feature-flag to be enabled.
+	//
+	//	entsql.Annotation{
+	//		History: true,
+	//	}
+	//
+	History bool `json:"history,omitempty"`
+
+	// OptimisticLock marks an integer field as the optimistic-lock (version) column of
+	// the schema. i.e. the generated UpdateOne builders update the row only if its
+	// version was not changed since it was loaded, and increment it by one. Bulk
+	// updates increment the version of all updated rows, unless they opt out.
+	//
+	//	field.Int("version").
+	//		Default(1).
+	//		Annotations(
+	//			entsql.Annotation{
+	//				OptimisticLock: true,
+	//			},
+	//		)
+	//
+	OptimisticLock bool `json:"optimistic_lock,omitempty"`
+
+	// TenantKey marks a field as the tenant column of the schema. i.e. the generated
+	// queries are filtered by the tenant that is stored in their context, and the
+	// generated mutations are scoped to it. Note, this option requires the
+	// "sql/multitenancy" feature-flag to be enabled.
+	//
+	//	field.Int("tenant_id").
+	//		Immutable().
+	//		Annotations(
+	//			entsql.Annotation{
+	//				TenantKey: true,
+	//			},
+	//		)
+	//
+	TenantKey bool `json:"tenant_key,omitempty"`
+
+	// Array stores a slice field (e.g. field.Strings or field.Ints) as a native array
+	// column in Postgres (e.g. text[] or bigint[]), instead of a JSON column. In MySQL
+	// and SQLite, the field is stored as a JSON array.
+	//
+	//	field.Strings("tags").
+	//		Annotations(
+	//			entsql.Annotation{
+	//				Array: true,
+	//			},
+	//		)
+	//
+	Array bool `json:"array,omitempty"`
+
+	// Partition defines the partitioning of the table. See the Partition
+	// function for details.
+	//
+	//	entsql.Annotation{
+	//		Partition: &entsql.TablePartition{
+	//			Type:    entsql.PartitionRange,
+	//			Columns: []string{"created_at"},
+	//		},
+	//	}
+	//
+	Partition *TablePartition `json:"partition,omitempty"`
+
 	// error occurs during annotation build. This field is not
 	// serialized to JSON and used only by the codegen loader.
 	err error
@@ -299,6 +405,120 @@ func ViewFor(dialect string, as func(*sql.Selector)) *Annotation {
 	}
 }
 
+// SoftDelete configures the schema rows to be soft-deleted by the generated
+// delete builders, and to be filtered out from the generated queries. Note,
+// this option requires the "sql/softdelete" feature-flag to be enabled.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.SoftDelete(),
+//		}
+//	}
+func SoftDelete() *Annotation {
+	return &Annotation{SoftDelete: true}
+}
+
+// History configures the changes of the schema rows to be recorded in
+// a companion "<T>History" table. Note, this option requires the
+// "sql/history" feature-flag to be enabled.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.History(),
+//		}
+//	}
+func History() *Annotation {
+	return &Annotation{History: true}
+}
+
+// OptimisticLock marks the annotated integer field as the optimistic-lock
+// (version) column of the schema.
+//
+//	field.Int("version").
+//		Default(1).
+//		Annotations(
+//			entsql.OptimisticLock(),
+//		)
+func OptimisticLock() *Annotation {
+	return &Annotation{OptimisticLock: true}
+}
+
+// TenantKey marks the annotated field as the tenant column of the schema.
+// Note, this option requires the "sql/multitenancy" feature-flag to be enabled.
+//
+//	field.Int("tenant_id").
+//		Immutable().
+//		Annotations(
+//			entsql.TenantKey(),
+//		)
+func TenantKey() *Annotation {
+	return &Annotation{TenantKey: true}
+}
+
+// Array stores the annotated slice field as a native array column in Postgres,
+// and as a JSON array in MySQL and SQLite.
+//
+//	field.Strings("tags").
+//		Annotations(
+//			entsql.Array(),
+//		)
+func Array() *Annotation {
+	return &Annotation{Array: true}
+}
+
+// Partition defines the partitioning of the annotated table by the given type and key
+// columns, and the partitions that are created by the migration. Since the databases
+// require the partition key to be part of the unique keys of the table, the partition
+// key columns are added to its primary key.
+//
+//	func (Event) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Partition(entsql.PartitionRange, []string{"created_at"},
+//				entsql.RangePartition("events_2025_01", "'2025-01-01'", "'2025-02-01'"),
+//				entsql.RangePartition("events_2025_02", "'2025-02-01'", "'2025-03-01'"),
+//			),
+//		}
+//	}
+//
+// Partitions that are added at runtime (e.g. the partition of next month) should be
+// created using the schema.CreatePartition function.
+func Partition(typ PartitionType, columns []string, defs ...*PartitionDef) *Annotation {
+	return &Annotation{
+		Partition: &TablePartition{
+			Type:    typ,
+			Columns: columns,
+			Defs:    defs,
+		},
+	}
+}
+
+// RangePartition defines a partition of a RANGE partitioned table, that holds the rows
+// whose partition key is greater than or equal to from, and less than to. The bounds
+// are SQL expressions. i.e. strings must be quoted. An empty from or to means that the
+// partition is unbounded (MINVALUE or MAXVALUE). Note that MySQL ignores the lower
+// bound, as it is defined by the previous partition.
+//
+//	entsql.RangePartition("events_2025_01", "'2025-01-01'", "'2025-02-01'")
+func RangePartition(name, from, to string) *PartitionDef {
+	return &PartitionDef{Name: name, From: from, To: to}
+}
+
+// ListPartition defines a partition of a LIST partitioned table, that holds the rows
+// whose partition key is one of the given values. The values are SQL expressions.
+//
+//	entsql.ListPartition("orders_eu", "'de'", "'fr'", "'it'")
+func ListPartition(name string, values ...string) *PartitionDef {
+	return &PartitionDef{Name: name, Values: values}
+}
+
+// HashPartition defines a partition of a HASH partitioned table. The rows are
+// distributed between the partitions by the hash of their partition key.
+//
+//	entsql.HashPartition("users_p0")
+func HashPartition(name string) *PartitionDef {
+	return &PartitionDef{Name: name}
+}
+
 // Default specifies a literal default value of a column. Note that using
 // this option overrides the default behavior of the code-generation.
 //
@@ -344,6 +564,40 @@ func DefaultExprs(exprs map[string]string) *Annotation {
 	}
 }
 
+// GeneratedExpr defines the annotated column as a generated column of the
+// given type, whose value is computed by the database from the expression.
+//
+//	field.Float("total").
+//		Optional().
+//		Annotations(
+//			entsql.GeneratedExpr("price * quantity", entsql.Stored),
+//		)
+func GeneratedExpr(expr string, typ GeneratedType) *Annotation {
+	return &Annotation{
+		GeneratedExpr: expr,
+		GeneratedType: typ,
+	}
+}
+
+// GeneratedExprs defines the annotated column as a generated column per
+// dialect. See, GeneratedExpr for full doc.
+//
+//	field.String("full_name").
+//		Optional().
+//		Annotations(
+//			entsql.GeneratedExprs(map[string]string{
+//				dialect.MySQL:    "concat(first_name, ' ', last_name)",
+//				dialect.Postgres: "first_name || ' ' || last_name",
+//				dialect.SQLite:   "first_name || ' ' || last_name",
+//			}, entsql.Stored),
+//		)
+func GeneratedExprs(exprs map[string]string, typ GeneratedType) *Annotation {
+	return &Annotation{
+		GeneratedExprs: exprs,
+		GeneratedType:  typ,
+	}
+}
+
 // WithComments specifies whether fields' comments should
 // be stored in the database schema as column comments.
 //
@@ -429,6 +683,20 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 			a.DefaultExprs[dialect] = x
 		}
 	}
+	if x := ant.GeneratedExpr; x != "" {
+		a.GeneratedExpr = x
+	}
+	if g := ant.GeneratedExprs; g != nil {
+		if a.GeneratedExprs == nil {
+			a.GeneratedExprs = make(map[string]string)
+		}
+		for dialect, x := range g {
+			a.GeneratedExprs[dialect] = x
+		}
+	}
+	if t := ant.GeneratedType; t != "" {
+		a.GeneratedType = t
+	}
 	if o := ant.Options; o != "" {
 		a.Options = o
 	}
@@ -472,6 +740,24 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 			a.ViewFor[dialect] = view
 		}
 	}
+	if ant.SoftDelete {
+		a.SoftDelete = true
+	}
+	if ant.History {
+		a.History = true
+	}
+	if ant.OptimisticLock {
+		a.OptimisticLock = true
+	}
+	if ant.TenantKey {
+		a.TenantKey = true
+	}
+	if ant.Array {
+		a.Array = true
+	}
+	if p := ant.Partition; p != nil {
+		a.Partition = p
+	}
 	if ant.err != nil {
 		a.err = errors.Join(a.err, ant.err)
 	}
@@ -501,6 +787,49 @@ const (
 	SetDefault ReferenceOption = "SET DEFAULT"
 )
 
+// GeneratedType defines how the values of generated columns are computed.
+type GeneratedType string
+
+// Types of generated columns.
+const (
+	Stored  GeneratedType = "STORED"
+	Virtual GeneratedType = "VIRTUAL"
+)
+
+type (
+	// TablePartition describes the partitioning of a table.
+	TablePartition struct {
+		// Type of the partitioning. i.e. RANGE, LIST or HASH.
+		Type PartitionType `json:"type"`
+		// Columns of the partition key.
+		Columns []string `json:"columns"`
+		// Defs holds the partitions of the table.
+		Defs []*PartitionDef `json:"defs,omitempty"`
+	}
+
+	// PartitionDef defines a partition of a table. See the RangePartition,
+	// ListPartition and HashPartition functions for details.
+	PartitionDef struct {
+		// Name of the partition. In PostgreSQL, partitions are tables.
+		Name string `json:"name"`
+		// From and To are the bounds of RANGE partitions.
+		From string `json:"from,omitempty"`
+		To   string `json:"to,omitempty"`
+		// Values of LIST partitions.
+		Values []string `json:"values,omitempty"`
+	}
+
+	// PartitionType defines how the rows of a partitioned table are distributed.
+	PartitionType string
+)
+
+// Partitioning types.
+const (
+	PartitionRange PartitionType = "RANGE"
+	PartitionList  PartitionType = "LIST"
+	PartitionHash  PartitionType = "HASH"
+)
+
 // IndexAnnotation is a builtin schema annotation for attaching
 // SQL metadata to schema indexes for both codegen and runtime.
 type IndexAnnotation struct {
@@ -625,6 +954,57 @@ type IndexAnnotation struct {
 	//		)
 	//	CREATE INDEX "table_a" ON "table"("a") WHERE (b AND c > 0)
 	Where string
+
+	// FullText defines a full-text index on string columns. The generated code includes
+	// <Field>Search predicates and By<Field>SearchRank order options for fields that are
+	// indexed using a single-column full-text index. The index is created as follows:
+	//
+	//	index.Fields("title").
+	//		Annotations(
+	//			entsql.FullText(),
+	//		)
+	//
+	//	// MySQL
+	//	CREATE FULLTEXT INDEX `table_title` ON `table`(`title`)
+	//
+	//	// PostgreSQL
+	//	CREATE INDEX "table_title" ON "table" USING GIN ((to_tsvector('simple'::regconfig, (title)::text)))
+	//
+	//	// SQLite (FTS5 external-content table, kept in sync by triggers)
+	//	CREATE VIRTUAL TABLE `table_title` USING fts5(`title`, content=`table`)
+	//
+	FullText bool
+
+	// TextSearchConfig defines the text search configuration of a full-text index in
+	// PostgreSQL. The default is "simple".
+	//
+	//	index.Fields("title").
+	//		Annotations(
+	//			entsql.FullText(),
+	//			entsql.TextSearchConfig("english"),
+	//		)
+	//
+	//	CREATE INDEX "table_title" ON "table" USING GIN ((to_tsvector('english'::regconfig, (title)::text)))
+	//
+	TextSearchConfig string
+
+	// Spatial defines a spatial index on geometry columns (see the sqlgeo package).
+	// The index is used by the spatial predicates and the distance ordering of the
+	// generated code. Note that SQLite does not support spatial indexes, and they
+	// are skipped in migrations. The index is created as follows:
+	//
+	//	index.Fields("location").
+	//		Annotations(
+	//			entsql.Spatial(),
+	//		)
+	//
+	//	// MySQL
+	//	CREATE SPATIAL INDEX `table_location` ON `table`(`location`)
+	//
+	//	// PostgreSQL
+	//	CREATE INDEX "table_location" ON "table" USING GIST ("location")
+	//
+	Spatial bool
 }
 
 // Prefix returns a new index annotation with a single string column index.
@@ -780,6 +1160,39 @@ func IndexWhere(pred string) *IndexAnnotation {
 	return &IndexAnnotation{Where: pred}
 }
 
+// FullText returns a new index annotation that defines a full-text index.
+// See IndexAnnotation.FullText for more info.
+//
+//	index.Fields("title").
+//		Annotations(
+//			entsql.FullText(),
+//		)
+func FullText() *IndexAnnotation {
+	return &IndexAnnotation{FullText: true}
+}
+
+// TextSearchConfig returns a new index annotation that defines a full-text index
+// with the given text search configuration in PostgreSQL.
+//
+//	index.Fields("title").
+//		Annotations(
+//			entsql.TextSearchConfig("english"),
+//		)
+func TextSearchConfig(name string) *IndexAnnotation {
+	return &IndexAnnotation{FullText: true, TextSearchConfig: name}
+}
+
+// Spatial returns a new index annotation that defines a spatial index.
+// See IndexAnnotation.Spatial for more info.
+//
+//	index.Fields("location").
+//		Annotations(
+//			entsql.Spatial(),
+//		)
+func Spatial() *IndexAnnotation {
+	return &IndexAnnotation{Spatial: true}
+}
+
 // Name describes the annotation name.
 func (IndexAnnotation) Name() string {
 	return "EntSQLIndexes"
@@ -848,6 +1261,15 @@ func (a IndexAnnotation) Merge(other schema.Annotation) schema.Annotation {
 	if ant.Where != "" {
 		a.Where = ant.Where
 	}
+	if ant.FullText {
+		a.FullText = true
+	}
+	if ant.TextSearchConfig != "" {
+		a.TextSearchConfig = ant.TextSearchConfig
+	}
+	if ant.Spatial {
+		a.Spatial = true
+	}
 	return a
 }
 
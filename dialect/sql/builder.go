@@ -14,8 +14,11 @@ package sql
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -194,7 +197,8 @@ func (i *InsertBuilder) Default() *InsertBuilder {
 }
 
 // Returning adds the `RETURNING` clause to the insert statement.
-// Supported by SQLite and PostgreSQL.
+// Supported by SQLite and PostgreSQL. In SQL Server, the columns
+// are returned using the `OUTPUT` clause.
 func (i *InsertBuilder) Returning(columns ...string) *InsertBuilder {
 	i.returning = columns
 	return i
@@ -211,7 +215,7 @@ type (
 		}
 		action struct {
 			nothing bool
-			where   *Predicate
+			where   []*Predicate
 			update  []func(*UpdateSet)
 		}
 	}
@@ -264,9 +268,50 @@ func ConflictWhere(p *Predicate) ConflictOption {
 
 // UpdateWhere allows setting the update condition. Only rows
 // for which this expression returns true will be updated.
+//
+// Note that MySQL does not support the WHERE clause in the `ON DUPLICATE KEY UPDATE`
+// clause. Hence, each assignment is conditioned on the expression instead, and keeps
+// the column value in case it returns false. The expression is evaluated once, before
+// any of the columns is assigned, and its result is stored in a user variable.
 func UpdateWhere(p *Predicate) ConflictOption {
 	return func(c *conflict) {
-		c.action.where = p
+		c.action.where = []*Predicate{p}
+	}
+}
+
+// AndUpdateWhere adds the given predicate to the update condition using the AND
+// operator. Unlike UpdateWhere, it does not replace the condition that was set
+// by the previous options, and therefore, can be used for extending an upsert
+// that was configured by the user (e.g. by scoping it to a tenant).
+//
+//	sql.Insert("users").
+//		Columns("id", "name").
+//		Values(1, "Mashraki").
+//		OnConflict(
+//			sql.ConflictColumns("id"),
+//			sql.ResolveWithNewValues(),
+//			sql.UpdateWhere(sql.NEQ("updated_at", 0)),
+//			sql.AndUpdateWhere(sql.EQ("tenant", "t1")),
+//		)
+func AndUpdateWhere(p *Predicate) ConflictOption {
+	return func(c *conflict) {
+		c.action.where = append(c.action.where, p)
+	}
+}
+
+// updateWhere returns the update condition of the conflict
+// action, with its columns qualified by the given table.
+func (c *conflict) updateWhere(table string) *Predicate {
+	for _, p := range c.action.where {
+		p.qualifier = table
+	}
+	switch len(c.action.where) {
+	case 0:
+		return nil
+	case 1:
+		return c.action.where[0]
+	default:
+		return And(c.action.where...)
 	}
 }
 
@@ -417,6 +462,34 @@ func (u *UpdateSet) SetIgnore(name string) *UpdateSet {
 	return u.Set(name, Expr(u.Table().C(name)))
 }
 
+// updateWhereVar is the MySQL user variable that holds the result of the update condition.
+const updateWhereVar = "@ent_update_where"
+
+// setIf conditions all assignments of the set on the given predicate. i.e. each
+// column is set to IF(p, v, c), and keeps its current value in case p is false.
+// MySQL evaluates the assignments from left to right, and the columns that were
+// assigned are seen by the next assignments. Therefore, the predicate is evaluated
+// once by the first assignment, and its result is stored in a user variable that
+// is read by the rest of the assignments.
+func (u *UpdateSet) setIf(p *Predicate) {
+	ub := u.UpdateBuilder
+	columns := append(ub.nulls, ub.columns...)
+	values := append(make([]any, len(ub.nulls)), ub.values...)
+	for i, c := range columns {
+		i, c, v := i, c, values[i]
+		values[i] = ExprFunc(func(b *Builder) {
+			b.WriteString("IF(")
+			if i == 0 {
+				b.WriteString(updateWhereVar).WriteString(" := (").Join(p).WriteByte(')')
+			} else {
+				b.WriteString(updateWhereVar)
+			}
+			b.Comma().Arg(v).Comma().WriteString(u.Table().C(c)).WriteByte(')')
+		})
+	}
+	ub.nulls, ub.columns, ub.values = nil, columns, values
+}
+
 // SetExcluded sets the column name to its EXCLUDED/VALUES value.
 // For example, "c" = "excluded"."c", or `c` = VALUES(`c`).
 func (u *UpdateSet) SetExcluded(name string) *UpdateSet {
@@ -442,13 +515,22 @@ func (i *InsertBuilder) Query() (string, []any) {
 // statement and any error occurred in building the statement.
 func (i *InsertBuilder) QueryErr() (string, []any, error) {
 	b := i.Builder.clone()
+	// SQL Server does not support the "ON CONFLICT" clause,
+	// and upserts are executed using the MERGE statement.
+	if b.sqlserver() && i.conflict != nil {
+		i.writeMerge(&b)
+		return b.String(), b.args, b.Err()
+	}
 	b.WriteString("INSERT INTO ")
 	b.writeSchema(i.schema)
-	b.Ident(i.table).Pad()
+	b.Ident(i.table)
 	if i.defaults && len(i.columns) == 0 {
+		joinOutput("INSERTED", i.returning, &b)
+		b.Pad()
 		i.writeDefault(&b)
 	} else {
-		b.WriteByte('(').IdentComma(i.columns...).WriteByte(')')
+		b.WriteString(" (").IdentComma(i.columns...).WriteByte(')')
+		joinOutput("INSERTED", i.returning, &b)
 		b.WriteString(" VALUES ")
 		for j, v := range i.values {
 			if j > 0 {
@@ -468,11 +550,71 @@ func (i *InsertBuilder) writeDefault(b *Builder) {
 	switch i.Dialect() {
 	case dialect.MySQL:
 		b.WriteString("VALUES ()")
-	case dialect.SQLite, dialect.Postgres:
+	case dialect.SQLite, dialect.Postgres, dialect.SQLServer:
 		b.WriteString("DEFAULT VALUES")
 	}
 }
 
+// writeMerge writes the MERGE statement that is used by SQL Server for executing upserts.
+// The inserted values are used as the source table of the statement, and they are named
+// "excluded" for compatibility with the UpdateSet functions. For example:
+//
+//	MERGE INTO [users] WITH (HOLDLOCK)
+//	USING (VALUES (@p1, @p2)) AS [excluded] ([id], [name]) ON [users].[id] = [excluded].[id]
+//	WHEN MATCHED THEN UPDATE SET [name] = [excluded].[name]
+//	WHEN NOT MATCHED THEN INSERT ([id], [name]) VALUES ([excluded].[id], [excluded].[name])
+//	OUTPUT INSERTED.[id];
+func (i *InsertBuilder) writeMerge(b *Builder) {
+	switch t := i.conflict.target; {
+	case t.constraint != "":
+		b.AddError(fmt.Errorf("sql: conflict constraint %q is not supported by SQL Server", t.constraint))
+	case t.where != nil:
+		b.AddError(errors.New("sql: conflict target predicate is not supported by SQL Server"))
+	case len(t.columns) == 0:
+		b.AddError(errors.New("sql: missing conflict columns for SQL Server MERGE statement"))
+	case len(i.columns) == 0:
+		b.AddError(errors.New("sql: missing insert columns for SQL Server MERGE statement"))
+	}
+	excluded := Dialect(i.dialect).Table("excluded")
+	target := Dialect(i.dialect).Table(i.table).Schema(i.schema)
+	b.WriteString("MERGE INTO ")
+	b.writeSchema(i.schema)
+	b.Ident(i.table).WriteString(" WITH (HOLDLOCK) USING (VALUES ")
+	for j, v := range i.values {
+		if j > 0 {
+			b.Comma()
+		}
+		b.WriteByte('(').Args(v...).WriteByte(')')
+	}
+	b.WriteString(") AS ").Ident(excluded.name).WriteString(" (").IdentComma(i.columns...).WriteString(") ON ")
+	for j, c := range i.conflict.target.columns {
+		if j > 0 {
+			b.WriteString(" AND ")
+		}
+		b.Ident(target.C(c)).WriteString(" = ").Ident(excluded.C(c))
+	}
+	if !i.conflict.action.nothing {
+		if len(i.conflict.action.update) == 0 {
+			b.AddError(errors.New("missing action for 'WHEN MATCHED' clause"))
+		}
+		u := &UpdateSet{UpdateBuilder: Dialect(i.dialect).Update(i.table), columns: i.columns}
+		for _, f := range i.conflict.action.update {
+			f(u)
+		}
+		b.WriteString(" WHEN MATCHED")
+		if p := i.conflict.updateWhere(i.table); p != nil {
+			b.WriteString(" AND ").Join(p)
+		}
+		b.WriteString(" THEN UPDATE SET ")
+		u.writeSetter(b)
+	}
+	b.WriteString(" WHEN NOT MATCHED THEN INSERT (").IdentComma(i.columns...).WriteString(") VALUES (").
+		IdentComma(excluded.Columns(i.columns...)...).WriteByte(')')
+	joinOutput("INSERTED", i.returning, b)
+	// MERGE statements must be terminated by a semicolon.
+	b.WriteByte(';')
+}
+
 func (i *InsertBuilder) writeConflict(b *Builder) {
 	switch i.Dialect() {
 	case dialect.MySQL:
@@ -509,9 +651,12 @@ func (i *InsertBuilder) writeConflict(b *Builder) {
 	for _, f := range i.conflict.action.update {
 		f(u)
 	}
+	p := i.conflict.updateWhere(i.table)
+	if p != nil && i.Dialect() == dialect.MySQL {
+		u.setIf(p)
+	}
 	u.writeSetter(b)
-	if p := i.conflict.action.where; p != nil {
-		p.qualifier = i.table
+	if p != nil && i.Dialect() != dialect.MySQL {
 		b.WriteString(" WHERE ").Join(p)
 	}
 }
@@ -556,6 +701,17 @@ func (u *UpdateBuilder) Set(column string, v any) *UpdateBuilder {
 	return u
 }
 
+// Value returns the value that was set to the given column using Set, if there is one.
+// It allows modifiers to build the new value of a column on top of its previous value.
+func (u *UpdateBuilder) Value(column string) (any, bool) {
+	for i := range u.columns {
+		if column == u.columns[i] {
+			return u.values[i], true
+		}
+	}
+	return nil, false
+}
+
 // Add adds a numeric value to the given column. Note that, calling Set(c)
 // after Add(c) will erase previous calls with c from the builder.
 func (u *UpdateBuilder) Add(column string, v any) *UpdateBuilder {
@@ -604,8 +760,8 @@ func (u *UpdateBuilder) Empty() bool {
 // OrderBy appends the `ORDER BY` clause to the `UPDATE` statement.
 // Supported by SQLite and MySQL.
 func (u *UpdateBuilder) OrderBy(columns ...string) *UpdateBuilder {
-	if u.postgres() {
-		u.AddError(errors.New("ORDER BY is not supported by PostgreSQL"))
+	if u.postgres() || u.sqlserver() {
+		u.AddError(fmt.Errorf("ORDER BY is not supported by %s", dialectName(u.dialect)))
 		return u
 	}
 	for i := range columns {
@@ -617,8 +773,8 @@ func (u *UpdateBuilder) OrderBy(columns ...string) *UpdateBuilder {
 // Limit appends the `LIMIT` clause to the `UPDATE` statement.
 // Supported by SQLite and MySQL.
 func (u *UpdateBuilder) Limit(limit int) *UpdateBuilder {
-	if u.postgres() {
-		u.AddError(errors.New("LIMIT is not supported by PostgreSQL"))
+	if u.postgres() || u.sqlserver() {
+		u.AddError(fmt.Errorf("LIMIT is not supported by %s", dialectName(u.dialect)))
 		return u
 	}
 	u.limit = &limit
@@ -631,8 +787,9 @@ func (u *UpdateBuilder) Prefix(stmts ...Querier) *UpdateBuilder {
 	return u
 }
 
-// Returning adds the `RETURNING` clause to the insert statement.
-// Supported by SQLite and PostgreSQL.
+// Returning adds the `RETURNING` clause to the update statement.
+// Supported by SQLite and PostgreSQL. In SQL Server, the columns
+// are returned using the `OUTPUT` clause.
 func (u *UpdateBuilder) Returning(columns ...string) *UpdateBuilder {
 	u.returning = columns
 	return u
@@ -649,6 +806,7 @@ func (u *UpdateBuilder) Query() (string, []any) {
 	b.writeSchema(u.schema)
 	b.Ident(u.table).WriteString(" SET ")
 	u.writeSetter(&b)
+	joinOutput("INSERTED", u.returning, &b)
 	if u.where != nil {
 		b.WriteString(" WHERE ")
 		b.Join(u.where)
@@ -690,9 +848,10 @@ func (u *UpdateBuilder) writeSetter(b *Builder) {
 // DeleteBuilder is a builder for `DELETE` statement.
 type DeleteBuilder struct {
 	Builder
-	table  string
-	schema string
-	where  *Predicate
+	table     string
+	schema    string
+	where     *Predicate
+	returning []string
 }
 
 // Delete creates a builder for the `DELETE` statement.
@@ -735,15 +894,25 @@ func (d *DeleteBuilder) FromSelect(s *Selector) *DeleteBuilder {
 	return d
 }
 
+// Returning adds the `RETURNING` clause to the delete statement.
+// Supported by SQLite and PostgreSQL. In SQL Server, the columns
+// are returned using the `OUTPUT` clause.
+func (d *DeleteBuilder) Returning(columns ...string) *DeleteBuilder {
+	d.returning = columns
+	return d
+}
+
 // Query returns query representation of a `DELETE` statement.
 func (d *DeleteBuilder) Query() (string, []any) {
 	d.WriteString("DELETE FROM ")
 	d.writeSchema(d.schema)
 	d.Ident(d.table)
+	joinOutput("DELETED", d.returning, &d.Builder)
 	if d.where != nil {
 		d.WriteString(" WHERE ")
 		d.Join(d.where)
 	}
+	joinReturning(d.returning, &d.Builder)
 	return d.String(), d.args
 }
 
@@ -790,6 +959,11 @@ func False() *Predicate {
 // False appends FALSE to the predicate.
 func (p *Predicate) False() *Predicate {
 	return p.Append(func(b *Builder) {
+		// SQL Server does not support boolean literals.
+		if b.sqlserver() {
+			b.WriteString("1 = 0")
+			return
+		}
 		b.WriteString("FALSE")
 	})
 }
@@ -843,6 +1017,11 @@ func IsTrue(col string) *Predicate {
 func (p *Predicate) IsTrue(col string) *Predicate {
 	return p.Append(func(b *Builder) {
 		b.Ident(col)
+		// SQL Server does not support boolean expressions
+		// on BIT columns, and they are compared explicitly.
+		if b.sqlserver() {
+			b.WriteString(" = 1")
+		}
 	})
 }
 
@@ -854,6 +1033,10 @@ func IsFalse(col string) *Predicate {
 // IsFalse appends a predicate that checks if the column value is falsey.
 func (p *Predicate) IsFalse(col string) *Predicate {
 	return p.Append(func(b *Builder) {
+		if b.sqlserver() {
+			b.Ident(col).WriteString(" = 0")
+			return
+		}
 		b.WriteString("NOT ").Ident(col)
 	})
 }
@@ -1190,12 +1373,22 @@ func escape(w string) (string, bool) {
 	return b.String(), true
 }
 
+// escapeLike escapes w using escape, and the SQL Server "[" wildcard character
+// in case the given dialect is SQL Server.
+func escapeLike(d, w string) (string, bool) {
+	w, escaped := escape(w)
+	if d == dialect.SQLServer && strings.Contains(w, "[") {
+		w, escaped = strings.ReplaceAll(w, "[", "\\["), true
+	}
+	return w, escaped
+}
+
 func (p *Predicate) escapedLike(col, left, right, word string) *Predicate {
 	return p.Append(func(b *Builder) {
-		w, escaped := escape(word)
+		w, escaped := escapeLike(p.dialect, word)
 		b.Ident(col).WriteOp(OpLike)
 		b.Arg(left + w + right)
-		if p.dialect == dialect.SQLite && escaped {
+		if (p.dialect == dialect.SQLite || p.dialect == dialect.SQLServer) && escaped {
 			p.WriteString(" ESCAPE ").Arg("\\")
 		}
 	})
@@ -1204,7 +1397,7 @@ func (p *Predicate) escapedLike(col, left, right, word string) *Predicate {
 // ContainsFold is a helper predicate that applies the LIKE predicate with case-folding.
 func (p *Predicate) escapedLikeFold(col, left, substr, right string) *Predicate {
 	return p.Append(func(b *Builder) {
-		w, escaped := escape(substr)
+		w, escaped := escapeLike(b.dialect, substr)
 		switch b.dialect {
 		case dialect.MySQL:
 			// We assume the CHARACTER SET is configured to utf8mb4,
@@ -1214,7 +1407,7 @@ func (p *Predicate) escapedLikeFold(col, left, substr, right string) *Predicate
 		case dialect.Postgres:
 			b.Ident(col).WriteString(" ILIKE ")
 			b.Arg(left + strings.ToLower(w) + right)
-		default: // SQLite.
+		default: // SQLite and SQL Server.
 			var f Func
 			f.SetDialect(b.dialect)
 			f.Lower(col)
@@ -1267,6 +1460,11 @@ func (p *Predicate) ColumnsHasPrefix(col, prefixC string) *Predicate {
 			if p.dialect == dialect.SQLite {
 				p.WriteString(" ESCAPE ").Arg("\\")
 			}
+		case dialect.SQLServer:
+			b.Ident(col)
+			b.WriteOp(OpLike)
+			b.S("(REPLACE(REPLACE(REPLACE(").Ident(prefixC).S(", '[', '\\['), '_', '\\_'), '%', '\\%') + '%')")
+			p.WriteString(" ESCAPE ").Arg("\\")
 		default:
 			b.AddError(fmt.Errorf("ColumnsHasPrefix: unsupported dialect: %q", p.dialect))
 		}
@@ -1307,7 +1505,7 @@ func (p *Predicate) EqualFold(col, sub string) *Predicate {
 			b.Ident(col).WriteString(" ILIKE ")
 			w, _ := escape(sub)
 			b.Arg(strings.ToLower(w))
-		default: // SQLite.
+		default: // SQLite and SQL Server.
 			f.Lower(col)
 			b.WriteString(f.String())
 			b.WriteOp(OpEQ)
@@ -1332,6 +1530,182 @@ func (p *Predicate) ContainsFold(col, substr string) *Predicate {
 	return p.escapedLikeFold(col, "%", substr, "%")
 }
 
+// SearchOptions holds the options of full-text search predicates and rank expressions.
+type SearchOptions struct {
+	// Index is the name of the full-text index. It is required by SQLite, where
+	// full-text indexes are stored in FTS5 tables that are named after the index.
+	Index string
+	// Config is the text search configuration in PostgreSQL. Defaults to "simple".
+	Config string
+}
+
+// SearchOption allows configuring the full-text search using functional options.
+type SearchOption func(*SearchOptions)
+
+// SearchIndex sets the name of the full-text index that is used by the search.
+func SearchIndex(name string) SearchOption {
+	return func(o *SearchOptions) {
+		o.Index = name
+	}
+}
+
+// SearchConfig sets the text search configuration that is used by the search in PostgreSQL.
+func SearchConfig(name string) SearchOption {
+	return func(o *SearchOptions) {
+		o.Config = name
+	}
+}
+
+// newSearchOptions returns the SearchOptions of the given options.
+func newSearchOptions(opts []SearchOption) *SearchOptions {
+	o := &SearchOptions{Config: "simple"}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Search returns a full-text search predicate on the given columns. In MySQL, the columns
+// must match the columns of a FULLTEXT index. In PostgreSQL, the predicate uses indexes on
+// to_tsvector expressions with the same configuration. In SQLite, the search is executed
+// on the FTS5 table of the index.
+//
+//	Search([]string{"title"}, "ent orm", SearchIndex("posts_title"))
+func Search(columns []string, query string, opts ...SearchOption) *Predicate {
+	return P().Search(columns, query, opts...)
+}
+
+// Search appends a full-text search predicate on the given columns.
+func (p *Predicate) Search(columns []string, query string, opts ...SearchOption) *Predicate {
+	o := newSearchOptions(opts)
+	return p.Append(func(b *Builder) {
+		switch b.dialect {
+		case dialect.MySQL:
+			b.matchAgainst(columns, query)
+		case dialect.Postgres:
+			b.Wrap(func(b *Builder) {
+				for i, c := range columns {
+					if i > 0 {
+						b.WriteString(" OR ")
+					}
+					b.tsVector(o.Config, c).WriteString(" @@ ").tsQuery(o.Config, query)
+				}
+			})
+		case dialect.SQLServer:
+			// Errors are reported by the builder. The predicate is replaced
+			// with a false expression to keep the statement well-formed.
+			b.AddError(errors.New("sql: full-text search is not supported by SQL Server")).WriteString("1 = 0")
+		default: // SQLite.
+			if o.Index == "" {
+				b.AddError(errors.New("sql: missing full-text index name for search")).WriteString("1 = 0")
+				return
+			}
+			b.WriteString(rowidOf(columns)).WriteOp(OpIn).Wrap(func(b *Builder) {
+				b.WriteString("SELECT rowid FROM ").Ident(o.Index).
+					WriteString(" WHERE ").Ident(o.Index).
+					WriteString(" MATCH ").Arg(ftsQuery(columns, query))
+			})
+		}
+	})
+}
+
+// SearchRank returns an expression that evaluates to the full-text search rank of the
+// given columns. In all dialects, higher ranks indicate more relevant results. In SQLite,
+// the columns must be qualified with their table (e.g. using Selector.C).
+//
+//	Select().
+//		From(Table("posts")).
+//		Where(Search([]string{"title"}, "ent")).
+//		OrderExpr(DescExpr(SearchRank([]string{"title"}, "ent")))
+func SearchRank(columns []string, query string, opts ...SearchOption) Querier {
+	o := newSearchOptions(opts)
+	return ExprFunc(func(b *Builder) {
+		switch b.dialect {
+		case dialect.MySQL:
+			b.matchAgainst(columns, query)
+		case dialect.Postgres:
+			b.WriteString("ts_rank(")
+			for i, c := range columns {
+				if i > 0 {
+					b.WriteString(" || ")
+				}
+				b.tsVector(o.Config, c)
+			}
+			b.Comma().tsQuery(o.Config, query).WriteByte(')')
+		case dialect.SQLServer:
+			// Errors are reported by the builder. The rank is replaced
+			// with NULL to keep the statement well-formed.
+			b.AddError(errors.New("sql: full-text search is not supported by SQL Server")).WriteString("NULL")
+		default: // SQLite.
+			rowid := rowidOf(columns)
+			switch {
+			case o.Index == "":
+				b.AddError(errors.New("sql: missing full-text index name for search rank")).WriteString("NULL")
+				return
+			// The rank is computed in a subquery on the FTS5 table, where an
+			// unqualified rowid refers to the FTS5 table and not to the outer one.
+			case !strings.Contains(rowid, "."):
+				b.AddError(errors.New("sql: columns of full-text search rank must be qualified with their table")).WriteString("NULL")
+				return
+			}
+			// FTS5 ranks are based on bm25, where lower values indicate more relevant results.
+			b.Wrap(func(b *Builder) {
+				b.WriteString("SELECT -rank FROM ").Ident(o.Index).
+					WriteString(" WHERE ").Ident(o.Index).
+					WriteString(" MATCH ").Arg(ftsQuery(columns, query)).
+					WriteString(" AND rowid = ").WriteString(rowid)
+			})
+		}
+	})
+}
+
+// matchAgainst writes the MySQL MATCH ... AGAINST expression.
+func (b *Builder) matchAgainst(columns []string, query string) *Builder {
+	b.WriteString("MATCH ").Wrap(func(b *Builder) {
+		b.IdentComma(columns...)
+	})
+	return b.WriteString(" AGAINST ").Wrap(func(b *Builder) {
+		b.Arg(query).WriteString(" IN NATURAL LANGUAGE MODE")
+	})
+}
+
+// tsVector writes the PostgreSQL to_tsvector expression of the given column.
+func (b *Builder) tsVector(config, column string) *Builder {
+	return b.WriteString("to_tsvector(").WriteString(quoteLiteral(config)).Comma().Ident(column).WriteByte(')')
+}
+
+// tsQuery writes the PostgreSQL websearch_to_tsquery expression of the given query.
+func (b *Builder) tsQuery(config, query string) *Builder {
+	return b.WriteString("websearch_to_tsquery(").WriteString(quoteLiteral(config)).Comma().Arg(query).WriteByte(')')
+}
+
+// quoteLiteral quotes the given string as an SQL string literal.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// rowidOf returns the rowid column of the table that the given columns are qualified with.
+func rowidOf(columns []string) string {
+	if len(columns) > 0 {
+		if i := strings.LastIndexByte(columns[0], '.'); i != -1 {
+			return columns[0][:i+1] + "rowid"
+		}
+	}
+	return "rowid"
+}
+
+// ftsQuery returns an FTS5 query that limits the given query to the given columns.
+func ftsQuery(columns []string, query string) string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		if j := strings.LastIndexByte(c, '.'); j != -1 {
+			c = c[j+1:]
+		}
+		names[i] = strings.Trim(c, "`\"")
+	}
+	return fmt.Sprintf("{%s} : (%s)", strings.Join(names, " "), query)
+}
+
 // CompositeGT returns a composite ">" predicate
 func CompositeGT(columns []string, args ...any) *Predicate {
 	return P().CompositeGT(columns, args...)
@@ -1654,11 +2028,81 @@ func (s *SelectTable) ref() string {
 // implement the table view.
 func (*SelectTable) view() {}
 
+// TableFunc is a table-valued function (e.g. json_each, jsonb_array_elements)
+// that can be used as a TableView in the FROM and JOIN clauses.
+type TableFunc struct {
+	Builder
+	name    string
+	args    []any
+	as      string
+	columns []string
+}
+
+// TableFunction returns a new table-valued function with the given name and arguments.
+// Arguments that implement the Querier interface (e.g. Expr) are added to the query as
+// is, and other arguments are added as query parameters.
+//
+//	users := Table("users")
+//	tags := TableFunction("jsonb_array_elements_text", Expr(users.C("tags"))).As("t", "tag")
+//	Select(users.C("name"), tags.C("tag")).From(users).CrossJoin(tags)
+func TableFunction(name string, args ...any) *TableFunc {
+	return &TableFunc{name: name, args: args}
+}
+
+// As sets the alias of the function, and optionally the aliases of the columns it returns.
+// Note that column aliases are not supported by SQLite and MySQL.
+func (t *TableFunc) As(alias string, columns ...string) *TableFunc {
+	t.as, t.columns = alias, columns
+	return t
+}
+
+// C returns a formatted string for the column that is returned by the function.
+func (t *TableFunc) C(column string) string {
+	b := &Builder{dialect: t.dialect}
+	if t.as != "" {
+		b.Ident(t.as).WriteByte('.')
+	}
+	b.Ident(column)
+	return b.String()
+}
+
+// Query returns query representation of the function call.
+func (t *TableFunc) Query() (string, []any) {
+	b := t.Builder.clone()
+	b.WriteString(t.name)
+	b.Wrap(func(b *Builder) {
+		for i, a := range t.args {
+			if i > 0 {
+				b.Comma()
+			}
+			if x, ok := a.(Querier); ok {
+				b.Join(x)
+			} else {
+				b.Arg(a)
+			}
+		}
+	})
+	if t.as != "" {
+		b.WriteString(" AS ")
+		b.Ident(t.as)
+		if len(t.columns) > 0 {
+			b.Wrap(func(b *Builder) {
+				b.IdentComma(t.columns...)
+			})
+		}
+	}
+	return b.String(), b.args
+}
+
+// implement the table view.
+func (*TableFunc) view() {}
+
 // join table option.
 type join struct {
-	on    *Predicate
-	kind  string
-	table TableView
+	on      *Predicate
+	kind    string
+	table   TableView
+	lateral bool
 }
 
 // clone a joiner.
@@ -1687,6 +2131,7 @@ type Selector struct {
 	order     []any
 	group     []string
 	having    *Predicate
+	windows   []namedWindow
 	limit     *int
 	offset    *int
 	distinct  bool
@@ -1813,17 +2258,13 @@ func (s *Selector) AppendSelectExprAs(expr Querier, as string) *Selector {
 // For example, for column "a" the following match: a, "a", "t"."a", "t"."b" AS "a".
 func (s *Selector) FindSelection(name string) (matches []string) {
 	matchC := func(qualified string) bool {
-		switch ident, pg := s.isIdent(qualified), s.postgres(); {
+		switch ident := s.isIdent(qualified); {
 		case !ident:
 			if i := strings.IndexRune(qualified, '.'); i > 0 {
 				return qualified[i+1:] == name
 			}
-		case ident && pg:
-			if i := strings.Index(qualified, `"."`); i > 0 {
-				return s.unquote(qualified[i+2:]) == name
-			}
 		case ident:
-			if i := strings.Index(qualified, "`.`"); i > 0 {
+			if i := strings.Index(qualified, s.qualifiedSep()); i > 0 {
 				return s.unquote(qualified[i+2:]) == name
 			}
 		}
@@ -2031,7 +2472,7 @@ func selectTable(t TableView) *SelectTable {
 			return nil
 		}
 		return selectTable(view.from[0])
-	case *queryView, *WithBuilder:
+	case *queryView, *WithBuilder, *TableFunc:
 		return nil
 	default:
 		panic(fmt.Sprintf("unexpected TableView %T", t))
@@ -2073,6 +2514,10 @@ func (s *Selector) JoinedTableView(name string) (TableView, bool) {
 			if t.name == name || t.as == name {
 				return t, true
 			}
+		case *TableFunc:
+			if t.as == name {
+				return t, true
+			}
 		case *Selector:
 			if t.as == name {
 				return t, true
@@ -2107,6 +2552,46 @@ func (s *Selector) FullJoin(t TableView) *Selector {
 	return s.join("FULL JOIN", t)
 }
 
+// CrossJoin appends a `CROSS JOIN` clause to the statement. Table-valued functions
+// that are joined this way can reference the columns of the preceding tables.
+//
+//	users := Table("users")
+//	tags := TableFunction("json_each", Expr(users.C("tags")))
+//	Select(users.C("name"), tags.C("value")).From(users).CrossJoin(tags)
+func (s *Selector) CrossJoin(t TableView) *Selector {
+	return s.join("CROSS JOIN", t)
+}
+
+// JoinLateral appends a `JOIN LATERAL` clause to the statement. Unlike regular joins,
+// the joined subquery can reference the columns of the preceding tables. For example,
+// selecting the 3 most recent posts of each user:
+//
+//	users, posts := Table("users"), Table("posts")
+//	recent := Select(posts.C("title")).
+//		From(posts).
+//		Where(ColumnsEQ(posts.C("author_id"), users.C("id"))).
+//		OrderBy(Desc(posts.C("created_at"))).
+//		Limit(3).
+//		As("recent")
+//	Select(users.C("name"), recent.C("title")).From(users).JoinLateral(recent)
+//
+// If no `ON` clause is set, all rows of the subquery are joined (i.e. `ON TRUE`). SQL Server
+// expresses lateral joins using `CROSS APPLY`, that does not support the `ON` clause, and
+// SQLite supports lateral joins only of table-valued functions.
+func (s *Selector) JoinLateral(t TableView) *Selector {
+	s.join("JOIN", t)
+	s.joins[len(s.joins)-1].lateral = true
+	return s
+}
+
+// LeftJoinLateral appends a `LEFT JOIN LATERAL` clause to the statement. See JoinLateral
+// for more details. SQL Server expresses left lateral joins using `OUTER APPLY`.
+func (s *Selector) LeftJoinLateral(t TableView) *Selector {
+	s.join("LEFT JOIN", t)
+	s.joins[len(s.joins)-1].lateral = true
+	return s
+}
+
 // join adds a join table to the selector with the given kind.
 func (s *Selector) join(kind string, t TableView) *Selector {
 	s.joins = append(s.joins, join{
@@ -2122,6 +2607,10 @@ func (s *Selector) join(kind string, t TableView) *Selector {
 		if view.as == "" {
 			view.as = "t" + strconv.Itoa(len(s.joins))
 		}
+	case *TableFunc:
+		if view.as == "" {
+			view.as = "t" + strconv.Itoa(len(s.joins))
+		}
 	}
 	if st, ok := t.(state); ok {
 		st.SetDialect(s.dialect)
@@ -2361,7 +2850,8 @@ func WithLockClause(clause string) LockOption {
 }
 
 // For sets the lock configuration for suffixing the `SELECT`
-// statement with the `FOR [SHARE | UPDATE] ...` clause.
+// statement with the `FOR [SHARE | UPDATE] ...` clause. In SQL
+// Server, the lock is applied using table hints on the locked tables.
 func (s *Selector) For(l LockStrength, opts ...LockOption) *Selector {
 	if s.Dialect() == dialect.SQLite {
 		s.AddError(errors.New("sql: SELECT .. FOR UPDATE/SHARE not supported in SQLite"))
@@ -2410,6 +2900,7 @@ func (s *Selector) Clone() *Selector {
 		joins:     append([]join{}, joins...),
 		group:     append([]string{}, s.group...),
 		order:     append([]any{}, s.order...),
+		windows:   append([]namedWindow{}, s.windows...),
 		selection: append([]selection{}, s.selection...),
 	}
 }
@@ -2491,6 +2982,24 @@ func (s *Selector) Having(p *Predicate) *Selector {
 	return s
 }
 
+// namedWindow represents a window definition in the `WINDOW` clause.
+type namedWindow struct {
+	name string
+	spec *WindowBuilder
+}
+
+// Window appends a named window definition to the `WINDOW` clause of the `SELECT`
+// statement. Window functions can reference it using WindowBuilder.OverWindow.
+//
+//	Select().
+//		AppendSelectExprAs(Rank().OverWindow("w"), "rank").
+//		From(Table("scores")).
+//		Window("w", WindowSpec().PartitionBy("game_id").OrderBy(Desc("score")))
+func (s *Selector) Window(name string, spec *WindowBuilder) *Selector {
+	s.windows = append(s.windows, namedWindow{name: name, spec: spec})
+	return s
+}
+
 // Query returns query representation of a `SELECT` statement.
 func (s *Selector) Query() (string, []any) {
 	b := s.Builder.clone()
@@ -2515,6 +3024,7 @@ func (s *Selector) Query() (string, []any) {
 		case *SelectTable:
 			t.SetDialect(s.dialect)
 			b.WriteString(t.ref())
+			s.joinLockHint(&b, t)
 		case *Selector:
 			t.SetDialect(s.dialect)
 			b.Wrap(func(b *Builder) {
@@ -2527,16 +3037,19 @@ func (s *Selector) Query() (string, []any) {
 		case *WithBuilder:
 			t.SetDialect(s.dialect)
 			b.Ident(t.Name())
+		case *TableFunc:
+			b.Join(t)
 		case *queryView:
 			b.Join(t.Querier)
 		}
 	}
 	for _, join := range s.joins {
-		b.WriteString(" " + join.kind + " ")
+		s.joinKind(&b, join)
 		switch view := join.table.(type) {
 		case *SelectTable:
 			view.SetDialect(s.dialect)
 			b.WriteString(view.ref())
+			s.joinLockHint(&b, view)
 		case *Selector:
 			view.SetDialect(s.dialect)
 			b.Wrap(func(b *Builder) {
@@ -2547,10 +3060,15 @@ func (s *Selector) Query() (string, []any) {
 		case *WithBuilder:
 			view.SetDialect(s.dialect)
 			b.Ident(view.Name())
+		case *TableFunc:
+			b.Join(view)
 		}
-		if join.on != nil {
+		switch {
+		case join.on != nil:
 			b.WriteString(" ON ")
 			b.Join(join.on)
+		case join.lateral && !b.sqlserver():
+			b.WriteString(" ON TRUE")
 		}
 	}
 	if s.where != nil {
@@ -2565,24 +3083,83 @@ func (s *Selector) Query() (string, []any) {
 		b.WriteString(" HAVING ")
 		b.Join(s.having)
 	}
+	for i, w := range s.windows {
+		if i == 0 {
+			b.WriteString(" WINDOW ")
+		} else {
+			b.Comma()
+		}
+		b.Ident(w.name).WriteString(" AS ").Wrap(w.spec.spec)
+	}
 	if len(s.setOps) > 0 {
 		s.joinSetOps(&b)
 	}
 	joinOrder(s.order, &b)
-	if s.limit != nil {
-		b.WriteString(" LIMIT ")
-		b.WriteString(strconv.Itoa(*s.limit))
-	}
-	if s.offset != nil {
-		b.WriteString(" OFFSET ")
-		b.WriteString(strconv.Itoa(*s.offset))
+	switch {
+	case b.sqlserver():
+		s.joinFetch(&b)
+	default:
+		if s.limit != nil {
+			b.WriteString(" LIMIT ")
+			b.WriteString(strconv.Itoa(*s.limit))
+		}
+		if s.offset != nil {
+			b.WriteString(" OFFSET ")
+			b.WriteString(strconv.Itoa(*s.offset))
+		}
+		s.joinLock(&b)
 	}
-	s.joinLock(&b)
 	s.total = b.total
 	s.AddError(b.Err())
 	return b.String(), b.args
 }
 
+// joinKind writes the kind of the given join. Joins that cannot be expressed
+// in the dialect are reported by joinErr.
+func (s *Selector) joinKind(b *Builder, j join) {
+	_, derived := j.table.(*Selector)
+	_, fn := j.table.(*TableFunc)
+	switch {
+	case j.kind == "CROSS JOIN" && fn && b.sqlserver():
+		// Functions that reference the preceding tables must be applied in SQL Server.
+		b.WriteString(" CROSS APPLY ")
+	case j.lateral && b.sqlserver() && j.kind == "LEFT JOIN":
+		b.WriteString(" OUTER APPLY ")
+	case j.lateral && b.sqlserver():
+		b.WriteString(" CROSS APPLY ")
+	case j.lateral && derived:
+		b.WriteString(" " + j.kind + " LATERAL ")
+	default:
+		// Table-valued functions can reference the preceding tables without the LATERAL keyword.
+		b.WriteString(" " + j.kind + " ")
+	}
+}
+
+// joinErr reports if the given join cannot be expressed in the dialect of the selector.
+func (s *Selector) joinErr(j join) error {
+	_, derived := j.table.(*Selector)
+	switch {
+	case j.kind == "CROSS JOIN" && j.on != nil:
+		return errors.New("sql: ON clause is not supported by CROSS JOIN")
+	case j.lateral && s.sqlserver() && j.on != nil:
+		return errors.New("sql: ON clause is not supported by lateral joins in SQL Server")
+	case j.lateral && derived && s.sqlite():
+		return errors.New("sql: LATERAL joins of subqueries are not supported by SQLite")
+	}
+	return nil
+}
+
+// Err returns a concatenated error of all errors encountered during
+// the query-building, including joins that cannot be expressed in the
+// dialect of the selector.
+func (s *Selector) Err() error {
+	b := Builder{errs: append([]error(nil), s.errs...)}
+	for _, j := range s.joins {
+		b.AddError(s.joinErr(j))
+	}
+	return b.Err()
+}
+
 func (s *Selector) joinPrefix(b *Builder) {
 	if len(s.prefix) > 0 {
 		b.join(s.prefix, " ")
@@ -2608,6 +3185,55 @@ func (s *Selector) joinLock(b *Builder) {
 	}
 }
 
+// joinFetch writes the `OFFSET ... FETCH` clause that is used by SQL Server for paging.
+// The clause requires an `ORDER BY` clause, and therefore, an arbitrary order is used in
+// case the query is not ordered.
+func (s *Selector) joinFetch(b *Builder) {
+	if s.limit == nil && s.offset == nil {
+		return
+	}
+	if len(s.order) == 0 {
+		b.WriteString(" ORDER BY (SELECT NULL)")
+	}
+	offset := 0
+	if s.offset != nil {
+		offset = *s.offset
+	}
+	b.WriteString(" OFFSET ").WriteString(strconv.Itoa(offset)).WriteString(" ROWS")
+	if s.limit != nil {
+		b.WriteString(" FETCH NEXT ").WriteString(strconv.Itoa(*s.limit)).WriteString(" ROWS ONLY")
+	}
+}
+
+// joinLockHint writes the table hint that is used by SQL Server for locking the rows
+// that are read from the given table, in case the statement is locked. For example:
+//
+//	SELECT * FROM [users] WITH (UPDLOCK, ROWLOCK) WHERE [id] = @p1
+func (s *Selector) joinLockHint(b *Builder, t *SelectTable) {
+	if s.lock == nil || !b.sqlserver() {
+		return
+	}
+	if len(s.lock.Tables) > 0 && !slices.Contains(s.lock.Tables, t.name) && (t.as == "" || !slices.Contains(s.lock.Tables, t.as)) {
+		return
+	}
+	b.Pad()
+	if s.lock.clause != "" {
+		b.WriteString(s.lock.clause)
+		return
+	}
+	hints := []string{"REPEATABLEREAD", "ROWLOCK"}
+	if s.lock.Strength == LockUpdate || s.lock.Strength == LockNoKeyUpdate {
+		hints[0] = "UPDLOCK"
+	}
+	switch s.lock.Action {
+	case NoWait:
+		hints = append(hints, "NOWAIT")
+	case SkipLocked:
+		hints = append(hints, "READPAST")
+	}
+	b.WriteString("WITH (").WriteString(strings.Join(hints, ", ")).WriteByte(')')
+}
+
 func (s *Selector) joinSetOps(b *Builder) {
 	for _, op := range s.setOps {
 		b.WriteString(" " + string(op.Type) + " ")
@@ -2655,6 +3281,22 @@ func joinReturning(columns []string, b *Builder) {
 	b.IdentComma(columns...)
 }
 
+// joinOutput writes the `OUTPUT` clause that is used by SQL Server instead of
+// the `RETURNING` clause. The columns are read from the given pseudo table (i.e.
+// INSERTED or DELETED). For example, OUTPUT INSERTED.[id].
+func joinOutput(table string, columns []string, b *Builder) {
+	if len(columns) == 0 || !b.sqlserver() {
+		return
+	}
+	b.WriteString(" OUTPUT ")
+	for i, c := range columns {
+		if i > 0 {
+			b.Comma()
+		}
+		b.WriteString(table).WriteByte('.').Ident(c)
+	}
+}
+
 func (s *Selector) joinSelect(b *Builder) {
 	for i, sc := range s.selection {
 		if i > 0 {
@@ -2770,14 +3412,17 @@ func (w *WithBuilder) Query() (string, []any) {
 // implement the table view interface.
 func (*WithBuilder) view() {}
 
-// WindowBuilder represents a builder for a window clause.
-// Note that window functions support is limited and used
-// only to query rows-limited edges in pagination.
+// WindowBuilder represents a builder for a window function call, or
+// for a window specification that is defined using Selector.Window.
 type WindowBuilder struct {
 	Builder
 	fn        func(*Builder) // e.g. ROW_NUMBER(), RANK()
+	base      string         // name of a window defined in the WINDOW clause.
 	partition func(*Builder)
 	order     []any
+	frame     string // e.g. ROWS, RANGE.
+	start     FrameBound
+	end       FrameBound
 }
 
 // RowNumber returns a new window clause with the ROW_NUMBER() as a function.
@@ -2789,6 +3434,90 @@ func RowNumber() *WindowBuilder {
 	})
 }
 
+// Rank returns a new window clause with the RANK() as a function. Rows with
+// equal values in the ORDER BY clause get the same rank, and the ranks that
+// follow them are skipped. e.g. 1, 2, 2, 4.
+func Rank() *WindowBuilder {
+	return Window(func(b *Builder) {
+		b.WriteString("RANK()")
+	})
+}
+
+// DenseRank returns a new window clause with the DENSE_RANK() as a function.
+// Unlike Rank, no ranks are skipped after rows with equal values. e.g. 1, 2, 2, 3.
+func DenseRank() *WindowBuilder {
+	return Window(func(b *Builder) {
+		b.WriteString("DENSE_RANK()")
+	})
+}
+
+// Ntile returns a new window clause with the NTILE(n) as a function. Using this
+// function will divide the rows of each partition into n buckets, and assign each
+// row the number of its bucket, from 1 to n.
+func Ntile(n int) *WindowBuilder {
+	return Window(func(b *Builder) {
+		b.WriteString("NTILE(").WriteString(strconv.Itoa(n)).WriteByte(')')
+	})
+}
+
+// Lag returns a new window clause with the LAG() as a function. Using this function
+// will return the value of the column in the row that is offset rows before the
+// current row in the partition, or the optional default value if there is none.
+//
+//	Lag("score", 1, 0).PartitionBy("game_id").OrderBy("created_at")
+func Lag(column string, offset int, value ...any) *WindowBuilder {
+	return offsetWindow("LAG", column, offset, value...)
+}
+
+// Lead returns a new window clause with the LEAD() as a function. Using this function
+// will return the value of the column in the row that is offset rows after the current
+// row in the partition, or the optional default value if there is none.
+func Lead(column string, offset int, value ...any) *WindowBuilder {
+	return offsetWindow("LEAD", column, offset, value...)
+}
+
+// offsetWindow returns a new window clause for the LAG and LEAD functions.
+func offsetWindow(fn, column string, offset int, value ...any) *WindowBuilder {
+	return Window(func(b *Builder) {
+		b.WriteString(fn).WriteByte('(').Ident(column).Comma().WriteString(strconv.Itoa(offset))
+		if len(value) > 0 {
+			b.Comma().Arg(value[0])
+		}
+		b.WriteByte(')')
+	})
+}
+
+// FirstValue returns a new window clause with the FIRST_VALUE() as a function.
+// Using this function will return the value of the column in the first row of
+// the window frame.
+func FirstValue(column string) *WindowBuilder {
+	return Window(func(b *Builder) {
+		b.WriteString("FIRST_VALUE(").Ident(column).WriteByte(')')
+	})
+}
+
+// LastValue returns a new window clause with the LAST_VALUE() as a function.
+// Using this function will return the value of the column in the last row of
+// the window frame. Note that the default frame ends with the current row,
+// and therefore, it is usually used with a frame clause.
+//
+//	LastValue("score").OrderBy("created_at").RowsBetween(UnboundedPreceding, UnboundedFollowing)
+func LastValue(column string) *WindowBuilder {
+	return Window(func(b *Builder) {
+		b.WriteString("LAST_VALUE(").Ident(column).WriteByte(')')
+	})
+}
+
+// Over returns a new window clause with the given aggregate function as a function.
+// For example, the following computes the running total of the scores of each user:
+//
+//	Over(Sum("score")).PartitionBy("user_id").OrderBy("created_at")
+func Over(agg string) *WindowBuilder {
+	return Window(func(b *Builder) {
+		b.Ident(agg)
+	})
+}
+
 // Window returns a new window clause with a custom selector allowing
 // for custom window functions.
 //
@@ -2799,6 +3528,26 @@ func Window(fn func(*Builder)) *WindowBuilder {
 	return &WindowBuilder{fn: fn}
 }
 
+// WindowSpec returns a new window specification without a function. It is
+// used for defining named windows in the WINDOW clause of the Selector.
+//
+//	Select().
+//		AppendSelectExprAs(Rank().OverWindow("w"), "rank").
+//		AppendSelectExprAs(Over(Sum("score")).OverWindow("w"), "total").
+//		From(Table("scores")).
+//		Window("w", WindowSpec().PartitionBy("game_id").OrderBy(Desc("score")))
+func WindowSpec() *WindowBuilder {
+	return &WindowBuilder{}
+}
+
+// OverWindow indicates to evaluate the function over the window with the given name
+// that is defined in the WINDOW clause. The window can be extended with an ORDER BY
+// or a frame clause, if the named window does not define them.
+func (w *WindowBuilder) OverWindow(name string) *WindowBuilder {
+	w.base = name
+	return w
+}
+
 // PartitionBy indicates to divide the query rows into groups by the given columns.
 // Note that, standard SQL spec allows partition only by columns, and in order to
 // use the "expression" version, use the PartitionByExpr.
@@ -2834,18 +3583,98 @@ func (w *WindowBuilder) OrderExpr(exprs ...Querier) *WindowBuilder {
 	return w
 }
 
+// FrameBound represents the start or the end of a window frame.
+type FrameBound string
+
+// Frame bounds that are not relative to the current row by a number of rows (or values).
+const (
+	UnboundedPreceding FrameBound = "UNBOUNDED PRECEDING"
+	CurrentRow         FrameBound = "CURRENT ROW"
+	UnboundedFollowing FrameBound = "UNBOUNDED FOLLOWING"
+)
+
+// Preceding returns a frame bound that starts (or ends) n rows (or values) before the current row.
+func Preceding(n int) FrameBound {
+	return FrameBound(strconv.Itoa(n) + " PRECEDING")
+}
+
+// Following returns a frame bound that starts (or ends) n rows (or values) after the current row.
+func Following(n int) FrameBound {
+	return FrameBound(strconv.Itoa(n) + " FOLLOWING")
+}
+
+// RowsBetween sets the frame of the window to the rows between the given bounds.
+//
+//	// Moving average of the last 7 rows.
+//	Over(Avg("amount")).OrderBy("day").RowsBetween(Preceding(6), CurrentRow)
+func (w *WindowBuilder) RowsBetween(start, end FrameBound) *WindowBuilder {
+	w.frame, w.start, w.end = "ROWS", start, end
+	return w
+}
+
+// RangeBetween sets the frame of the window to the rows whose values of the ORDER BY
+// clause are between the given bounds, relative to the value of the current row. Note
+// that SQL Server supports only the UnboundedPreceding, CurrentRow and UnboundedFollowing
+// bounds in RANGE frames.
+func (w *WindowBuilder) RangeBetween(start, end FrameBound) *WindowBuilder {
+	w.frame, w.start, w.end = "RANGE", start, end
+	return w
+}
+
 // Query returns query representation of the window function.
 func (w *WindowBuilder) Query() (string, []any) {
-	w.fn(&w.Builder)
-	w.WriteString(" OVER ")
-	w.Wrap(func(b *Builder) {
-		if w.partition != nil {
+	b := w.Builder.clone()
+	if w.fn != nil {
+		w.fn(&b)
+		b.WriteString(" OVER ")
+	}
+	if w.base != "" && w.partition == nil && len(w.order) == 0 && w.frame == "" {
+		b.Ident(w.base)
+	} else {
+		b.Wrap(w.spec)
+	}
+	return b.String(), b.args
+}
+
+// spec writes the window specification.
+func (w *WindowBuilder) spec(b *Builder) {
+	var clauses []func()
+	if w.base != "" {
+		clauses = append(clauses, func() { b.Ident(w.base) })
+	}
+	if w.partition != nil {
+		clauses = append(clauses, func() {
 			b.WriteString("PARTITION BY ")
 			w.partition(b)
+		})
+	}
+	if len(w.order) > 0 {
+		clauses = append(clauses, func() {
+			b.WriteString("ORDER BY ")
+			for i := range w.order {
+				if i > 0 {
+					b.Comma()
+				}
+				switch r := w.order[i].(type) {
+				case string:
+					b.Ident(r)
+				case Querier:
+					b.Join(r)
+				}
+			}
+		})
+	}
+	if w.frame != "" {
+		clauses = append(clauses, func() {
+			b.WriteString(w.frame).WriteString(" BETWEEN ").WriteString(string(w.start)).WriteString(" AND ").WriteString(string(w.end))
+		})
+	}
+	for i, c := range clauses {
+		if i > 0 {
+			b.Pad()
 		}
-		joinOrder(w.order, b)
-	})
-	return w.Builder.String(), w.args
+		c()
+	}
 }
 
 // Wrapper wraps a given Querier with different format.
@@ -2923,15 +3752,92 @@ func ExprFunc(fn func(*Builder)) Querier {
 
 type exprFunc struct {
 	Builder
-	fn func(*Builder)
+	fn   func(*Builder)
+	errs []error // errors of the last build.
 }
 
 func (e *exprFunc) Query() (string, []any) {
 	b := e.Builder.clone()
 	e.fn(&b)
+	e.errs = b.errs
 	return b.Query()
 }
 
+// Err returns the errors that were added to the expression, or encountered while building it.
+func (e *exprFunc) Err() error {
+	b := Builder{errs: append(e.Builder.errs[:len(e.Builder.errs):len(e.Builder.errs)], e.errs...)}
+	return b.Err()
+}
+
+// Array returns an argument for a slice value (e.g. []string or []int) that is stored as
+// a native array in Postgres (e.g. text[] or bigint[]), and as a JSON array in MySQL and
+// SQLite. It is used by the generated code for fields that are annotated with entsql.Array.
+//
+//	Update("users").Set("tags", Array([]string{"a", "b"}))
+func Array(v any) Querier {
+	return &arrayArg{v: v}
+}
+
+// arrayArg is the argument of an array value.
+type arrayArg struct {
+	Builder
+	v any
+}
+
+// Query returns query representation of the array argument.
+func (a *arrayArg) Query() (string, []any) {
+	b := a.Builder.clone()
+	if b.postgres() {
+		v, err := marshalArray(a.v)
+		if err != nil {
+			a.AddError(err)
+		}
+		b.Arg(v)
+	} else {
+		buf, err := json.Marshal(a.v)
+		if err != nil {
+			a.AddError(err)
+		}
+		b.Arg(json.RawMessage(buf))
+	}
+	return b.Query()
+}
+
+// arrayQuoter escapes the backslashes and double quotes of array elements.
+var arrayQuoter = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// marshalArray returns the Postgres array literal of the given slice. e.g. {"a","b"}.
+func marshalArray(v any) (string, error) {
+	rv := reflect.ValueOf(v)
+	if k := rv.Kind(); k != reflect.Slice && k != reflect.Array {
+		return "", fmt.Errorf("sql: unexpected array value type %T", v)
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := 0; i < rv.Len(); i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		// Convert pointers, driver.Valuer and custom types to their driver values.
+		e, err := driver.DefaultParameterConverter.ConvertValue(rv.Index(i).Interface())
+		if err != nil {
+			return "", err
+		}
+		switch e := e.(type) {
+		case nil:
+			b.WriteString("NULL")
+		case string:
+			b.WriteString(`"` + arrayQuoter.Replace(e) + `"`)
+		case []byte:
+			b.WriteString(`"` + arrayQuoter.Replace(string(e)) + `"`)
+		default:
+			fmt.Fprint(&b, e)
+		}
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
 // Queries are list of queries join with space between them.
 type Queries []Querier
 
@@ -2971,6 +3877,13 @@ func (b *Builder) Quote(ident string) string {
 			return strings.ReplaceAll(ident, "`", `"`)
 		}
 		quote = `"`
+	case b.sqlserver():
+		// If it was quoted with the wrong
+		// identifier character.
+		if strings.Contains(ident, "`") {
+			return bracketIdent(ident)
+		}
+		return "[" + strings.ReplaceAll(ident, "]", "]]") + "]"
 	// An identifier for unknown dialect.
 	case b.dialect == "" && strings.ContainsAny(ident, "`\""):
 		return ident
@@ -2991,6 +3904,8 @@ func (b *Builder) Ident(s string) *Builder {
 		// Modifiers and aggregation functions that
 		// were called without dialect information.
 		b.WriteString(strings.ReplaceAll(s, "`", `"`))
+	case (isFunc(s) || isModifier(s) || isAlias(s)) && b.sqlserver():
+		b.WriteString(bracketIdent(s))
 	default:
 		b.WriteString(s)
 	}
@@ -3072,18 +3987,33 @@ func (b *Builder) writeSchema(schema string) {
 
 // Err returns a concatenated error of all errors encountered during
 // the query-building, or were added manually by calling AddError.
+// The returned error wraps these errors, and they can be checked
+// using errors.Is and errors.As.
 func (b *Builder) Err() error {
 	if len(b.errs) == 0 {
 		return nil
 	}
+	return builderErrors(slices.Clone(b.errs))
+}
+
+// builderErrors holds the errors encountered during the query-building.
+type builderErrors []error
+
+// Error implements the error interface.
+func (e builderErrors) Error() string {
 	br := strings.Builder{}
-	for i := range b.errs {
+	for i := range e {
 		if i > 0 {
 			br.WriteString("; ")
 		}
-		br.WriteString(b.errs[i].Error())
+		br.WriteString(e[i].Error())
 	}
-	return errors.New(br.String())
+	return br.String()
+}
+
+// Unwrap returns the wrapped errors.
+func (e builderErrors) Unwrap() []error {
+	return e
 }
 
 // An Op represents an operator.
@@ -3178,6 +4108,15 @@ func (b *Builder) Arg(a any) *Builder {
 		// $1 refers to the 1st argument, $2 to the 2nd, and so on.
 		format = "$" + strconv.Itoa(b.total+1)
 	}
+	if b.sqlserver() {
+		// SQL Server's arguments are referenced using the syntax @pn.
+		format = "@p" + strconv.Itoa(b.total+1)
+		// JSON values are stored in NVARCHAR columns, and binary
+		// values cannot be implicitly converted to character types.
+		if v, ok := a.(json.RawMessage); ok {
+			a = string(v)
+		}
+	}
 	if f, ok := a.(ParamFormatter); ok {
 		format = f.FormatParam(format, &StmtInfo{
 			Dialect: b.dialect,
@@ -3321,6 +4260,18 @@ func (b Builder) clone() Builder {
 	return c
 }
 
+// dialectName returns the display name of the given dialect, used in error messages.
+func dialectName(name string) string {
+	switch name {
+	case dialect.Postgres:
+		return "PostgreSQL"
+	case dialect.SQLServer:
+		return "SQL Server"
+	default:
+		return name
+	}
+}
+
 // postgres reports if the builder dialect is PostgreSQL.
 func (b Builder) postgres() bool {
 	return b.Dialect() == dialect.Postgres
@@ -3331,6 +4282,34 @@ func (b Builder) sqlite() bool {
 	return b.Dialect() == dialect.SQLite
 }
 
+// sqlserver reports if the builder dialect is SQL Server.
+func (b Builder) sqlserver() bool {
+	return b.Dialect() == dialect.SQLServer
+}
+
+// bracketIdent replaces the "`" quotes of the identifiers in the given
+// string with the square brackets that are used by SQL Server.
+func bracketIdent(s string) string {
+	var (
+		open bool
+		b    strings.Builder
+	)
+	for _, r := range s {
+		switch {
+		case r != '`':
+			b.WriteRune(r)
+		case open:
+			b.WriteByte(']')
+		default:
+			b.WriteByte('[')
+		}
+		if r == '`' {
+			open = !open
+		}
+	}
+	return b.String()
+}
+
 // fromIdent sets the builder dialect from the identifier format.
 func (b *Builder) fromIdent(ident string) {
 	if strings.Contains(ident, `"`) {
@@ -3344,6 +4323,8 @@ func (b *Builder) isIdent(s string) bool {
 	switch {
 	case b.postgres():
 		return strings.Contains(s, `"`)
+	case b.sqlserver():
+		return strings.Contains(s, "[")
 	default:
 		return strings.Contains(s, "`")
 	}
@@ -3351,9 +4332,11 @@ func (b *Builder) isIdent(s string) bool {
 
 // unquote database identifiers.
 func (b *Builder) unquote(s string) string {
-	switch pg := b.postgres(); {
+	switch pg, ss := b.postgres(), b.sqlserver(); {
 	case len(s) < 2:
-	case !pg && s[0] == '`' && s[len(s)-1] == '`', pg && s[0] == '"' && s[len(s)-1] == '"':
+	case ss && s[0] == '[' && s[len(s)-1] == ']':
+		return strings.ReplaceAll(s[1:len(s)-1], "]]", "]")
+	case !pg && !ss && s[0] == '`' && s[len(s)-1] == '`', pg && s[0] == '"' && s[len(s)-1] == '"':
 		if u, err := strconv.Unquote(s); err == nil {
 			return u
 		}
@@ -3361,12 +4344,24 @@ func (b *Builder) unquote(s string) string {
 	return s
 }
 
+// qualifiedSep returns the separator between the quoted
+// qualifier and column of a qualified identifier.
+func (b *Builder) qualifiedSep() string {
+	switch {
+	case b.postgres():
+		return `"."`
+	case b.sqlserver():
+		return "].["
+	default:
+		return "`.`"
+	}
+}
+
 // isQualified reports if the given string is a qualified identifier.
 func (b *Builder) isQualified(s string) bool {
-	ident, pg := b.isIdent(s), b.postgres()
+	ident := b.isIdent(s)
 	return !ident && len(s) > 2 && strings.ContainsRune(s[1:len(s)-1], '.') || // <qualifier>.<column>
-		ident && pg && strings.Contains(s, `"."`) || // "qualifier"."column"
-		ident && !pg && strings.Contains(s, "`.`") // `qualifier`.`column`
+		ident && strings.Contains(s, b.qualifiedSep()) // `qualifier`.`column`
 }
 
 // state wraps all methods for setting and getting
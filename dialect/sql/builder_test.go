@@ -7,6 +7,7 @@ package sql
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -546,6 +547,28 @@ func TestBuilder(t *testing.T) {
 				Schema("mydb"),
 			wantQuery: `DELETE FROM "mydb"."users" WHERE "parent_id" IS NULL`,
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id", "name"),
+			wantQuery: `DELETE FROM "users" WHERE "parent_id" IS NULL RETURNING "id", "name"`,
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Delete("users").
+				Where(EQ("name", "foo")).
+				Returning("*"),
+			wantQuery: "DELETE FROM `users` WHERE `name` = ? RETURNING *",
+			wantArgs:  []any{"foo"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id"),
+			wantQuery: "DELETE FROM `users` WHERE `parent_id` IS NULL",
+		},
 		{
 			input: Delete("users").
 				Where(And(IsNull("parent_id"), NotIn("name", "foo", "bar"))),
@@ -1492,11 +1515,13 @@ func TestBuilder_Err(t *testing.T) {
 	require.EqualError(t, b.Err(), "invalid")
 	b.AddError(fmt.Errorf("unexpected"))
 	require.EqualError(t, b.Err(), "invalid; unexpected")
+	errInner := fmt.Errorf("inner")
 	b.Where(P(func(builder *Builder) {
-		builder.AddError(fmt.Errorf("inner"))
+		builder.AddError(errInner)
 	}))
 	_, _ = b.Query()
 	require.EqualError(t, b.Err(), "invalid; unexpected; inner")
+	require.ErrorIs(t, b.Err(), errInner)
 }
 
 func TestSelector_OrderByExpr(t *testing.T) {
@@ -1525,6 +1550,66 @@ func TestSelector_OrderByExpr(t *testing.T) {
 	require.Equal(t, []any{28, 1, 2}, args)
 }
 
+func TestSearch(t *testing.T) {
+	search := func(d string) (string, []any) {
+		s := Dialect(d).Select("id").From(Table("posts"))
+		FieldSearch("title", "ent orm", SearchIndex("post_title"), SearchConfig("english"))(s)
+		OrderBySearchRank("title", "ent orm", SearchIndex("post_title"), SearchConfig("english"))(s)
+		return s.Query()
+	}
+	query, args := search(dialect.MySQL)
+	require.Equal(t, "SELECT `id` FROM `posts` WHERE MATCH (`posts`.`title`) AGAINST (? IN NATURAL LANGUAGE MODE) ORDER BY MATCH (`posts`.`title`) AGAINST (? IN NATURAL LANGUAGE MODE) DESC", query)
+	require.Equal(t, []any{"ent orm", "ent orm"}, args)
+
+	query, args = search(dialect.Postgres)
+	require.Equal(t, `SELECT "id" FROM "posts" WHERE (to_tsvector('english', "posts"."title") @@ websearch_to_tsquery('english', $1)) ORDER BY ts_rank(to_tsvector('english', "posts"."title"), websearch_to_tsquery('english', $2)) DESC`, query)
+	require.Equal(t, []any{"ent orm", "ent orm"}, args)
+
+	query, args = search(dialect.SQLite)
+	require.Equal(t, "SELECT `id` FROM `posts` WHERE `posts`.rowid IN (SELECT rowid FROM `post_title` WHERE `post_title` MATCH ?) ORDER BY (SELECT -rank FROM `post_title` WHERE `post_title` MATCH ? AND rowid = `posts`.rowid) DESC", query)
+	require.Equal(t, []any{"{title} : (ent orm)", "{title} : (ent orm)"}, args)
+
+	query, args = Dialect(dialect.Postgres).Select("id").From(Table("posts")).
+		Where(And(EQ("author_id", 1), Search([]string{"title", "body"}, "ent"))).
+		Query()
+	require.Equal(t, `SELECT "id" FROM "posts" WHERE "author_id" = $1 AND (to_tsvector('simple', "title") @@ websearch_to_tsquery('simple', $2) OR to_tsvector('simple', "body") @@ websearch_to_tsquery('simple', $3))`, query)
+	require.Equal(t, []any{1, "ent", "ent"}, args)
+
+	s := Dialect(dialect.SQLite).Select("id").From(Table("posts")).Where(Search([]string{"title"}, "ent"))
+	query, _ = s.Query()
+	require.EqualError(t, s.Err(), "sql: missing full-text index name for search")
+	require.Equal(t, "SELECT `id` FROM `posts` WHERE 1 = 0", query, "invalid predicates should not leave a dangling WHERE")
+
+	s = Dialect(dialect.SQLite).Select("id").From(Table("posts")).OrderExpr(DescExpr(SearchRank([]string{"title"}, "ent")))
+	query, _ = s.Query()
+	require.EqualError(t, s.Err(), "sql: missing full-text index name for search rank")
+	require.Equal(t, "SELECT `id` FROM `posts` ORDER BY NULL DESC", query)
+
+	s = Dialect(dialect.SQLite).Select("id").From(Table("posts")).OrderExpr(DescExpr(SearchRank([]string{"title"}, "ent", SearchIndex("post_title"))))
+	query, _ = s.Query()
+	require.EqualError(t, s.Err(), "sql: columns of full-text search rank must be qualified with their table")
+	require.NotContains(t, query, "rowid = rowid")
+}
+
+func TestArray(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).Update("users").Set("tags", Array([]string{"a", `b"c`, `d\e`})).Query()
+	require.Equal(t, `UPDATE "users" SET "tags" = $1`, query)
+	require.Equal(t, []any{`{"a","b\"c","d\\e"}`}, args)
+
+	query, args = Dialect(dialect.Postgres).Update("users").Set("ids", Array([]*int{nil, new(int)})).Query()
+	require.Equal(t, `UPDATE "users" SET "ids" = $1`, query)
+	require.Equal(t, []any{`{NULL,0}`}, args)
+
+	query, args = Dialect(dialect.MySQL).Update("users").Set("tags", Array([]string{"a"})).Query()
+	require.Equal(t, "UPDATE `users` SET `tags` = ?", query)
+	require.Equal(t, []any{json.RawMessage(`["a"]`)}, args)
+
+	a := Array("a").(*arrayArg)
+	a.SetDialect(dialect.Postgres)
+	_, _ = a.Query()
+	require.EqualError(t, a.Err(), "sql: unexpected array value type string")
+}
+
 func TestSelector_ClearOrder(t *testing.T) {
 	query, args := Select("*").
 		From(Table("users")).
@@ -1834,6 +1919,22 @@ func TestInsert_OnConflict(t *testing.T) {
 		require.Equal(t, `INSERT INTO "users" ("id", "email", "creation_time") VALUES ($1, $2, $3) ON CONFLICT ("email") WHERE "name" = $4 DO UPDATE SET "id" = "users"."id", "email" = "excluded"."email", "creation_time" = "users"."creation_time", "version" = COALESCE("users"."version", 0) + $5 WHERE "users"."updated_at" <> $6`, query)
 		require.Equal(t, []any{"1", "user@example.com", 1633279231, "Ariel", 1, 0}, args)
 
+		query, args = Dialect(dialect.Postgres).
+			Insert("users").
+			Columns("id", "name").
+			Values(1, "Mashraki").
+			OnConflict(
+				ConflictColumns("id"),
+				ResolveWithNewValues(),
+				UpdateWhere(NEQ("updated_at", 0)),
+				AndUpdateWhere(P(func(b *Builder) {
+					b.Ident("tenant").WriteOp(OpEQ).WriteString(b.Quote("excluded")).WriteByte('.').WriteString(b.Quote("tenant"))
+				})),
+			).
+			Query()
+		require.Equal(t, `INSERT INTO "users" ("id", "name") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "id" = "excluded"."id", "name" = "excluded"."name" WHERE "users"."updated_at" <> $3 AND "users"."tenant" = "excluded"."tenant"`, query)
+		require.Equal(t, []any{1, "Mashraki", 0}, args)
+
 		query, args = Dialect(dialect.Postgres).
 			Insert("users").
 			Columns("id", "name").
@@ -1951,6 +2052,36 @@ func TestInsert_OnConflict(t *testing.T) {
 			Query()
 		require.Equal(t, "INSERT INTO `users` (`name`, `rank`) VALUES (?, ?), (?, NULL) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `rank` = VALUES(`rank`), `id` = LAST_INSERT_ID(`id`)", query)
 		require.Equal(t, []any{"Ariel", 10, "Mashraki"}, args)
+
+		query, args = Dialect(dialect.MySQL).
+			Insert("users").
+			Columns("id", "name").
+			Values(1, "Mashraki").
+			OnConflict(
+				ResolveWith(func(s *UpdateSet) {
+					s.SetExcluded("name")
+					s.SetNull("created_at")
+				}),
+				UpdateWhere(EQ("tenant", 1)),
+			).
+			Query()
+		require.Equal(t, "INSERT INTO `users` (`id`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `created_at` = IF(@ent_update_where := (`users`.`tenant` = ?), NULL, `users`.`created_at`), `name` = IF(@ent_update_where, VALUES(`name`), `users`.`name`)", query)
+		require.Equal(t, []any{1, "Mashraki", 1}, args)
+
+		// The condition is evaluated once, before the columns it reads are assigned.
+		query, args = Dialect(dialect.MySQL).
+			Insert("users").
+			Columns("tenant", "name").
+			Values("t1", "Mashraki").
+			OnConflict(
+				ResolveWithNewValues(),
+				UpdateWhere(P(func(b *Builder) {
+					b.Ident("tenant").WriteOp(OpEQ).WriteString("VALUES(").WriteString(b.Quote("tenant")).WriteByte(')')
+				})),
+			).
+			Query()
+		require.Equal(t, "INSERT INTO `users` (`tenant`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `tenant` = IF(@ent_update_where := (`users`.`tenant` = VALUES(`tenant`)), VALUES(`tenant`), `users`.`tenant`), `name` = IF(@ent_update_where, VALUES(`name`), `users`.`name`)", query)
+		require.Equal(t, []any{"t1", "Mashraki"}, args)
 	})
 }
 
@@ -2113,6 +2244,160 @@ func TestWindowFunction_Select(t *testing.T) {
 	require.Nil(t, args)
 }
 
+func TestWindowFunctions(t *testing.T) {
+	tests := []struct {
+		input     Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input:     Rank().PartitionBy("game_id").OrderBy(Desc("score")),
+			wantQuery: "RANK() OVER (PARTITION BY `game_id` ORDER BY `score` DESC)",
+		},
+		{
+			input:     DenseRank().OrderBy(Desc("score")),
+			wantQuery: "DENSE_RANK() OVER (ORDER BY `score` DESC)",
+		},
+		{
+			input:     Ntile(4).OrderBy("score"),
+			wantQuery: "NTILE(4) OVER (ORDER BY `score`)",
+		},
+		{
+			input:     Lag("score", 1).PartitionBy("user_id").OrderBy("created_at"),
+			wantQuery: "LAG(`score`, 1) OVER (PARTITION BY `user_id` ORDER BY `created_at`)",
+		},
+		{
+			input:     Dialect(dialect.Postgres).Select().AppendSelectExprAs(Lead("score", 2, 0).OrderBy("created_at"), "next").From(Table("scores")).Where(GT("score", 10)),
+			wantQuery: `SELECT (LEAD("score", 2, $1) OVER (ORDER BY "created_at")) AS "next" FROM "scores" WHERE "score" > $2`,
+			wantArgs:  []any{0, 10},
+		},
+		{
+			input:     FirstValue("score").PartitionBy("game_id").OrderBy(Desc("score")),
+			wantQuery: "FIRST_VALUE(`score`) OVER (PARTITION BY `game_id` ORDER BY `score` DESC)",
+		},
+		{
+			input:     LastValue("score").OrderBy("created_at").RowsBetween(UnboundedPreceding, UnboundedFollowing),
+			wantQuery: "LAST_VALUE(`score`) OVER (ORDER BY `created_at` ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)",
+		},
+		{
+			input:     Over(Sum("score")).PartitionBy("user_id").OrderBy("created_at").RangeBetween(UnboundedPreceding, CurrentRow),
+			wantQuery: "SUM(`score`) OVER (PARTITION BY `user_id` ORDER BY `created_at` RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)",
+		},
+		{
+			input:     Dialect(dialect.Postgres).Select().AppendSelectExprAs(Over(Avg("amount")).OrderBy("day").RowsBetween(Preceding(6), CurrentRow), "avg").From(Table("sales")),
+			wantQuery: `SELECT (AVG("amount") OVER (ORDER BY "day" ROWS BETWEEN 6 PRECEDING AND CURRENT ROW)) AS "avg" FROM "sales"`,
+		},
+		{
+			input:     Over(Count("*")).RowsBetween(CurrentRow, Following(1)),
+			wantQuery: "COUNT(*) OVER (ROWS BETWEEN CURRENT ROW AND 1 FOLLOWING)",
+		},
+		{
+			input: Select("id").
+				AppendSelectExprAs(Rank().OverWindow("w"), "rank").
+				AppendSelectExprAs(Over(Sum("score")).OverWindow("w").RowsBetween(UnboundedPreceding, CurrentRow), "total").
+				From(Table("scores")).
+				Window("w", WindowSpec().PartitionBy("game_id").OrderBy(Desc("score"))).
+				OrderBy("rank"),
+			wantQuery: "SELECT `id`, (RANK() OVER `w`) AS `rank`, (SUM(`score`) OVER (`w` ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)) AS `total` FROM `scores` WINDOW `w` AS (PARTITION BY `game_id` ORDER BY `score` DESC) ORDER BY `rank`",
+		},
+		{
+			input: Dialect(dialect.SQLServer).Select("id").
+				From(Table("scores")).
+				Window("w1", WindowSpec().PartitionBy("game_id")).
+				Window("w2", WindowSpec().OverWindow("w1").OrderBy("id")),
+			wantQuery: "SELECT [id] FROM [scores] WINDOW [w1] AS (PARTITION BY [game_id]), [w2] AS ([w1] ORDER BY [id])",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestOrderByWindow(t *testing.T) {
+	s := Dialect(dialect.Postgres).Select("id").From(Table("players"))
+	OrderByWindow(Rank().OrderBy(Desc("score")), OrderSelectAs("rank"))(s)
+	OrderByWindow(Over(Sum("score")).PartitionBy("team_id"), OrderDesc(), OrderNullsLast())(s)
+	query, args := s.Query()
+	require.Equal(t, `SELECT "id", (RANK() OVER (ORDER BY "score" DESC)) AS "rank" FROM "players" ORDER BY "rank", SUM("score") OVER (PARTITION BY "team_id") DESC NULLS LAST`, query)
+	require.Nil(t, args)
+	query, _ = s.Query()
+	require.Equal(t, `SELECT "id", (RANK() OVER (ORDER BY "score" DESC)) AS "rank" FROM "players" ORDER BY "rank", SUM("score") OVER (PARTITION BY "team_id") DESC NULLS LAST`, query, "window functions can be queried more than once")
+}
+
+func TestSelector_LateralJoin(t *testing.T) {
+	recent := func(d string) (*SelectTable, *Selector) {
+		users, posts := Dialect(d).Table("users"), Dialect(d).Table("posts")
+		return users, Dialect(d).Select(posts.C("title")).
+			From(posts).
+			Where(ColumnsEQ(posts.C("author_id"), users.C("id"))).
+			OrderExpr(DescExpr(Expr(posts.C("id")))).
+			Limit(3).
+			As("recent")
+	}
+	users, sub := recent(dialect.Postgres)
+	query, args := Dialect(dialect.Postgres).Select(users.C("name"), sub.C("title")).From(users).JoinLateral(sub).Query()
+	require.Equal(t, `SELECT "users"."name", "recent"."title" FROM "users" JOIN LATERAL (SELECT "posts"."title" FROM "posts" WHERE "posts"."author_id" = "users"."id" ORDER BY "posts"."id" DESC LIMIT 3) AS "recent" ON TRUE`, query)
+	require.Nil(t, args)
+
+	users, sub = recent(dialect.MySQL)
+	s := Dialect(dialect.MySQL).Select(users.C("name"), sub.C("title")).From(users).LeftJoinLateral(sub).OnP(GT(sub.C("title"), "a"))
+	query, args = s.Query()
+	require.NoError(t, s.Err())
+	require.Equal(t, "SELECT `users`.`name`, `recent`.`title` FROM `users` LEFT JOIN LATERAL (SELECT `posts`.`title` FROM `posts` WHERE `posts`.`author_id` = `users`.`id` ORDER BY `posts`.`id` DESC LIMIT 3) AS `recent` ON `recent`.`title` > ?", query)
+	require.Equal(t, []any{"a"}, args)
+
+	users, sub = recent(dialect.SQLServer)
+	query, _ = Dialect(dialect.SQLServer).Select(users.C("name"), sub.C("title")).From(users).LeftJoinLateral(sub).Query()
+	require.Equal(t, "SELECT [users].[name], [recent].[title] FROM [users] OUTER APPLY (SELECT [posts].[title] FROM [posts] WHERE [posts].[author_id] = [users].[id] ORDER BY [posts].[id] DESC OFFSET 0 ROWS FETCH NEXT 3 ROWS ONLY) AS [recent]", query)
+
+	users, sub = recent(dialect.SQLServer)
+	s = Dialect(dialect.SQLServer).Select(users.C("name")).From(users).JoinLateral(sub).On(sub.C("id"), users.C("id"))
+	s.Query()
+	require.EqualError(t, s.Err(), "sql: ON clause is not supported by lateral joins in SQL Server")
+
+	users, sub = recent(dialect.SQLite)
+	s = Dialect(dialect.SQLite).Select(users.C("name")).From(users).JoinLateral(sub)
+	s.Query()
+	require.EqualError(t, s.Err(), "sql: LATERAL joins of subqueries are not supported by SQLite")
+}
+
+func TestSelector_TableFunction(t *testing.T) {
+	users := Dialect(dialect.Postgres).Table("users")
+	tags := TableFunction("jsonb_array_elements_text", Expr(users.C("tags"))).As("t", "tag")
+	query, args := Dialect(dialect.Postgres).Select(users.C("name"), tags.C("tag")).From(users).CrossJoin(tags).Where(EQ(tags.C("tag"), "go")).Query()
+	require.Equal(t, `SELECT "users"."name", "t"."tag" FROM "users" CROSS JOIN jsonb_array_elements_text("users"."tags") AS "t"("tag") WHERE "t"."tag" = $1`, query)
+	require.Equal(t, []any{"go"}, args)
+
+	users = Dialect(dialect.Postgres).Table("users")
+	series := TableFunction("generate_series", 1, Expr(users.C("level"))).As("s", "n")
+	query, args = Dialect(dialect.Postgres).Select(users.C("name"), series.C("n")).From(users).JoinLateral(series).Where(GT(users.C("id"), 10)).Query()
+	require.Equal(t, `SELECT "users"."name", "s"."n" FROM "users" JOIN generate_series($1, "users"."level") AS "s"("n") ON TRUE WHERE "users"."id" > $2`, query)
+	require.Equal(t, []any{1, 10}, args)
+
+	users = Dialect(dialect.SQLite).Table("users")
+	each := TableFunction("json_each", Expr(users.C("tags")))
+	query, args = Dialect(dialect.SQLite).Select(users.C("name")).From(users).CrossJoin(each).Where(EQ(each.C("value"), "go")).Query()
+	require.Equal(t, "SELECT `users`.`name` FROM `users` CROSS JOIN json_each(`users`.`tags`) AS `t1` WHERE `t1`.`value` = ?", query)
+	require.Equal(t, []any{"go"}, args)
+
+	users = Dialect(dialect.SQLServer).Table("users")
+	tags = TableFunction("OPENJSON", Expr(users.C("tags"))).As("t")
+	query, _ = Dialect(dialect.SQLServer).Select(users.C("name"), tags.C("value")).From(users).CrossJoin(tags).Query()
+	require.Equal(t, "SELECT [users].[name], [t].[value] FROM [users] CROSS APPLY OPENJSON([users].[tags]) AS [t]", query)
+
+	query, args = Dialect(dialect.SQLite).Select("value").From(TableFunction("json_each", `["a","b"]`)).Query()
+	require.Equal(t, "SELECT `value` FROM json_each(?)", query)
+	require.Equal(t, []any{`["a","b"]`}, args)
+
+	s := Select("*").From(Table("a")).CrossJoin(Table("b")).On("a.id", "b.id")
+	s.Query()
+	require.EqualError(t, s.Err(), "sql: ON clause is not supported by CROSS JOIN")
+}
+
 func TestSelector_UnqualifiedColumns(t *testing.T) {
 	t1, t2 := Table("t1"), Table("t2")
 	s := Select(t1.C("a"), t2.C("b"))
@@ -2335,3 +2620,145 @@ func TestColumnsHasPrefix(t *testing.T) {
 		require.Equal(t, []any{`\`}, args)
 	})
 }
+
+func TestSQLServer(t *testing.T) {
+	users, pets := Table("users"), Table("pets").As("p")
+	tests := []struct {
+		input     Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input:     Dialect(dialect.SQLServer).Select("id", "name").From(Table("users").Schema("dbo")).Where(EQ("name", "a8m")),
+			wantQuery: "SELECT [id], [name] FROM [dbo].[users] WHERE [name] = @p1",
+			wantArgs:  []any{"a8m"},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select(users.C("id"), Count("*")).From(users).Where(GT(users.C("age"), 30)).GroupBy(users.C("id")),
+			wantQuery: "SELECT [users].[id], COUNT(*) FROM [users] WHERE [users].[age] > @p1 GROUP BY [users].[id]",
+			wantArgs:  []any{30},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select("*").From(Table("weird]name")).Where(In("id", 1, 2)),
+			wantQuery: "SELECT * FROM [weird]]name] WHERE [id] IN (@p1, @p2)",
+			wantArgs:  []any{1, 2},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select("*").From(Table("users")).Where(In("id")),
+			wantQuery: "SELECT * FROM [users] WHERE 1 = 0",
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select("*").From(Table("users")).Limit(10),
+			wantQuery: "SELECT * FROM [users] ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select("*").From(Table("users")).OrderBy(Desc("id")).Offset(20).Limit(10),
+			wantQuery: "SELECT * FROM [users] ORDER BY [id] DESC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select("*").From(Table("users")).OrderBy("id").Offset(5),
+			wantQuery: "SELECT * FROM [users] ORDER BY [id] OFFSET 5 ROWS",
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select("*").From(Table("users")).Where(Or(Contains("name", "a_[b]"), HasPrefixFold("name", "A%"))),
+			wantQuery: "SELECT * FROM [users] WHERE [name] LIKE @p1 ESCAPE @p2 OR LOWER([name]) LIKE @p3 ESCAPE @p4",
+			wantArgs:  []any{`%a\_\[b]%`, `\`, `a\%%`, `\`},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select("*").From(Table("users")).Where(ColumnsHasPrefix("a", "b")),
+			wantQuery: `SELECT * FROM [users] WHERE [a] LIKE (REPLACE(REPLACE(REPLACE([b], '[', '\['), '_', '\_'), '%', '\%') + '%') ESCAPE @p1`,
+			wantArgs:  []any{`\`},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select(pets.C("name")).From(users).Join(pets).On(users.C("id"), pets.C("owner_id")).Where(EQ(users.C("id"), 1)).ForUpdate(),
+			wantQuery: "SELECT [p].[name] FROM [users] WITH (UPDLOCK, ROWLOCK) JOIN [pets] AS [p] WITH (UPDLOCK, ROWLOCK) ON [users].[id] = [p].[owner_id] WHERE [users].[id] = @p1",
+			wantArgs:  []any{1},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Select(pets.C("name")).From(users).Join(pets).On(users.C("id"), pets.C("owner_id")).ForShare(WithLockTables("p"), WithLockAction(SkipLocked)),
+			wantQuery: "SELECT [p].[name] FROM [users] JOIN [pets] AS [p] WITH (REPEATABLEREAD, ROWLOCK, READPAST) ON [users].[id] = [p].[owner_id]",
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Insert("users").Columns("name", "age").Values("a8m", 30).Values("nati", 20).Returning("id"),
+			wantQuery: "INSERT INTO [users] ([name], [age]) OUTPUT INSERTED.[id] VALUES (@p1, @p2), (@p3, @p4)",
+			wantArgs:  []any{"a8m", 30, "nati", 20},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Insert("users").Default().Returning("id", "name"),
+			wantQuery: "INSERT INTO [users] OUTPUT INSERTED.[id], INSERTED.[name] DEFAULT VALUES",
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Insert("users").Columns("doc").Values(json.RawMessage(`{"a":1}`)),
+			wantQuery: "INSERT INTO [users] ([doc]) VALUES (@p1)",
+			wantArgs:  []any{`{"a":1}`},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Update("users").Set("name", "a8m").Add("age", 1).Where(EQ("id", 1)).Returning("id", "age"),
+			wantQuery: "UPDATE [users] SET [name] = @p1, [age] = COALESCE([users].[age], 0) + @p2 OUTPUT INSERTED.[id], INSERTED.[age] WHERE [id] = @p3",
+			wantArgs:  []any{"a8m", 1, 1},
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Delete("users").Schema("dbo").Where(IsNull("name")),
+			wantQuery: "DELETE FROM [dbo].[users] WHERE [name] IS NULL",
+		},
+		{
+			input:     Dialect(dialect.SQLServer).Delete("users").Where(IsNull("name")).Returning("id", "age"),
+			wantQuery: "DELETE FROM [users] OUTPUT DELETED.[id], DELETED.[age] WHERE [name] IS NULL",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+
+	t.Run("Merge", func(t *testing.T) {
+		query, args, err := Dialect(dialect.SQLServer).
+			Insert("users").
+			Columns("email", "name").
+			Values("a8m@example.com", "a8m").
+			Values("nati@example.com", "nati").
+			OnConflict(
+				ConflictColumns("email"),
+				ResolveWithNewValues(),
+				ResolveWith(func(u *UpdateSet) {
+					u.SetIgnore("email")
+					u.Add("version", 1)
+				}),
+				UpdateWhere(NEQ("active", false)),
+			).
+			Returning("id").
+			QueryErr()
+		require.NoError(t, err)
+		require.Equal(t, "MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2), (@p3, @p4)) AS [excluded] ([email], [name]) ON [users].[email] = [excluded].[email] WHEN MATCHED AND [users].[active] = 1 THEN UPDATE SET [email] = [users].[email], [name] = [excluded].[name], [version] = COALESCE([users].[version], 0) + @p5 WHEN NOT MATCHED THEN INSERT ([email], [name]) VALUES ([excluded].[email], [excluded].[name]) OUTPUT INSERTED.[id];", query)
+		require.Equal(t, []any{"a8m@example.com", "a8m", "nati@example.com", "nati", 1}, args)
+
+		query, args, err = Dialect(dialect.SQLServer).
+			Insert("users").
+			Columns("id", "name").
+			Values(1, "a8m").
+			OnConflict(ConflictColumns("id"), DoNothing()).
+			QueryErr()
+		require.NoError(t, err)
+		require.Equal(t, "MERGE INTO [users] WITH (HOLDLOCK) USING (VALUES (@p1, @p2)) AS [excluded] ([id], [name]) ON [users].[id] = [excluded].[id] WHEN NOT MATCHED THEN INSERT ([id], [name]) VALUES ([excluded].[id], [excluded].[name]);", query)
+		require.Equal(t, []any{1, "a8m"}, args)
+
+		_, _, err = Dialect(dialect.SQLServer).
+			Insert("users").
+			Columns("id").
+			Values(1).
+			OnConflict(ConflictConstraint("users_pkey"), DoNothing()).
+			QueryErr()
+		require.EqualError(t, err, `sql: conflict constraint "users_pkey" is not supported by SQL Server`)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		u := Dialect(dialect.SQLServer).Update("users").Set("name", "a8m").Limit(1)
+		require.EqualError(t, u.Err(), "LIMIT is not supported by SQL Server")
+		s := Dialect(dialect.SQLServer).Select("*").From(Table("posts")).Where(Search([]string{"title"}, "ent"))
+		s.Query()
+		require.EqualError(t, s.Err(), "sql: full-text search is not supported by SQL Server")
+	})
+}
@@ -0,0 +1,412 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Cursor holds the values of the ordering keys of a row, and it is
+// used for keyset (cursor) pagination. Cursors are opaque to callers,
+// and are encoded and decoded using their String and DecodeCursor.
+type Cursor struct {
+	Values []any
+}
+
+// cursorValue is the encoded form of a single cursor value.
+// The type is kept to preserve it when the cursor is decoded.
+type cursorValue struct {
+	T string          `json:"t"`
+	V json.RawMessage `json:"v,omitempty"`
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c Cursor) MarshalText() ([]byte, error) {
+	vs := make([]cursorValue, len(c.Values))
+	for i, v := range c.Values {
+		var (
+			err error
+			cv  cursorValue
+		)
+		switch v := v.(type) {
+		case nil:
+			cv.T = "null"
+		case bool:
+			cv.T = "bool"
+			cv.V, err = json.Marshal(v)
+		case int:
+			cv.T, cv.V = "int", []byte(strconv.FormatInt(int64(v), 10))
+		case int8:
+			cv.T, cv.V = "int", []byte(strconv.FormatInt(int64(v), 10))
+		case int16:
+			cv.T, cv.V = "int", []byte(strconv.FormatInt(int64(v), 10))
+		case int32:
+			cv.T, cv.V = "int", []byte(strconv.FormatInt(int64(v), 10))
+		case int64:
+			cv.T, cv.V = "int", []byte(strconv.FormatInt(v, 10))
+		case uint:
+			cv.T, cv.V = "uint", []byte(strconv.FormatUint(uint64(v), 10))
+		case uint8:
+			cv.T, cv.V = "uint", []byte(strconv.FormatUint(uint64(v), 10))
+		case uint16:
+			cv.T, cv.V = "uint", []byte(strconv.FormatUint(uint64(v), 10))
+		case uint32:
+			cv.T, cv.V = "uint", []byte(strconv.FormatUint(uint64(v), 10))
+		case uint64:
+			cv.T, cv.V = "uint", []byte(strconv.FormatUint(v, 10))
+		case float32:
+			cv.T = "float"
+			cv.V, err = json.Marshal(float64(v))
+		case float64:
+			cv.T = "float"
+			cv.V, err = json.Marshal(v)
+		case string:
+			cv.T = "string"
+			cv.V, err = json.Marshal(v)
+		case []byte:
+			cv.T = "bytes"
+			cv.V, err = json.Marshal(v)
+		case time.Time:
+			cv.T = "time"
+			cv.V, err = json.Marshal(v.Format(time.RFC3339Nano))
+		default:
+			return nil, fmt.Errorf("sql: unsupported cursor value type %T", v)
+		}
+		if err != nil {
+			return nil, err
+		}
+		vs[i] = cv
+	}
+	buf, err := json.Marshal(vs)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(base64.RawURLEncoding.EncodeToString(buf)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *Cursor) UnmarshalText(text []byte) error {
+	buf, err := base64.RawURLEncoding.DecodeString(string(text))
+	if err != nil {
+		return fmt.Errorf("sql: invalid cursor: %w", err)
+	}
+	var vs []cursorValue
+	if err := json.Unmarshal(buf, &vs); err != nil {
+		return fmt.Errorf("sql: invalid cursor: %w", err)
+	}
+	values := make([]any, len(vs))
+	for i, cv := range vs {
+		switch cv.T {
+		case "null":
+		case "bool":
+			var v bool
+			err = json.Unmarshal(cv.V, &v)
+			values[i] = v
+		case "int":
+			var v int64
+			v, err = strconv.ParseInt(string(cv.V), 10, 64)
+			values[i] = v
+		case "uint":
+			var v uint64
+			v, err = strconv.ParseUint(string(cv.V), 10, 64)
+			values[i] = v
+		case "float":
+			var v float64
+			err = json.Unmarshal(cv.V, &v)
+			values[i] = v
+		case "string":
+			var v string
+			err = json.Unmarshal(cv.V, &v)
+			values[i] = v
+		case "bytes":
+			var v []byte
+			err = json.Unmarshal(cv.V, &v)
+			values[i] = v
+		case "time":
+			var s string
+			if err = json.Unmarshal(cv.V, &s); err == nil {
+				values[i], err = time.Parse(time.RFC3339Nano, s)
+			}
+		default:
+			err = fmt.Errorf("unknown value type %q", cv.T)
+		}
+		if err != nil {
+			return fmt.Errorf("sql: invalid cursor: %w", err)
+		}
+	}
+	c.Values = values
+	return nil
+}
+
+// String returns the opaque encoding of the cursor.
+func (c Cursor) String() string {
+	text, err := c.MarshalText()
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+// DecodeCursor decodes a cursor that was encoded by Cursor.String.
+func DecodeCursor(s string) (*Cursor, error) {
+	c := &Cursor{}
+	if err := c.UnmarshalText([]byte(s)); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// OrderKey describes a single ordering term of a SELECT statement.
+type OrderKey struct {
+	Expr       string // Ordering expression, without its direction.
+	Desc       bool   // Whether the term is sorted in descending order.
+	NullsFirst bool   // Whether NULL values are sorted first.
+	NotNull    bool   // Whether the expression cannot be NULL. See Paginator.NotNull.
+}
+
+// OrderKeys returns the keys of the ORDER BY clause of the selector. The
+// NullsFirst option of each key is resolved using the selector dialect in
+// case it was not set explicitly. Duplicate terms are returned only once.
+func (s *Selector) OrderKeys() ([]OrderKey, error) {
+	keys := make([]OrderKey, 0, len(s.order))
+	seen := make(map[string]bool, len(s.order))
+	for i := range s.order {
+		b := &Builder{dialect: s.Dialect()}
+		switch r := s.order[i].(type) {
+		case string:
+			b.Ident(r)
+		case Querier:
+			b.Join(r)
+		}
+		if err := b.Err(); err != nil {
+			return nil, err
+		}
+		if len(b.args) > 0 {
+			return nil, fmt.Errorf("sql: ordering term %q with arguments is not supported", b.String())
+		}
+		k := parseOrderKey(s.Dialect(), b.String())
+		if !seen[k.Expr] {
+			seen[k.Expr] = true
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+// parseOrderKey parses a rendered ORDER BY term into an OrderKey.
+func parseOrderKey(d, term string) OrderKey {
+	var (
+		k           OrderKey
+		first, last bool
+	)
+	for done := false; !done; {
+		upper := strings.ToUpper(term)
+		switch {
+		case strings.HasSuffix(upper, " NULLS FIRST"):
+			term, first = term[:len(term)-len(" NULLS FIRST")], true
+		case strings.HasSuffix(upper, " NULLS LAST"):
+			term, last = term[:len(term)-len(" NULLS LAST")], true
+		case strings.HasSuffix(upper, " DESC"):
+			term, k.Desc = term[:len(term)-len(" DESC")], true
+		case strings.HasSuffix(upper, " ASC"):
+			term = term[:len(term)-len(" ASC")]
+		default:
+			done = true
+		}
+	}
+	switch {
+	case first:
+		k.NullsFirst = true
+	case last:
+		k.NullsFirst = false
+	case d == dialect.Postgres:
+		// Unlike MySQL and SQLite, PostgreSQL considers NULL
+		// values as larger than any other value.
+		k.NullsFirst = k.Desc
+	default:
+		k.NullsFirst = !k.Desc
+	}
+	k.Expr = strings.TrimSpace(term)
+	if strings.ContainsAny(k.Expr, " ") {
+		k.Expr = "(" + k.Expr + ")"
+	}
+	return k
+}
+
+// Paginator implements keyset (cursor) pagination on top of the ORDER BY
+// clause of a selector. Its Where method is a selector function that must
+// be applied after the ordering terms were set on the selector:
+//
+//	p := sql.NewPaginator(after)
+//	t := sql.Table("users")
+//	s := sql.Select(t.Columns("id", "name")...).
+//		From(t).
+//		OrderBy(t.C("name"), t.C("id"))
+//	p.Where(s)
+//	s.Limit(first + 1)
+//
+// The values of the ordering keys are selected using the aliases returned by
+// Columns, and the cursor of each row is created from them using Cursor.
+type Paginator struct {
+	after   *Cursor
+	keys    []OrderKey
+	notNull []string
+}
+
+// NewPaginator returns a new Paginator for the rows that come
+// after the given cursor. A nil cursor starts from the first row.
+func NewPaginator(after *Cursor) *Paginator {
+	return &Paginator{after: after}
+}
+
+// NotNull marks the given columns of the selector table as non-nullable. The ordering
+// keys of these columns do not require handling NULL values in the cursor predicate,
+// which allows using a composite (row value) comparison in more cases.
+//
+//	sql.NewPaginator(after).NotNull("id", "name")
+func (p *Paginator) NotNull(columns ...string) *Paginator {
+	p.notNull = append(p.notNull, columns...)
+	return p
+}
+
+// Where selects the ordering keys of the selector and filters
+// out all rows that do not come after the paginator's cursor.
+func (p *Paginator) Where(s *Selector) {
+	keys, err := s.OrderKeys()
+	if err != nil {
+		s.AddError(err)
+		return
+	}
+	if len(keys) == 0 {
+		s.AddError(errors.New("sql: pagination requires an ordered query"))
+		return
+	}
+	for _, c := range p.notNull {
+		b := &Builder{dialect: s.Dialect()}
+		expr := b.Ident(s.C(c)).String()
+		for i := range keys {
+			if keys[i].Expr == expr {
+				keys[i].NotNull = true
+			}
+		}
+	}
+	p.keys = keys
+	for i, k := range keys {
+		s.AppendSelectExprAs(Raw(k.Expr), p.column(i))
+	}
+	if p.after == nil {
+		return
+	}
+	if len(p.after.Values) != len(keys) {
+		s.AddError(fmt.Errorf("sql: cursor with %d values does not match %d ordering terms", len(p.after.Values), len(keys)))
+		return
+	}
+	s.Where(p.predicate())
+}
+
+// Columns returns the aliases of the selected ordering keys.
+func (p *Paginator) Columns() []string {
+	columns := make([]string, len(p.keys))
+	for i := range p.keys {
+		columns[i] = p.column(i)
+	}
+	return columns
+}
+
+// Cursor returns the cursor of a row using the given function
+// to get the value of its selected ordering keys.
+func (p *Paginator) Cursor(value func(string) (any, error)) (*Cursor, error) {
+	c := &Cursor{Values: make([]any, len(p.keys))}
+	for i := range p.keys {
+		v, err := value(p.column(i))
+		if err != nil {
+			return nil, err
+		}
+		c.Values[i] = v
+	}
+	return c, nil
+}
+
+// column returns the alias of the i-th ordering key.
+func (p *Paginator) column(i int) string {
+	return fmt.Sprintf("cursor_%d", i)
+}
+
+// predicate returns the predicate for the rows that come after the cursor.
+// If all terms are sorted in the same direction and the cursor does not hold
+// NULL values that require special handling, a composite predicate is used.
+// Otherwise, the predicate is expanded to:
+//
+//	(k1 > v1) OR (k1 = v1 AND k2 > v2) OR ...
+func (p *Paginator) predicate() *Predicate {
+	composite := true
+	for i, k := range p.keys {
+		if k.Desc != p.keys[0].Desc || !k.NullsFirst && !k.NotNull || p.after.Values[i] == nil {
+			composite = false
+		}
+	}
+	if composite && len(p.keys) > 1 {
+		columns := make([]string, len(p.keys))
+		for i, k := range p.keys {
+			columns[i] = k.Expr
+		}
+		if p.keys[0].Desc {
+			return CompositeLT(columns, p.after.Values...)
+		}
+		return CompositeGT(columns, p.after.Values...)
+	}
+	ors := make([]*Predicate, 0, len(p.keys))
+	for i, k := range p.keys {
+		next := p.next(k, p.after.Values[i])
+		if next == nil {
+			continue
+		}
+		ands := make([]*Predicate, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, p.equal(p.keys[j], p.after.Values[j]))
+		}
+		ors = append(ors, And(append(ands, next)...))
+	}
+	if len(ors) == 0 {
+		return False()
+	}
+	return Or(ors...)
+}
+
+// next returns the predicate for the key values that are sorted after v,
+// or nil in case no such value exists.
+func (*Paginator) next(k OrderKey, v any) *Predicate {
+	switch {
+	case v == nil && k.NullsFirst:
+		return NotNull(k.Expr)
+	case v == nil:
+		return nil
+	}
+	p := GT(k.Expr, v)
+	if k.Desc {
+		p = LT(k.Expr, v)
+	}
+	// NULL values are sorted after v, unless the expression cannot be NULL.
+	if !k.NullsFirst && !k.NotNull {
+		p = Or(p, IsNull(k.Expr))
+	}
+	return p
+}
+
+// equal returns the predicate for the key values that are equal to v.
+func (*Paginator) equal(k OrderKey, v any) *Predicate {
+	if v == nil {
+		return IsNull(k.Expr)
+	}
+	return EQ(k.Expr, v)
+}
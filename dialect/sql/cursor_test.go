@@ -0,0 +1,183 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_Encoding(t *testing.T) {
+	now := time.Now().UTC()
+	c := Cursor{Values: []any{nil, true, 1, int64(-2), uint8(3), 1.5, "a8m", []byte("ent"), now}}
+	s := c.String()
+	require.NotEmpty(t, s)
+	got, err := DecodeCursor(s)
+	require.NoError(t, err)
+	require.Equal(t, []any{nil, true, int64(1), int64(-2), uint64(3), 1.5, "a8m", []byte("ent"), now}, got.Values)
+
+	_, err = DecodeCursor("invalid")
+	require.Error(t, err)
+	_, err = Cursor{Values: []any{struct{}{}}}.MarshalText()
+	require.Error(t, err)
+}
+
+func TestSelector_OrderKeys(t *testing.T) {
+	t1 := Table("users")
+	s := Dialect(dialect.Postgres).Select().From(t1).
+		OrderBy(t1.C("name"), Desc(t1.C("age"))).
+		OrderExpr(Expr("LENGTH(name) NULLS FIRST")).
+		OrderBy(t1.C("name"))
+	keys, err := s.OrderKeys()
+	require.NoError(t, err)
+	require.Equal(t, []OrderKey{
+		{Expr: `"users"."name"`},
+		{Expr: `"users"."age"`, Desc: true, NullsFirst: true},
+		{Expr: `LENGTH(name)`, NullsFirst: true},
+	}, keys)
+
+	s = Dialect(dialect.MySQL).Select().From(t1).OrderBy(t1.C("name"), Desc(t1.C("age")))
+	keys, err = s.OrderKeys()
+	require.NoError(t, err)
+	require.Equal(t, []OrderKey{
+		{Expr: "`users`.`name`", NullsFirst: true},
+		{Expr: "`users`.`age`", Desc: true},
+	}, keys)
+
+	s = Select().From(t1).OrderExpr(Expr("age > ?", 1))
+	_, err = s.OrderKeys()
+	require.Error(t, err)
+}
+
+func TestPaginator(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  func() *Selector
+		after     *Cursor
+		notNull   []string
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			name: "first page",
+			selector: func() *Selector {
+				t1 := Table("users")
+				return Dialect(dialect.SQLite).Select(t1.C("id")).From(t1).OrderBy(t1.C("id"))
+			},
+			wantQuery: "SELECT `users`.`id`, `users`.`id` AS `cursor_0` FROM `users` ORDER BY `users`.`id`",
+		},
+		{
+			name: "composite",
+			selector: func() *Selector {
+				t1 := Table("users")
+				return Dialect(dialect.SQLite).Select(t1.C("id")).From(t1).OrderBy(t1.C("name"), t1.C("id"))
+			},
+			after:     &Cursor{Values: []any{"a8m", 1}},
+			wantQuery: "SELECT `users`.`id`, `users`.`name` AS `cursor_0`, `users`.`id` AS `cursor_1` FROM `users` WHERE (`users`.`name`, `users`.`id`) > (?, ?) ORDER BY `users`.`name`, `users`.`id`",
+			wantArgs:  []any{"a8m", 1},
+		},
+		{
+			name: "composite desc",
+			selector: func() *Selector {
+				t1 := Table("users")
+				return Dialect(dialect.Postgres).Select(t1.C("id")).From(t1).OrderBy(Desc(t1.C("age")), Desc(t1.C("id")))
+			},
+			after:     &Cursor{Values: []any{30, 1}},
+			wantQuery: `SELECT "users"."id", "users"."age" AS "cursor_0", "users"."id" AS "cursor_1" FROM "users" WHERE ("users"."age", "users"."id") < ($1, $2) ORDER BY "users"."age" DESC, "users"."id" DESC`,
+			wantArgs:  []any{30, 1},
+		},
+		{
+			name: "mixed directions",
+			selector: func() *Selector {
+				t1 := Table("users")
+				return Dialect(dialect.MySQL).Select(t1.C("id")).From(t1).OrderBy(Desc(t1.C("age")), t1.C("id"))
+			},
+			after:     &Cursor{Values: []any{30, 1}},
+			wantQuery: "SELECT `users`.`id`, `users`.`age` AS `cursor_0`, `users`.`id` AS `cursor_1` FROM `users` WHERE `users`.`age` < ? OR `users`.`age` IS NULL OR (`users`.`age` = ? AND `users`.`id` > ?) ORDER BY `users`.`age` DESC, `users`.`id`",
+			wantArgs:  []any{30, 30, 1},
+		},
+		{
+			name: "mixed directions not null",
+			selector: func() *Selector {
+				t1 := Table("users")
+				return Dialect(dialect.MySQL).Select(t1.C("id")).From(t1).OrderBy(Desc(t1.C("age")), t1.C("id"))
+			},
+			after:     &Cursor{Values: []any{30, 1}},
+			notNull:   []string{"age", "id"},
+			wantQuery: "SELECT `users`.`id`, `users`.`age` AS `cursor_0`, `users`.`id` AS `cursor_1` FROM `users` WHERE `users`.`age` < ? OR (`users`.`age` = ? AND `users`.`id` > ?) ORDER BY `users`.`age` DESC, `users`.`id`",
+			wantArgs:  []any{30, 30, 1},
+		},
+		{
+			name: "nulls last",
+			selector: func() *Selector {
+				t1 := Table("users")
+				return Dialect(dialect.Postgres).Select(t1.C("id")).From(t1).OrderBy(t1.C("name"), t1.C("id"))
+			},
+			after:     &Cursor{Values: []any{"a8m", 1}},
+			notNull:   []string{"id"},
+			wantQuery: `SELECT "users"."id", "users"."name" AS "cursor_0", "users"."id" AS "cursor_1" FROM "users" WHERE "users"."name" > $1 OR "users"."name" IS NULL OR ("users"."name" = $2 AND "users"."id" > $3) ORDER BY "users"."name", "users"."id"`,
+			wantArgs:  []any{"a8m", "a8m", 1},
+		},
+		{
+			name: "nulls last not null",
+			selector: func() *Selector {
+				t1 := Table("users")
+				return Dialect(dialect.Postgres).Select(t1.C("id")).From(t1).OrderBy(t1.C("name"), t1.C("id"))
+			},
+			after:     &Cursor{Values: []any{"a8m", 1}},
+			notNull:   []string{"name", "id"},
+			wantQuery: `SELECT "users"."id", "users"."name" AS "cursor_0", "users"."id" AS "cursor_1" FROM "users" WHERE ("users"."name", "users"."id") > ($1, $2) ORDER BY "users"."name", "users"."id"`,
+			wantArgs:  []any{"a8m", 1},
+		},
+		{
+			name: "null values",
+			selector: func() *Selector {
+				t1 := Table("users")
+				return Dialect(dialect.SQLite).Select(t1.C("id")).From(t1).OrderBy(t1.C("nickname"), t1.C("id"))
+			},
+			after:     &Cursor{Values: []any{nil, 1}},
+			wantQuery: "SELECT `users`.`id`, `users`.`nickname` AS `cursor_0`, `users`.`id` AS `cursor_1` FROM `users` WHERE `users`.`nickname` IS NOT NULL OR (`users`.`nickname` IS NULL AND `users`.`id` > ?) ORDER BY `users`.`nickname`, `users`.`id`",
+			wantArgs:  []any{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.selector()
+			p := NewPaginator(tt.after).NotNull(tt.notNull...)
+			p.Where(s)
+			query, args := s.Query()
+			require.NoError(t, s.Err())
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		t1 := Table("users")
+		s := Dialect(dialect.SQLite).Select(t1.C("id")).From(t1)
+		NewPaginator(nil).Where(s)
+		require.Error(t, s.Err())
+
+		s = Dialect(dialect.SQLite).Select(t1.C("id")).From(t1).OrderBy(t1.C("id"))
+		NewPaginator(&Cursor{Values: []any{1, 2}}).Where(s)
+		require.Error(t, s.Err())
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		t1 := Table("users")
+		s := Dialect(dialect.SQLite).Select(t1.C("id")).From(t1).OrderBy(t1.C("name"), t1.C("id"))
+		p := NewPaginator(nil)
+		p.Where(s)
+		require.Equal(t, []string{"cursor_0", "cursor_1"}, p.Columns())
+		values := map[string]any{"cursor_0": "a8m", "cursor_1": int64(1)}
+		c, err := p.Cursor(func(c string) (any, error) { return values[c], nil })
+		require.NoError(t, err)
+		require.Equal(t, []any{"a8m", int64(1)}, c.Values)
+	})
+}
@@ -10,8 +10,10 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect"
 )
@@ -33,12 +35,12 @@ func Open(dialect, source string) (*Driver, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewDriver(dialect, Conn{db, dialect}), nil
+	return NewDriver(dialect, Conn{ExecQuerier: db, dialect: dialect}), nil
 }
 
 // OpenDB wraps the given database/sql.DB method with a Driver.
 func OpenDB(dialect string, db *sql.DB) *Driver {
-	return NewDriver(dialect, Conn{db, dialect})
+	return NewDriver(dialect, Conn{ExecQuerier: db, dialect: dialect})
 }
 
 // DB returns the underlying *sql.DB instance.
@@ -49,11 +51,15 @@ func (d Driver) DB() *sql.DB {
 // Dialect implements the dialect.Dialect method.
 func (d Driver) Dialect() string {
 	// If the underlying driver is wrapped with a telemetry driver.
-	for _, name := range []string{dialect.MySQL, dialect.SQLite, dialect.Postgres} {
+	for _, name := range []string{dialect.MySQL, dialect.SQLite, dialect.Postgres, dialect.SQLServer} {
 		if strings.HasPrefix(d.dialect, name) {
 			return name
 		}
 	}
+	// The pure-Go SQLite driver (or a wrapper of it).
+	if strings.HasPrefix(d.dialect, dialect.SQLiteDriver) {
+		return dialect.SQLite
+	}
 	return d.dialect
 }
 
@@ -69,7 +75,7 @@ func (d *Driver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, erro
 		return nil, err
 	}
 	return &Tx{
-		Conn: Conn{tx, d.dialect},
+		Conn: Conn{ExecQuerier: tx, dialect: d.dialect, copyIn: pqConn(d.ExecQuerier)},
 		Tx:   tx,
 	}, nil
 }
@@ -83,6 +89,53 @@ type Tx struct {
 	driver.Tx
 }
 
+// Savepointer is the interface implemented by transactions that support savepoints.
+// Savepoints allow rolling back a part of a transaction, and are used for nesting
+// transactions in each other.
+type Savepointer interface {
+	// Savepoint creates a savepoint with the given name in the transaction.
+	Savepoint(ctx context.Context, name string) error
+	// RollbackTo rolls back the changes that were made in the transaction
+	// since the savepoint with the given name was created.
+	RollbackTo(ctx context.Context, name string) error
+	// Release releases (destroys) the savepoint with the given name,
+	// keeping the changes that were made since it was created.
+	Release(ctx context.Context, name string) error
+}
+
+// Savepoint implements the Savepointer interface. In SQL Server,
+// the savepoint is created using the SAVE TRANSACTION statement.
+func (t *Tx) Savepoint(ctx context.Context, name string) error {
+	if t.dialect == dialect.SQLServer {
+		return t.savepoint(ctx, "SAVE TRANSACTION ", name)
+	}
+	return t.savepoint(ctx, "SAVEPOINT ", name)
+}
+
+// RollbackTo implements the Savepointer interface.
+func (t *Tx) RollbackTo(ctx context.Context, name string) error {
+	if t.dialect == dialect.SQLServer {
+		return t.savepoint(ctx, "ROLLBACK TRANSACTION ", name)
+	}
+	return t.savepoint(ctx, "ROLLBACK TO SAVEPOINT ", name)
+}
+
+// Release implements the Savepointer interface. SQL Server does not support
+// releasing savepoints, and they are kept until the transaction is completed.
+func (t *Tx) Release(ctx context.Context, name string) error {
+	if t.dialect == dialect.SQLServer {
+		return nil
+	}
+	return t.savepoint(ctx, "RELEASE SAVEPOINT ", name)
+}
+
+// savepoint executes the savepoint statement with the given name.
+func (t *Tx) savepoint(ctx context.Context, stmt, name string) error {
+	b := &Builder{dialect: t.dialect}
+	b.WriteString(stmt).Ident(name)
+	return t.Exec(ctx, b.String(), []any{}, nil)
+}
+
 // ctyVarsKey is the key used for attaching and reading the context variables.
 type ctxVarsKey struct{}
 
@@ -129,6 +182,9 @@ type ExecQuerier interface {
 type Conn struct {
 	ExecQuerier
 	dialect string
+	// copyIn indicates the transaction was started on
+	// a lib/pq connection that supports the COPY command.
+	copyIn bool
 }
 
 // Exec implements the dialect.Exec method.
@@ -189,6 +245,116 @@ func (c Conn) Query(ctx context.Context, query string, args, v any) error {
 	return nil
 }
 
+type (
+	// CopySpec holds the information for copying rows into a table.
+	CopySpec struct {
+		Schema  string   // Optional schema of the table.
+		Table   string   // Table name.
+		Columns []string // Columns of the rows.
+		Rows    [][]any  // Values of the rows, ordered by their columns.
+	}
+
+	// Copier is the interface implemented by connections that support bulk-loading
+	// rows into a table using the COPY FROM protocol of PostgreSQL. Drivers that do not
+	// support the protocol through database/sql (e.g. pgx) can be wrapped by an
+	// ExecQuerier that implements this interface using their native copy API.
+	Copier interface {
+		// Copy copies the rows into the table, and returns the number of copied rows.
+		Copy(context.Context, *CopySpec) (int64, error)
+	}
+)
+
+// ErrCopyNotSupported is returned by Copy in case the connection does not support copying rows.
+var ErrCopyNotSupported = errors.New("dialect/sql: copy is not supported")
+
+// Copy implements the Copier interface. Unless the underlying ExecQuerier implements
+// the Copier interface itself, the rows are copied using the COPY FROM STDIN statement
+// that is supported only by lib/pq, and ErrCopyNotSupported is returned for other drivers.
+// Note that copying rows is done in a transaction, and therefore, a new transaction is
+// started in case the connection is not one.
+func (c Conn) Copy(ctx context.Context, spec *CopySpec) (n int64, rerr error) {
+	if cp, ok := c.ExecQuerier.(Copier); ok {
+		return cp.Copy(ctx, spec)
+	}
+	if !c.copyIn && !pqConn(c.ExecQuerier) {
+		return 0, fmt.Errorf("%w by %T", ErrCopyNotSupported, c.ExecQuerier)
+	}
+	ex, cf, err := c.maySetVars(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if cf != nil {
+		defer func() { rerr = errors.Join(rerr, cf()) }()
+	}
+	if c, ok := ex.(Conn); ok {
+		ex = c.ExecQuerier
+	}
+	switch e := ex.(type) {
+	case *sql.Tx:
+		return copyIn(ctx, e, spec)
+	case interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	}:
+		tx, err := e.BeginTx(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+		if n, err = copyIn(ctx, tx, spec); err != nil {
+			return 0, errors.Join(err, tx.Rollback())
+		}
+		return n, tx.Commit()
+	default:
+		return 0, fmt.Errorf("%w by %T", ErrCopyNotSupported, ex)
+	}
+}
+
+// pqConn reports if the given connection uses the lib/pq driver, the only driver
+// that supports the COPY FROM STDIN statement through the database/sql package.
+func pqConn(ex ExecQuerier) bool {
+	var drv any
+	switch ex := ex.(type) {
+	case *sql.DB:
+		drv = ex.Driver()
+	case *sql.Conn:
+		_ = ex.Raw(func(c any) error {
+			drv = c
+			return nil
+		})
+	}
+	t := reflect.TypeOf(drv)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t != nil && t.PkgPath() == "github.com/lib/pq"
+}
+
+// copyIn copies the rows using the COPY FROM STDIN statement. Each row is sent by executing the
+// prepared statement with its values, and the data is flushed by executing it without arguments.
+func copyIn(ctx context.Context, tx *sql.Tx, spec *CopySpec) (int64, error) {
+	b := &Builder{dialect: dialect.Postgres}
+	b.WriteString("COPY ").
+		WriteString(Dialect(dialect.Postgres).Table(spec.Table).Schema(spec.Schema).ref()).
+		WriteString(" (").IdentComma(spec.Columns...).WriteString(") FROM STDIN")
+	stmt, err := tx.PrepareContext(ctx, b.String())
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	for _, r := range spec.Rows {
+		if _, err := stmt.ExecContext(ctx, r...); err != nil {
+			return 0, err
+		}
+	}
+	res, err := stmt.ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if n, err := res.RowsAffected(); err == nil && n > 0 {
+		return n, nil
+	}
+	return int64(len(spec.Rows)), nil
+}
+
 // maySetVars sets the session variables before executing a query.
 func (c Conn) maySetVars(ctx context.Context) (ExecQuerier, func() error, error) {
 	sv, _ := ctx.Value(ctxVarsKey{}).(sessionVars)
@@ -243,7 +409,11 @@ func (c Conn) maySetVars(ctx context.Context) (ExecQuerier, func() error, error)
 	return ex, cf, nil
 }
 
-var _ dialect.Driver = (*Driver)(nil)
+var (
+	_ dialect.Driver = (*Driver)(nil)
+	_ Copier         = (*Conn)(nil)
+	_ Savepointer    = (*Tx)(nil)
+)
 
 type (
 	// Rows wraps the sql.Rows to avoid locks copy.
@@ -280,6 +450,47 @@ func (n *NullScanner) Scan(value any) error {
 	return nil
 }
 
+// TextTimeScanner implements the sql.Scanner interface such that it scans
+// time values into T, including their textual representation. SQLite, for
+// example, returns time values as text for expressions that lose the declared
+// type of their column, like aggregation functions (e.g. MAX(created_at)).
+type TextTimeScanner struct {
+	T *NullTime
+}
+
+// textTimeFormats holds the formats that are used for storing time values as text by
+// the SQLite drivers (i.e. mattn/go-sqlite3 and modernc.org/sqlite).
+var textTimeFormats = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Scan implements the Scanner interface.
+func (s *TextTimeScanner) Scan(value any) error {
+	var text string
+	switch v := value.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return s.T.Scan(value)
+	}
+	for _, f := range textTimeFormats {
+		if t, err := time.ParseInLocation(f, text, time.UTC); err == nil {
+			s.T.Time, s.T.Valid = t, true
+			return nil
+		}
+	}
+	return fmt.Errorf("sql: unable to parse time value %q", text)
+}
+
 // ColumnScanner is the interface that wraps the standard
 // sql.Rows methods used for scanning database rows.
 type ColumnScanner interface {
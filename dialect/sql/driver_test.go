@@ -7,6 +7,7 @@ package sql
 import (
 	"context"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 
@@ -91,3 +92,92 @@ func TestWithVars(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 	// No rows are returned, so no need to close them.
 }
+
+func TestTx_Savepoint(t *testing.T) {
+	tests := []struct {
+		dialect                   string
+		savepoint, rollback, rels string
+	}{
+		{
+			dialect:   dialect.Postgres,
+			savepoint: `SAVEPOINT "sp1"`,
+			rollback:  `ROLLBACK TO SAVEPOINT "sp1"`,
+			rels:      `RELEASE SAVEPOINT "sp1"`,
+		},
+		{
+			dialect:   dialect.MySQL,
+			savepoint: "SAVEPOINT `sp1`",
+			rollback:  "ROLLBACK TO SAVEPOINT `sp1`",
+			rels:      "RELEASE SAVEPOINT `sp1`",
+		},
+		{
+			dialect:   dialect.SQLServer,
+			savepoint: "SAVE TRANSACTION [sp1]",
+			rollback:  "ROLLBACK TRANSACTION [sp1]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+			ctx := context.Background()
+			mock.ExpectBegin()
+			mock.ExpectExec(tt.savepoint).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(tt.rollback).WillReturnResult(sqlmock.NewResult(0, 0))
+			if tt.rels != "" {
+				mock.ExpectExec(tt.rels).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectCommit()
+			tx, err := OpenDB(tt.dialect, db).Tx(ctx)
+			require.NoError(t, err)
+			sp, ok := tx.(Savepointer)
+			require.True(t, ok)
+			require.NoError(t, sp.Savepoint(ctx, "sp1"))
+			require.NoError(t, sp.RollbackTo(ctx, "sp1"))
+			require.NoError(t, sp.Release(ctx, "sp1"))
+			require.NoError(t, tx.Commit())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConn_Copy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	spec := &CopySpec{Table: "users", Columns: []string{"age", "name"}, Rows: [][]any{{1, "a8m"}, {2, "nati"}}}
+	// Only lib/pq supports the COPY FROM STDIN statement.
+	_, err = OpenDB(dialect.Postgres, db).Copy(context.Background(), spec)
+	require.ErrorIs(t, err, ErrCopyNotSupported)
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare(`COPY "users" \("age", "name"\) FROM STDIN`)
+	mock.ExpectExec(`COPY "users"`).WithArgs(1, "a8m").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`COPY "users"`).WithArgs(2, "nati").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`COPY "users"`).WithArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	n, err := Conn{ExecQuerier: tx, dialect: dialect.Postgres, copyIn: true}.Copy(context.Background(), spec)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTextTimeScanner(t *testing.T) {
+	want := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+	for _, v := range []any{
+		want,
+		"2024-01-02 03:04:05.0000006+00:00",
+		"2024-01-02T03:04:05.0000006Z",
+		[]byte("2024-01-02 03:04:05.0000006"),
+		"2024-01-02 03:04:05.0000006 +0000 UTC",
+	} {
+		var nt NullTime
+		require.NoError(t, (&TextTimeScanner{T: &nt}).Scan(v), v)
+		require.True(t, nt.Valid)
+		require.True(t, want.Equal(nt.Time), "%v != %v", want, nt.Time)
+	}
+	var nt NullTime
+	require.NoError(t, (&TextTimeScanner{T: &nt}).Scan(nil))
+	require.False(t, nt.Valid)
+	require.Error(t, (&TextTimeScanner{T: &nt}).Scan("yesterday"))
+}
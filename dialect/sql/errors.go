@@ -0,0 +1,79 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"errors"
+	"strings"
+)
+
+// IsSerializationFailure reports if the error resulted from a serialization failure of a
+// transaction. i.e. SQLSTATE 40001 in PostgreSQL, or an update conflict of a snapshot
+// transaction in SQL Server. Such transactions can be safely retried.
+func IsSerializationFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if code, ok := sqlState(err); ok {
+		return code == "40001"
+	}
+	return containsAny(err.Error(),
+		"could not serialize access",                                    // Postgres
+		"Snapshot isolation transaction aborted due to update conflict", // SQL Server (Error 3960).
+	)
+}
+
+// IsDeadlock reports if the error resulted from a deadlock between transactions, in which
+// the transaction was chosen as the victim and rolled back. i.e. SQLSTATE 40P01 in PostgreSQL,
+// error 1213 in MySQL and error 1205 in SQL Server.
+func IsDeadlock(err error) bool {
+	if err == nil {
+		return false
+	}
+	if code, ok := sqlState(err); ok {
+		return code == "40P01"
+	}
+	return containsAny(err.Error(),
+		"deadlock detected",                // Postgres
+		"Error 1213",                       // MySQL
+		"was deadlocked on lock resources", // SQL Server
+	)
+}
+
+// IsRetryable reports if the error resulted from a conflict between concurrent transactions,
+// and the transaction can be retried. i.e. serialization failures and deadlocks, lock wait
+// timeouts in MySQL (error 1205), and locked databases in SQLite (SQLITE_BUSY).
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if IsSerializationFailure(err) || IsDeadlock(err) {
+		return true
+	}
+	return containsAny(err.Error(),
+		"Error 1205",         // MySQL (Lock wait timeout exceeded).
+		"database is locked", // SQLite
+		"SQLITE_BUSY",        // SQLite
+	)
+}
+
+// sqlState returns the SQLSTATE code of the error,
+// if it is reported by the driver (e.g. pgx and lib/pq).
+func sqlState(err error) (string, bool) {
+	var e interface{ SQLState() string }
+	if errors.As(err, &e) {
+		return e.SQLState(), true
+	}
+	return "", false
+}
+
+func containsAny(s string, substrs ...string) bool {
+	for _, sub := range substrs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,419 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// ReplicaDriver is a dialect.Driver implementation that routes read queries to a set
+// of replicas, and writes and transactions to the primary database. Each database is
+// accessed using its Driver, and therefore session variables that are attached to the
+// context using WithVar are set on all databases.
+//
+//	drv := sql.NewReplicaDriver(primary, []*sql.Driver{replica1, replica2},
+//		sql.ReplicaPolicyOption(sql.LeastLatency()),
+//		sql.ReplicaHealthCheck(10*time.Second),
+//	)
+//	client := ent.NewClient(ent.Driver(drv))
+type ReplicaDriver struct {
+	primary   *Driver
+	replicas  []*Replica
+	policy    ReplicaPolicy
+	unhealthy func(error) bool
+	failures  int32
+	ejectFor  time.Duration
+	interval  time.Duration
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// ReplicaOption allows configuring a ReplicaDriver using functional options.
+type ReplicaOption func(*ReplicaDriver)
+
+// ReplicaPolicyOption sets the policy that is used for picking the replica to execute
+// a read query on. The default is RoundRobin.
+func ReplicaPolicyOption(p ReplicaPolicy) ReplicaOption {
+	return func(d *ReplicaDriver) {
+		d.policy = p
+	}
+}
+
+// ReplicaEjection configures the health-based ejection of replicas. A replica is ejected
+// for the given duration after the given number of consecutive failures. The default is
+// 3 failures and 30 seconds.
+func ReplicaEjection(failures int, d time.Duration) ReplicaOption {
+	return func(r *ReplicaDriver) {
+		r.failures, r.ejectFor = int32(failures), d
+	}
+}
+
+// ReplicaUnhealthy sets the function that reports if an error returned by a replica
+// indicates it is unhealthy, and should be counted as a failure. The default reports
+// connection errors (e.g. driver.ErrBadConn and net.Error).
+func ReplicaUnhealthy(f func(error) bool) ReplicaOption {
+	return func(d *ReplicaDriver) {
+		d.unhealthy = f
+	}
+}
+
+// ReplicaHealthCheck enables pinging the replicas in the given interval. Replicas that
+// fail to respond are counted as failures, and ejected replicas that respond successfully
+// are reinstated. The health check is stopped when the driver is closed.
+func ReplicaHealthCheck(interval time.Duration) ReplicaOption {
+	return func(d *ReplicaDriver) {
+		d.interval = interval
+	}
+}
+
+// NewReplicaDriver creates a new ReplicaDriver with the given primary and replica drivers.
+// Read queries are executed on the primary in case there are no healthy replicas.
+func NewReplicaDriver(primary *Driver, replicas []*Driver, opts ...ReplicaOption) *ReplicaDriver {
+	d := &ReplicaDriver{
+		primary:   primary,
+		replicas:  make([]*Replica, len(replicas)),
+		policy:    RoundRobin(),
+		unhealthy: isConnError,
+		failures:  3,
+		ejectFor:  30 * time.Second,
+		stop:      make(chan struct{}),
+	}
+	for i, r := range replicas {
+		d.replicas[i] = &Replica{Driver: r}
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.interval > 0 {
+		go d.healthCheck()
+	}
+	return d
+}
+
+// Primary returns the driver of the primary database.
+func (d *ReplicaDriver) Primary() *Driver {
+	return d.primary
+}
+
+// Replicas returns the replicas of the driver.
+func (d *ReplicaDriver) Replicas() []*Replica {
+	return d.replicas
+}
+
+// DB returns the underlying *sql.DB instance of the primary database.
+func (d *ReplicaDriver) DB() *sql.DB {
+	return d.primary.DB()
+}
+
+// Dialect implements the dialect.Dialect method.
+func (d *ReplicaDriver) Dialect() string {
+	return d.primary.Dialect()
+}
+
+// Exec implements the dialect.Exec method. Statements are always executed on the primary.
+func (d *ReplicaDriver) Exec(ctx context.Context, query string, args, v any) error {
+	markWritten(ctx)
+	return d.primary.Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method. Read queries are executed on one of the healthy
+// replicas, or on the primary in case there are none, or the context was created using
+// WithPrimary, or WithReadYourWrites and a statement was already executed with it. Other
+// queries (e.g. INSERT ... RETURNING) are executed on the primary.
+func (d *ReplicaDriver) Query(ctx context.Context, query string, args, v any) error {
+	if !isReadQuery(query) {
+		markWritten(ctx)
+		return d.primary.Query(ctx, query, args, v)
+	}
+	r := d.pick(ctx)
+	if r == nil {
+		return d.primary.Query(ctx, query, args, v)
+	}
+	start := time.Now()
+	err := r.Query(ctx, query, args, v)
+	switch {
+	case err == nil:
+		r.observe(time.Since(start))
+	case d.unhealthy(err):
+		d.fail(r)
+		// The query was not executed. Hence, it is safe to retry it on the primary.
+		return d.primary.Query(ctx, query, args, v)
+	}
+	return err
+}
+
+// Tx starts and returns a transaction on the primary.
+func (d *ReplicaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	markWritten(ctx)
+	return d.primary.Tx(ctx)
+}
+
+// BeginTx starts a transaction with options on the primary.
+func (d *ReplicaDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	markWritten(ctx)
+	return d.primary.BeginTx(ctx, opts)
+}
+
+// Close stops the health check, and closes the primary and replica connections.
+func (d *ReplicaDriver) Close() error {
+	var errs []error
+	d.closeOnce.Do(func() {
+		close(d.stop)
+		errs = append(errs, d.primary.Close())
+		for _, r := range d.replicas {
+			errs = append(errs, r.Close())
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// pick returns the replica to execute a read query on, or nil if the query should be executed on the primary.
+func (d *ReplicaDriver) pick(ctx context.Context) *Replica {
+	if _, ok := ctx.Value(primaryKey{}).(bool); ok {
+		return nil
+	}
+	if s, ok := ctx.Value(readYourWritesKey{}).(*readYourWrites); ok && s.written.Load() {
+		return nil
+	}
+	healthy := make([]*Replica, 0, len(d.replicas))
+	now := time.Now()
+	for _, r := range d.replicas {
+		if r.Healthy(now) {
+			healthy = append(healthy, r)
+		}
+	}
+	if len(healthy) == 0 {
+		return nil
+	}
+	return d.policy.Pick(healthy)
+}
+
+// fail records a failure of the replica, and ejects it in case it reached the limit.
+func (d *ReplicaDriver) fail(r *Replica) {
+	if r.failures.Add(1) >= d.failures {
+		r.failures.Store(0)
+		r.ejected.Store(time.Now().Add(d.ejectFor).UnixNano())
+	}
+}
+
+// healthCheck pings the replicas in the configured interval until the driver is closed.
+func (d *ReplicaDriver) healthCheck() {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			for _, r := range d.replicas {
+				ctx, cancel := context.WithTimeout(context.Background(), d.interval)
+				err := r.DB().PingContext(ctx)
+				cancel()
+				if err != nil {
+					d.fail(r)
+				} else {
+					r.failures.Store(0)
+					r.ejected.Store(0)
+				}
+			}
+		}
+	}
+}
+
+// Replica wraps a replica Driver with the health and latency
+// stats that are used by the ReplicaDriver and its policy.
+type Replica struct {
+	*Driver
+	latency  atomic.Int64 // Moving average of the query latency, in nanoseconds.
+	failures atomic.Int32 // Consecutive failures.
+	ejected  atomic.Int64 // Unix time in nanoseconds until which the replica is ejected.
+}
+
+// Latency returns the moving average of the query latency of the replica.
+// Zero is returned in case no queries were executed on the replica yet.
+func (r *Replica) Latency() time.Duration {
+	return time.Duration(r.latency.Load())
+}
+
+// Healthy reports if the replica is not ejected at the given time.
+func (r *Replica) Healthy(now time.Time) bool {
+	return r.ejected.Load() <= now.UnixNano()
+}
+
+// observe records a successful query with the given latency.
+func (r *Replica) observe(d time.Duration) {
+	r.failures.Store(0)
+	for {
+		old := r.latency.Load()
+		v := int64(d)
+		if old != 0 {
+			// Exponentially weighted moving average with a factor of 1/8.
+			v = old + (v-old)/8
+		}
+		if r.latency.CompareAndSwap(old, v) {
+			return
+		}
+	}
+}
+
+// ReplicaPolicy picks the replica to execute a read query on.
+type ReplicaPolicy interface {
+	// Pick picks one of the given healthy replicas. The list is never empty.
+	Pick([]*Replica) *Replica
+}
+
+// ReplicaPolicyFunc type is an adapter to allow the use of
+// ordinary functions as replica policies.
+type ReplicaPolicyFunc func([]*Replica) *Replica
+
+// Pick calls f(replicas).
+func (f ReplicaPolicyFunc) Pick(replicas []*Replica) *Replica {
+	return f(replicas)
+}
+
+// RoundRobin returns a policy that picks the replicas in turn.
+func RoundRobin() ReplicaPolicy {
+	var next atomic.Uint64
+	return ReplicaPolicyFunc(func(replicas []*Replica) *Replica {
+		return replicas[(next.Add(1)-1)%uint64(len(replicas))]
+	})
+}
+
+// LeastLatency returns a policy that picks the replica with the lowest moving average
+// of query latency. Replicas that were not queried yet are picked first.
+func LeastLatency() ReplicaPolicy {
+	return ReplicaPolicyFunc(func(replicas []*Replica) *Replica {
+		best := replicas[0]
+		for _, r := range replicas[1:] {
+			if r.Latency() < best.Latency() {
+				best = r
+			}
+		}
+		return best
+	})
+}
+
+type (
+	// primaryKey is the context key for forcing queries to be executed on the primary.
+	primaryKey struct{}
+	// readYourWritesKey is the context key for the read-your-writes state.
+	readYourWritesKey struct{}
+	// readYourWrites reports if a statement was executed with the context.
+	readYourWrites struct{ written atomic.Bool }
+)
+
+// WithPrimary returns a new context that forces the queries that are executed
+// with it by a ReplicaDriver to be executed on the primary database.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// WithReadYourWrites returns a new context that forces the queries that are executed
+// with it by a ReplicaDriver to be executed on the primary database, once a statement
+// (e.g. INSERT or UPDATE) was executed with it. i.e. a request reads its own writes,
+// regardless of the replication lag.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, &readYourWrites{})
+}
+
+// markWritten marks the read-your-writes state of the context, if there is one, as written.
+func markWritten(ctx context.Context) {
+	if s, ok := ctx.Value(readYourWritesKey{}).(*readYourWrites); ok {
+		s.written.Store(true)
+	}
+}
+
+// isReadQuery reports if the query is a read query. i.e. a SELECT statement. Locking
+// reads (e.g. SELECT ... FOR UPDATE) and statements with data-modifying CTEs (e.g.
+// WITH ... DELETE) are executed on the primary, and therefore are not read queries.
+func isReadQuery(query string) bool {
+	words := sqlWords(query)
+	if len(words) == 0 || words[0] != "SELECT" && words[0] != "WITH" && words[0] != "VALUES" {
+		return false
+	}
+	for i, w := range words {
+		switch {
+		case w == "INSERT", w == "UPDATE", w == "DELETE", w == "MERGE":
+			return false
+		// FOR UPDATE, FOR NO KEY UPDATE, FOR SHARE and FOR KEY SHARE.
+		case w == "FOR" && i+1 < len(words) && (words[i+1] == "SHARE" || words[i+1] == "NO" || words[i+1] == "KEY"):
+			return false
+		// MySQL LOCK IN SHARE MODE.
+		case w == "LOCK" && i+1 < len(words) && words[i+1] == "IN":
+			return false
+		}
+	}
+	return true
+}
+
+// sqlWords returns the unquoted words (keywords and identifiers) of the query
+// in upper case. String literals, quoted identifiers and comments are skipped.
+func sqlWords(query string) []string {
+	var words []string
+	for i := 0; i < len(query); {
+		switch c := query[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			// Quotes are escaped by doubling them.
+			for i++; i < len(query); i++ {
+				if query[i] == c {
+					if i+1 < len(query) && query[i+1] == c {
+						i++
+						continue
+					}
+					break
+				}
+			}
+			i++
+		case strings.HasPrefix(query[i:], "--"):
+			if end := strings.IndexByte(query[i:], '\n'); end != -1 {
+				i += end + 1
+			} else {
+				i = len(query)
+			}
+		case strings.HasPrefix(query[i:], "/*"):
+			if end := strings.Index(query[i+2:], "*/"); end != -1 {
+				i += end + 4
+			} else {
+				i = len(query)
+			}
+		case isWordByte(c):
+			j := i
+			for j < len(query) && (isWordByte(query[j]) || query[j] >= '0' && query[j] <= '9') {
+				j++
+			}
+			words = append(words, strings.ToUpper(query[i:j]))
+			i = j
+		default:
+			i++
+		}
+	}
+	return words
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// isConnError reports if the error is a connection error. Context errors are
+// not connection errors, as they are caused by the caller and not the database.
+func isConnError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	return errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) || errors.As(err, &netErr)
+}
+
+var _ dialect.Driver = (*ReplicaDriver)(nil)
@@ -0,0 +1,182 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicaDriver(t *testing.T) {
+	open := func() (*Driver, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		db.SetMaxOpenConns(1)
+		return OpenDB(dialect.Postgres, db), mock
+	}
+	expectQuery := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	}
+	query := func(ctx context.Context, drv dialect.Driver) error {
+		rows := &Rows{}
+		if err := drv.Query(ctx, "SELECT 1", []any{}, rows); err != nil {
+			return err
+		}
+		return rows.Close()
+	}
+	var (
+		ctx       = context.Background()
+		p, pm     = open()
+		r1, r1m   = open()
+		r2, r2m   = open()
+		drv       = NewReplicaDriver(p, []*Driver{r1, r2}, ReplicaEjection(2, time.Hour))
+		expectAll = func() {
+			for _, m := range []sqlmock.Sqlmock{pm, r1m, r2m} {
+				require.NoError(t, m.ExpectationsWereMet())
+			}
+		}
+	)
+	require.Equal(t, dialect.Postgres, drv.Dialect())
+
+	// Round-robin.
+	expectQuery(r1m)
+	expectQuery(r2m)
+	expectQuery(r1m)
+	for range 3 {
+		require.NoError(t, query(ctx, drv))
+	}
+	expectAll()
+
+	// Writes and transactions.
+	pm.ExpectExec("INSERT INTO users DEFAULT VALUES").WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, drv.Exec(ctx, "INSERT INTO users DEFAULT VALUES", []any{}, nil))
+	pm.ExpectBegin()
+	pm.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	expectAll()
+
+	// Queries that are not reads.
+	pm.ExpectQuery("INSERT INTO users DEFAULT VALUES RETURNING id").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, "INSERT INTO users DEFAULT VALUES RETURNING id", []any{}, rows))
+	require.NoError(t, rows.Close())
+	expectAll()
+
+	// Forced primary.
+	expectQuery(pm)
+	require.NoError(t, query(WithPrimary(ctx), drv))
+	expectAll()
+
+	// Read-your-writes.
+	rctx := WithReadYourWrites(ctx)
+	expectQuery(r2m)
+	require.NoError(t, query(rctx, drv))
+	pm.ExpectExec("INSERT INTO users DEFAULT VALUES").WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, drv.Exec(rctx, "INSERT INTO users DEFAULT VALUES", []any{}, nil))
+	expectQuery(pm)
+	require.NoError(t, query(rctx, drv))
+	expectAll()
+
+	// Session variables are set on the replicas.
+	r1m.ExpectExec("SET foo = 'bar'").WillReturnResult(sqlmock.NewResult(0, 0))
+	expectQuery(r1m)
+	r1m.ExpectExec("RESET foo").WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, query(WithVar(ctx, "foo", "bar"), drv))
+	expectAll()
+
+	// Ejection. Failed queries are retried on the primary.
+	for range 2 {
+		r2m.ExpectQuery("SELECT 1").WillReturnError(&net.OpError{Op: "read", Err: errors.New("connection reset")})
+		expectQuery(pm)
+		require.NoError(t, query(ctx, drv))
+		expectQuery(r1m)
+		require.NoError(t, query(ctx, drv))
+	}
+	expectAll()
+	require.False(t, drv.Replicas()[1].Healthy(time.Now()))
+	require.True(t, drv.Replicas()[1].Healthy(time.Now().Add(2*time.Hour)))
+	expectQuery(r1m)
+	expectQuery(r1m)
+	for range 2 {
+		require.NoError(t, query(ctx, drv))
+	}
+	expectAll()
+
+	// Other errors are returned as-is.
+	r1m.ExpectQuery("SELECT 1").WillReturnError(context.Canceled)
+	require.ErrorIs(t, query(ctx, drv), context.Canceled)
+	expectAll()
+
+	pm.ExpectClose()
+	r1m.ExpectClose()
+	r2m.ExpectClose()
+	require.NoError(t, drv.Close())
+	expectAll()
+}
+
+func TestReplicaPolicy(t *testing.T) {
+	replicas := []*Replica{{}, {}, {}}
+	rr := RoundRobin()
+	for i := range 6 {
+		require.Equal(t, replicas[i%3], rr.Pick(replicas))
+	}
+	ll := LeastLatency()
+	require.Equal(t, replicas[0], ll.Pick(replicas), "unmeasured replicas first")
+	replicas[0].observe(10 * time.Millisecond)
+	replicas[1].observe(5 * time.Millisecond)
+	replicas[2].observe(20 * time.Millisecond)
+	require.Equal(t, replicas[1], ll.Pick(replicas))
+	for range 10 {
+		replicas[1].observe(100 * time.Millisecond)
+	}
+	require.Equal(t, replicas[0], ll.Pick(replicas))
+	require.Equal(t, 10*time.Millisecond, replicas[0].Latency())
+}
+
+func TestIsReadQuery(t *testing.T) {
+	for q, read := range map[string]bool{
+		"SELECT * FROM users":                                        true,
+		"(SELECT 1) UNION (SELECT 2)":                                true,
+		"/* controller=users */ SELECT * FROM users":                 true,
+		"-- comment\nSELECT * FROM users":                            true,
+		"VALUES (1), (2)":                                            true,
+		"WITH t AS (SELECT id FROM users) SELECT * FROM t":           true,
+		"SELECT * FROM users WHERE name = 'DELETE' OR `update` = 1":  true,
+		`SELECT "for", "share" FROM t`:                               true,
+		"SELECT * FROM users FOR UPDATE":                             false,
+		"SELECT * FROM users FOR NO KEY UPDATE SKIP LOCKED":          false,
+		"SELECT * FROM users FOR SHARE":                              false,
+		"SELECT * FROM users FOR KEY SHARE":                          false,
+		"SELECT * FROM users LOCK IN SHARE MODE":                     false,
+		"WITH d AS (DELETE FROM users RETURNING id) SELECT * FROM d": false,
+		"WITH u AS (UPDATE users SET age = 1 RETURNING id) SELECT 1": false,
+		"WITH t AS (SELECT 1) INSERT INTO users SELECT * FROM t":     false,
+		"INSERT INTO users (name) VALUES ('a')":                      false,
+		"/* SELECT */ UPDATE users SET name = 'a'":                   false,
+		"UPDATE users SET name = 'SELECT'":                           false,
+	} {
+		require.Equal(t, read, isReadQuery(q), q)
+	}
+}
+
+func TestIsConnError(t *testing.T) {
+	require.True(t, isConnError(driver.ErrBadConn))
+	require.True(t, isConnError(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	require.False(t, isConnError(context.DeadlineExceeded))
+	require.False(t, isConnError(fmt.Errorf("query: %w", context.Canceled)))
+	require.False(t, isConnError(errors.New("syntax error")))
+}
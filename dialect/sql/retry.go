@@ -0,0 +1,96 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// Default values for the RetryOptions.
+const (
+	DefaultRetryAttempts   = 5
+	DefaultRetryMinBackoff = 10 * time.Millisecond
+	DefaultRetryMaxBackoff = time.Second
+)
+
+// RetryOptions configures how transactions that fail with retryable errors (e.g. serialization
+// failures and deadlocks) are retried. The zero value is ready for use, and uses the defaults.
+type RetryOptions struct {
+	// TxOptions holds the options used for starting the transactions (e.g. the isolation level).
+	TxOptions
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the exponential backoff between attempts.
+	// The actual delay is randomized (jittered) between half and the full backoff.
+	MinBackoff, MaxBackoff time.Duration
+	// Retryable reports if a failed attempt should be retried. Defaults to IsRetryable.
+	Retryable func(error) bool
+	// OnRetry is an optional hook that is called before a failed attempt is retried with
+	// its number (starting from 1), its error and the delay before the next attempt.
+	OnRetry func(ctx context.Context, attempt int, err error, delay time.Duration)
+}
+
+// Retry calls fn until it succeeds, it fails with an error that is not retryable,
+// the maximum number of attempts is reached, or the context is done. The error of
+// the last attempt is returned in case all attempts failed.
+func Retry(ctx context.Context, opts *RetryOptions, fn func(context.Context) error) error {
+	if opts == nil {
+		opts = &RetryOptions{}
+	}
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= opts.maxAttempts() || !opts.retryable(err) {
+			return err
+		}
+		delay := opts.backoff(attempt)
+		if opts.OnRetry != nil {
+			opts.OnRetry(ctx, attempt, err, delay)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+func (o *RetryOptions) maxAttempts() int {
+	if o.MaxAttempts > 0 {
+		return o.MaxAttempts
+	}
+	return DefaultRetryAttempts
+}
+
+func (o *RetryOptions) retryable(err error) bool {
+	if o.Retryable != nil {
+		return o.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// backoff returns the delay after the given attempt. The backoff
+// is doubled on each attempt, and is jittered by up to its half.
+func (o *RetryOptions) backoff(attempt int) time.Duration {
+	minB, maxB := o.MinBackoff, o.MaxBackoff
+	if minB <= 0 {
+		minB = DefaultRetryMinBackoff
+	}
+	if maxB <= 0 {
+		maxB = DefaultRetryMaxBackoff
+	}
+	d := minB
+	for i := 1; i < attempt && d < maxB; i++ {
+		d *= 2
+	}
+	d = min(d, maxB)
+	if half := d / 2; half > 0 {
+		d = half + rand.N(half+1)
+	}
+	return d
+}
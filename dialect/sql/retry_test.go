@@ -0,0 +1,123 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stateError struct{ code string }
+
+func (e *stateError) Error() string    { return "ERROR: " + e.code }
+func (e *stateError) SQLState() string { return e.code }
+
+func TestRetryableErrors(t *testing.T) {
+	tests := []struct {
+		err                            error
+		serialization, deadlock, retry bool
+	}{
+		{err: nil},
+		{err: errors.New("UNIQUE constraint failed: users.name")},
+		{err: &stateError{code: "23505"}},
+		{err: &stateError{code: "40001"}, serialization: true, retry: true},
+		{err: fmt.Errorf("ent: committing transaction: %w", &stateError{code: "40001"}), serialization: true, retry: true},
+		{err: &stateError{code: "40P01"}, deadlock: true, retry: true},
+		{err: errors.New("pq: could not serialize access due to concurrent update"), serialization: true, retry: true},
+		{err: errors.New("ERROR: deadlock detected (SQLSTATE 40P01)"), deadlock: true, retry: true},
+		{err: errors.New("Error 1213 (40001): Deadlock found when trying to get lock; try restarting transaction"), deadlock: true, retry: true},
+		{err: errors.New("Error 1205 (HY000): Lock wait timeout exceeded; try restarting transaction"), retry: true},
+		{err: errors.New("mssql: Transaction (Process ID 52) was deadlocked on lock resources with another process"), deadlock: true, retry: true},
+		{err: errors.New("mssql: Snapshot isolation transaction aborted due to update conflict."), serialization: true, retry: true},
+		{err: errors.New("database is locked"), retry: true},
+		{err: errors.New("database is locked (5) (SQLITE_BUSY)"), retry: true},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.serialization, IsSerializationFailure(tt.err), tt.err)
+		require.Equal(t, tt.deadlock, IsDeadlock(tt.err), tt.err)
+		require.Equal(t, tt.retry, IsRetryable(tt.err), tt.err)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Success", func(t *testing.T) {
+		var calls int
+		err := Retry(ctx, nil, func(context.Context) error {
+			calls++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("NotRetryable", func(t *testing.T) {
+		var calls int
+		err := Retry(ctx, nil, func(context.Context) error {
+			calls++
+			return errors.New("UNIQUE constraint failed: users.name")
+		})
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("Retried", func(t *testing.T) {
+		var (
+			calls    int
+			attempts []int
+		)
+		opts := &RetryOptions{
+			MinBackoff: time.Microsecond,
+			OnRetry: func(_ context.Context, attempt int, err error, delay time.Duration) {
+				require.True(t, IsSerializationFailure(err))
+				require.LessOrEqual(t, delay, DefaultRetryMaxBackoff)
+				attempts = append(attempts, attempt)
+			},
+		}
+		err := Retry(ctx, opts, func(context.Context) error {
+			if calls++; calls < 3 {
+				return &stateError{code: "40001"}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+		require.Equal(t, []int{1, 2}, attempts)
+	})
+	t.Run("MaxAttempts", func(t *testing.T) {
+		var calls int
+		opts := &RetryOptions{MaxAttempts: 2, MinBackoff: time.Microsecond}
+		err := Retry(ctx, opts, func(context.Context) error {
+			calls++
+			return &stateError{code: "40P01"}
+		})
+		require.True(t, IsDeadlock(err))
+		require.Equal(t, 2, calls)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		var calls int
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		err := Retry(ctx, &RetryOptions{MinBackoff: time.Hour}, func(context.Context) error {
+			calls++
+			return errors.New("database is locked")
+		})
+		require.EqualError(t, err, "database is locked")
+		require.Equal(t, 1, calls)
+	})
+}
+
+func TestRetryOptions_Backoff(t *testing.T) {
+	opts := &RetryOptions{MinBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	for attempt, limit := range []time.Duration{10, 20, 40, 50, 50} {
+		limit *= time.Millisecond
+		d := opts.backoff(attempt + 1)
+		require.GreaterOrEqual(t, d, limit/2)
+		require.LessOrEqual(t, d, limit)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -260,7 +261,7 @@ func scanStruct(typ reflect.Type, columns []string) (*rowScan, error) {
 				if rv = reflect.Indirect(rv); rv.IsNil() {
 					continue
 				}
-				if err := json.Unmarshal(rv.Bytes(), rvalue.Addr().Interface()); err != nil {
+				if err := UnmarshalArray(rv.Bytes(), rvalue.Addr().Interface()); err != nil {
 					return reflect.Value{}, fmt.Errorf("unmarshal field %q: %w", ft.Name, err)
 				}
 			case !nillable(rvalue.Type()):
@@ -275,6 +276,122 @@ func scanStruct(typ reflect.Type, columns []string) (*rowScan, error) {
 	return scan, nil
 }
 
+// UnmarshalArray parses the given Postgres array literal (e.g. {a,"b c",NULL}), or
+// JSON array, into the slice that v points to. It is used by the generated code for
+// scanning fields that are annotated with entsql.Array.
+func UnmarshalArray(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	// JSON arrays, or JSON values that are scanned into non-slice types.
+	if len(data) == 0 || data[0] != '{' || rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		return json.Unmarshal(data, v)
+	}
+	elems, err := parseArray(string(data))
+	if err != nil {
+		return err
+	}
+	slice := reflect.MakeSlice(rv.Elem().Type(), len(elems), len(elems))
+	for i, e := range elems {
+		// NULL elements are kept as zero values.
+		if e == nil {
+			continue
+		}
+		if err := assignArrayElem(slice.Index(i), *e); err != nil {
+			return fmt.Errorf("sql/scan: array element %d: %w", i, err)
+		}
+	}
+	rv.Elem().Set(slice)
+	return nil
+}
+
+// parseArray parses a one-dimensional Postgres array literal into its elements.
+// NULL elements are returned as nil.
+func parseArray(s string) ([]*string, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("sql/scan: invalid array literal: %q", s)
+	}
+	var (
+		elems []*string
+		body  = s[1 : len(s)-1]
+	)
+	for i := 0; i < len(body); {
+		var b strings.Builder
+		switch body[i] {
+		case '{':
+			return nil, fmt.Errorf("sql/scan: multi-dimensional arrays are not supported: %q", s)
+		case '"':
+			for i++; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' {
+					i++
+				}
+				if i < len(body) {
+					b.WriteByte(body[i])
+				}
+			}
+			if i >= len(body) {
+				return nil, fmt.Errorf("sql/scan: invalid array literal: %q", s)
+			}
+			i++ // closing quote.
+			e := b.String()
+			elems = append(elems, &e)
+		default:
+			for ; i < len(body) && body[i] != ','; i++ {
+				b.WriteByte(body[i])
+			}
+			e := strings.TrimSpace(b.String())
+			if strings.EqualFold(e, "NULL") {
+				elems = append(elems, nil)
+			} else {
+				elems = append(elems, &e)
+			}
+		}
+		if i < len(body) {
+			if body[i] != ',' {
+				return nil, fmt.Errorf("sql/scan: invalid array literal: %q", s)
+			}
+			i++
+		}
+	}
+	return elems, nil
+}
+
+// assignArrayElem assigns the text representation of an array element to the given value.
+func assignArrayElem(v reflect.Value, s string) error {
+	if sc, ok := v.Addr().Interface().(sql.Scanner); ok {
+		return sc.Scan(s)
+	}
+	switch k := v.Kind(); {
+	case k == reflect.String:
+		v.SetString(s)
+	case k == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case k >= reflect.Int && k <= reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case k >= reflect.Uint && k <= reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case k == reflect.Float32 || k == reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
 // columnName returns the column name of a struct-field.
 func columnName(f reflect.StructField) string {
 	name := strings.ToLower(f.Name)
@@ -216,6 +216,30 @@ func TestScanJSON(t *testing.T) {
 	require.EqualError(t, ScanSlice(toRows(mock), &v1), `unmarshal field "V": unexpected end of JSON input`)
 }
 
+func TestScanArray(t *testing.T) {
+	mock := sqlmock.NewRows([]string{"tags", "ids"}).
+		AddRow([]byte(`{a,"b c","d\"e",NULL}`), []byte(`{1,2}`)).
+		AddRow([]byte(`["a"]`), []byte(`{}`)).
+		AddRow(nil, nil)
+	var v []*struct {
+		Tags []string `json:"tags"`
+		IDs  []int    `json:"ids"`
+	}
+	require.NoError(t, ScanSlice(toRows(mock), &v))
+	require.Equal(t, []string{"a", "b c", `d"e`, ""}, v[0].Tags)
+	require.Equal(t, []int{1, 2}, v[0].IDs)
+	require.Equal(t, []string{"a"}, v[1].Tags, "JSON arrays are supported as well")
+	require.Equal(t, []int{}, v[1].IDs)
+	require.Nil(t, v[2].Tags)
+	require.Nil(t, v[2].IDs)
+
+	var ids []uuid.UUID
+	require.NoError(t, UnmarshalArray([]byte(`{6ba7b810-9dad-11d1-80b4-00c04fd430c8}`), &ids))
+	require.Equal(t, []uuid.UUID{uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")}, ids)
+	require.Error(t, UnmarshalArray([]byte(`{{1,2},{3,4}}`), &ids))
+	require.Error(t, UnmarshalArray([]byte(`{"a}`), &ids))
+}
+
 func TestScanNestedStruct(t *testing.T) {
 	mock := sqlmock.NewRows([]string{"name", "age"}).
 		AddRow("foo", 1).
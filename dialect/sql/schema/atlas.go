@@ -38,6 +38,7 @@ type Atlas struct {
 	dropColumns     bool   // drop deleted columns
 	dropIndexes     bool   // drop deleted indexes
 	withForeignKeys bool   // with foreign keys
+	skipPartFKs     bool   // skip foreign keys that cannot be created due to partitioning
 	mode            Mode
 	hooks           []Hook              // hooks to apply before creation
 	diffHooks       []DiffHook          // diff hooks to run when diffing current and desired
@@ -664,12 +665,21 @@ var noQualifierOpt = func(opts *migrate.PlanOptions) {
 // planInspect creates the current state by inspecting the connected database, computing the current state of the Ent schema
 // and proceeds to diff the changes to create a migration plan.
 func (a *Atlas) planInspect(ctx context.Context, conn dialect.ExecQuerier, name string, tables []*Table) (*migrate.Plan, error) {
+	var fts []string
+	// Inspect the FTS5 tables of the full-text indexes, including the ones that were removed from the schema.
+	if d, ok := a.sqlDialect.(*SQLite); ok {
+		names, err := d.ftsTables(ctx, conn)
+		if err != nil {
+			return nil, err
+		}
+		fts = names
+	}
 	current, err := a.atDriver.InspectSchema(ctx, a.schema, &schema.InspectOptions{
 		Tables: func() (t []string) {
 			for i := range tables {
 				t = append(t, tables[i].Name)
 			}
-			return t
+			return append(t, fts...)
 		}(),
 		// Ent supports table-level inspection only.
 		Mode: schema.InspectSchemas | schema.InspectTables,
@@ -685,6 +695,10 @@ func (a *Atlas) planInspect(ctx context.Context, conn dialect.ExecQuerier, name
 		}
 		a.types = types
 	}
+	exist, err := a.partitions(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
 	realm, err := a.StateReader(tables...).ReadState(ctx)
 	if err != nil {
 		return nil, err
@@ -697,7 +711,7 @@ func (a *Atlas) planInspect(ctx context.Context, conn dialect.ExecQuerier, name
 		desired = &schema.Schema{}
 	}
 	desired.Name, desired.Attrs = current.Name, current.Attrs
-	return a.diff(ctx, name, current, desired, a.types[len(types):], noQualifierOpt)
+	return a.diff(ctx, name, current, desired, tables, exist, a.types[len(types):], noQualifierOpt)
 }
 
 func (a *Atlas) planReplay(ctx context.Context, name string, tables []*Table) (*migrate.Plan, error) {
@@ -729,6 +743,10 @@ func (a *Atlas) planReplay(ctx context.Context, name string, tables []*Table) (*
 		}
 		a.types = types
 	}
+	exist, err := a.partitions(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, a.schema, err)
+	}
 	if err := a.cleanSchema(ctx, a.schema, nil); err != nil {
 		return nil, fmt.Errorf("clean schemas after migration replaying: %w", err)
 	}
@@ -755,12 +773,12 @@ func (a *Atlas) planReplay(ctx context.Context, name string, tables []*Table) (*
 		}
 	}
 	return a.diff(ctx, name, current,
-		&schema.Schema{Name: current.Name, Attrs: current.Attrs, Tables: desired}, a.types[len(types):],
+		&schema.Schema{Name: current.Name, Attrs: current.Attrs, Tables: desired}, tables, exist, a.types[len(types):],
 		noQualifierOpt,
 	)
 }
 
-func (a *Atlas) diff(ctx context.Context, name string, current, desired *schema.Schema, newTypes []string, opts ...migrate.PlanOption) (*migrate.Plan, error) {
+func (a *Atlas) diff(ctx context.Context, name string, current, desired *schema.Schema, tables []*Table, exist map[string]*partitions, newTypes []string, opts ...migrate.PlanOption) (*migrate.Plan, error) {
 	changes, err := (&diffDriver{a.atDriver, a.diffHooks}).SchemaDiff(current, desired, a.diffOptions...)
 	if err != nil {
 		return nil, err
@@ -785,6 +803,14 @@ func (a *Atlas) diff(ctx context.Context, name string, current, desired *schema.
 	if err != nil {
 		return nil, err
 	}
+	if a.dialect == dialect.SQLite {
+		plan.Changes = append(plan.Changes, ftsChanges(current, tables)...)
+	}
+	parts, err := a.partitionChanges(tables, exist)
+	if err != nil {
+		return nil, err
+	}
+	plan.Changes = append(plan.Changes, parts...)
 	if len(newTypes) > 0 {
 		plan.Changes = append(plan.Changes, &migrate.Change{
 			Cmd:     a.sqlDialect.atTypeRangeSQL(newTypes...),
@@ -892,6 +918,11 @@ func (a *Atlas) realm(tables []*Table) (*schema.Realm, error) {
 		if err := a.aIndexes(et, at); err != nil {
 			return nil, err
 		}
+		if p, ok := a.sqlDialect.(partitioner); ok {
+			if err := p.atPartition(et, at); err != nil {
+				return nil, err
+			}
+		}
 		s.AddTables(at)
 		byT[et] = at
 	}
@@ -901,6 +932,12 @@ func (a *Atlas) realm(tables []*Table) (*schema.Realm, error) {
 		}
 		t2 := byT[t1]
 		for _, fk1 := range t1.ForeignKeys {
+			switch skip, err := a.skipForeignKey(t1, fk1); {
+			case err != nil:
+				return nil, err
+			case skip:
+				continue
+			}
 			fk2 := schema.NewForeignKey(fk1.Symbol).
 				SetTable(t2).
 				SetOnUpdate(schema.ReferenceOption(fk1.OnUpdate)).
@@ -968,6 +1005,9 @@ func (a *Atlas) aColumns(et *Table, at *schema.Table) error {
 		if err := a.atDefault(c1, c2); err != nil {
 			return err
 		}
+		if err := a.atGenerated(c1, c2); err != nil {
+			return err
+		}
 		if c1.Unique && (len(et.PrimaryKey) != 1 || et.PrimaryKey[0] != c1) {
 			a.sqlDialect.atUniqueC(et, c1, at, c2)
 		}
@@ -1026,6 +1066,13 @@ func (a *Atlas) atDefault(c1 *Column, c2 *schema.Column) error {
 			if !ok {
 				return fmt.Errorf("invalid default value for JSON column %q: %v", c1.Name, c1.Default)
 			}
+			if a.dialect == dialect.Postgres {
+				d, err := arrayDefault(c2, s)
+				if err != nil {
+					return fmt.Errorf("invalid default value for array column %q: %w", c1.Name, err)
+				}
+				s = d
+			}
 			c2.SetDefault(&schema.Literal{V: strings.ReplaceAll(s, "'", "''")})
 		default:
 			// Keep backwards compatibility with the old default value format.
@@ -1040,10 +1087,28 @@ func (a *Atlas) atDefault(c1 *Column, c2 *schema.Column) error {
 	return nil
 }
 
+func (a *Atlas) atGenerated(c1 *Column, c2 *schema.Column) error {
+	if c1.Generated == nil {
+		return nil
+	}
+	x := c1.Generated.Expr
+	if d, ok := c1.Generated.Exprs[a.sqlDialect.Dialect()]; ok {
+		x = d
+	}
+	if x == "" {
+		return fmt.Errorf("missing generation expression of column %q for dialect %q", c1.Name, a.sqlDialect.Dialect())
+	}
+	c2.SetGeneratedExpr(&schema.GeneratedExpr{Expr: x, Type: c1.Generated.Type})
+	return nil
+}
+
 func (a *Atlas) aIndexes(et *Table, at *schema.Table) error {
 	// Primary-key index.
 	pk := make([]*schema.Column, 0, len(et.PrimaryKey))
 	for _, c1 := range et.PrimaryKey {
+		if a.skipPartitionKey(et, c1) {
+			continue
+		}
 		c2, ok := at.Column(c1.Name)
 		if !ok {
 			return fmt.Errorf("unexpected primary-key column: %q", c1.Name)
@@ -1056,6 +1121,11 @@ func (a *Atlas) aIndexes(et *Table, at *schema.Table) error {
 	}
 	// Rest of indexes.
 	for _, idx1 := range et.Indexes {
+		// Full-text indexes are created as FTS5 virtual tables in SQLite,
+		// and spatial indexes are skipped, as geometries are stored as blobs.
+		if a.dialect == dialect.SQLite && (isFullText(idx1) || isSpatial(idx1)) {
+			continue
+		}
 		idx2 := schema.NewIndex(idx1.Name).
 			SetUnique(idx1.Unique)
 		if err := a.sqlDialect.atIndex(idx1, at, idx2); err != nil {
@@ -1063,7 +1133,9 @@ func (a *Atlas) aIndexes(et *Table, at *schema.Table) error {
 		}
 		desc := descIndexes(idx1)
 		for _, p := range idx2.Parts {
-			p.Desc = desc[p.C.Name]
+			if p.C != nil {
+				p.Desc = desc[p.C.Name]
+			}
 		}
 		at.AddIndexes(idx2)
 	}
@@ -1103,8 +1175,11 @@ func (a *Atlas) setupTables(tables []*Table) {
 // symbol makes sure the symbol length is not longer than the maxlength in the dialect.
 func (a *Atlas) symbol(name string) string {
 	size := 64
-	if a.dialect == dialect.Postgres {
+	switch a.dialect {
+	case dialect.Postgres:
 		size = 63
+	case dialect.SQLServer:
+		size = 128
 	}
 	if len(name) <= size {
 		return name
@@ -1122,6 +1197,8 @@ func (a *Atlas) entDialect(ctx context.Context, drv dialect.Driver) (sqlDialect,
 		d = &SQLite{Driver: drv, WithForeignKeys: a.withForeignKeys}
 	case dialect.Postgres:
 		d = &Postgres{Driver: drv}
+	case dialect.SQLServer:
+		d = &SQLServer{Driver: drv}
 	default:
 		return nil, fmt.Errorf("sql/schema: unsupported dialect %q", a.dialect)
 	}
@@ -1198,6 +1275,7 @@ func (r *diffDriver) RealmDiff(_, _ *schema.Realm, _ ...schema.DiffOption) ([]sc
 // SchemaDiff creates the diff between two schemas, but includes "diff hooks".
 func (r *diffDriver) SchemaDiff(from, to *schema.Schema, opts ...schema.DiffOption) ([]schema.Change, error) {
 	var d Differ = DiffFunc(func(current, desired *schema.Schema) ([]schema.Change, error) {
+		normalizeGenerated(current, desired)
 		return r.Driver.SchemaDiff(current, desired, opts...)
 	})
 	for i := len(r.hooks) - 1; i >= 0; i-- {
@@ -1206,6 +1284,265 @@ func (r *diffDriver) SchemaDiff(from, to *schema.Schema, opts ...schema.DiffOpti
 	return d.Diff(from, to)
 }
 
+// normalizeGenerated copies the generation expressions of the current columns to
+// their desired columns if they are equivalent. Databases store the expressions in
+// their own format (e.g. with quoted identifiers, redundant parentheses and casts),
+// and comparing them textually reports changes that were not made to the schema.
+func normalizeGenerated(current, desired *schema.Schema) {
+	for _, t2 := range desired.Tables {
+		t1, ok := current.Table(t2.Name)
+		if !ok {
+			continue
+		}
+		for _, c2 := range t2.Columns {
+			c1, ok := t1.Column(c2.Name)
+			if !ok {
+				continue
+			}
+			x1, x2 := generatedExpr(c1), generatedExpr(c2)
+			if x1 == nil || x2 == nil || x1.Expr == x2.Expr {
+				continue
+			}
+			// Expressions that could not be parsed are reported as changed.
+			if k1, ok := generatedKey(x1.Expr); ok {
+				if k2, ok := generatedKey(x2.Expr); ok && k1 == k2 {
+					c2.SetGeneratedExpr(&schema.GeneratedExpr{Expr: x1.Expr, Type: x2.Type})
+				}
+			}
+		}
+	}
+}
+
+// generatedExpr returns the generation expression of the column, if it is a generated column.
+func generatedExpr(c *schema.Column) *schema.GeneratedExpr {
+	for _, a := range c.Attrs {
+		if x, ok := a.(*schema.GeneratedExpr); ok {
+			return x
+		}
+	}
+	return nil
+}
+
+// generatedKey returns the canonical form of a generation expression that is used for comparing
+// it with the inspected expression, or false if the expression could not be parsed. The expression
+// is parsed by the precedence of its operators, and only what databases rewrite is normalized:
+// redundant parentheses, PostgreSQL type casts, MySQL charset introducers, the quoting style of
+// identifiers and the case of unquoted identifiers and keywords. e.g. "(`a` + `b`) * c" and
+// "((a)::numeric + (b)::numeric) * c" have the same key, but "a + (b * c)" does not.
+func generatedKey(x string) (string, bool) {
+	toks, ok := generatedTokens(x)
+	if !ok {
+		return "", false
+	}
+	p := &exprParser{toks: toks}
+	k := p.expr(0)
+	if p.failed || p.pos != len(p.toks) {
+		return "", false
+	}
+	return k, true
+}
+
+// exprToken is a token of a generation expression.
+type exprToken struct {
+	text   string
+	ident  bool // Identifier or keyword.
+	quoted bool // Quoted identifier.
+}
+
+// generatedTokens splits the expression to tokens. Casts and charset introducers are
+// skipped, unquoted identifiers and keywords are lower-cased, and quoted identifiers
+// are kept as is.
+func generatedTokens(x string) ([]exprToken, bool) {
+	var toks []exprToken
+	for i := 0; i < len(x); i++ {
+		switch c := x[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		case c == '\'':
+			j := i + 1
+			for ; j < len(x); j++ {
+				if x[j] == '\'' {
+					if j+1 < len(x) && x[j+1] == '\'' {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j == len(x) {
+				return nil, false
+			}
+			toks = append(toks, exprToken{text: x[i : j+1]})
+			i = j
+		case c == '`' || c == '"' || c == '[':
+			end := c
+			if c == '[' {
+				end = ']'
+			}
+			j := strings.IndexByte(x[i+1:], end)
+			if j == -1 {
+				return nil, false
+			}
+			toks = append(toks, exprToken{text: x[i+1 : i+1+j], ident: true, quoted: true})
+			i += j + 1
+		case c == ':' && i+1 < len(x) && x[i+1] == ':':
+			i = skipCast(x, i+2) - 1
+		case isIdentByte(c):
+			j := i
+			for j < len(x) && (isIdentByte(x[j]) || x[j] == '.') {
+				j++
+			}
+			// Skip charset introducers of string literals. e.g. _utf8mb4'abc'.
+			if c != '_' || j == len(x) || x[j] != '\'' {
+				w := strings.ToLower(x[i:j])
+				toks = append(toks, exprToken{text: w, ident: c < '0' || c > '9'})
+			}
+			i = j - 1
+		default:
+			op := string(c)
+			for _, o := range []string{"->>", "->", "||", "<=", ">=", "<>", "!="} {
+				if strings.HasPrefix(x[i:], o) {
+					op = o
+					break
+				}
+			}
+			toks = append(toks, exprToken{text: op})
+			i += len(op) - 1
+		}
+	}
+	return toks, true
+}
+
+// exprParser parses the tokens of a generation expression to their canonical form, in
+// which every operation is wrapped with parentheses, regardless of how it was written.
+type exprParser struct {
+	toks   []exprToken
+	pos    int
+	failed bool
+}
+
+// exprPrecedence holds the precedence of the binary operators, based on PostgreSQL.
+var exprPrecedence = map[string]int{
+	"or":  1,
+	"and": 2,
+	"=":   4, "<>": 4, "!=": 4, "<": 4, ">": 4, "<=": 4, ">=": 4, "like": 4,
+	"||": 5, "->": 5, "->>": 5,
+	"+": 6, "-": 6,
+	"*": 7, "/": 7, "%": 7,
+}
+
+// peek returns the current token, or an empty token at the end of the expression.
+func (p *exprParser) peek() exprToken {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return exprToken{}
+}
+
+// expr parses a binary expression whose operators bind at least as tight as prec.
+func (p *exprParser) expr(prec int) string {
+	left := p.unary()
+	for !p.failed {
+		t := p.peek()
+		o, ok := exprPrecedence[t.text]
+		if !ok || o < prec || t.quoted {
+			break
+		}
+		p.pos++
+		left = "(" + left + " " + t.text + " " + p.expr(o+1) + ")"
+	}
+	return left
+}
+
+// unary parses a unary expression, a parenthesized expression, a function call or an operand.
+// Expressions that are not supported by the parser (e.g. CASE) fail the parsing.
+func (p *exprParser) unary() string {
+	if p.pos == len(p.toks) {
+		p.failed = true
+		return ""
+	}
+	t := p.toks[p.pos]
+	p.pos++
+	switch {
+	case t.quoted:
+		return `"` + t.text + `"`
+	case t.text == "-" || t.text == "+":
+		return "(" + t.text + p.expr(8) + ")"
+	case t.text == "not":
+		return "(not " + p.expr(3) + ")"
+	case t.text == "(":
+		x := p.expr(0)
+		p.expect(")")
+		return x
+	case t.ident && p.peek().text == "(":
+		p.pos++
+		var args []string
+		for !p.failed && p.peek().text != ")" {
+			if len(args) > 0 {
+				p.expect(",")
+			}
+			args = append(args, p.expr(0))
+		}
+		p.expect(")")
+		return t.text + "(" + strings.Join(args, ", ") + ")"
+	case t.ident:
+		if _, ok := exprPrecedence[t.text]; ok || exprKeywords[t.text] {
+			p.failed = true
+		}
+		return `"` + t.text + `"`
+	case t.text != "" && (t.text[0] == '\'' || t.text[0] >= '0' && t.text[0] <= '9'):
+		return t.text
+	default:
+		p.failed = true
+		return ""
+	}
+}
+
+// expect consumes the given token, or fails the parsing.
+func (p *exprParser) expect(text string) {
+	if p.peek().text != text {
+		p.failed = true
+		return
+	}
+	p.pos++
+}
+
+// exprKeywords holds the keywords that are not supported by the parser.
+var exprKeywords = map[string]bool{"case": true, "when": true, "then": true, "else": true, "end": true, "is": true, "in": true, "between": true}
+
+// skipCast returns the position after the PostgreSQL type name that starts at i.
+// Multi-word types, like "character varying" or "double precision", are skipped
+// as a whole.
+func skipCast(x string, i int) int {
+	for i < len(x) && x[i] == ' ' {
+		i++
+	}
+	for first := true; ; first = false {
+		j := i
+		for j < len(x) && x[j] == ' ' {
+			j++
+		}
+		k := j
+		for k < len(x) && isIdentByte(x[k]) {
+			k++
+		}
+		if k == j || !first && !castWords[strings.ToLower(x[j:k])] {
+			break
+		}
+		i = k
+	}
+	for strings.HasPrefix(x[i:], "[]") {
+		i += 2
+	}
+	return i
+}
+
+// castWords holds the words that follow the first word of multi-word type names.
+var castWords = map[string]bool{"varying": true, "precision": true, "with": true, "without": true, "time": true, "zone": true}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
 // removeAttr is a temporary patch due to compiler errors we get by using the generic
 // schema.RemoveAttr function (<autogenerated>:1: internal compiler error: panic: ...).
 // Can be removed in Go 1.20. See: https://github.com/golang/go/issues/54302.
@@ -88,6 +88,16 @@ func WithForeignKeys(b bool) MigrateOption {
 	}
 }
 
+// WithSkipPartitionForeignKeys sets the option for skipping the foreign keys that cannot be
+// created due to partitioning. i.e. foreign keys that reference partitioned tables, and in
+// MySQL, the foreign keys of partitioned tables. Defaults to false, and these foreign keys
+// fail the migration.
+func WithSkipPartitionForeignKeys(b bool) MigrateOption {
+	return func(a *Atlas) {
+		a.skipPartFKs = b
+	}
+}
+
 // WithHooks adds a list of hooks to the schema migration.
 func WithHooks(hooks ...Hook) MigrateOption {
 	return func(a *Atlas) {
@@ -7,9 +7,11 @@ package schema
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 	"text/template"
@@ -22,11 +24,13 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
 )
 
 func TestMigrate_SchemaName(t *testing.T) {
@@ -444,3 +448,534 @@ func TestAtlas_StateReader(t *testing.T) {
 		},
 	)
 }
+
+func TestMigrate_FullText(t *testing.T) {
+	posts := &Table{
+		Name: "posts",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "title", Type: field.TypeString},
+			{Name: "body", Type: field.TypeString, Size: 1 << 20, SchemaType: map[string]string{dialect.Postgres: "text"}},
+		},
+	}
+	posts.PrimaryKey = posts.Columns[:1]
+	posts.Indexes = []*Index{
+		{Name: "post_title_body", Columns: posts.Columns[1:], Annotation: entsql.TextSearchConfig("english")},
+	}
+	ctx := context.Background()
+	for _, tt := range []struct{ dialect, version, expected string }{
+		{dialect.MySQL, "8", "CREATE TABLE `posts` (\n  `id` bigint NOT NULL AUTO_INCREMENT,\n  `title` varchar(255) NOT NULL,\n  `body` longtext NOT NULL,\n  PRIMARY KEY (`id`),\n  FULLTEXT INDEX `post_title_body` (`title`, `body`)\n) CHARSET utf8mb4 COLLATE utf8mb4_bin;\n"},
+		{dialect.Postgres, "15", "CREATE INDEX \"post_title_body\" ON \"posts\" USING GIN ((to_tsvector('english'::regconfig, (title)::text)), (to_tsvector('english'::regconfig, body)));\n"},
+	} {
+		out, err := Dump(ctx, tt.dialect, tt.version, []*Table{posts})
+		require.NoError(t, err)
+		require.Contains(t, out, tt.expected)
+	}
+
+	db, err := sql.Open(dialect.SQLite, "file:fts?mode=memory&_fk=1")
+	require.NoError(t, err)
+	d, err := migrate.NewLocalDir(t.TempDir())
+	require.NoError(t, err)
+	f, err := migrate.NewTemplateFormatter(
+		template.Must(template.New("").Parse("{{ .Name }}.sql")),
+		template.Must(template.New("").Parse(`{{ range .Changes }}{{ printf "%s;\n" .Cmd }}{{ end }}`)),
+	)
+	require.NoError(t, err)
+	m, err := NewMigrate(db, WithFormatter(f), WithDir(d))
+	require.NoError(t, err)
+	require.NoError(t, m.NamedDiff(ctx, "changes", posts))
+	requireFileEqual(t, filepath.Join(d.Path(), "changes.sql"), strings.Join([]string{
+		"CREATE TABLE `posts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `title` text NOT NULL, `body` text NOT NULL);",
+		"CREATE VIRTUAL TABLE `post_title_body` USING fts5(`title`, `body`, content=`posts`);",
+		"CREATE TRIGGER `post_title_body_ai` AFTER INSERT ON `posts` BEGIN INSERT INTO `post_title_body`(rowid, `title`, `body`) VALUES (new.rowid, new.`title`, new.`body`); END;",
+		"CREATE TRIGGER `post_title_body_ad` AFTER DELETE ON `posts` BEGIN INSERT INTO `post_title_body`(`post_title_body`, rowid, `title`, `body`) VALUES ('delete', old.rowid, old.`title`, old.`body`); END;",
+		"CREATE TRIGGER `post_title_body_au` AFTER UPDATE ON `posts` BEGIN INSERT INTO `post_title_body`(`post_title_body`, rowid, `title`, `body`) VALUES ('delete', old.rowid, old.`title`, old.`body`); INSERT INTO `post_title_body`(rowid, `title`, `body`) VALUES (new.rowid, new.`title`, new.`body`); END;",
+		"INSERT INTO `post_title_body`(`post_title_body`) VALUES ('rebuild');",
+		"",
+	}, "\n"))
+
+	// FTS5 tables with a different definition are recreated, and the ones of removed indexes are dropped.
+	current := schema.New("main").AddTables(
+		schema.NewTable("posts"),
+		schema.NewTable("post_title_body").
+			AddAttrs(&sqlite.CreateStmt{S: "CREATE VIRTUAL TABLE `post_title_body` USING fts5(`title`, `body`, content=`posts`)"}),
+	)
+	cmds := func(changes []*migrate.Change) []string {
+		s := make([]string, len(changes))
+		for i := range changes {
+			s[i] = changes[i].Cmd
+		}
+		return s
+	}
+	require.Empty(t, ftsChanges(current, []*Table{posts}))
+	drop := []string{
+		"DROP TRIGGER IF EXISTS `post_title_body_ai`",
+		"DROP TRIGGER IF EXISTS `post_title_body_ad`",
+		"DROP TRIGGER IF EXISTS `post_title_body_au`",
+		"DROP TABLE `post_title_body`",
+	}
+	posts.Indexes[0].Columns = posts.Columns[1:2]
+	require.Equal(t, append(drop,
+		"CREATE VIRTUAL TABLE `post_title_body` USING fts5(`title`, content=`posts`)",
+		"CREATE TRIGGER `post_title_body_ai` AFTER INSERT ON `posts` BEGIN INSERT INTO `post_title_body`(rowid, `title`) VALUES (new.rowid, new.`title`); END",
+		"CREATE TRIGGER `post_title_body_ad` AFTER DELETE ON `posts` BEGIN INSERT INTO `post_title_body`(`post_title_body`, rowid, `title`) VALUES ('delete', old.rowid, old.`title`); END",
+		"CREATE TRIGGER `post_title_body_au` AFTER UPDATE ON `posts` BEGIN INSERT INTO `post_title_body`(`post_title_body`, rowid, `title`) VALUES ('delete', old.rowid, old.`title`); INSERT INTO `post_title_body`(rowid, `title`) VALUES (new.rowid, new.`title`); END",
+		"INSERT INTO `post_title_body`(`post_title_body`) VALUES ('rebuild')",
+	), cmds(ftsChanges(current, []*Table{posts})))
+	posts.Indexes = nil
+	require.Equal(t, drop, cmds(ftsChanges(current, []*Table{posts})))
+	// FTS5 tables of other tables are left untouched.
+	require.Empty(t, ftsChanges(current, []*Table{{Name: "users"}}))
+}
+
+func TestMigrate_Array(t *testing.T) {
+	posts := &Table{
+		Name: "posts",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "tags", Type: field.TypeJSON, Default: `["a","b\"c"]`, SchemaType: map[string]string{dialect.Postgres: "text[]"}},
+			{Name: "ranks", Type: field.TypeJSON, Nullable: true, Default: `[1,1000000]`, SchemaType: map[string]string{dialect.Postgres: "bigint[]"}},
+		},
+	}
+	posts.PrimaryKey = posts.Columns[:1]
+	out, err := Dump(context.Background(), dialect.Postgres, "15", []*Table{posts})
+	require.NoError(t, err)
+	require.Contains(t, out, "\"tags\" text[] NOT NULL DEFAULT '{\"a\",\"b\\\"c\"}',\n  \"ranks\" bigint[] NULL DEFAULT '{1,1000000}',\n")
+}
+
+func TestMigrate_SQLServer(t *testing.T) {
+	users := &Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "name", Type: field.TypeString, Default: "unknown"},
+			{Name: "nickname", Type: field.TypeString, Unique: true, Nullable: true},
+			{Name: "active", Type: field.TypeBool, Default: true},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	pets := &Table{
+		Name: "pets",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeUUID},
+			{Name: "attrs", Type: field.TypeJSON, Default: `{"a":1}`},
+			{Name: "born_at", Type: field.TypeTime, Nullable: true},
+			{Name: "owner_id", Type: field.TypeInt, Nullable: true},
+		},
+	}
+	pets.PrimaryKey = pets.Columns[:1]
+	pets.AddForeignKey(&ForeignKey{
+		Symbol:     "pets_users_pets",
+		Columns:    pets.Columns[3:],
+		RefTable:   users,
+		RefColumns: users.Columns[:1],
+		OnDelete:   SetNull,
+	})
+	out, err := Dump(context.Background(), dialect.SQLServer, "16", []*Table{pets, users})
+	require.NoError(t, err)
+	require.Contains(t, out, `-- Create "pets" table
+CREATE TABLE [pets] (
+  [id] char(36) NOT NULL,
+  [attrs] nvarchar(max) NOT NULL CONSTRAINT [DF_pets_attrs] DEFAULT N'{"a":1}',
+  [born_at] datetimeoffset NULL,
+  [owner_id] bigint NULL,
+  CONSTRAINT [pets_pkey] PRIMARY KEY ([id])
+);
+-- Create "users" table
+CREATE TABLE [users] (
+  [id] bigint IDENTITY(1, 1) NOT NULL,
+  [name] nvarchar(255) NOT NULL CONSTRAINT [DF_users_name] DEFAULT N'unknown',
+  [nickname] nvarchar(255) NULL,
+  [active] bit NOT NULL CONSTRAINT [DF_users_active] DEFAULT 1,
+  CONSTRAINT [users_pkey] PRIMARY KEY ([id])
+);
+-- Create index "users_nickname_key" to table: "users"
+CREATE UNIQUE INDEX [users_nickname_key] ON [users] ([nickname]) WHERE [nickname] IS NOT NULL;
+-- Modify "pets" table
+ALTER TABLE [pets] ADD CONSTRAINT [pets_users_pets] FOREIGN KEY ([owner_id]) REFERENCES [users] ([id]) ON DELETE SET NULL;
+`)
+
+	// Inspect the existing "users" table, and apply the changes.
+	db, mk, err := sqlmock.New()
+	require.NoError(t, err)
+	mk.ExpectQuery(escape("SELECT CAST(SERVERPROPERTY('ProductVersion') AS nvarchar(128))")).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow("16.0.1000.6"))
+	mk.ExpectQuery(escape("SELECT SCHEMA_NAME()")).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("dbo"))
+	mk.ExpectQuery(escape("SELECT [t].[name] FROM [sys].[tables] AS [t] WHERE [t].[schema_id] = SCHEMA_ID(@p1) AND [t].[name] IN (@p2) ORDER BY [t].[name]")).
+		WithArgs("dbo", "users").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("users"))
+	mk.ExpectQuery("SELECT .+ FROM \\[sys\\]\\.\\[columns\\] .+").
+		WithArgs("dbo", "users").
+		WillReturnRows(sqlmock.NewRows([]string{"table", "name", "type", "max_length", "precision", "scale", "is_nullable", "is_identity", "seed", "increment", "df_name", "df_definition", "collation"}).
+			AddRow("users", "id", "bigint", 8, 19, 0, false, true, 1, 1, nil, nil, nil).
+			AddRow("users", "name", "nvarchar", 510, 0, 0, false, false, nil, nil, "DF_users_name", "(N'unknown')", "SQL_Latin1_General_CP1_CI_AS").
+			AddRow("users", "nickname", "nvarchar", 200, 0, 0, true, false, nil, nil, nil, nil, "SQL_Latin1_General_CP1_CI_AS").
+			AddRow("users", "active", "bit", 1, 1, 0, false, false, nil, nil, "DF_users_active", "((0))", nil))
+	mk.ExpectQuery("SELECT .+ FROM \\[sys\\]\\.\\[indexes\\] .+").
+		WithArgs("dbo", "users").
+		WillReturnRows(sqlmock.NewRows([]string{"table", "name", "is_primary_key", "is_unique", "type_desc", "filter_definition", "column", "is_descending_key", "is_included_column"}).
+			AddRow("users", "users_pkey", true, true, "CLUSTERED", nil, "id", false, false))
+	mk.ExpectQuery("SELECT .+ FROM \\[sys\\]\\.\\[foreign_keys\\] .+").
+		WithArgs("dbo", "users").
+		WillReturnRows(sqlmock.NewRows([]string{"table", "name", "column", "ref_schema", "ref_table", "ref_column", "on_update", "on_delete"}))
+	mk.ExpectQuery("SELECT .+ FROM \\[sys\\]\\.\\[check_constraints\\] .+").
+		WithArgs("dbo", "users").
+		WillReturnRows(sqlmock.NewRows([]string{"table", "name", "definition"}))
+	mk.ExpectBegin()
+	for _, q := range []string{
+		"ALTER TABLE [users] ALTER COLUMN [nickname] nvarchar(255) NULL",
+		"ALTER TABLE [users] DROP CONSTRAINT [DF_users_active]",
+		"ALTER TABLE [users] ADD CONSTRAINT [DF_users_active] DEFAULT 1 FOR [active]",
+		"CREATE UNIQUE INDEX [users_nickname_key] ON [users] ([nickname]) WHERE [nickname] IS NOT NULL",
+	} {
+		mk.ExpectExec(escape(q)).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	mk.ExpectCommit()
+	m, err := NewMigrate(sql.OpenDB(dialect.SQLServer, db))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(context.Background(), users))
+	require.NoError(t, mk.ExpectationsWereMet())
+}
+
+func TestMigrate_SQLiteDriver(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLiteDriver, "file:purego?mode=memory")
+	require.NoError(t, err)
+	require.Equal(t, dialect.SQLite, drv.Dialect())
+	m, err := NewMigrate(drv)
+	require.NoError(t, err)
+	require.EqualError(t, m.Create(ctx), `sqlite: foreign_keys pragma is off: missing "_fk=1" (or "_pragma=foreign_keys(1)") in the connection string`)
+	require.NoError(t, drv.Close())
+
+	drv, err = sql.Open(dialect.SQLiteDriver, "file:purego?mode=memory&_pragma=foreign_keys(1)")
+	require.NoError(t, err)
+	defer drv.Close()
+	users := &Table{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "name", Type: field.TypeString, Unique: true},
+		},
+	}
+	users.PrimaryKey = users.Columns[:1]
+	pets := &Table{
+		Name: "pets",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "owner_id", Type: field.TypeInt},
+		},
+	}
+	pets.PrimaryKey = pets.Columns[:1]
+	pets.AddForeignKey(&ForeignKey{
+		Symbol:     "pets_users_pets",
+		Columns:    pets.Columns[1:],
+		RefTable:   users,
+		RefColumns: users.Columns[:1],
+		OnDelete:   NoAction,
+	})
+	m, err = NewMigrate(drv)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users, pets))
+	// Second run is a noop.
+	require.NoError(t, m.Create(ctx, users, pets))
+
+	require.NoError(t, drv.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?)", []any{"a8m"}, nil))
+	err = drv.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?)", []any{"a8m"}, nil)
+	require.True(t, sqlgraph.IsUniqueConstraintError(err), err)
+	err = drv.Exec(ctx, "INSERT INTO `pets` (`owner_id`) VALUES (?)", []any{2}, nil)
+	require.True(t, sqlgraph.IsForeignKeyConstraintError(err), err)
+}
+
+func TestMigrate_Spatial(t *testing.T) {
+	stores := &Table{
+		Name: "stores",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "location", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "geography(Point,4326)", dialect.MySQL: "point", dialect.SQLite: "blob"}},
+		},
+	}
+	stores.PrimaryKey = stores.Columns[:1]
+	stores.Indexes = []*Index{
+		{Name: "store_location", Columns: stores.Columns[1:], Annotation: entsql.Spatial()},
+	}
+	ctx := context.Background()
+	for _, tt := range []struct{ dialect, version, expected string }{
+		{dialect.MySQL, "8", "CREATE TABLE `stores` (\n  `id` bigint NOT NULL AUTO_INCREMENT,\n  `location` point NOT NULL,\n  PRIMARY KEY (`id`),\n  SPATIAL INDEX `store_location` (`location`)\n) CHARSET utf8mb4 COLLATE utf8mb4_bin;\n"},
+		{dialect.Postgres, "15", "CREATE INDEX \"store_location\" ON \"stores\" USING GIST (\"location\");\n"},
+	} {
+		out, err := Dump(ctx, tt.dialect, tt.version, []*Table{stores})
+		require.NoError(t, err)
+		require.Contains(t, out, tt.expected)
+	}
+	out, err := Dump(ctx, dialect.SQLite, "3", []*Table{stores})
+	require.NoError(t, err)
+	require.NotContains(t, out, "store_location")
+}
+
+func TestMigrate_Generated(t *testing.T) {
+	items := &Table{
+		Name: "items",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "price", Type: field.TypeFloat64},
+			{Name: "quantity", Type: field.TypeInt},
+			{Name: "total", Type: field.TypeFloat64, Generated: &Generated{Expr: "price * quantity", Type: "STORED"}},
+			{Name: "label", Type: field.TypeString, Nullable: true, Generated: &Generated{
+				Exprs: map[string]string{
+					dialect.MySQL:    "concat('#', id)",
+					dialect.Postgres: "'#' || id",
+					dialect.SQLite:   "'#' || id",
+				},
+			}},
+		},
+	}
+	items.PrimaryKey = items.Columns[:1]
+	ctx := context.Background()
+	for _, tt := range []struct{ dialect, version string }{
+		{dialect.MySQL, "8"},
+		{dialect.Postgres, "15"},
+		{dialect.SQLite, "3"},
+	} {
+		out, err := Dump(ctx, tt.dialect, tt.version, []*Table{items})
+		require.NoError(t, err)
+		switch tt.dialect {
+		case dialect.MySQL:
+			require.Contains(t, out, "`total` double AS (price * quantity) STORED NOT NULL")
+			require.Contains(t, out, "`label` varchar(255) AS (concat('#', id)) NULL")
+		case dialect.Postgres:
+			require.Contains(t, out, `"total" double precision NOT NULL GENERATED ALWAYS AS (price * quantity) STORED`)
+			require.Contains(t, out, `"label" character varying NULL GENERATED ALWAYS AS ('#' || id) STORED`)
+		case dialect.SQLite:
+			require.Contains(t, out, "`total` real NOT NULL AS (price * quantity) STORED")
+			require.Contains(t, out, "`label` text NULL AS ('#' || id)\n")
+		}
+	}
+
+	db, err := sql.Open(dialect.SQLite, "file:generated?mode=memory&_fk=1")
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "CREATE TABLE `items` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `price` real NOT NULL, `quantity` integer NOT NULL, `total` real NOT NULL GENERATED ALWAYS AS ((`price`) * (\"quantity\")) STORED, `label` text NULL AS ('#' || `id`) VIRTUAL)")
+	require.NoError(t, err)
+	changes := func(tables ...*Table) []schema.Change {
+		var changes []schema.Change
+		m, err := NewMigrate(db, WithDiffHook(func(next Differ) Differ {
+			return DiffFunc(func(current, desired *schema.Schema) ([]schema.Change, error) {
+				cs, err := next.Diff(current, desired)
+				changes = cs
+				return nil, err
+			})
+		}))
+		require.NoError(t, err)
+		require.NoError(t, m.Create(ctx, tables...))
+		return changes
+	}
+	// Equivalent expressions are not reported as changes.
+	require.Empty(t, changes(items))
+
+	// Changing the expression or the type of the column.
+	modified := *items
+	modified.Columns = slices.Clone(items.Columns)
+	modified.Columns[3] = &Column{Name: "total", Type: field.TypeFloat64, Generated: &Generated{Expr: "price * quantity * 2", Type: "STORED"}}
+	cs := changes(&modified)
+	require.Len(t, cs, 1)
+	require.Len(t, cs[0].(*schema.ModifyTable).Changes, 1)
+	modified.Columns[3] = &Column{Name: "total", Type: field.TypeFloat64, Generated: &Generated{Expr: "price * quantity"}}
+	require.Len(t, changes(&modified), 1)
+
+	// Changing only the parentheses of the expression.
+	_, err = db.ExecContext(ctx, "CREATE TABLE `orders` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `price` real NOT NULL, `tax` real NOT NULL, `quantity` integer NOT NULL, `total` real NOT NULL GENERATED ALWAYS AS (((`price` + `tax`)) * `quantity`) STORED)")
+	require.NoError(t, err)
+	orders := &Table{
+		Name: "orders",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "price", Type: field.TypeFloat64},
+			{Name: "tax", Type: field.TypeFloat64},
+			{Name: "quantity", Type: field.TypeInt},
+			{Name: "total", Type: field.TypeFloat64, Generated: &Generated{Expr: "(price + tax) * quantity", Type: "STORED"}},
+		},
+	}
+	orders.PrimaryKey = orders.Columns[:1]
+	require.Empty(t, changes(orders))
+	orders.Columns[4].Generated.Expr = "price + (tax * quantity)"
+	cs = changes(orders)
+	require.Len(t, cs, 1)
+	require.Len(t, cs[0].(*schema.ModifyTable).Changes, 1)
+}
+
+func TestGeneratedKey(t *testing.T) {
+	for _, tt := range []struct {
+		x1, x2 string
+		equal  bool
+	}{
+		{"price * quantity", "(`price` * `quantity`)", true},
+		{"price * quantity", `("price" * "quantity")`, true},
+		{"first_name || ' ' || last_name", "(((first_name)::text || ' '::text) || (last_name)::text)", true},
+		{"concat(first_name, ' ', last_name)", "concat(`first_name`,_utf8mb4' ',`last_name`)", true},
+		{"amount::double precision / 100", "((amount)::double precision / (100)::double precision)", true},
+		{"lower(name)", "LOWER(`name`)", true},
+		{"first_name || ' ' || last_name", "first_name || '' || last_name", false},
+		{"price * quantity", "price * quantity * 2", false},
+		{"'A'", "'a'", false},
+		{"(price + tax) * quantity", "((`price` + `tax`) * `quantity`)", true},
+		{"(price + tax) * quantity", "price + (tax * quantity)", false},
+		{"price + tax * quantity", "(price + (tax * quantity))", true},
+		{"price - (tax - discount)", "price - tax - discount", false},
+		{`"First Name" || last_name`, "firstname || last_name", false},
+		{`"Price" * quantity`, "price * quantity", false},
+		{"case when price > 0 then price end", "case when price > 0 then price end", false},
+	} {
+		k1, ok1 := generatedKey(tt.x1)
+		k2, ok2 := generatedKey(tt.x2)
+		require.Equal(t, tt.equal, ok1 && ok2 && k1 == k2, "%s = %s", tt.x1, tt.x2)
+	}
+}
+
+func TestMigrate_Partition(t *testing.T) {
+	events := &Table{
+		Name: "events",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt},
+			{Name: "created_at", Type: field.TypeTime},
+			{Name: "name", Type: field.TypeString},
+		},
+		Annotation: entsql.Partition(entsql.PartitionRange, []string{"created_at"},
+			entsql.RangePartition("events_2025_01", "'2025-01-01'", "'2025-02-01'"),
+			entsql.RangePartition("events_2025_02", "'2025-02-01'", "'2025-03-01'"),
+		),
+	}
+	events.PrimaryKey = events.Columns[:2]
+	logs := &Table{
+		Name: "logs",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "event_logs", Type: field.TypeInt, Nullable: true},
+		},
+	}
+	logs.PrimaryKey = logs.Columns[:1]
+	logs.ForeignKeys = []*ForeignKey{{
+		Symbol:     "logs_events_logs",
+		Columns:    logs.Columns[1:],
+		RefTable:   events,
+		RefColumns: events.Columns[:1],
+		OnDelete:   SetNull,
+	}}
+	ctx := context.Background()
+	out, err := Dump(ctx, dialect.Postgres, "15", []*Table{events, logs})
+	require.NoError(t, err)
+	require.Contains(t, out, `PRIMARY KEY ("id", "created_at")`)
+	require.Contains(t, out, `PARTITION BY RANGE ("created_at")`)
+	require.Contains(t, out, `CREATE TABLE "events_2025_01" PARTITION OF "events" FOR VALUES FROM ('2025-01-01') TO ('2025-02-01');`)
+	require.Contains(t, out, `CREATE TABLE "events_2025_02" PARTITION OF "events" FOR VALUES FROM ('2025-02-01') TO ('2025-03-01');`)
+	require.NotContains(t, out, "logs_events_logs", "foreign keys cannot reference partitioned tables")
+
+	out, err = Dump(ctx, dialect.MySQL, "8", []*Table{events, logs})
+	require.NoError(t, err)
+	require.Contains(t, out, "ALTER TABLE `events` PARTITION BY RANGE COLUMNS (`created_at`) (PARTITION `events_2025_01` VALUES LESS THAN ('2025-02-01'), PARTITION `events_2025_02` VALUES LESS THAN ('2025-03-01'));")
+	require.NotContains(t, out, "logs_events_logs")
+
+	out, err = Dump(ctx, dialect.SQLite, "3", []*Table{events, logs})
+	require.NoError(t, err)
+	require.NotContains(t, out, "PARTITION")
+
+	// Foreign keys that cannot be created due to partitioning fail the migration, unless they are skipped.
+	for _, d := range []string{dialect.Postgres, dialect.MySQL} {
+		a := &Atlas{sqlDialect: drivers("8")[d].sqlDialect, dialect: d, withForeignKeys: true}
+		_, err = a.StateReader(events, logs).ReadState(ctx)
+		require.EqualError(t, err, `sql/schema: foreign key "logs_events_logs" of table "logs" cannot be created, because it references partitioned table "events". Use WithSkipPartitionForeignKeys to skip it`)
+		WithSkipPartitionForeignKeys(true)(a)
+		_, err = a.StateReader(events, logs).ReadState(ctx)
+		require.NoError(t, err)
+	}
+	hosts := &Table{Name: "hosts", Columns: []*Column{{Name: "id", Type: field.TypeInt, Increment: true}}}
+	hosts.PrimaryKey = hosts.Columns
+	metrics := &Table{
+		Name: "metrics",
+		Columns: []*Column{
+			{Name: "id", Type: field.TypeInt},
+			{Name: "host_metrics", Type: field.TypeInt, Nullable: true},
+		},
+		Annotation: entsql.Partition(entsql.PartitionHash, []string{"id"}, entsql.HashPartition("p0")),
+	}
+	metrics.PrimaryKey = metrics.Columns[:1]
+	metrics.ForeignKeys = []*ForeignKey{{
+		Symbol:     "metrics_hosts_metrics",
+		Columns:    metrics.Columns[1:],
+		RefTable:   hosts,
+		RefColumns: hosts.Columns,
+	}}
+	a := &Atlas{sqlDialect: drivers("8")[dialect.MySQL].sqlDialect, dialect: dialect.MySQL, withForeignKeys: true}
+	_, err = a.StateReader(hosts, metrics).ReadState(ctx)
+	require.EqualError(t, err, `sql/schema: foreign key "metrics_hosts_metrics" of table "metrics" cannot be created, because MySQL does not support foreign keys on partitioned tables. Use WithSkipPartitionForeignKeys to skip it`)
+	a.dialect, a.sqlDialect = dialect.Postgres, drivers("15")[dialect.Postgres].sqlDialect
+	_, err = a.StateReader(hosts, metrics).ReadState(ctx)
+	require.NoError(t, err, "PostgreSQL supports foreign keys on partitioned tables")
+
+	// Only missing partitions are created.
+	changes, err := (&Postgres{}).partitionChanges(events, &partitions{partitioned: true, names: []string{"events_2025_01"}})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, `CREATE TABLE "events_2025_02" PARTITION OF "events" FOR VALUES FROM ('2025-02-01') TO ('2025-03-01')`, changes[0].Cmd)
+	changes, err = (&MySQL{}).partitionChanges(events, &partitions{method: "RANGE COLUMNS", partitioned: true, names: []string{"events_2025_01"}})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "ALTER TABLE `events` ADD PARTITION (PARTITION `events_2025_02` VALUES LESS THAN ('2025-03-01'))", changes[0].Cmd)
+	changes, err = (&MySQL{}).partitionChanges(events, &partitions{method: "RANGE COLUMNS", partitioned: true, names: []string{"events_2025_01", "events_2025_02", "events_old"}})
+	require.NoError(t, err)
+	require.Empty(t, changes, "existing partitions are not dropped")
+	_, err = (&MySQL{}).partitionChanges(events, &partitions{method: "KEY", partitioned: true, names: []string{"p0"}})
+	require.EqualError(t, err, `partitioning of table "events" cannot be changed from KEY to RANGE COLUMNS`)
+
+	hashed := *events
+	hashed.Annotation = entsql.Partition(entsql.PartitionHash, []string{"id"}, entsql.HashPartition("p0"), entsql.HashPartition("p1"))
+	changes, err = (&Postgres{}).partitionChanges(&hashed, nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, `CREATE TABLE "p1" PARTITION OF "events" FOR VALUES WITH (MODULUS 2, REMAINDER 1)`, changes[1].Cmd)
+	_, err = (&Postgres{}).partitionChanges(&hashed, &partitions{partitioned: true, names: []string{"p0"}})
+	require.Error(t, err)
+
+	// Partitions are created in the schema of their table, and their names are quoted.
+	qualified := *events
+	qualified.Schema = "app"
+	changes, err = (&Postgres{}).partitionChanges(&qualified, &partitions{partitioned: true, names: []string{"events_2025_01"}})
+	require.NoError(t, err)
+	require.Equal(t, `CREATE TABLE "app"."events_2025_02" PARTITION OF "app"."events" FOR VALUES FROM ('2025-02-01') TO ('2025-03-01')`, changes[0].Cmd)
+	require.Equal(t, `DROP TABLE "app"."events_2025_02"`, changes[0].Reverse)
+	require.Equal(t, []string{`ALTER TABLE "app"."events" DETACH PARTITION "app"."events_2025_01"`}, (&Postgres{}).detachPartition(&qualified, "events_2025_01"))
+	changes, err = (&MySQL{}).partitionChanges(&qualified, nil)
+	require.NoError(t, err)
+	require.Equal(t, "ALTER TABLE `app`.`events` PARTITION BY RANGE COLUMNS (`created_at`) (PARTITION `events_2025_01` VALUES LESS THAN ('2025-02-01'), PARTITION `events_2025_02` VALUES LESS THAN ('2025-03-01'))", changes[0].Cmd)
+	require.Equal(t, "CREATE TABLE `app`.`events_2025_01` LIKE `app`.`events`", (&MySQL{}).detachPartition(&qualified, "events_2025_01")[0])
+
+	// Runtime partition management.
+	for _, tt := range []struct {
+		dialect string
+		create  string
+		detach  string
+	}{
+		{
+			dialect: dialect.Postgres,
+			create:  `CREATE TABLE "events_2025_03" PARTITION OF "events" FOR VALUES FROM ('2025-03-01') TO ('2025-04-01');` + "\n",
+			detach:  `ALTER TABLE "events" DETACH PARTITION "events_2025_01";` + "\n",
+		},
+		{
+			dialect: dialect.MySQL,
+			create:  "ALTER TABLE `events` ADD PARTITION (PARTITION `events_2025_03` VALUES LESS THAN ('2025-04-01'));\n",
+			detach: "CREATE TABLE `events_2025_01` LIKE `events`;\n" +
+				"ALTER TABLE `events_2025_01` REMOVE PARTITIONING;\n" +
+				"ALTER TABLE `events` EXCHANGE PARTITION `events_2025_01` WITH TABLE `events_2025_01`;\n" +
+				"ALTER TABLE `events` DROP PARTITION `events_2025_01`;\n",
+		},
+	} {
+		var b strings.Builder
+		drv := &WriteDriver{Driver: nopDriver{dialect: tt.dialect}, Writer: &b}
+		require.NoError(t, CreatePartition(ctx, drv, events, entsql.RangePartition("events_2025_03", "'2025-03-01'", "'2025-04-01'")))
+		require.Equal(t, tt.create, b.String())
+		b.Reset()
+		require.NoError(t, DetachPartition(ctx, drv, events, "events_2025_01"))
+		require.Equal(t, tt.detach, b.String())
+	}
+	err = CreatePartition(ctx, &WriteDriver{Driver: nopDriver{dialect: dialect.Postgres}, Writer: io.Discard}, logs, entsql.RangePartition("p", "", ""))
+	require.EqualError(t, err, `sql/schema: table "logs" is not partitioned`)
+}
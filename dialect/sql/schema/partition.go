@@ -0,0 +1,422 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+)
+
+type (
+	// partitioner wraps the methods for managing the partitions of
+	// partitioned tables. Implemented by PostgreSQL and MySQL.
+	partitioner interface {
+		// atPartition sets the partition key of the table, if it is managed by Atlas.
+		atPartition(*Table, *schema.Table) error
+		// partitions returns the existing partitions of the given tables, by table name.
+		partitions(context.Context, dialect.ExecQuerier, []*Table) (map[string]*partitions, error)
+		// partitionChanges returns the changes for creating the partitions
+		// of the table that do not exist in the database.
+		partitionChanges(*Table, *partitions) ([]*migrate.Change, error)
+		// detachPartition returns the statements for detaching a partition from its table.
+		detachPartition(*Table, string) []string
+	}
+
+	// partitions describes the existing partitions of a table.
+	partitions struct {
+		method      string   // partitioning method (MySQL only). e.g. RANGE COLUMNS.
+		names       []string // partition names.
+		partitioned bool     // table is partitioned in the database.
+	}
+)
+
+// partition returns the partitioning of the table, if it is partitioned.
+func partition(t *Table) *entsql.TablePartition {
+	if t.Annotation == nil || t.Annotation.Partition == nil || len(t.Annotation.Partition.Columns) == 0 {
+		return nil
+	}
+	return t.Annotation.Partition
+}
+
+// CreatePartition creates the given partition of a partitioned table. It is used for
+// creating partitions at runtime. For example, the partition of the next month:
+//
+//	err := schema.CreatePartition(ctx, drv, migrate.EventsTable,
+//		entsql.RangePartition("events_2025_03", "'2025-03-01'", "'2025-04-01'"),
+//	)
+//
+// Note that the partitions of HASH partitioned tables cannot be created at runtime.
+func CreatePartition(ctx context.Context, drv dialect.Driver, t *Table, def *entsql.PartitionDef) error {
+	p, err := partitionerOf(drv, t)
+	if err != nil {
+		return err
+	}
+	if partition(t).Type == entsql.PartitionHash {
+		return fmt.Errorf("sql/schema: partitions of HASH partitioned table %q cannot be created at runtime", t.Name)
+	}
+	changes, err := p.partitionChanges(withDefs(t, def), &partitions{method: mysqlMethod(partition(t).Type), partitioned: true})
+	if err != nil {
+		return err
+	}
+	for _, c := range changes {
+		if err := drv.Exec(ctx, c.Cmd, []any{}, nil); err != nil {
+			return fmt.Errorf("sql/schema: create partition %q: %w", def.Name, err)
+		}
+	}
+	return nil
+}
+
+// DetachPartition detaches the partition from its partitioned table, and keeps it as a
+// standalone table with the same name. The rows of the partition are no longer returned
+// by the queries on the partitioned table, and the table can be archived or dropped.
+//
+//	err := schema.DetachPartition(ctx, drv, migrate.EventsTable, "events_2025_01")
+//
+// In MySQL, the rows of the partition are exchanged with a new table, and the partition
+// is dropped afterward. Note that these statements are not executed atomically.
+func DetachPartition(ctx context.Context, drv dialect.Driver, t *Table, name string) error {
+	p, err := partitionerOf(drv, t)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range p.detachPartition(t, name) {
+		if err := drv.Exec(ctx, stmt, []any{}, nil); err != nil {
+			return fmt.Errorf("sql/schema: detach partition %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// partitionerOf returns the partitioner of the driver dialect.
+func partitionerOf(drv dialect.Driver, t *Table) (partitioner, error) {
+	if partition(t) == nil {
+		return nil, fmt.Errorf("sql/schema: table %q is not partitioned", t.Name)
+	}
+	switch drv.Dialect() {
+	case dialect.Postgres:
+		return &Postgres{Driver: drv}, nil
+	case dialect.MySQL:
+		return &MySQL{Driver: drv}, nil
+	default:
+		return nil, fmt.Errorf("sql/schema: partitioning is not supported by dialect %q", drv.Dialect())
+	}
+}
+
+// withDefs returns a copy of the table with the given partition definitions.
+func withDefs(t *Table, defs ...*entsql.PartitionDef) *Table {
+	p := *partition(t)
+	p.Defs = defs
+	ant := *t.Annotation
+	ant.Partition = &p
+	c := *t
+	c.Annotation = &ant
+	return &c
+}
+
+// partitionName returns the quoted name of the table, or one of its
+// partitions, qualified by the schema of the table if it has one.
+func partitionName(d string, t *Table, name string) string {
+	return sql.Dialect(d).String(func(b *sql.Builder) {
+		if t.Schema != "" {
+			b.Ident(t.Schema).WriteByte('.')
+		}
+		b.Ident(name)
+	})
+}
+
+// missingDefs returns the partition definitions of the table that do not exist in the database.
+func missingDefs(t *Table, exist *partitions) []*entsql.PartitionDef {
+	var defs []*entsql.PartitionDef
+	for _, d := range partition(t).Defs {
+		if exist == nil || !slices.Contains(exist.names, d.Name) {
+			defs = append(defs, d)
+		}
+	}
+	return defs
+}
+
+// scanPartitions scans the rows of the partitions query. The columns
+// of each row are the table name, the partition name and the method.
+func scanPartitions(ctx context.Context, conn dialect.ExecQuerier, query string, args []any) (map[string]*partitions, error) {
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("query partitions: %w", err)
+	}
+	defer rows.Close()
+	parts := make(map[string]*partitions)
+	for rows.Next() {
+		var table, name, method sql.NullString
+		if err := rows.Scan(&table, &name, &method); err != nil {
+			return nil, err
+		}
+		if parts[table.String] == nil {
+			parts[table.String] = &partitions{method: method.String, partitioned: true}
+		}
+		parts[table.String].names = append(parts[table.String].names, name.String)
+	}
+	return parts, rows.Err()
+}
+
+// partitionedNames returns the names of the partitioned tables.
+func partitionedNames(tables []*Table) []any {
+	var names []any
+	for _, t := range tables {
+		if partition(t) != nil {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+func (d *Postgres) atPartition(t1 *Table, t2 *schema.Table) error {
+	p := partition(t1)
+	if p == nil {
+		return nil
+	}
+	key := &postgres.Partition{T: string(p.Type)}
+	for _, name := range p.Columns {
+		c, ok := t2.Column(name)
+		if !ok {
+			return fmt.Errorf("unknown partition key column %q in table %q", name, t1.Name)
+		}
+		key.Parts = append(key.Parts, &postgres.PartitionPart{C: c})
+	}
+	t2.AddAttrs(key)
+	return nil
+}
+
+func (d *Postgres) partitions(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*partitions, error) {
+	names := partitionedNames(tables)
+	if len(names) == 0 {
+		return nil, nil
+	}
+	var (
+		inh    = sql.Table("pg_inherits").As("i")
+		parent = sql.Table("pg_class").As("p")
+		child  = sql.Table("pg_class").As("c")
+		ns     = sql.Table("pg_namespace").As("n")
+	)
+	query, args := sql.Dialect(dialect.Postgres).
+		Select(parent.C("relname"), child.C("relname"), "''").
+		From(inh).
+		Join(parent).On(inh.C("inhparent"), parent.C("oid")).
+		Join(child).On(inh.C("inhrelid"), child.C("oid")).
+		Join(ns).On(parent.C("relnamespace"), ns.C("oid")).
+		Where(sql.And(
+			d.matchSchema(ns.C("nspname")),
+			sql.In(parent.C("relname"), names...),
+		)).
+		Query()
+	return scanPartitions(ctx, conn, query, args)
+}
+
+func (d *Postgres) partitionChanges(t *Table, exist *partitions) ([]*migrate.Change, error) {
+	p, defs := partition(t), missingDefs(t, exist)
+	if len(defs) == 0 {
+		return nil, nil
+	}
+	if p.Type == entsql.PartitionHash && exist != nil && exist.partitioned {
+		return nil, fmt.Errorf("partitions of HASH partitioned table %q cannot be changed", t.Name)
+	}
+	changes := make([]*migrate.Change, 0, len(defs))
+	for _, def := range defs {
+		var bound string
+		switch p.Type {
+		case entsql.PartitionRange:
+			from, to := cmp.Or(def.From, "MINVALUE"), cmp.Or(def.To, "MAXVALUE")
+			bound = fmt.Sprintf("FROM (%s) TO (%s)", from, to)
+		case entsql.PartitionList:
+			bound = fmt.Sprintf("IN (%s)", strings.Join(def.Values, ", "))
+		case entsql.PartitionHash:
+			// The modulus and remainders are derived from all partitions of the table.
+			bound = fmt.Sprintf("WITH (MODULUS %d, REMAINDER %d)", len(p.Defs), slices.Index(p.Defs, def))
+		default:
+			return nil, fmt.Errorf("unknown partition type %q of table %q", p.Type, t.Name)
+		}
+		name := partitionName(dialect.Postgres, t, def.Name)
+		changes = append(changes, &migrate.Change{
+			Cmd:     fmt.Sprintf("CREATE TABLE %s PARTITION OF %s FOR VALUES %s", name, partitionName(dialect.Postgres, t, t.Name), bound),
+			Comment: fmt.Sprintf("create partition %q of table %q", def.Name, t.Name),
+			Reverse: "DROP TABLE " + name,
+		})
+	}
+	return changes, nil
+}
+
+func (d *Postgres) detachPartition(t *Table, name string) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", partitionName(dialect.Postgres, t, t.Name), partitionName(dialect.Postgres, t, name)),
+	}
+}
+
+// atPartition is a no-op in MySQL, as Atlas does not manage its partitions.
+// The tables are partitioned by the changes returned from partitionChanges.
+func (d *MySQL) atPartition(*Table, *schema.Table) error {
+	return nil
+}
+
+func (d *MySQL) partitions(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*partitions, error) {
+	names := partitionedNames(tables)
+	if len(names) == 0 {
+		return nil, nil
+	}
+	query, args := sql.Select("TABLE_NAME", "PARTITION_NAME", "PARTITION_METHOD").
+		From(sql.Table("PARTITIONS").Schema("INFORMATION_SCHEMA")).
+		Where(sql.And(
+			d.matchSchema(),
+			sql.In("TABLE_NAME", names...),
+			sql.NotNull("PARTITION_NAME"),
+		)).
+		OrderBy("TABLE_NAME", "PARTITION_ORDINAL_POSITION").
+		Query()
+	return scanPartitions(ctx, conn, query, args)
+}
+
+// mysqlMethod returns the MySQL partitioning method of the given type. The COLUMNS
+// methods and KEY partitioning support partitioning by columns of all types.
+func mysqlMethod(t entsql.PartitionType) string {
+	switch t {
+	case entsql.PartitionRange, entsql.PartitionList:
+		return string(t) + " COLUMNS"
+	case entsql.PartitionHash:
+		return "KEY"
+	default:
+		return ""
+	}
+}
+
+func (d *MySQL) partitionChanges(t *Table, exist *partitions) ([]*migrate.Change, error) {
+	p, method := partition(t), mysqlMethod(partition(t).Type)
+	switch {
+	case method == "":
+		return nil, fmt.Errorf("unknown partition type %q of table %q", p.Type, t.Name)
+	case exist != nil && exist.partitioned && exist.method != method:
+		return nil, fmt.Errorf("partitioning of table %q cannot be changed from %s to %s", t.Name, exist.method, method)
+	}
+	defs := missingDefs(t, exist)
+	if len(defs) == 0 {
+		return nil, nil
+	}
+	parts := sql.Dialect(dialect.MySQL).String(func(b *sql.Builder) {
+		for i, def := range defs {
+			if i > 0 {
+				b.Comma()
+			}
+			b.WriteString("PARTITION ").Ident(def.Name)
+			switch p.Type {
+			case entsql.PartitionRange:
+				b.WriteString(" VALUES LESS THAN (").WriteString(cmp.Or(def.To, "MAXVALUE")).WriteByte(')')
+			case entsql.PartitionList:
+				b.WriteString(" VALUES IN (").WriteString(strings.Join(def.Values, ", ")).WriteByte(')')
+			}
+		}
+	})
+	table := partitionName(dialect.MySQL, t, t.Name)
+	if exist != nil && exist.partitioned {
+		return []*migrate.Change{{
+			Cmd:     fmt.Sprintf("ALTER TABLE %s ADD PARTITION (%s)", table, parts),
+			Comment: fmt.Sprintf("add partitions to table %q", t.Name),
+		}}, nil
+	}
+	columns := sql.Dialect(dialect.MySQL).String(func(b *sql.Builder) {
+		b.IdentComma(p.Columns...)
+	})
+	return []*migrate.Change{{
+		Cmd:     fmt.Sprintf("ALTER TABLE %s PARTITION BY %s (%s) (%s)", table, method, columns, parts),
+		Comment: fmt.Sprintf("partition table %q", t.Name),
+	}}, nil
+}
+
+func (d *MySQL) detachPartition(t *Table, name string) []string {
+	var (
+		table = partitionName(dialect.MySQL, t, t.Name)
+		// The partition is exchanged with a table of the same name.
+		part  = partitionName(dialect.MySQL, t, name)
+		ident = sql.Dialect(dialect.MySQL).String(func(b *sql.Builder) { b.Ident(name) })
+	)
+	return []string{
+		fmt.Sprintf("CREATE TABLE %s LIKE %s", part, table),
+		fmt.Sprintf("ALTER TABLE %s REMOVE PARTITIONING", part),
+		fmt.Sprintf("ALTER TABLE %s EXCHANGE PARTITION %s WITH TABLE %s", table, ident, part),
+		fmt.Sprintf("ALTER TABLE %s DROP PARTITION %s", table, ident),
+	}
+}
+
+// partitions loads the existing partitions of the partitioned tables, if supported by the dialect.
+func (a *Atlas) partitions(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*partitions, error) {
+	p, ok := a.sqlDialect.(partitioner)
+	if !ok {
+		return nil, nil
+	}
+	return p.partitions(ctx, conn, tables)
+}
+
+// partitionChanges returns the changes for creating the missing partitions of the partitioned tables.
+// Partitions that exist in the database, but not defined in the schema are never dropped.
+func (a *Atlas) partitionChanges(tables []*Table, exist map[string]*partitions) ([]*migrate.Change, error) {
+	p, ok := a.sqlDialect.(partitioner)
+	if !ok {
+		return nil, nil
+	}
+	var changes []*migrate.Change
+	for _, t := range tables {
+		if t.View || partition(t) == nil {
+			continue
+		}
+		c, err := p.partitionChanges(t, exist[t.Name])
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c...)
+	}
+	return changes, nil
+}
+
+// skipForeignKey reports if the foreign key cannot be created due to partitioning. A foreign
+// key cannot reference a partitioned table, because its primary key includes the partition
+// key. In addition, MySQL does not support foreign keys on partitioned tables at all. These
+// foreign keys fail the migration, unless they are skipped using WithSkipPartitionForeignKeys.
+func (a *Atlas) skipForeignKey(t *Table, fk *ForeignKey) (bool, error) {
+	if _, ok := a.sqlDialect.(partitioner); !ok {
+		return false, nil
+	}
+	var reason string
+	switch {
+	case partition(fk.RefTable) != nil:
+		reason = fmt.Sprintf("it references partitioned table %q", fk.RefTable.Name)
+	case a.dialect == dialect.MySQL && partition(t) != nil:
+		reason = "MySQL does not support foreign keys on partitioned tables"
+	default:
+		return false, nil
+	}
+	// Foreign keys are not created anyway, if they are disabled.
+	if a.withForeignKeys && !a.skipPartFKs {
+		return false, fmt.Errorf("sql/schema: foreign key %q of table %q cannot be created, because %s. Use WithSkipPartitionForeignKeys to skip it", fk.Symbol, t.Name, reason)
+	}
+	return true, nil
+}
+
+// skipPartitionKey reports if the partition key column should be excluded from the
+// primary key of the table. In dialects that do not support partitioning (e.g. SQLite),
+// partitioned tables are created as regular tables, and auto-increment columns cannot
+// be part of a composite primary key.
+func (a *Atlas) skipPartitionKey(t *Table, c *Column) bool {
+	if _, ok := a.sqlDialect.(partitioner); ok {
+		return false
+	}
+	p := partition(t)
+	return p != nil && t.PrimaryKey[0].Increment && t.PrimaryKey[0] != c && slices.Contains(p.Columns, c.Name)
+}
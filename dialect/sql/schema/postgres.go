@@ -6,8 +6,10 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -155,6 +157,35 @@ func (d *Postgres) atTypeC(c1 *Column, c2 *schema.Column) error {
 	return nil
 }
 
+// arrayDefault converts the JSON default value of an array column (e.g. ["a","b"])
+// to an array literal (e.g. {"a","b"}). Other values are returned as is.
+func arrayDefault(c *schema.Column, s string) (string, error) {
+	if _, ok := c.Type.Type.(*postgres.ArrayType); !ok || !strings.HasPrefix(s, "[") {
+		return s, nil
+	}
+	var vs []any
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(&vs); err != nil {
+		return "", err
+	}
+	var (
+		elems = make([]string, len(vs))
+		quote = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	)
+	for i, v := range vs {
+		switch v := v.(type) {
+		case nil:
+			elems[i] = "NULL"
+		case string:
+			elems[i] = `"` + quote.Replace(v) + `"`
+		default:
+			elems[i] = fmt.Sprint(v)
+		}
+	}
+	return "{" + strings.Join(elems, ",") + "}", nil
+}
+
 func (d *Postgres) atUniqueC(t1 *Table, c1 *Column, t2 *schema.Table, c2 *schema.Column) {
 	// For UNIQUE columns, PostgreSQL creates an implicit index named
 	// "<table>_<column>_key<i>".
@@ -213,6 +244,26 @@ func indexOpClass(idx *Index) map[string]string {
 	return opc
 }
 
+// reIdent matches identifiers that are not quoted by pg_get_indexdef.
+var reIdent = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+// tsVectorExpr returns the to_tsvector expression of a full-text index column. The expression
+// is written in the form it is returned by pg_get_indexdef, to avoid redundant diffs.
+func tsVectorExpr(idx *Index, c *schema.Column) string {
+	config := "simple"
+	if idx.Annotation.TextSearchConfig != "" {
+		config = idx.Annotation.TextSearchConfig
+	}
+	name := c.Name
+	if !reIdent.MatchString(name) {
+		name = strconv.Quote(name)
+	}
+	if t, ok := c.Type.Type.(*schema.StringType); !ok || t.T != postgres.TypeText {
+		name = fmt.Sprintf("(%s)::text", name)
+	}
+	return fmt.Sprintf("to_tsvector('%s'::regconfig, %s)", config, name)
+}
+
 func (d *Postgres) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) error {
 	opc := indexOpClass(idx1)
 	for _, c1 := range idx1.Columns {
@@ -220,6 +271,10 @@ func (d *Postgres) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) er
 		if !ok {
 			return fmt.Errorf("unexpected index %q column: %q", idx1.Name, c1.Name)
 		}
+		if idx1.Annotation != nil && idx1.Annotation.FullText {
+			idx2.AddParts(&schema.IndexPart{X: &schema.RawExpr{X: tsVectorExpr(idx1, c2)}})
+			continue
+		}
 		part := &schema.IndexPart{C: c2}
 		if v, ok := opc[c1.Name]; ok {
 			var op postgres.IndexOpClass
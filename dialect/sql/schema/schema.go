@@ -304,6 +304,7 @@ type Column struct {
 	Default    any               // default value.
 	Enums      []string          // enum values.
 	Collation  string            // collation type (utf8mb4_unicode_ci, utf8mb4_general_ci)
+	Generated  *Generated        // optional generation expression.
 	typ        string            // row column type (used for Rows.Scan).
 	indexes    Indexes           // linked indexes.
 	foreign    *ForeignKey       // linked foreign-key.
@@ -314,6 +315,14 @@ type Column struct {
 // literal values and raw expressions when defining default values.
 type Expr string
 
+// Generated holds the expression of a generated column. The value of a generated
+// column is computed by the database, and it cannot be written by the application.
+type Generated struct {
+	Expr  string            // generation expression.
+	Exprs map[string]string // generation expression per dialect (overrides Expr).
+	Type  string            // STORED or VIRTUAL.
+}
+
 // UniqueKey returns boolean indicates if this column is a unique key.
 // Used by the migration tool when parsing the `DESCRIBE TABLE` output Go objects.
 func (c *Column) UniqueKey() bool { return c.Key == UniqueKey }
@@ -549,6 +558,18 @@ func indexType(idx *Index, d string) (string, bool) {
 	if ant.Type != "" {
 		return ant.Type, true
 	}
+	// Full-text and spatial indexes default to the index
+	// type that supports their search in the dialect.
+	switch {
+	case ant.FullText && d == entdialect.MySQL:
+		return "FULLTEXT", true
+	case ant.FullText && d == entdialect.Postgres:
+		return "GIN", true
+	case ant.Spatial && d == entdialect.MySQL:
+		return "SPATIAL", true
+	case ant.Spatial && d == entdialect.Postgres:
+		return "GIST", true
+	}
 	return "", false
 }
 
@@ -584,6 +605,14 @@ var drivers = func(v string) map[string]driver {
 			postgres.DefaultDiff,
 			postgres.DefaultPlan,
 		},
+		entdialect.SQLServer: {
+			&SQLServer{
+				version: v,
+				Driver:  nopDriver{dialect: entdialect.SQLServer},
+			},
+			&sqlserverDriver{},
+			&sqlserverDriver{},
+		},
 	}
 }
 
@@ -597,7 +626,8 @@ func Dump(ctx context.Context, dialect, version string, tables []*Table, opts ..
 	if !ok {
 		return "", fmt.Errorf("unsupported dialect %q", dialect)
 	}
-	r, err := (&Atlas{sqlDialect: d, dialect: dialect}).StateReader(tables...).ReadState(ctx)
+	a := &Atlas{sqlDialect: d.sqlDialect, dialect: dialect}
+	r, err := a.StateReader(tables...).ReadState(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -621,6 +651,11 @@ func Dump(ctx context.Context, dialect, version string, tables []*Table, opts ..
 	if err != nil {
 		return "", err
 	}
+	parts, err := a.partitionChanges(tables, nil)
+	if err != nil {
+		return "", err
+	}
+	p.Changes = append(p.Changes, parts...)
 	for _, v := range vs {
 		q, _ := sql.Dialect(dialect).
 			CreateView(v.Name).
@@ -275,6 +275,36 @@ CREATE UNIQUE INDEX "name" ON "s2"."pets" ("name" DESC);
 CREATE VIEW "s3"."pets_without_fur" ("id", "name", "owner_id") AS SELECT id, name, owner_id FROM pets;
 -- Add "pet_names" view
 CREATE VIEW "s3"."pet_names" AS SELECT "name" FROM "pets";
+`
+
+	mssql := `-- Add new schema named "s1"
+CREATE SCHEMA [s1];
+-- Add new schema named "s2"
+CREATE SCHEMA [s2];
+-- Add new schema named "s3"
+CREATE SCHEMA [s3];
+-- Create "users" table
+CREATE TABLE [s1].[users] (
+  [id] bigint NOT NULL,
+  [name] nvarchar(255) NOT NULL,
+  [spouse_id] bigint NOT NULL,
+  CONSTRAINT [users_pkey] PRIMARY KEY ([id]),
+  FOREIGN KEY ([spouse_id]) REFERENCES [s1].[users] ([id])
+);
+-- Create index "name" to table: "users"
+CREATE INDEX [name] ON [s1].[users] ([name]);
+-- Create "pets" table
+CREATE TABLE [s2].[pets] (
+  [id] bigint NOT NULL,
+  [name] nvarchar(255) NOT NULL,
+  [owner_id] bigint NOT NULL,
+  [owner_id] bigint NOT NULL,
+  FOREIGN KEY ([owner_id]) REFERENCES [s1].[users] ([id]) ON DELETE SET DEFAULT
+);
+-- Create index "name" to table: "pets"
+CREATE UNIQUE INDEX [name] ON [s2].[pets] ([name] DESC);
+-- Add "pets_without_fur" view
+CREATE VIEW [s3].[pets_without_fur] ([id], [name], [owner_id]) AS SELECT id, name, owner_id FROM pets;
 `
 
 	for _, tt := range []struct{ dialect, version, expected string }{
@@ -311,6 +341,7 @@ CREATE VIEW $pets_without_fur$ ($id$, $name$, $owner_id$) AS SELECT id, name, ow
 		{dialect.Postgres, "13", pg},
 		{dialect.Postgres, "14", pg},
 		{dialect.Postgres, "15", pg},
+		{dialect.SQLServer, "16", mssql},
 	} {
 		n := tt.dialect
 		if tt.version != "" {
@@ -9,6 +9,7 @@ import (
 	stdsql "database/sql"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -71,8 +72,9 @@ func (d *SQLite) init(ctx context.Context) error {
 	}
 	if !on {
 		// foreign_keys pragma is off, either enable it by execute "PRAGMA foreign_keys=ON"
-		// or add the following parameter in the connection string "_fk=1".
-		return fmt.Errorf("sqlite: foreign_keys pragma is off: missing %q in the connection string", "_fk=1")
+		// or add the following parameter in the connection string "_fk=1", or the parameter
+		// "_pragma=foreign_keys(1)" in case of the pure-Go driver (modernc.org/sqlite).
+		return fmt.Errorf("sqlite: foreign_keys pragma is off: missing %q (or %q) in the connection string", "_fk=1", "_pragma=foreign_keys(1)")
 	}
 	return nil
 }
@@ -197,6 +199,146 @@ func (d *SQLite) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) erro
 	return nil
 }
 
+// isFullText reports if the index is a full-text index. In SQLite, full-text indexes are
+// FTS5 virtual tables that are named after the index, and are kept in sync with the content
+// of their table using triggers.
+func isFullText(idx *Index) bool {
+	return idx.Annotation != nil && idx.Annotation.FullText
+}
+
+// isSpatial reports if the index is a spatial index.
+func isSpatial(idx *Index) bool {
+	return idx.Annotation != nil && idx.Annotation.Spatial
+}
+
+// ftsTables returns the names of the FTS5 virtual tables that exist in the database.
+func (d *SQLite) ftsTables(ctx context.Context, conn dialect.ExecQuerier) ([]string, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Select("name").
+		From(sql.Table("sqlite_master")).
+		Where(sql.And(
+			sql.EQ("type", "table"),
+			sql.Like("sql", "CREATE VIRTUAL TABLE %USING fts5(%"),
+		)).
+		Query()
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("query fts5 tables: %w", err)
+	}
+	defer rows.Close()
+	var names []string
+	if err := sql.ScanSlice(rows, &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// ftsChanges returns the changes for creating the FTS5 virtual tables of the full-text
+// indexes that do not exist in the current schema. FTS5 tables that were created with a
+// different definition are dropped and recreated, and the ones of removed full-text
+// indexes are dropped.
+func ftsChanges(current *schema.Schema, tables []*Table) []*migrate.Change {
+	var (
+		changes []*migrate.Change
+		indexes = make(map[string]bool)
+	)
+	for _, t := range tables {
+		for _, idx := range t.Indexes {
+			if !isFullText(idx) {
+				continue
+			}
+			indexes[idx.Name] = true
+			create := ftsCreate(t, idx)
+			if t2, ok := current.Table(idx.Name); ok {
+				if createStmt(t2) == create[0].Cmd {
+					continue
+				}
+				changes = append(changes, ftsDrop(idx.Name, t.Name)...)
+			}
+			changes = append(changes, create...)
+		}
+	}
+	for _, t2 := range current.Tables {
+		if indexes[t2.Name] {
+			continue
+		}
+		if name, ok := ftsContent(t2); ok && slices.ContainsFunc(tables, func(t *Table) bool { return t.Name == name }) {
+			changes = append(changes, ftsDrop(t2.Name, name)...)
+		}
+	}
+	return changes
+}
+
+// ftsCreate returns the changes for creating the FTS5 virtual table of the full-text index,
+// and the triggers that keep it in sync with the content of its table.
+func ftsCreate(t *Table, idx *Index) []*migrate.Change {
+	columns := make([]string, len(idx.Columns))
+	for i, c := range idx.Columns {
+		columns[i] = "`" + c.Name + "`"
+	}
+	values := func(row string) string {
+		vs := make([]string, len(columns))
+		for i, c := range columns {
+			vs[i] = row + "." + c
+		}
+		return strings.Join(vs, ", ")
+	}
+	var (
+		fts = "`" + idx.Name + "`"
+		tbl = "`" + t.Name + "`"
+		cs  = strings.Join(columns, ", ")
+		ins = fmt.Sprintf("INSERT INTO %s(rowid, %s) VALUES (new.rowid, %s);", fts, cs, values("new"))
+		del = fmt.Sprintf("INSERT INTO %s(%s, rowid, %s) VALUES ('delete', old.rowid, %s);", fts, fts, cs, values("old"))
+	)
+	return []*migrate.Change{
+		{
+			Cmd:     fmt.Sprintf("CREATE VIRTUAL TABLE %s USING fts5(%s, content=%s)", fts, cs, tbl),
+			Comment: fmt.Sprintf("create full-text index %q to table: %q", idx.Name, t.Name),
+		},
+		{Cmd: fmt.Sprintf("CREATE TRIGGER `%s_ai` AFTER INSERT ON %s BEGIN %s END", idx.Name, tbl, ins)},
+		{Cmd: fmt.Sprintf("CREATE TRIGGER `%s_ad` AFTER DELETE ON %s BEGIN %s END", idx.Name, tbl, del)},
+		{Cmd: fmt.Sprintf("CREATE TRIGGER `%s_au` AFTER UPDATE ON %s BEGIN %s %s END", idx.Name, tbl, del, ins)},
+		// Index the rows that exist in the table.
+		{Cmd: fmt.Sprintf("INSERT INTO %s(%s) VALUES ('rebuild')", fts, fts)},
+	}
+}
+
+// ftsDrop returns the changes for dropping the FTS5 virtual table of the full-text index and its triggers.
+func ftsDrop(name, table string) []*migrate.Change {
+	return []*migrate.Change{
+		{
+			Cmd:     fmt.Sprintf("DROP TRIGGER IF EXISTS `%s_ai`", name),
+			Comment: fmt.Sprintf("drop full-text index %q from table: %q", name, table),
+		},
+		{Cmd: fmt.Sprintf("DROP TRIGGER IF EXISTS `%s_ad`", name)},
+		{Cmd: fmt.Sprintf("DROP TRIGGER IF EXISTS `%s_au`", name)},
+		{Cmd: fmt.Sprintf("DROP TABLE `%s`", name)},
+	}
+}
+
+// ftsContent returns the content table of an FTS5 virtual table that was created by ftsCreate.
+func ftsContent(t *schema.Table) (string, bool) {
+	stmt := createStmt(t)
+	if !strings.HasPrefix(stmt, "CREATE VIRTUAL TABLE") || !strings.Contains(stmt, "USING fts5(") {
+		return "", false
+	}
+	i := strings.LastIndex(stmt, "content=`")
+	if i == -1 {
+		return "", false
+	}
+	name, _, ok := strings.Cut(stmt[i+len("content=`"):], "`")
+	return name, ok
+}
+
+// createStmt returns the inspected statement that was used to create the table.
+func createStmt(t *schema.Table) string {
+	for _, a := range t.Attrs {
+		if s, ok := a.(*sqlite.CreateStmt); ok {
+			return s.S
+		}
+	}
+	return ""
+}
+
 func (*SQLite) atTypeRangeSQL(ts ...string) string {
 	for i := range ts {
 		ts[i] = fmt.Sprintf("('%s')", ts[i])
@@ -0,0 +1,1515 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/schema"
+)
+
+// SQLServer adapter for Atlas migration engine. Since Atlas does not provide
+// an open-source driver for SQL Server, the inspection, diffing and planning
+// of the schema changes are implemented by the sqlserverDriver below.
+type SQLServer struct {
+	dialect.Driver
+	version string
+}
+
+// init loads the SQL Server version from the database for later use in the migration process.
+// It returns an error if the server version is lower than v13 (SQL Server 2016).
+func (d *SQLServer) init(ctx context.Context) error {
+	if d.version != "" {
+		return nil // already initialized.
+	}
+	rows := &sql.Rows{}
+	if err := d.Query(ctx, "SELECT CAST(SERVERPROPERTY('ProductVersion') AS nvarchar(128))", []any{}, rows); err != nil {
+		return fmt.Errorf("querying server version %w", err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return fmt.Errorf("product version was not found")
+	}
+	var version string
+	if err := rows.Scan(&version); err != nil {
+		return fmt.Errorf("scanning version: %w", err)
+	}
+	d.version = version
+	if compareVersions(d.version, "13.0.0") == -1 {
+		return fmt.Errorf("unsupported sqlserver version: %s", d.version)
+	}
+	return nil
+}
+
+// tableExist checks if a table exists in the database and current schema.
+func (d *SQLServer) tableExist(ctx context.Context, conn dialect.ExecQuerier, name string) (bool, error) {
+	query, args := sql.Dialect(dialect.SQLServer).
+		Select(sql.Count("*")).From(sql.Table("TABLES").Schema("INFORMATION_SCHEMA")).
+		Where(sql.And(
+			sql.EQ("TABLE_SCHEMA", sql.Raw("SCHEMA_NAME()")),
+			sql.EQ("TABLE_NAME", name),
+		)).Query()
+	return exist(ctx, conn, query, args...)
+}
+
+// Limits of the SQL Server types (in characters and bytes). Larger sizes use the (max) types.
+const (
+	sqlserverMaxNChar  = 4000
+	sqlserverMaxBinary = 8000
+)
+
+func (d *SQLServer) atOpen(conn dialect.ExecQuerier) (migrate.Driver, error) {
+	return &sqlserverDriver{ExecQuerier: &db{ExecQuerier: conn}}, nil
+}
+
+func (d *SQLServer) atTable(t1 *Table, t2 *schema.Table) {
+	if t1.Annotation != nil {
+		setAtChecks(t1, t2)
+	}
+}
+
+func (d *SQLServer) supportsDefault(*Column) bool {
+	// SQL Server supports default values for all standard types.
+	return true
+}
+
+func (d *SQLServer) atTypeC(c1 *Column, c2 *schema.Column) error {
+	if c1.SchemaType != nil && c1.SchemaType[dialect.SQLServer] != "" {
+		t, err := sqlserverParseType(strings.ToLower(c1.SchemaType[dialect.SQLServer]))
+		if err != nil {
+			return err
+		}
+		c2.Type.Type = t
+		return nil
+	}
+	var t schema.Type
+	switch c1.Type {
+	case field.TypeBool:
+		t = &schema.BoolType{T: "bit"}
+	case field.TypeUint8:
+		t = &schema.IntegerType{T: "tinyint"}
+	case field.TypeInt8, field.TypeInt16:
+		t = &schema.IntegerType{T: "smallint"}
+	case field.TypeUint16, field.TypeInt32:
+		t = &schema.IntegerType{T: "int"}
+	case field.TypeUint32, field.TypeInt, field.TypeUint, field.TypeInt64, field.TypeUint64:
+		t = &schema.IntegerType{T: "bigint"}
+	case field.TypeFloat32:
+		t = &schema.FloatType{T: c1.scanTypeOr("real")}
+	case field.TypeFloat64:
+		t = &schema.FloatType{T: c1.scanTypeOr("float")}
+	case field.TypeBytes:
+		// Sizes larger than 8000 bytes are stored in varbinary(max).
+		size := -1
+		if c1.Size > 0 && c1.Size <= sqlserverMaxBinary {
+			size = int(c1.Size)
+		}
+		t = &schema.BinaryType{T: "varbinary", Size: &size}
+	case field.TypeJSON:
+		// SQL Server stores JSON documents as text, and provides
+		// the JSON functions for querying and modifying them.
+		t = &schema.JSONType{T: "nvarchar(max)"}
+	case field.TypeString:
+		size := c1.Size
+		if size == 0 {
+			size = DefaultStringLen
+		}
+		if size > sqlserverMaxNChar {
+			size = -1
+		}
+		t = &schema.StringType{T: "nvarchar", Size: int(size)}
+	case field.TypeTime:
+		t = &schema.TimeType{T: c1.scanTypeOr("datetimeoffset")}
+	case field.TypeEnum:
+		t = &schema.StringType{T: "nvarchar", Size: int(DefaultStringLen)}
+	case field.TypeUUID:
+		// The uniqueidentifier type is returned by the driver in a mixed-endian
+		// byte order. Hence, UUIDs are stored in their string representation.
+		t = &schema.StringType{T: "char", Size: 36}
+	case field.TypeOther:
+		t = &schema.UnsupportedType{T: c1.typ}
+	default:
+		t, err := sqlserverParseType(strings.ToLower(c1.typ))
+		if err != nil {
+			return err
+		}
+		c2.Type.Type = t
+	}
+	c2.Type.Type = t
+	return nil
+}
+
+func (d *SQLServer) atUniqueC(t1 *Table, c1 *Column, t2 *schema.Table, c2 *schema.Column) {
+	for _, idx := range t1.Indexes {
+		// Index also defined explicitly, and will be added in atIndexes.
+		if idx.Unique && idx.Name == fmt.Sprintf("%s_%s_key", t1.Name, c1.Name) {
+			return
+		}
+	}
+	idx := schema.NewUniqueIndex(fmt.Sprintf("%s_%s_key", t1.Name, c1.Name)).AddColumns(c2)
+	// Unlike other databases, SQL Server considers NULL values as equal in UNIQUE
+	// indexes. Hence, nullable columns are indexed using a filtered index.
+	if c2.Type.Null {
+		idx.AddAttrs(&sqlserverIndexFilter{P: fmt.Sprintf("%s IS NOT NULL", sqlserverQuote(c2.Name))})
+	}
+	t2.AddIndexes(idx)
+}
+
+func (d *SQLServer) atIncrementC(t *schema.Table, c *schema.Column) {
+	if c.Default != nil {
+		t.Attrs = removeAttr(t.Attrs, reflect.TypeOf(&sqlserverIdentity{}))
+		return
+	}
+	id := &sqlserverIdentity{Seed: 1, Increment: 1}
+	for _, a := range t.Attrs {
+		if a, ok := a.(*sqlserverIdentity); ok {
+			id = a
+		}
+	}
+	c.AddAttrs(id)
+}
+
+func (d *SQLServer) atIncrementT(t *schema.Table, v int64) {
+	t.AddAttrs(&sqlserverIdentity{Seed: v, Increment: 1})
+}
+
+func (d *SQLServer) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) error {
+	if idx1.Annotation != nil && idx1.Annotation.FullText {
+		return fmt.Errorf("full-text index %q is not supported by SQL Server", idx1.Name)
+	}
+	var nullable []string
+	for _, c1 := range idx1.Columns {
+		c2, ok := t2.Column(c1.Name)
+		if !ok {
+			return fmt.Errorf("unexpected index %q column: %q", idx1.Name, c1.Name)
+		}
+		if c2.Type.Null {
+			nullable = append(nullable, fmt.Sprintf("%s IS NOT NULL", sqlserverQuote(c2.Name)))
+		}
+		idx2.AddParts(&schema.IndexPart{C: c2})
+	}
+	if t, ok := indexType(idx1, dialect.SQLServer); ok {
+		idx2.AddAttrs(&sqlserverIndexType{T: t})
+	}
+	if ant := idx1.Annotation; ant != nil && len(ant.IncludeColumns) > 0 {
+		columns := make([]*schema.Column, len(ant.IncludeColumns))
+		for i, ic := range ant.IncludeColumns {
+			c, ok := t2.Column(ic)
+			if !ok {
+				return fmt.Errorf("include column %q was not found for index %q", ic, idx1.Name)
+			}
+			columns[i] = c
+		}
+		idx2.AddAttrs(&sqlserverIndexInclude{Columns: columns})
+	}
+	switch {
+	case idx1.Annotation != nil && idx1.Annotation.Where != "":
+		idx2.AddAttrs(&sqlserverIndexFilter{P: idx1.Annotation.Where})
+	// See atUniqueC for the reason unique indexes of nullable columns are filtered.
+	case idx1.Unique && len(nullable) > 0:
+		idx2.AddAttrs(&sqlserverIndexFilter{P: strings.Join(nullable, " AND ")})
+	}
+	return nil
+}
+
+func (*SQLServer) atTypeRangeSQL(ts ...string) string {
+	for i := range ts {
+		ts[i] = fmt.Sprintf("(N'%s')", ts[i])
+	}
+	return fmt.Sprintf("INSERT INTO [%s] ([type]) VALUES %s", TypeTable, strings.Join(ts, ", "))
+}
+
+type (
+	// sqlserverIdentity describes an IDENTITY column. When it is attached
+	// to a table, it defines the seed of its identity column.
+	sqlserverIdentity struct {
+		schema.Attr
+		Seed, Increment int64
+	}
+	// sqlserverIndexType describes the type of an index (e.g. CLUSTERED).
+	sqlserverIndexType struct {
+		schema.Attr
+		T string
+	}
+	// sqlserverIndexFilter describes the predicate of a filtered index.
+	sqlserverIndexFilter struct {
+		schema.Attr
+		P string
+	}
+	// sqlserverIndexInclude describes the INCLUDE columns of an index.
+	sqlserverIndexInclude struct {
+		schema.Attr
+		Columns []*schema.Column
+	}
+	// sqlserverDefaultName holds the name of the DEFAULT constraint of an inspected column.
+	sqlserverDefaultName struct {
+		schema.Attr
+		N string
+	}
+)
+
+// sqlserverDriver implements the migrate.Driver interface for SQL Server.
+type sqlserverDriver struct {
+	schema.ExecQuerier
+}
+
+var _ interface {
+	migrate.Driver
+	migrate.Snapshoter
+	migrate.CleanChecker
+} = (*sqlserverDriver)(nil)
+
+// InspectRealm implements schema.Inspector. Only the default schema of the connection is inspected.
+func (d *sqlserverDriver) InspectRealm(ctx context.Context, opts *schema.InspectRealmOption) (*schema.Realm, error) {
+	name := ""
+	if opts != nil && len(opts.Schemas) == 1 {
+		name = opts.Schemas[0]
+	}
+	s, err := d.InspectSchema(ctx, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewRealm(s), nil
+}
+
+// InspectSchema implements schema.Inspector. An empty name inspects the default schema of the connection.
+func (d *sqlserverDriver) InspectSchema(ctx context.Context, name string, opts *schema.InspectOptions) (*schema.Schema, error) {
+	query, args := "SELECT SCHEMA_NAME()", []any{}
+	if name != "" {
+		query, args = "SELECT [name] FROM [sys].[schemas] WHERE [name] = @p1", []any{name}
+	}
+	names, err := d.strings(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sql/sqlserver: querying schema: %w", err)
+	}
+	if len(names) == 0 {
+		return nil, &schema.NotExistError{Err: fmt.Errorf("sql/sqlserver: schema %q was not found", name)}
+	}
+	s := schema.New(names[0])
+	schema.NewRealm(s)
+	if opts == nil {
+		opts = &schema.InspectOptions{}
+	}
+	query, args = "SELECT [t].[name] FROM [sys].[tables] AS [t] WHERE [t].[schema_id] = SCHEMA_ID(@p1)", []any{s.Name}
+	if len(opts.Tables) > 0 {
+		query += " AND [t].[name] IN (" + sqlserverArgs(2, len(opts.Tables)) + ")"
+		for _, t := range opts.Tables {
+			args = append(args, t)
+		}
+	}
+	names, err = d.strings(ctx, query+" ORDER BY [t].[name]", args...)
+	if err != nil {
+		return nil, fmt.Errorf("sql/sqlserver: querying tables: %w", err)
+	}
+	if len(names) == 0 {
+		return s, nil
+	}
+	for _, n := range names {
+		s.AddTables(schema.NewTable(n))
+	}
+	args = []any{s.Name}
+	for _, n := range names {
+		args = append(args, n)
+	}
+	filter := "SCHEMA_NAME([t].[schema_id]) = @p1 AND [t].[name] IN (" + sqlserverArgs(2, len(names)) + ")"
+	for _, f := range []func(context.Context, *schema.Schema, string, []any) error{
+		d.inspectColumns, d.inspectIndexes, d.inspectForeignKeys, d.inspectChecks,
+	} {
+		if err := f(ctx, s, filter, args); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Queries for inspecting the tables of a schema. The %s verb is replaced with the filter of the tables.
+const (
+	sqlserverColumnsQuery = `SELECT [t].[name], [c].[name], TYPE_NAME([c].[user_type_id]), [c].[max_length], [c].[precision], [c].[scale], [c].[is_nullable], [c].[is_identity], CAST([ic].[seed_value] AS bigint), CAST([ic].[increment_value] AS bigint), [dc].[name], [dc].[definition], [c].[collation_name] ` +
+		`FROM [sys].[columns] AS [c] JOIN [sys].[tables] AS [t] ON [t].[object_id] = [c].[object_id] ` +
+		`LEFT JOIN [sys].[identity_columns] AS [ic] ON [ic].[object_id] = [c].[object_id] AND [ic].[column_id] = [c].[column_id] ` +
+		`LEFT JOIN [sys].[default_constraints] AS [dc] ON [dc].[object_id] = [c].[default_object_id] ` +
+		`WHERE %s ORDER BY [t].[name], [c].[column_id]`
+	sqlserverIndexesQuery = `SELECT [t].[name], [i].[name], [i].[is_primary_key], [i].[is_unique], [i].[type_desc], [i].[filter_definition], [c].[name], [ic].[is_descending_key], [ic].[is_included_column] ` +
+		`FROM [sys].[indexes] AS [i] JOIN [sys].[tables] AS [t] ON [t].[object_id] = [i].[object_id] ` +
+		`JOIN [sys].[index_columns] AS [ic] ON [ic].[object_id] = [i].[object_id] AND [ic].[index_id] = [i].[index_id] ` +
+		`JOIN [sys].[columns] AS [c] ON [c].[object_id] = [ic].[object_id] AND [c].[column_id] = [ic].[column_id] ` +
+		`WHERE %s ORDER BY [t].[name], [i].[name], [ic].[is_included_column], [ic].[key_ordinal], [ic].[index_column_id]`
+	sqlserverForeignKeysQuery = `SELECT [t].[name], [fk].[name], [c].[name], SCHEMA_NAME([rt].[schema_id]), [rt].[name], [rc].[name], [fk].[update_referential_action_desc], [fk].[delete_referential_action_desc] ` +
+		`FROM [sys].[foreign_keys] AS [fk] JOIN [sys].[tables] AS [t] ON [t].[object_id] = [fk].[parent_object_id] ` +
+		`JOIN [sys].[tables] AS [rt] ON [rt].[object_id] = [fk].[referenced_object_id] ` +
+		`JOIN [sys].[foreign_key_columns] AS [fc] ON [fc].[constraint_object_id] = [fk].[object_id] ` +
+		`JOIN [sys].[columns] AS [c] ON [c].[object_id] = [fc].[parent_object_id] AND [c].[column_id] = [fc].[parent_column_id] ` +
+		`JOIN [sys].[columns] AS [rc] ON [rc].[object_id] = [fc].[referenced_object_id] AND [rc].[column_id] = [fc].[referenced_column_id] ` +
+		`WHERE %s ORDER BY [t].[name], [fk].[name], [fc].[constraint_column_id]`
+	sqlserverChecksQuery = `SELECT [t].[name], [cc].[name], [cc].[definition] ` +
+		`FROM [sys].[check_constraints] AS [cc] JOIN [sys].[tables] AS [t] ON [t].[object_id] = [cc].[parent_object_id] ` +
+		`WHERE %s ORDER BY [t].[name], [cc].[name]`
+)
+
+func (d *sqlserverDriver) inspectColumns(ctx context.Context, s *schema.Schema, filter string, args []any) error {
+	rows, err := d.QueryContext(ctx, fmt.Sprintf(sqlserverColumnsQuery, filter), args...)
+	if err != nil {
+		return fmt.Errorf("sql/sqlserver: querying columns: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			tName, cName, typ        string
+			size, precision, scale   int64
+			nullable, identity       bool
+			seed, increment          stdsql.NullInt64
+			dfName, dfDef, collation stdsql.NullString
+		)
+		if err := rows.Scan(&tName, &cName, &typ, &size, &precision, &scale, &nullable, &identity, &seed, &increment, &dfName, &dfDef, &collation); err != nil {
+			return fmt.Errorf("sql/sqlserver: scanning columns: %w", err)
+		}
+		t, ok := s.Table(tName)
+		if !ok {
+			return fmt.Errorf("sql/sqlserver: table %q was not found", tName)
+		}
+		raw := sqlserverRawType(typ, size, precision, scale)
+		ct, err := sqlserverParseType(raw)
+		if err != nil {
+			return err
+		}
+		c := schema.NewColumn(cName).SetNull(nullable)
+		c.Type.Type, c.Type.Raw = ct, raw
+		if identity {
+			c.AddAttrs(&sqlserverIdentity{Seed: seed.Int64, Increment: increment.Int64})
+		}
+		if dfDef.Valid {
+			c.SetDefault(&schema.RawExpr{X: dfDef.String})
+			c.AddAttrs(&sqlserverDefaultName{N: dfName.String})
+		}
+		if collation.Valid {
+			c.SetCollation(collation.String)
+		}
+		t.AddColumns(c)
+	}
+	return rows.Err()
+}
+
+func (d *sqlserverDriver) inspectIndexes(ctx context.Context, s *schema.Schema, filter string, args []any) error {
+	rows, err := d.QueryContext(ctx, fmt.Sprintf(sqlserverIndexesQuery, filter), args...)
+	if err != nil {
+		return fmt.Errorf("sql/sqlserver: querying indexes: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			tName, iName, typ, cName string
+			pk, unique, desc, incl   bool
+			where                    stdsql.NullString
+		)
+		if err := rows.Scan(&tName, &iName, &pk, &unique, &typ, &where, &cName, &desc, &incl); err != nil {
+			return fmt.Errorf("sql/sqlserver: scanning indexes: %w", err)
+		}
+		t, ok := s.Table(tName)
+		if !ok {
+			return fmt.Errorf("sql/sqlserver: table %q was not found", tName)
+		}
+		c, ok := t.Column(cName)
+		if !ok {
+			return fmt.Errorf("sql/sqlserver: column %q was not found in table %q", cName, tName)
+		}
+		idx, ok := t.Index(iName)
+		if pk && t.PrimaryKey != nil {
+			idx, ok = t.PrimaryKey, true
+		}
+		if !ok {
+			idx = schema.NewIndex(iName).SetUnique(unique).SetTable(t)
+			// Clustered indexes are the default of primary keys, and
+			// nonclustered indexes are the default of other indexes.
+			if typ == "CLUSTERED" && !pk || typ == "NONCLUSTERED" && pk || typ != "CLUSTERED" && typ != "NONCLUSTERED" {
+				idx.AddAttrs(&sqlserverIndexType{T: strings.ReplaceAll(typ, "_", " ")})
+			}
+			if where.Valid {
+				idx.AddAttrs(&sqlserverIndexFilter{P: where.String})
+			}
+			if pk {
+				t.SetPrimaryKey(idx)
+			} else {
+				t.AddIndexes(idx)
+			}
+		}
+		if incl {
+			if i := sqlserverInclude(idx); i != nil {
+				i.Columns = append(i.Columns, c)
+			} else {
+				idx.AddAttrs(&sqlserverIndexInclude{Columns: []*schema.Column{c}})
+			}
+			continue
+		}
+		idx.AddParts(&schema.IndexPart{SeqNo: len(idx.Parts), C: c, Desc: desc})
+		if !pk {
+			c.Indexes = append(c.Indexes, idx)
+		}
+	}
+	return rows.Err()
+}
+
+func (d *sqlserverDriver) inspectForeignKeys(ctx context.Context, s *schema.Schema, filter string, args []any) error {
+	rows, err := d.QueryContext(ctx, fmt.Sprintf(sqlserverForeignKeysQuery, filter), args...)
+	if err != nil {
+		return fmt.Errorf("sql/sqlserver: querying foreign keys: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tName, symbol, cName, rSchema, rName, rcName, onUpdate, onDelete string
+		if err := rows.Scan(&tName, &symbol, &cName, &rSchema, &rName, &rcName, &onUpdate, &onDelete); err != nil {
+			return fmt.Errorf("sql/sqlserver: scanning foreign keys: %w", err)
+		}
+		t, ok := s.Table(tName)
+		if !ok {
+			return fmt.Errorf("sql/sqlserver: table %q was not found", tName)
+		}
+		fk, ok := t.ForeignKey(symbol)
+		if !ok {
+			fk = &schema.ForeignKey{
+				Symbol:   symbol,
+				Table:    t,
+				OnUpdate: schema.ReferenceOption(strings.ReplaceAll(onUpdate, "_", " ")),
+				OnDelete: schema.ReferenceOption(strings.ReplaceAll(onDelete, "_", " ")),
+			}
+			switch rt, ok := s.Table(rName); {
+			case ok && rSchema == s.Name:
+				fk.RefTable = rt
+			default:
+				// Tables of other schemas are referenced by their name and columns only.
+				fk.RefTable = &schema.Table{Name: rName, Schema: &schema.Schema{Name: rSchema}}
+			}
+			t.ForeignKeys = append(t.ForeignKeys, fk)
+		}
+		c, ok := t.Column(cName)
+		if !ok {
+			return fmt.Errorf("sql/sqlserver: column %q was not found in table %q", cName, tName)
+		}
+		rc, ok := fk.RefTable.Column(rcName)
+		if !ok {
+			rc = schema.NewColumn(rcName)
+			fk.RefTable.Columns = append(fk.RefTable.Columns, rc)
+		}
+		fk.Columns = append(fk.Columns, c)
+		fk.RefColumns = append(fk.RefColumns, rc)
+		c.ForeignKeys = append(c.ForeignKeys, fk)
+	}
+	return rows.Err()
+}
+
+func (d *sqlserverDriver) inspectChecks(ctx context.Context, s *schema.Schema, filter string, args []any) error {
+	rows, err := d.QueryContext(ctx, fmt.Sprintf(sqlserverChecksQuery, filter), args...)
+	if err != nil {
+		return fmt.Errorf("sql/sqlserver: querying checks: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tName, name, expr string
+		if err := rows.Scan(&tName, &name, &expr); err != nil {
+			return fmt.Errorf("sql/sqlserver: scanning checks: %w", err)
+		}
+		t, ok := s.Table(tName)
+		if !ok {
+			return fmt.Errorf("sql/sqlserver: table %q was not found", tName)
+		}
+		t.AddChecks(&schema.Check{Name: name, Expr: expr})
+	}
+	return rows.Err()
+}
+
+// strings executes the query and scans its first column into a slice of strings.
+func (d *sqlserverDriver) strings(ctx context.Context, query string, args ...any) ([]string, error) {
+	rows, err := d.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var vs []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		vs = append(vs, v)
+	}
+	return vs, rows.Err()
+}
+
+// RealmDiff implements schema.Differ.
+func (d *sqlserverDriver) RealmDiff(from, to *schema.Realm, opts ...schema.DiffOption) ([]schema.Change, error) {
+	o := schema.NewDiffOptions(opts...)
+	var changes, tables []schema.Change
+	for _, s2 := range to.Schemas {
+		s1, ok := from.Schema(s2.Name)
+		if !ok {
+			changes = o.AddOrSkip(changes, &schema.AddSchema{S: s2})
+			s1 = schema.New(s2.Name)
+		}
+		change, err := d.SchemaDiff(s1, s2, opts...)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, change...)
+	}
+	// Schemas are created before the tables, as tables may reference tables in other schemas.
+	changes = append(changes, tables...)
+	for _, s1 := range from.Schemas {
+		if _, ok := to.Schema(s1.Name); !ok {
+			changes = o.AddOrSkip(changes, &schema.DropSchema{S: s1})
+		}
+	}
+	return changes, nil
+}
+
+// SchemaDiff implements schema.Differ.
+func (d *sqlserverDriver) SchemaDiff(from, to *schema.Schema, opts ...schema.DiffOption) ([]schema.Change, error) {
+	o := schema.NewDiffOptions(opts...)
+	var changes []schema.Change
+	for _, t2 := range to.Tables {
+		t1, ok := from.Table(t2.Name)
+		if !ok {
+			changes = o.AddOrSkip(changes, &schema.AddTable{T: t2})
+			continue
+		}
+		change, err := d.TableDiff(t1, t2, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if len(change) > 0 {
+			changes = o.AddOrSkip(changes, &schema.ModifyTable{T: t2, Changes: change})
+		}
+	}
+	for _, t1 := range from.Tables {
+		if _, ok := to.Table(t1.Name); !ok {
+			changes = o.AddOrSkip(changes, &schema.DropTable{T: t1})
+		}
+	}
+	return changes, nil
+}
+
+// TableDiff implements schema.Differ. Like the other dialects, dropped constraints
+// and indexes are returned before the column changes, and added ones after them.
+func (d *sqlserverDriver) TableDiff(from, to *schema.Table, opts ...schema.DiffOption) ([]schema.Change, error) {
+	if from.Name != to.Name {
+		return nil, fmt.Errorf("sql/sqlserver: mismatched table names: %q != %q", from.Name, to.Name)
+	}
+	var (
+		o                 = schema.NewDiffOptions(opts...)
+		drops, cols, adds []schema.Change
+	)
+	if !sqlserverSameIndex(from.PrimaryKey, to.PrimaryKey) {
+		return nil, fmt.Errorf("sql/sqlserver: changing the primary key of table %q is not supported", to.Name)
+	}
+	for _, fk1 := range from.ForeignKeys {
+		fk2, ok := to.ForeignKey(fk1.Symbol)
+		switch {
+		case !ok:
+			drops = o.AddOrSkip(drops, &schema.DropForeignKey{F: fk1})
+		case !sqlserverSameForeignKey(fk1, fk2):
+			drops = o.AddOrSkip(drops, &schema.DropForeignKey{F: fk1})
+			adds = o.AddOrSkip(adds, &schema.AddForeignKey{F: fk2})
+		}
+	}
+	for _, fk2 := range to.ForeignKeys {
+		if _, ok := from.ForeignKey(fk2.Symbol); !ok {
+			adds = o.AddOrSkip(adds, &schema.AddForeignKey{F: fk2})
+		}
+	}
+	checks1, checks2 := sqlserverChecks(from), sqlserverChecks(to)
+	for _, c1 := range checks1 {
+		if !slices.ContainsFunc(checks2, func(c2 *schema.Check) bool { return sqlserverSameCheck(c1, c2) }) {
+			drops = o.AddOrSkip(drops, &schema.DropCheck{C: c1})
+		}
+	}
+	for _, c2 := range checks2 {
+		if !slices.ContainsFunc(checks1, func(c1 *schema.Check) bool { return sqlserverSameCheck(c1, c2) }) {
+			adds = o.AddOrSkip(adds, &schema.AddCheck{C: c2})
+		}
+	}
+	for _, idx1 := range from.Indexes {
+		idx2, ok := to.Index(idx1.Name)
+		switch {
+		case !ok:
+			drops = o.AddOrSkip(drops, &schema.DropIndex{I: idx1})
+		case !sqlserverSameIndex(idx1, idx2):
+			drops = o.AddOrSkip(drops, &schema.DropIndex{I: idx1})
+			adds = o.AddOrSkip(adds, &schema.AddIndex{I: idx2})
+		}
+	}
+	for _, idx2 := range to.Indexes {
+		if _, ok := from.Index(idx2.Name); !ok {
+			adds = o.AddOrSkip(adds, &schema.AddIndex{I: idx2})
+		}
+	}
+	for _, c1 := range from.Columns {
+		if _, ok := to.Column(c1.Name); !ok {
+			cols = o.AddOrSkip(cols, &schema.DropColumn{C: c1})
+		}
+	}
+	for _, c2 := range to.Columns {
+		c1, ok := from.Column(c2.Name)
+		if !ok {
+			cols = o.AddOrSkip(cols, &schema.AddColumn{C: c2})
+			continue
+		}
+		change, err := sqlserverColumnChange(c1, c2)
+		if err != nil {
+			return nil, err
+		}
+		if change != schema.NoChange {
+			cols = o.AddOrSkip(cols, &schema.ModifyColumn{From: c1, To: c2, Change: change})
+		}
+	}
+	return append(append(drops, cols...), adds...), nil
+}
+
+// sqlserverColumnChange returns the changes between the two columns.
+func sqlserverColumnChange(c1, c2 *schema.Column) (schema.ChangeKind, error) {
+	var change schema.ChangeKind
+	t1, err := sqlserverFormatType(c1.Type.Type)
+	if err != nil {
+		return change, err
+	}
+	t2, err := sqlserverFormatType(c2.Type.Type)
+	if err != nil {
+		return change, err
+	}
+	if !strings.EqualFold(t1, t2) {
+		change |= schema.ChangeType
+	}
+	if c1.Type.Null != c2.Type.Null {
+		change |= schema.ChangeNull
+	}
+	x1, ok1 := sqlserverDefault(c1)
+	x2, ok2 := sqlserverDefault(c2)
+	if ok1 != ok2 || !sqlserverSameDefault(x1, x2) {
+		change |= schema.ChangeDefault
+	}
+	var co1, co2 schema.Collation
+	if sqlserverHas(c2.Attrs, &co2) && sqlserverHas(c1.Attrs, &co1) && !strings.EqualFold(co1.V, co2.V) {
+		change |= schema.ChangeCollate
+	}
+	return change, nil
+}
+
+// sqlserverSameIndex reports if the two indexes (or primary keys) are equal.
+func sqlserverSameIndex(idx1, idx2 *schema.Index) bool {
+	if idx1 == nil || idx2 == nil {
+		return idx1 == nil && idx2 == nil || idx1 == nil && len(idx2.Parts) == 0 || idx2 == nil && len(idx1.Parts) == 0
+	}
+	if idx1.Unique != idx2.Unique || len(idx1.Parts) != len(idx2.Parts) {
+		return false
+	}
+	for i := range idx1.Parts {
+		p1, p2 := idx1.Parts[i], idx2.Parts[i]
+		if p1.C == nil || p2.C == nil || p1.C.Name != p2.C.Name || p1.Desc != p2.Desc {
+			return false
+		}
+	}
+	if sqlserverNormalize(sqlserverFilter(idx1)) != sqlserverNormalize(sqlserverFilter(idx2)) {
+		return false
+	}
+	var t1, t2 sqlserverIndexType
+	sqlserverHas(idx1.Attrs, &t1)
+	sqlserverHas(idx2.Attrs, &t2)
+	if !strings.EqualFold(t1.T, t2.T) {
+		return false
+	}
+	var in1, in2 []string
+	if i := sqlserverInclude(idx1); i != nil {
+		for _, c := range i.Columns {
+			in1 = append(in1, c.Name)
+		}
+	}
+	if i := sqlserverInclude(idx2); i != nil {
+		for _, c := range i.Columns {
+			in2 = append(in2, c.Name)
+		}
+	}
+	return slices.Equal(in1, in2)
+}
+
+// sqlserverSameForeignKey reports if the two foreign keys are equal.
+func sqlserverSameForeignKey(fk1, fk2 *schema.ForeignKey) bool {
+	if fk1.RefTable.Name != fk2.RefTable.Name || sqlserverAction(fk1, fk1.OnUpdate) != sqlserverAction(fk2, fk2.OnUpdate) || sqlserverAction(fk1, fk1.OnDelete) != sqlserverAction(fk2, fk2.OnDelete) ||
+		len(fk1.Columns) != len(fk2.Columns) || len(fk1.RefColumns) != len(fk2.RefColumns) {
+		return false
+	}
+	for i := range fk1.Columns {
+		if fk1.Columns[i].Name != fk2.Columns[i].Name {
+			return false
+		}
+	}
+	for i := range fk1.RefColumns {
+		if fk1.RefColumns[i].Name != fk2.RefColumns[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
+// sqlserverSameCheck reports if the two checks are equal. Checks are matched by their
+// names, or by their expressions in case one of them was not named explicitly.
+func sqlserverSameCheck(c1, c2 *schema.Check) bool {
+	if c1.Name != "" && c2.Name != "" && c1.Name != c2.Name {
+		return false
+	}
+	return sqlserverNormalize(c1.Expr) == sqlserverNormalize(c2.Expr)
+}
+
+// sqlserverChecks returns the checks of the table.
+func sqlserverChecks(t *schema.Table) []*schema.Check {
+	var checks []*schema.Check
+	for _, a := range t.Attrs {
+		if c, ok := a.(*schema.Check); ok {
+			checks = append(checks, c)
+		}
+	}
+	return checks
+}
+
+// sqlserverFilter returns the predicate of a filtered index, or an empty string.
+func sqlserverFilter(idx *schema.Index) string {
+	for _, a := range idx.Attrs {
+		if f, ok := a.(*sqlserverIndexFilter); ok {
+			return f.P
+		}
+	}
+	return ""
+}
+
+// sqlserverInclude returns the INCLUDE columns of an index, or nil.
+func sqlserverInclude(idx *schema.Index) *sqlserverIndexInclude {
+	for _, a := range idx.Attrs {
+		if i, ok := a.(*sqlserverIndexInclude); ok {
+			return i
+		}
+	}
+	return nil
+}
+
+// sqlserverNormalize normalizes an expression (e.g. a CHECK or an index filter)
+// for comparison, as SQL Server stores them with redundant parentheses and quoting.
+var sqlserverNormalize = strings.NewReplacer(" ", "", "\t", "", "\n", "", "(", "", ")", "", "[", "", "]", "", `"`, "").Replace
+
+// sqlserverDefault returns the DEFAULT expression of a column in the form it is written to the database.
+func sqlserverDefault(c *schema.Column) (string, bool) {
+	var v string
+	switch x := c.Default.(type) {
+	case *schema.Literal:
+		v = x.V
+		if _, ok := c.Type.Type.(*schema.JSONType); ok && !strings.HasPrefix(v, "'") {
+			v = "'" + v + "'"
+		}
+	case *schema.RawExpr:
+		v = x.X
+	default:
+		return "", false
+	}
+	switch c.Type.Type.(type) {
+	case *schema.BoolType:
+		switch strings.ToLower(strings.Trim(v, "()")) {
+		case "true":
+			v = "1"
+		case "false":
+			v = "0"
+		}
+	case *schema.StringType, *schema.JSONType:
+		if strings.HasPrefix(v, "'") {
+			v = "N" + v
+		}
+	}
+	return v, true
+}
+
+// sqlserverSameDefault reports if the two DEFAULT expressions are equal. SQL Server
+// stores the definitions wrapped with parentheses (e.g. ((1)) or (N'a')).
+func sqlserverSameDefault(x1, x2 string) bool {
+	trim := func(x string) string {
+		for len(x) > 1 && x[0] == '(' && x[len(x)-1] == ')' {
+			x = x[1 : len(x)-1]
+		}
+		if strings.HasPrefix(x, "N'") {
+			x = x[1:]
+		}
+		return x
+	}
+	x1, x2 = trim(x1), trim(x2)
+	if strings.HasPrefix(x1, "'") {
+		return x1 == x2
+	}
+	return strings.EqualFold(x1, x2)
+}
+
+// PlanChanges implements migrate.PlanApplier.
+func (d *sqlserverDriver) PlanChanges(_ context.Context, name string, changes []schema.Change, opts ...migrate.PlanOption) (*migrate.Plan, error) {
+	p := &sqlserverPlanner{
+		plan:    &migrate.Plan{Name: name, Transactional: true},
+		created: make(map[*schema.Table]bool),
+	}
+	for _, opt := range opts {
+		opt(&p.opts)
+	}
+	for _, c := range changes {
+		if c, ok := c.(*schema.AddTable); ok {
+			p.created[c.T] = false
+		}
+	}
+	// Foreign keys of dropped tables are dropped first, as tables
+	// cannot be dropped while they are referenced by other tables.
+	for _, c := range changes {
+		if c, ok := c.(*schema.DropTable); ok {
+			for _, fk := range c.T.ForeignKeys {
+				p.dropForeignKey(c.T, fk, c)
+			}
+		}
+	}
+	for _, c := range changes {
+		if err := p.change(c); err != nil {
+			return nil, err
+		}
+	}
+	for _, fk := range p.deferred {
+		p.addForeignKey(fk.Table, fk, &schema.AddForeignKey{F: fk})
+	}
+	return p.plan, nil
+}
+
+// ApplyChanges implements migrate.PlanApplier.
+func (d *sqlserverDriver) ApplyChanges(ctx context.Context, changes []schema.Change, opts ...migrate.PlanOption) error {
+	p, err := d.PlanChanges(ctx, "apply", changes, opts...)
+	if err != nil {
+		return err
+	}
+	for _, c := range p.Changes {
+		if _, err := d.ExecContext(ctx, c.Cmd, c.Args...); err != nil {
+			if c.Comment != "" {
+				err = fmt.Errorf("%s: %w", c.Comment, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Lock implements schema.Locker using an application lock that is owned by the session.
+func (d *sqlserverDriver) Lock(ctx context.Context, name string, timeout time.Duration) (schema.UnlockFunc, error) {
+	var conn schema.ExecQuerier = d.ExecQuerier
+	// Session locks are held by the connection, and therefore
+	// a single connection is used for acquiring and releasing it.
+	if opener, ok := d.ExecQuerier.(interface {
+		Conn(context.Context) (*stdsql.Conn, error)
+	}); ok {
+		c, err := opener.Conn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn = c
+	}
+	release := func() error {
+		if c, ok := conn.(*stdsql.Conn); ok {
+			return c.Close()
+		}
+		return nil
+	}
+	rows, err := conn.QueryContext(ctx, "DECLARE @r int; EXEC @r = sp_getapplock @Resource = @p1, @LockMode = 'Exclusive', @LockOwner = 'Session', @LockTimeout = @p2; SELECT @r", name, timeout.Milliseconds())
+	if err != nil {
+		return nil, errors.Join(err, release())
+	}
+	var r int
+	if err := scanOne(rows, &r); err != nil {
+		return nil, errors.Join(err, release())
+	}
+	switch {
+	case r == -1:
+		return nil, errors.Join(schema.ErrLocked, release())
+	case r < 0:
+		return nil, errors.Join(fmt.Errorf("sql/sqlserver: failed acquiring lock %q: %d", name, r), release())
+	}
+	return func() error {
+		_, err := conn.ExecContext(ctx, "EXEC sp_releaseapplock @Resource = @p1, @LockOwner = 'Session'", name)
+		return errors.Join(err, release())
+	}, nil
+}
+
+// Snapshot implements migrate.Snapshoter.
+func (d *sqlserverDriver) Snapshot(ctx context.Context) (migrate.RestoreFunc, error) {
+	s, err := d.InspectSchema(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Tables) > 0 {
+		return nil, &migrate.NotCleanError{State: schema.NewRealm(s), Reason: fmt.Sprintf("found table %q in connected schema", s.Tables[0].Name)}
+	}
+	return func(ctx context.Context) error {
+		current, err := d.InspectSchema(ctx, s.Name, nil)
+		if err != nil {
+			return err
+		}
+		changes, err := d.SchemaDiff(current, s)
+		if err != nil {
+			return err
+		}
+		return d.ApplyChanges(ctx, changes)
+	}, nil
+}
+
+// CheckClean implements migrate.CleanChecker.
+func (d *sqlserverDriver) CheckClean(ctx context.Context, revT *migrate.TableIdent) error {
+	if revT == nil { // accept nil values
+		revT = &migrate.TableIdent{}
+	}
+	s, err := d.InspectSchema(ctx, "", nil)
+	switch {
+	case err != nil:
+		return err
+	case len(s.Tables) == 0, (revT.Schema == "" || s.Name == revT.Schema) && len(s.Tables) == 1 && s.Tables[0].Name == revT.Name:
+		return nil
+	default:
+		return &migrate.NotCleanError{State: schema.NewRealm(s), Reason: fmt.Sprintf("found table %q in schema %q", s.Tables[0].Name, s.Name)}
+	}
+}
+
+// scanOne scans one record and closes the rows at the end.
+func scanOne(rows *stdsql.Rows, dest ...any) error {
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return stdsql.ErrNoRows
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+// sqlserverPlanner plans the schema changes of a migration.
+type sqlserverPlanner struct {
+	opts     migrate.PlanOptions
+	plan     *migrate.Plan
+	created  map[*schema.Table]bool // tables that are created by the plan.
+	deferred []*schema.ForeignKey   // foreign keys that reference tables that were not created yet.
+}
+
+func (p *sqlserverPlanner) change(c schema.Change) error {
+	switch c := c.(type) {
+	case *schema.AddSchema:
+		p.append(c, fmt.Sprintf("CREATE SCHEMA %s", sqlserverQuote(c.S.Name)), fmt.Sprintf("Add new schema named %q", c.S.Name))
+	case *schema.DropSchema:
+		p.append(c, fmt.Sprintf("DROP SCHEMA %s", sqlserverQuote(c.S.Name)), fmt.Sprintf("drop schema named %q", c.S.Name))
+	case *schema.AddTable:
+		return p.addTable(c)
+	case *schema.DropTable:
+		p.append(c, fmt.Sprintf("DROP TABLE %s%s", sqlserverIfExists(c.Extra), p.table(c.T)), fmt.Sprintf("drop %q table", c.T.Name))
+	case *schema.ModifyTable:
+		for _, mc := range c.Changes {
+			if err := p.modify(c.T, mc); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("sql/sqlserver: unsupported change %T", c)
+	}
+	return nil
+}
+
+func (p *sqlserverPlanner) addTable(c *schema.AddTable) error {
+	var defs []string
+	for _, col := range c.T.Columns {
+		def, err := p.column(c.T, col)
+		if err != nil {
+			return err
+		}
+		defs = append(defs, def)
+	}
+	if pk := c.T.PrimaryKey; pk != nil && len(pk.Parts) > 0 {
+		parts, err := p.parts(pk)
+		if err != nil {
+			return err
+		}
+		name := pk.Name
+		if name == "" {
+			name = c.T.Name + "_pkey"
+		}
+		defs = append(defs, fmt.Sprintf("CONSTRAINT %s PRIMARY KEY %s(%s)", sqlserverQuote(name), p.indexType(pk, true), parts))
+	}
+	p.created[c.T] = true
+	for _, fk := range c.T.ForeignKeys {
+		// Foreign keys that reference tables that are created later in the
+		// plan are added after all tables were created.
+		if created, ok := p.created[fk.RefTable]; ok && !created {
+			p.deferred = append(p.deferred, fk)
+			continue
+		}
+		defs = append(defs, p.foreignKey(fk))
+	}
+	for _, ck := range sqlserverChecks(c.T) {
+		defs = append(defs, p.check(ck))
+	}
+	sep := ", "
+	open, close := "(", ")"
+	if p.opts.Indent != "" {
+		sep = ",\n" + p.opts.Indent
+		open, close = "(\n"+p.opts.Indent, "\n)"
+	}
+	p.append(c, fmt.Sprintf("CREATE TABLE %s %s%s%s", p.table(c.T), open, strings.Join(defs, sep), close), fmt.Sprintf("create %q table", c.T.Name))
+	for _, idx := range c.T.Indexes {
+		if err := p.addIndex(c.T, idx, &schema.AddIndex{I: idx}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *sqlserverPlanner) modify(t *schema.Table, c schema.Change) error {
+	alter := "ALTER TABLE " + p.table(t)
+	comment := fmt.Sprintf("modify %q table", t.Name)
+	switch c := c.(type) {
+	case *schema.AddColumn:
+		def, err := p.column(t, c.C)
+		if err != nil {
+			return err
+		}
+		p.append(c, fmt.Sprintf("%s ADD %s", alter, def), comment)
+	case *schema.DropColumn:
+		p.dropDefault(t, c.C, c)
+		p.append(c, fmt.Sprintf("%s DROP COLUMN %s", alter, sqlserverQuote(c.C.Name)), comment)
+	case *schema.ModifyColumn:
+		if c.Change.Is(schema.ChangeDefault) {
+			p.dropDefault(t, c.From, c)
+		}
+		if c.Change&^schema.ChangeDefault != 0 {
+			typ, err := sqlserverFormatType(c.To.Type.Type)
+			if err != nil {
+				return err
+			}
+			p.append(c, fmt.Sprintf("%s ALTER COLUMN %s %s%s%s", alter, sqlserverQuote(c.To.Name), typ, sqlserverCollate(c.To), sqlserverNull(c.To)), comment)
+		}
+		if x, ok := sqlserverDefault(c.To); ok && c.Change.Is(schema.ChangeDefault) {
+			p.append(c, fmt.Sprintf("%s ADD CONSTRAINT %s DEFAULT %s FOR %s", alter, sqlserverQuote(sqlserverDefaultN(t, c.To)), x, sqlserverQuote(c.To.Name)), comment)
+		}
+	case *schema.AddIndex:
+		return p.addIndex(t, c.I, c)
+	case *schema.DropIndex:
+		p.append(c, fmt.Sprintf("DROP INDEX %s ON %s", sqlserverQuote(c.I.Name), p.table(t)), fmt.Sprintf("drop index %q from table: %q", c.I.Name, t.Name))
+	case *schema.AddForeignKey:
+		p.addForeignKey(t, c.F, c)
+	case *schema.DropForeignKey:
+		p.dropForeignKey(t, c.F, c)
+	case *schema.AddCheck:
+		p.append(c, fmt.Sprintf("%s ADD %s", alter, p.check(c.C)), comment)
+	case *schema.DropCheck:
+		p.append(c, fmt.Sprintf("%s DROP CONSTRAINT %s", alter, sqlserverQuote(c.C.Name)), comment)
+	default:
+		return fmt.Errorf("sql/sqlserver: unsupported table change %T", c)
+	}
+	return nil
+}
+
+// column returns the definition of the column.
+func (p *sqlserverPlanner) column(t *schema.Table, c *schema.Column) (string, error) {
+	typ, err := sqlserverFormatType(c.Type.Type)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString(sqlserverQuote(c.Name) + " " + typ)
+	var id sqlserverIdentity
+	if sqlserverHas(c.Attrs, &id) {
+		fmt.Fprintf(&b, " IDENTITY(%d, %d)", id.Seed, id.Increment)
+	}
+	b.WriteString(sqlserverCollate(c))
+	b.WriteString(sqlserverNull(c))
+	if x, ok := sqlserverDefault(c); ok {
+		fmt.Fprintf(&b, " CONSTRAINT %s DEFAULT %s", sqlserverQuote(sqlserverDefaultN(t, c)), x)
+	}
+	return b.String(), nil
+}
+
+// parts returns the columns of the index.
+func (p *sqlserverPlanner) parts(idx *schema.Index) (string, error) {
+	parts := make([]string, len(idx.Parts))
+	for i, part := range idx.Parts {
+		if part.C == nil {
+			return "", fmt.Errorf("sql/sqlserver: expression parts of index %q are not supported", idx.Name)
+		}
+		parts[i] = sqlserverQuote(part.C.Name)
+		if part.Desc {
+			parts[i] += " DESC"
+		}
+	}
+	return strings.Join(parts, ", "), nil
+}
+
+// indexType returns the type of the index (e.g. CLUSTERED), if it differs from the default.
+func (p *sqlserverPlanner) indexType(idx *schema.Index, pk bool) string {
+	var t sqlserverIndexType
+	switch {
+	case !sqlserverHas(idx.Attrs, &t):
+	case strings.EqualFold(t.T, "CLUSTERED") && pk, strings.EqualFold(t.T, "NONCLUSTERED") && !pk:
+	default:
+		return strings.ToUpper(t.T) + " "
+	}
+	return ""
+}
+
+func (p *sqlserverPlanner) addIndex(t *schema.Table, idx *schema.Index, c schema.Change) error {
+	parts, err := p.parts(idx)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if idx.Unique {
+		b.WriteString("UNIQUE ")
+	}
+	b.WriteString(p.indexType(idx, false))
+	fmt.Fprintf(&b, "INDEX %s ON %s (%s)", sqlserverQuote(idx.Name), p.table(t), parts)
+	if i := sqlserverInclude(idx); i != nil {
+		columns := make([]string, len(i.Columns))
+		for j, c := range i.Columns {
+			columns[j] = sqlserverQuote(c.Name)
+		}
+		fmt.Fprintf(&b, " INCLUDE (%s)", strings.Join(columns, ", "))
+	}
+	if f := sqlserverFilter(idx); f != "" {
+		fmt.Fprintf(&b, " WHERE %s", f)
+	}
+	p.append(c, b.String(), fmt.Sprintf("create index %q to table: %q", idx.Name, t.Name))
+	return nil
+}
+
+// foreignKey returns the definition of the foreign key.
+func (p *sqlserverPlanner) foreignKey(fk *schema.ForeignKey) string {
+	columns, refs := make([]string, len(fk.Columns)), make([]string, len(fk.RefColumns))
+	for i, c := range fk.Columns {
+		columns[i] = sqlserverQuote(c.Name)
+	}
+	for i, c := range fk.RefColumns {
+		refs[i] = sqlserverQuote(c.Name)
+	}
+	var b strings.Builder
+	if fk.Symbol != "" {
+		fmt.Fprintf(&b, "CONSTRAINT %s ", sqlserverQuote(fk.Symbol))
+	}
+	fmt.Fprintf(&b, "FOREIGN KEY (%s) REFERENCES %s (%s)", strings.Join(columns, ", "), p.table(fk.RefTable), strings.Join(refs, ", "))
+	if o := sqlserverAction(fk, fk.OnUpdate); o != schema.NoAction {
+		fmt.Fprintf(&b, " ON UPDATE %s", o)
+	}
+	if o := sqlserverAction(fk, fk.OnDelete); o != schema.NoAction {
+		fmt.Fprintf(&b, " ON DELETE %s", o)
+	}
+	return b.String()
+}
+
+// sqlserverAction returns the referential action that is applied by SQL Server for the foreign key.
+// RESTRICT is not supported and behaves like NO ACTION (the default). Also, SQL Server rejects
+// cascading actions that may cause cycles or multiple cascade paths, and therefore, they are
+// not applied to self-references and to multiple foreign keys that reference the same table.
+func sqlserverAction(fk *schema.ForeignKey, o schema.ReferenceOption) schema.ReferenceOption {
+	if o == "" || o == schema.Restrict || fk.Table != nil && fk.RefTable.Name == fk.Table.Name {
+		return schema.NoAction
+	}
+	if fk.Table != nil {
+		refs := 0
+		for _, f := range fk.Table.ForeignKeys {
+			if f.RefTable.Name == fk.RefTable.Name {
+				refs++
+			}
+		}
+		if refs > 1 {
+			return schema.NoAction
+		}
+	}
+	return o
+}
+
+func (p *sqlserverPlanner) addForeignKey(t *schema.Table, fk *schema.ForeignKey, c schema.Change) {
+	p.append(c, fmt.Sprintf("ALTER TABLE %s ADD %s", p.table(t), p.foreignKey(fk)), fmt.Sprintf("modify %q table", t.Name))
+}
+
+func (p *sqlserverPlanner) dropForeignKey(t *schema.Table, fk *schema.ForeignKey, c schema.Change) {
+	p.append(c, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", p.table(t), sqlserverQuote(fk.Symbol)), fmt.Sprintf("modify %q table", t.Name))
+}
+
+// dropDefault drops the DEFAULT constraint of an inspected column, if it has one.
+func (p *sqlserverPlanner) dropDefault(t *schema.Table, c *schema.Column, change schema.Change) {
+	var n sqlserverDefaultName
+	if c.Default != nil && sqlserverHas(c.Attrs, &n) {
+		p.append(change, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", p.table(t), sqlserverQuote(n.N)), fmt.Sprintf("modify %q table", t.Name))
+	}
+}
+
+// check returns the definition of the check.
+func (p *sqlserverPlanner) check(c *schema.Check) string {
+	if c.Name == "" {
+		return fmt.Sprintf("CHECK (%s)", c.Expr)
+	}
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", sqlserverQuote(c.Name), c.Expr)
+}
+
+// table returns the (optionally qualified) name of the table.
+func (p *sqlserverPlanner) table(t *schema.Table) string {
+	switch q := p.opts.SchemaQualifier; {
+	case q != nil && *q != "":
+		return sqlserverQuote(*q) + "." + sqlserverQuote(t.Name)
+	case q == nil && t.Schema != nil && t.Schema.Name != "":
+		return sqlserverQuote(t.Schema.Name) + "." + sqlserverQuote(t.Name)
+	}
+	return sqlserverQuote(t.Name)
+}
+
+func (p *sqlserverPlanner) append(source schema.Change, cmd, comment string) {
+	p.plan.Changes = append(p.plan.Changes, &migrate.Change{Cmd: cmd, Comment: comment, Source: source})
+}
+
+// sqlserverQuote quotes the identifier using brackets.
+func sqlserverQuote(s string) string {
+	return "[" + strings.ReplaceAll(s, "]", "]]") + "]"
+}
+
+// sqlserverArgs returns n placeholders, starting from the given index.
+func sqlserverArgs(start, n int) string {
+	args := make([]string, n)
+	for i := range args {
+		args[i] = "@p" + strconv.Itoa(start+i)
+	}
+	return strings.Join(args, ", ")
+}
+
+// sqlserverDefaultN returns the name of the DEFAULT constraint of a column.
+func sqlserverDefaultN(t *schema.Table, c *schema.Column) string {
+	var n sqlserverDefaultName
+	if sqlserverHas(c.Attrs, &n) && n.N != "" {
+		return n.N
+	}
+	return fmt.Sprintf("DF_%s_%s", t.Name, c.Name)
+}
+
+func sqlserverNull(c *schema.Column) string {
+	if c.Type.Null {
+		return " NULL"
+	}
+	return " NOT NULL"
+}
+
+func sqlserverCollate(c *schema.Column) string {
+	var co schema.Collation
+	if sqlserverHas(c.Attrs, &co) && co.V != "" {
+		return " COLLATE " + co.V
+	}
+	return ""
+}
+
+func sqlserverIfExists(extra []schema.Clause) string {
+	for _, c := range extra {
+		if _, ok := c.(*schema.IfExists); ok {
+			return "IF EXISTS "
+		}
+	}
+	return ""
+}
+
+// sqlserverRawType returns the raw type of an inspected column.
+func sqlserverRawType(typ string, size, precision, scale int64) string {
+	switch typ {
+	case "char", "varchar", "binary", "varbinary":
+		if size == -1 {
+			return typ + "(max)"
+		}
+		return fmt.Sprintf("%s(%d)", typ, size)
+	case "nchar", "nvarchar":
+		if size == -1 {
+			return typ + "(max)"
+		}
+		// The size of Unicode columns is returned in bytes.
+		return fmt.Sprintf("%s(%d)", typ, size/2)
+	case "decimal", "numeric":
+		return fmt.Sprintf("%s(%d,%d)", typ, precision, scale)
+	case "datetime2", "datetimeoffset", "time":
+		return fmt.Sprintf("%s(%d)", typ, scale)
+	case "float":
+		return fmt.Sprintf("%s(%d)", typ, precision)
+	default:
+		return typ
+	}
+}
+
+// sqlserverParseType parses a (lower-cased) SQL Server type.
+func sqlserverParseType(raw string) (schema.Type, error) {
+	t, args, err := sqlserverTypeArgs(raw)
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case "bit":
+		return &schema.BoolType{T: t}, nil
+	case "tinyint", "smallint", "int", "bigint":
+		return &schema.IntegerType{T: t}, nil
+	case "real":
+		return &schema.FloatType{T: t, Precision: 24}, nil
+	case "float":
+		p := 53
+		if len(args) > 0 {
+			p = args[0]
+		}
+		if p <= 24 {
+			return &schema.FloatType{T: "real", Precision: 24}, nil
+		}
+		return &schema.FloatType{T: t, Precision: 53}, nil
+	case "decimal", "numeric":
+		d := &schema.DecimalType{T: t, Precision: 18}
+		if len(args) > 0 {
+			d.Precision = args[0]
+		}
+		if len(args) > 1 {
+			d.Scale = args[1]
+		}
+		return d, nil
+	case "money", "smallmoney":
+		return &schema.DecimalType{T: t}, nil
+	case "char", "varchar", "nchar", "nvarchar":
+		s := &schema.StringType{T: t, Size: 1}
+		if len(args) > 0 {
+			s.Size = args[0]
+		}
+		return s, nil
+	case "text", "ntext":
+		return &schema.StringType{T: t}, nil
+	case "binary", "varbinary":
+		size := 1
+		if len(args) > 0 {
+			size = args[0]
+		}
+		return &schema.BinaryType{T: t, Size: &size}, nil
+	case "image":
+		return &schema.BinaryType{T: t}, nil
+	case "date", "datetime", "smalldatetime":
+		return &schema.TimeType{T: t}, nil
+	case "datetime2", "datetimeoffset", "time":
+		p := 7
+		if len(args) > 0 {
+			p = args[0]
+		}
+		return &schema.TimeType{T: t, Precision: &p}, nil
+	case "uniqueidentifier":
+		return &schema.UUIDType{T: t}, nil
+	default:
+		return &schema.UnsupportedType{T: raw}, nil
+	}
+}
+
+// sqlserverTypeArgs splits a raw type into its name and arguments. The max argument is returned as -1.
+func sqlserverTypeArgs(raw string) (string, []int, error) {
+	raw = strings.TrimSpace(raw)
+	i := strings.IndexByte(raw, '(')
+	if i == -1 {
+		return raw, nil, nil
+	}
+	if !strings.HasSuffix(raw, ")") {
+		return "", nil, fmt.Errorf("sql/sqlserver: malformed type %q", raw)
+	}
+	var args []int
+	for _, a := range strings.Split(raw[i+1:len(raw)-1], ",") {
+		a = strings.TrimSpace(a)
+		if a == "max" {
+			args = append(args, -1)
+			continue
+		}
+		v, err := strconv.Atoi(a)
+		if err != nil {
+			return "", nil, fmt.Errorf("sql/sqlserver: malformed type %q", raw)
+		}
+		args = append(args, v)
+	}
+	return strings.TrimSpace(raw[:i]), args, nil
+}
+
+// sqlserverFormatType returns the SQL representation of the type.
+func sqlserverFormatType(t schema.Type) (string, error) {
+	size := func(n int, limit int) string {
+		if n < 0 || n > limit {
+			return "(max)"
+		}
+		return fmt.Sprintf("(%d)", n)
+	}
+	switch t := t.(type) {
+	case *schema.BoolType:
+		return t.T, nil
+	case *schema.IntegerType:
+		return t.T, nil
+	case *schema.FloatType:
+		if t.T == "float" && t.Precision > 0 && t.Precision <= 24 {
+			return "real", nil
+		}
+		return t.T, nil
+	case *schema.DecimalType:
+		if t.T == "money" || t.T == "smallmoney" {
+			return t.T, nil
+		}
+		return fmt.Sprintf("%s(%d,%d)", t.T, t.Precision, t.Scale), nil
+	case *schema.StringType:
+		switch t.T {
+		case "text", "ntext":
+			return t.T, nil
+		case "nchar", "nvarchar":
+			return t.T + size(t.Size, sqlserverMaxNChar), nil
+		default:
+			return t.T + size(t.Size, sqlserverMaxBinary), nil
+		}
+	case *schema.BinaryType:
+		if t.T == "image" {
+			return t.T, nil
+		}
+		n := -1
+		if t.Size != nil {
+			n = *t.Size
+		}
+		return t.T + size(n, sqlserverMaxBinary), nil
+	case *schema.TimeType:
+		if t.Precision == nil || *t.Precision == 7 || t.T == "date" || t.T == "datetime" || t.T == "smalldatetime" {
+			return t.T, nil
+		}
+		return fmt.Sprintf("%s(%d)", t.T, *t.Precision), nil
+	case *schema.JSONType:
+		return t.T, nil
+	case *schema.UUIDType:
+		return t.T, nil
+	case *schema.UnsupportedType:
+		return t.T, nil
+	default:
+		return "", fmt.Errorf("sql/sqlserver: unsupported type %T", t)
+	}
+}
+
+// sqlserverHas reports if the attributes contain an attribute of the type of v,
+// and sets v to its value in case it does. v must be a pointer to a struct.
+func sqlserverHas(attrs []schema.Attr, v any) bool {
+	rv := reflect.ValueOf(v)
+	for _, a := range attrs {
+		if av := reflect.ValueOf(a); av.Type() == rv.Type() {
+			rv.Elem().Set(av.Elem())
+			return true
+		}
+	}
+	return false
+}
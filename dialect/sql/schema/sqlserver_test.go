@@ -0,0 +1,336 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"ariga.io/atlas/sql/schema"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLServer_TableDiff(t *testing.T) {
+	// users returns the "users" table. The inspected version of the table
+	// holds the DEFAULT expressions in the form they are stored by SQL Server.
+	users := func(inspected bool) *schema.Table {
+		groups := schema.NewTable("groups").
+			AddColumns(schema.NewColumn("id").SetType(&schema.IntegerType{T: "bigint"}))
+		var (
+			id     = schema.NewColumn("id").SetType(&schema.IntegerType{T: "bigint"})
+			name   = schema.NewColumn("name").SetType(&schema.StringType{T: "nvarchar", Size: 255})
+			active = schema.NewColumn("active").SetType(&schema.BoolType{T: "bit"})
+			gid    = schema.NewNullColumn("group_id").SetType(&schema.IntegerType{T: "bigint"})
+		)
+		if inspected {
+			name.SetDefault(&schema.RawExpr{X: "(N'unknown')"}).AddAttrs(&sqlserverDefaultName{N: "DF_users_name"})
+			active.SetDefault(&schema.RawExpr{X: "((1))"}).AddAttrs(&sqlserverDefaultName{N: "DF_users_active"})
+		} else {
+			name.SetDefault(&schema.Literal{V: "'unknown'"})
+			active.SetDefault(&schema.Literal{V: "true"})
+		}
+		users := schema.NewTable("users").AddColumns(id, name, active, gid)
+		users.SetPrimaryKey(schema.NewPrimaryKey(id))
+		users.AddIndexes(schema.NewIndex("users_name").AddColumns(name))
+		users.AddForeignKeys(
+			schema.NewForeignKey("users_groups_users").
+				AddColumns(gid).
+				SetRefTable(groups).
+				AddRefColumns(groups.Columns[0]).
+				SetOnDelete(schema.SetNull),
+		)
+		return users
+	}
+	column := func(t *schema.Table, name string) *schema.Column {
+		c, ok := t.Column(name)
+		if !ok {
+			panic("missing column " + name)
+		}
+		return c
+	}
+	tests := []struct {
+		name    string
+		change  func(from, to *schema.Table)
+		wantCmd []string
+		wantErr string
+	}{
+		{
+			name:   "no changes",
+			change: func(*schema.Table, *schema.Table) {},
+		},
+		{
+			name: "column type",
+			change: func(_, to *schema.Table) {
+				column(to, "name").Type.Type = &schema.StringType{T: "nvarchar", Size: 512}
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] ALTER COLUMN [name] nvarchar(512) NOT NULL",
+			},
+		},
+		{
+			name: "column type case",
+			change: func(_, to *schema.Table) {
+				column(to, "name").Type.Type = &schema.StringType{T: "NVARCHAR", Size: 255}
+			},
+		},
+		{
+			name: "column size max",
+			change: func(from, to *schema.Table) {
+				column(from, "name").Type.Type = &schema.StringType{T: "nvarchar", Size: -1}
+				column(to, "name").Type.Type = &schema.StringType{T: "nvarchar", Size: 1 << 20}
+			},
+		},
+		{
+			name: "column nullability",
+			change: func(_, to *schema.Table) {
+				column(to, "group_id").Type.Null = false
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] ALTER COLUMN [group_id] bigint NOT NULL",
+			},
+		},
+		{
+			name: "column collation",
+			change: func(from, to *schema.Table) {
+				column(from, "name").AddAttrs(&schema.Collation{V: "SQL_Latin1_General_CP1_CI_AS"})
+				column(to, "name").AddAttrs(&schema.Collation{V: "Latin1_General_100_CS_AS"})
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] ALTER COLUMN [name] nvarchar(255) COLLATE Latin1_General_100_CS_AS NOT NULL",
+			},
+		},
+		{
+			name: "column collation is not defined",
+			change: func(from, _ *schema.Table) {
+				column(from, "name").AddAttrs(&schema.Collation{V: "SQL_Latin1_General_CP1_CI_AS"})
+			},
+		},
+		{
+			name: "column default",
+			change: func(_, to *schema.Table) {
+				column(to, "name").SetDefault(&schema.Literal{V: "'a8m'"})
+				column(to, "active").SetDefault(&schema.Literal{V: "false"})
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [DF_users_name]",
+				"ALTER TABLE [users] ADD CONSTRAINT [DF_users_name] DEFAULT N'a8m' FOR [name]",
+				"ALTER TABLE [users] DROP CONSTRAINT [DF_users_active]",
+				"ALTER TABLE [users] ADD CONSTRAINT [DF_users_active] DEFAULT 0 FOR [active]",
+			},
+		},
+		{
+			name: "column default case",
+			change: func(from, _ *schema.Table) {
+				column(from, "name").SetDefault(&schema.RawExpr{X: "(N'Unknown')"})
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [DF_users_name]",
+				"ALTER TABLE [users] ADD CONSTRAINT [DF_users_name] DEFAULT N'unknown' FOR [name]",
+			},
+		},
+		{
+			name: "add column default",
+			change: func(_, to *schema.Table) {
+				column(to, "group_id").SetDefault(&schema.Literal{V: "1"})
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] ADD CONSTRAINT [DF_users_group_id] DEFAULT 1 FOR [group_id]",
+			},
+		},
+		{
+			name: "drop column default",
+			change: func(_, to *schema.Table) {
+				column(to, "name").Default = nil
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [DF_users_name]",
+			},
+		},
+		{
+			name: "column type and default",
+			change: func(_, to *schema.Table) {
+				column(to, "active").SetType(&schema.IntegerType{T: "int"}).SetDefault(&schema.Literal{V: "2"})
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [DF_users_active]",
+				"ALTER TABLE [users] ALTER COLUMN [active] int NOT NULL",
+				"ALTER TABLE [users] ADD CONSTRAINT [DF_users_active] DEFAULT 2 FOR [active]",
+			},
+		},
+		{
+			name: "add and drop columns",
+			change: func(_, to *schema.Table) {
+				to.Columns = slices.DeleteFunc(to.Columns, func(c *schema.Column) bool { return c.Name == "active" })
+				to.AddColumns(schema.NewNullColumn("nickname").SetType(&schema.StringType{T: "nvarchar", Size: 100}))
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [DF_users_active]",
+				"ALTER TABLE [users] DROP COLUMN [active]",
+				"ALTER TABLE [users] ADD [nickname] nvarchar(100) NULL",
+			},
+		},
+		{
+			name: "add index",
+			change: func(_, to *schema.Table) {
+				to.AddIndexes(
+					schema.NewUniqueIndex("users_group_id_name").
+						AddColumns(column(to, "group_id"), column(to, "name")).
+						AddAttrs(&sqlserverIndexFilter{P: "[group_id] IS NOT NULL"}),
+				)
+			},
+			wantCmd: []string{
+				"CREATE UNIQUE INDEX [users_group_id_name] ON [users] ([group_id], [name]) WHERE [group_id] IS NOT NULL",
+			},
+		},
+		{
+			name: "drop index",
+			change: func(_, to *schema.Table) {
+				to.Indexes = nil
+			},
+			wantCmd: []string{
+				"DROP INDEX [users_name] ON [users]",
+			},
+		},
+		{
+			name: "index uniqueness",
+			change: func(_, to *schema.Table) {
+				to.Indexes[0].Unique = true
+			},
+			wantCmd: []string{
+				"DROP INDEX [users_name] ON [users]",
+				"CREATE UNIQUE INDEX [users_name] ON [users] ([name])",
+			},
+		},
+		{
+			name: "index columns",
+			change: func(_, to *schema.Table) {
+				to.Indexes[0].Parts[0].Desc = true
+				to.Indexes[0].AddColumns(column(to, "active"))
+			},
+			wantCmd: []string{
+				"DROP INDEX [users_name] ON [users]",
+				"CREATE INDEX [users_name] ON [users] ([name] DESC, [active])",
+			},
+		},
+		{
+			name: "index type and include",
+			change: func(_, to *schema.Table) {
+				to.Indexes[0].AddAttrs(
+					&sqlserverIndexType{T: "CLUSTERED"},
+					&sqlserverIndexInclude{Columns: []*schema.Column{column(to, "active")}},
+				)
+			},
+			wantCmd: []string{
+				"DROP INDEX [users_name] ON [users]",
+				"CREATE CLUSTERED INDEX [users_name] ON [users] ([name]) INCLUDE ([active])",
+			},
+		},
+		{
+			name: "index filter",
+			change: func(from, to *schema.Table) {
+				from.Indexes[0].AddAttrs(&sqlserverIndexFilter{P: "([name] IS NOT NULL)"})
+				to.Indexes[0].AddAttrs(&sqlserverIndexFilter{P: `"name" IS NOT NULL`})
+			},
+		},
+		{
+			name: "add foreign key",
+			change: func(from, _ *schema.Table) {
+				from.ForeignKeys = nil
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] ADD CONSTRAINT [users_groups_users] FOREIGN KEY ([group_id]) REFERENCES [groups] ([id]) ON DELETE SET NULL",
+			},
+		},
+		{
+			name: "drop foreign key",
+			change: func(_, to *schema.Table) {
+				to.ForeignKeys = nil
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [users_groups_users]",
+			},
+		},
+		{
+			name: "foreign key action",
+			change: func(_, to *schema.Table) {
+				to.ForeignKeys[0].OnDelete = schema.Cascade
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [users_groups_users]",
+				"ALTER TABLE [users] ADD CONSTRAINT [users_groups_users] FOREIGN KEY ([group_id]) REFERENCES [groups] ([id]) ON DELETE CASCADE",
+			},
+		},
+		{
+			name: "foreign key restrict",
+			change: func(from, to *schema.Table) {
+				from.ForeignKeys[0].OnDelete = schema.NoAction
+				to.ForeignKeys[0].OnDelete = schema.Restrict
+			},
+		},
+		{
+			name: "foreign key columns",
+			change: func(_, to *schema.Table) {
+				owner := schema.NewNullColumn("owner_id").SetType(&schema.IntegerType{T: "bigint"})
+				to.AddColumns(owner)
+				to.ForeignKeys[0].Columns = []*schema.Column{owner}
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [users_groups_users]",
+				"ALTER TABLE [users] ADD [owner_id] bigint NULL",
+				"ALTER TABLE [users] ADD CONSTRAINT [users_groups_users] FOREIGN KEY ([owner_id]) REFERENCES [groups] ([id]) ON DELETE SET NULL",
+			},
+		},
+		{
+			name: "checks",
+			change: func(from, to *schema.Table) {
+				from.AddChecks(
+					&schema.Check{Name: "users_name_check", Expr: "(len([name])>(0))"},
+					&schema.Check{Name: "users_group_check", Expr: "([group_id]>(0))"},
+				)
+				to.AddChecks(
+					&schema.Check{Name: "users_name_check", Expr: "len(name) > 0"},
+					&schema.Check{Name: "users_active_check", Expr: "active = 1"},
+				)
+			},
+			wantCmd: []string{
+				"ALTER TABLE [users] DROP CONSTRAINT [users_group_check]",
+				"ALTER TABLE [users] ADD CONSTRAINT [users_active_check] CHECK (active = 1)",
+			},
+		},
+		{
+			name: "primary key",
+			change: func(_, to *schema.Table) {
+				to.PrimaryKey.AddColumns(column(to, "name"))
+			},
+			wantErr: `sql/sqlserver: changing the primary key of table "users" is not supported`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := users(true), users(false)
+			tt.change(from, to)
+			drv := &sqlserverDriver{}
+			changes, err := drv.TableDiff(from, to)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if len(tt.wantCmd) == 0 {
+				require.Empty(t, changes)
+				return
+			}
+			plan, err := drv.PlanChanges(context.Background(), "diff", []schema.Change{&schema.ModifyTable{T: to, Changes: changes}})
+			require.NoError(t, err)
+			cmds := make([]string, len(plan.Changes))
+			for i, c := range plan.Changes {
+				cmds[i] = c.Cmd
+			}
+			require.Equal(t, tt.wantCmd, cmds)
+		})
+	}
+}
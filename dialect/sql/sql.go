@@ -191,6 +191,13 @@ func FieldContainsFold(name string, substr string) func(*Selector) {
 	}
 }
 
+// FieldSearch returns a raw predicate to check if the field matches the given full-text search query.
+func FieldSearch(name, query string, opts ...SearchOption) func(*Selector) {
+	return func(s *Selector) {
+		s.Where(Search([]string{s.C(name)}, query, opts...))
+	}
+}
+
 // AndPredicates returns a new predicate for joining multiple generated predicates with AND between them.
 func AndPredicates[P ~func(*Selector)](predicates ...P) func(*Selector) {
 	return func(s *Selector) {
@@ -399,6 +406,8 @@ func OrderByRand() func(*Selector) {
 			switch s.Dialect() {
 			case dialect.MySQL:
 				b.WriteString("RAND()")
+			case dialect.SQLServer:
+				b.WriteString("NEWID()")
 			default:
 				b.WriteString("RANDOM()")
 			}
@@ -406,6 +415,48 @@ func OrderByRand() func(*Selector) {
 	}
 }
 
+// OrderBySearchRank returns a term to order by the full-text search rank of
+// the given field and query. The most relevant results are returned first.
+func OrderBySearchRank(field, query string, opts ...SearchOption) func(*Selector) {
+	return func(s *Selector) {
+		s.OrderExpr(DescExpr(SearchRank([]string{s.C(field)}, query, opts...)))
+	}
+}
+
+// OrderByWindow returns a term to order by the result of the given window function.
+// Use the OrderSelectAs option to select the result, and read it using the Value
+// method of the returned entities. For example, a leaderboard of the players:
+//
+//	client.Player.Query().
+//		Order(sql.OrderByWindow(sql.Rank().OrderBy(sql.Desc(player.FieldScore)), sql.OrderSelectAs("rank"))).
+//		AllX(ctx)
+func OrderByWindow(w *WindowBuilder, opts ...OrderTermOption) func(*Selector) {
+	o := NewOrderTermOptions(opts...)
+	return func(s *Selector) {
+		switch {
+		case o.Selected && o.As != "":
+			s.AppendSelectExprAs(w, o.As)
+		case o.Selected:
+			s.AppendSelectExpr(w)
+		}
+		s.OrderExprFunc(func(b *Builder) {
+			if o.Selected && o.As != "" {
+				b.Ident(o.As)
+			} else {
+				b.Join(w)
+			}
+			if o.Desc {
+				b.WriteString(" DESC")
+			}
+			if o.NullsFirst {
+				b.WriteString(" NULLS FIRST")
+			} else if o.NullsLast {
+				b.WriteString(" NULLS LAST")
+			}
+		})
+	}
+}
+
 // ToFunc returns a function that sets the ordering on the given selector.
 // This is used by the generated code.
 func (f *OrderFieldTerm) ToFunc() func(*Selector) {
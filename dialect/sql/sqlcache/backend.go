@@ -0,0 +1,231 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlcache
+
+import (
+	"container/list"
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ErrNotFound is returned by Backend.Get in case the key was not found.
+var ErrNotFound = errors.New("sqlcache: entry not found")
+
+// Backend is the interface for storing the cached query results.
+type Backend interface {
+	// Get returns the entry of the given key, or ErrNotFound if there is none.
+	Get(ctx context.Context, key string) (*Entry, error)
+	// Add stores the entry of the given key for the given duration.
+	Add(ctx context.Context, key string, e *Entry, ttl time.Duration) error
+	// Invalidate removes all entries that were read from the given tables.
+	Invalidate(ctx context.Context, tables ...string) error
+}
+
+// Entry holds the cached results of a query.
+type Entry struct {
+	// Columns and Values hold the names of the columns and the values of the rows.
+	Columns []string
+	Values  [][]driver.Value
+	// Tables holds the tables the query reads from.
+	Tables []string
+}
+
+// newEntry reads all rows into a new entry, and closes them.
+func newEntry(rows *sql.Rows, tables []string) (_ *Entry, err error) {
+	defer func() {
+		err = errors.Join(err, rows.Close())
+	}()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	e := &Entry{Columns: columns, Tables: tables}
+	for rows.Next() {
+		// Values are scanned into *any, as database/sql does not
+		// support scanning NULL values into *driver.Value.
+		scanned := make([]any, len(columns))
+		dest := make([]any, len(columns))
+		for i := range scanned {
+			dest[i] = &scanned[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		values := make([]driver.Value, len(columns))
+		for i, v := range scanned {
+			values[i] = v
+		}
+		e.Values = append(e.Values, values)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// replay sets the rows to iterate over the entry values. The values are scanned by the
+// database/sql package, the same way they are scanned when they are read from the database.
+func (e *Entry) replay(ctx context.Context, rows *sql.Rows) error {
+	r, err := replayDB.QueryContext(ctx, "", e)
+	if err != nil {
+		return err
+	}
+	*rows = sql.Rows{ColumnScanner: r}
+	return nil
+}
+
+// replayDB is a database whose queries return the rows of the entry that is passed as their argument.
+var replayDB = stdsql.OpenDB(replayConnector{})
+
+type (
+	replayConnector struct{}
+	replayConn      struct{}
+	replayRows      struct {
+		*Entry
+		idx int
+	}
+)
+
+func (replayConnector) Connect(context.Context) (driver.Conn, error) { return replayConn{}, nil }
+func (replayConnector) Driver() driver.Driver                        { return nil }
+
+func (replayConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("sqlcache: prepare is not supported")
+}
+func (replayConn) Close() error { return nil }
+func (replayConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("sqlcache: transactions are not supported")
+}
+
+// CheckNamedValue accepts the entry as the query argument.
+func (replayConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+// QueryContext returns the rows of the entry that is passed as the query argument.
+func (replayConn) QueryContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Rows, error) {
+	e, ok := args[0].Value.(*Entry)
+	if !ok {
+		return nil, errors.New("sqlcache: unexpected replay argument")
+	}
+	return &replayRows{Entry: e}, nil
+}
+
+func (r *replayRows) Columns() []string { return r.Entry.Columns }
+func (r *replayRows) Close() error      { return nil }
+
+func (r *replayRows) Next(dest []driver.Value) error {
+	if r.idx >= len(r.Values) {
+		return io.EOF
+	}
+	copy(dest, r.Values[r.idx])
+	r.idx++
+	return nil
+}
+
+// LRU is an in-memory Backend that evicts the least recently used entries.
+type LRU struct {
+	mu     sync.Mutex
+	size   int
+	list   *list.List
+	items  map[string]*list.Element
+	tables map[string]map[string]struct{} // table name to keys.
+}
+
+// lruItem is the value of the LRU list elements.
+type lruItem struct {
+	key     string
+	entry   *Entry
+	expires time.Time
+}
+
+// NewLRU returns a new LRU backend that holds at most size entries.
+func NewLRU(size int) *LRU {
+	return &LRU{
+		size:   size,
+		list:   list.New(),
+		items:  make(map[string]*list.Element),
+		tables: make(map[string]map[string]struct{}),
+	}
+}
+
+// Get implements the Backend interface.
+func (l *LRU) Get(_ context.Context, key string) (*Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	el, ok := l.items[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	it := el.Value.(*lruItem)
+	if time.Now().After(it.expires) {
+		l.remove(el)
+		return nil, ErrNotFound
+	}
+	l.list.MoveToFront(el)
+	return it.entry, nil
+}
+
+// Add implements the Backend interface.
+func (l *LRU) Add(_ context.Context, key string, e *Entry, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if el, ok := l.items[key]; ok {
+		l.remove(el)
+	}
+	l.items[key] = l.list.PushFront(&lruItem{key: key, entry: e, expires: time.Now().Add(ttl)})
+	for _, t := range e.Tables {
+		if l.tables[t] == nil {
+			l.tables[t] = make(map[string]struct{})
+		}
+		l.tables[t][key] = struct{}{}
+	}
+	for l.size > 0 && l.list.Len() > l.size {
+		l.remove(l.list.Back())
+	}
+	return nil
+}
+
+// Invalidate implements the Backend interface.
+func (l *LRU) Invalidate(_ context.Context, tables ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, t := range tables {
+		for key := range l.tables[t] {
+			if el, ok := l.items[key]; ok {
+				l.remove(el)
+			}
+		}
+	}
+	return nil
+}
+
+// Len returns the number of entries in the cache.
+func (l *LRU) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.Len()
+}
+
+// remove removes the given element from the cache.
+func (l *LRU) remove(el *list.Element) {
+	it := l.list.Remove(el).(*lruItem)
+	delete(l.items, it.key)
+	for _, t := range it.entry.Tables {
+		if keys := l.tables[t]; keys != nil {
+			delete(keys, it.key)
+			if len(keys) == 0 {
+				delete(l.tables, t)
+			}
+		}
+	}
+}
+
+var _ Backend = (*LRU)(nil)
@@ -0,0 +1,365 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlcache provides a dialect.Driver decorator that caches the results of SQL queries.
+package sqlcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Driver is a driver that caches the results of read queries. Results are cached by their
+// query and arguments, and they are invalidated by the tables they were read from.
+//
+//	drv := sqlcache.NewDriver(
+//		sql.OpenDB(dialect.Postgres, db),
+//		sqlcache.WithTTL(time.Minute),
+//		sqlcache.WithBackend(sqlcache.NewLRU(1024)),
+//	)
+//	client := ent.NewClient(ent.Driver(drv))
+type Driver struct {
+	dialect.Driver // underlying driver.
+	ttl            time.Duration
+	optIn          bool
+	backend        Backend
+	log            func(...any) // log function for backend errors.
+	mu             sync.Mutex
+	versions       map[string]uint64 // table versions, incremented on invalidation.
+}
+
+// Option allows configuring the Driver using functional options.
+type Option func(*Driver)
+
+// WithTTL sets the default duration the query results are cached for. The default is 1 minute.
+func WithTTL(ttl time.Duration) Option {
+	return func(d *Driver) {
+		d.ttl = ttl
+	}
+}
+
+// WithBackend sets the backend for storing the query results. The default is an LRU of 1024 entries.
+func WithBackend(b Backend) Option {
+	return func(d *Driver) {
+		d.backend = b
+	}
+}
+
+// WithLogger sets the function that is used to log the errors of the backend. Backend
+// errors do not fail the queries, as they fall back to the underlying driver.
+func WithLogger(log func(...any)) Option {
+	return func(d *Driver) {
+		d.log = log
+	}
+}
+
+// OptIn configures the driver to cache only the results of queries that were
+// explicitly opted-in, using the Cache option or the CacheQuery modifier.
+func OptIn() Option {
+	return func(d *Driver) {
+		d.optIn = true
+	}
+}
+
+// NewDriver wraps the given driver with a caching layer.
+func NewDriver(drv dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{
+		Driver:   drv,
+		ttl:      time.Minute,
+		versions: make(map[string]uint64),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.backend == nil {
+		d.backend = NewLRU(1024)
+	}
+	return d
+}
+
+// Query executes the query using the underlying driver, or returns its cached results.
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	rows, ok := v.(*sql.Rows)
+	argv, ok1 := args.([]any)
+	opts := optionsOf(ctx, query)
+	if !ok || !ok1 || opts.skip || (d.optIn && !opts.cache) {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	tables := Tables(query)
+	if len(tables) == 0 {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	key := Key(query, argv)
+	switch e, err := d.backend.Get(ctx, key); {
+	case err == nil:
+		return e.replay(ctx, rows)
+	case !errors.Is(err, ErrNotFound):
+		// The backend is unavailable, query the database instead.
+		d.logf("sqlcache: get cached results: %v", err)
+	}
+	version := d.version(tables)
+	if err := d.Driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	e, err := newEntry(rows, tables)
+	if err != nil {
+		return err
+	}
+	// Skip storing results that may have been invalidated while they were read.
+	if d.version(tables) == version {
+		ttl := d.ttl
+		if opts.ttl > 0 {
+			ttl = opts.ttl
+		}
+		if err := d.backend.Add(ctx, key, e, ttl); err != nil {
+			d.logf("sqlcache: add cached results: %v", err)
+		}
+	}
+	return e.replay(ctx, rows)
+}
+
+// logf logs the given message using the configured logger, if there is one.
+func (d *Driver) logf(format string, args ...any) {
+	if d.log != nil {
+		d.log(fmt.Sprintf(format, args...))
+	}
+}
+
+// Invalidate removes the cached results of queries that read from the given tables.
+// It is called by the hooks that are generated using the "sql/querycache" feature.
+func (d *Driver) Invalidate(ctx context.Context, tables ...string) error {
+	d.mu.Lock()
+	for _, t := range tables {
+		d.versions[t]++
+	}
+	d.mu.Unlock()
+	return d.backend.Invalidate(ctx, tables...)
+}
+
+// version returns the sum of the versions of the given tables.
+func (d *Driver) version(tables []string) (v uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, t := range tables {
+		v += d.versions[t]
+	}
+	return v
+}
+
+// Tx starts a transaction using the underlying driver. Queries that are executed in
+// the transaction are not cached, and invalidations are applied when it is committed.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d, ctx: ctx}, nil
+}
+
+// BeginTx starts a transaction with options using the underlying driver, if it is supported.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, drv: d, ctx: ctx}, nil
+}
+
+// Tx is a transaction that was started by the caching driver.
+type Tx struct {
+	dialect.Tx // underlying transaction.
+	drv        *Driver
+	ctx        context.Context
+	mu         sync.Mutex
+	tables     []string
+}
+
+// Invalidate records the tables to invalidate when the transaction is committed.
+func (t *Tx) Invalidate(_ context.Context, tables ...string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tables = append(t.tables, tables...)
+	return nil
+}
+
+// Commit commits the underlying transaction, and invalidates the tables that were changed in it.
+func (t *Tx) Commit() error {
+	if err := t.Tx.Commit(); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.tables) == 0 {
+		return nil
+	}
+	return t.drv.Invalidate(t.ctx, t.tables...)
+}
+
+// Savepoint calls the Savepoint method of the underlying transaction.
+func (t *Tx) Savepoint(ctx context.Context, name string) error {
+	sp, err := t.savepointer()
+	if err != nil {
+		return err
+	}
+	return sp.Savepoint(ctx, name)
+}
+
+// RollbackTo calls the RollbackTo method of the underlying transaction.
+func (t *Tx) RollbackTo(ctx context.Context, name string) error {
+	sp, err := t.savepointer()
+	if err != nil {
+		return err
+	}
+	return sp.RollbackTo(ctx, name)
+}
+
+// Release calls the Release method of the underlying transaction.
+func (t *Tx) Release(ctx context.Context, name string) error {
+	sp, err := t.savepointer()
+	if err != nil {
+		return err
+	}
+	return sp.Release(ctx, name)
+}
+
+// savepointer returns the underlying transaction as a sql.Savepointer.
+func (t *Tx) savepointer() (sql.Savepointer, error) {
+	sp, ok := t.Tx.(sql.Savepointer)
+	if !ok {
+		return nil, fmt.Errorf("sqlcache: savepoints are not supported by %T", t.Tx)
+	}
+	return sp, nil
+}
+
+type (
+	// ctxKey is the context key for the query options.
+	ctxKey struct{}
+	// queryOptions holds the caching options of a query.
+	queryOptions struct {
+		skip  bool
+		cache bool
+		ttl   time.Duration
+	}
+)
+
+// Skip returns a new context that skips the cache for the queries that are executed with it.
+func Skip(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKey{}, queryOptions{skip: true})
+}
+
+// Cache returns a new context that opts-in the queries that are executed with it to be
+// cached for the given duration. A zero duration uses the default TTL of the driver.
+func Cache(ctx context.Context, ttl time.Duration) context.Context {
+	return context.WithValue(ctx, ctxKey{}, queryOptions{cache: true, ttl: ttl})
+}
+
+// commentPrefix is the prefix of the query comments that are added by the query modifiers.
+const commentPrefix = "/* sqlcache:"
+
+// SkipQuery is a query modifier that skips the cache for the modified query.
+//
+//	client.User.Query().
+//		Where(user.Name("a8m")).
+//		Modify(sqlcache.SkipQuery).
+//		All(ctx)
+func SkipQuery(s *sql.Selector) {
+	s.Prefix(sql.Expr(commentPrefix + "skip */"))
+}
+
+// CacheQuery returns a query modifier that opts-in the modified query to be cached
+// for the given duration. A zero duration uses the default TTL of the driver.
+func CacheQuery(ttl time.Duration) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Prefix(sql.Expr(fmt.Sprintf("%sttl=%s */", commentPrefix, ttl)))
+	}
+}
+
+// optionsOf returns the caching options of the query from its context and its leading comments.
+func optionsOf(ctx context.Context, query string) queryOptions {
+	opts, _ := ctx.Value(ctxKey{}).(queryOptions)
+	for strings.HasPrefix(query, commentPrefix) {
+		end := strings.Index(query, "*/")
+		if end == -1 {
+			break
+		}
+		switch c := strings.TrimSpace(query[len(commentPrefix):end]); {
+		case c == "skip":
+			opts.skip = true
+		case strings.HasPrefix(c, "ttl="):
+			opts.cache = true
+			opts.ttl, _ = time.ParseDuration(strings.TrimPrefix(c, "ttl="))
+		}
+		query = strings.TrimLeft(query[end+2:], " ")
+	}
+	// Only SELECT statements are cached.
+	if len(query) < 6 || !strings.EqualFold(query[:6], "SELECT") {
+		opts.skip = true
+	}
+	return opts
+}
+
+// tablesRe matches the table names that follow the FROM and JOIN keywords.
+var tablesRe = regexp.MustCompile("(?i)\\b(?:FROM|JOIN)\\s+((?:[`\"\\[]?\\w+[`\"\\]]?\\.)?[`\"\\[]?\\w+[`\"\\]]?)")
+
+// Tables returns the names of the tables the query reads from.
+func Tables(query string) []string {
+	var (
+		tables []string
+		seen   = make(map[string]bool)
+	)
+	for _, m := range tablesRe.FindAllStringSubmatch(query, -1) {
+		name := m[1]
+		if i := strings.LastIndexByte(name, '.'); i != -1 {
+			name = name[i+1:]
+		}
+		name = strings.Trim(name, "`\"[]")
+		if !seen[name] {
+			seen[name] = true
+			tables = append(tables, name)
+		}
+	}
+	return tables
+}
+
+// Key returns the cache key of the given query and its arguments.
+func Key(query string, args []any) string {
+	h := sha256.New()
+	h.Write([]byte(query))
+	for _, arg := range args {
+		if v, ok := arg.(driver.Valuer); ok {
+			if dv, err := v.Value(); err == nil {
+				arg = dv
+			}
+		}
+		// Strip the monotonic clock reading, as it is not sent
+		// to the database and is different between calls.
+		if t, ok := arg.(time.Time); ok {
+			arg = t.Round(0)
+		}
+		fmt.Fprintf(h, "\x00%T:%v", arg, arg)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+var (
+	_ dialect.Driver  = (*Driver)(nil)
+	_ sql.Savepointer = (*Tx)(nil)
+)
@@ -0,0 +1,195 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := NewDriver(sql.OpenDB(dialect.MySQL, db))
+	ctx := context.Background()
+	query := func(ctx context.Context, query string, args ...any) (names []string) {
+		rows := &sql.Rows{}
+		require.NoError(t, drv.Query(ctx, query, args, rows))
+		defer rows.Close()
+		for rows.Next() {
+			var (
+				id   int
+				name sql.NullString
+			)
+			require.NoError(t, rows.Scan(&id, &name))
+			names = append(names, name.String)
+		}
+		require.NoError(t, rows.Err())
+		return names
+	}
+	const q = "SELECT `id`, `name` FROM `users` WHERE `age` > ?"
+	expect := func(arg int, names ...string) {
+		rows := sqlmock.NewRows([]string{"id", "name"})
+		for i, n := range names {
+			if n == "" {
+				rows.AddRow(i+1, nil)
+			} else {
+				rows.AddRow(i+1, n)
+			}
+		}
+		mock.ExpectQuery(q).WithArgs(arg).WillReturnRows(rows)
+	}
+
+	expect(30, "a8m", "nati", "")
+	require.Equal(t, []string{"a8m", "nati", ""}, query(ctx, q, 30))
+	require.Equal(t, []string{"a8m", "nati", ""}, query(ctx, q, 30), "cached")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	expect(20, "a8m")
+	require.Equal(t, []string{"a8m"}, query(ctx, q, 20), "different args")
+	expect(30, "a8m")
+	require.Equal(t, []string{"a8m"}, query(Skip(ctx), q, 30), "skipped")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.NoError(t, drv.Invalidate(ctx, "pets"))
+	require.Equal(t, []string{"a8m", "nati", ""}, query(ctx, q, 30), "other table")
+	require.NoError(t, drv.Invalidate(ctx, "users"))
+	expect(30, "a8m")
+	require.Equal(t, []string{"a8m"}, query(ctx, q, 30), "invalidated")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Query modifiers.
+	s := sql.Dialect(dialect.MySQL).Select("id", "name").From(sql.Table("users")).Where(sql.GT("age", 30))
+	SkipQuery(s)
+	sq, args := s.Query()
+	mock.ExpectQuery("SELECT `id`, `name` FROM `users` WHERE `age` > ?").WithArgs(30).WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	require.Empty(t, query(ctx, sq, args...))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Transactions.
+	mock.ExpectBegin()
+	mock.ExpectQuery(q).WithArgs(30).WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	rows := &sql.Rows{}
+	require.NoError(t, tx.Query(ctx, q, []any{30}, rows), "queries in transactions are not cached")
+	require.NoError(t, rows.Close())
+	require.NoError(t, tx.(*Tx).Invalidate(ctx, "users"))
+	require.Equal(t, []string{"a8m"}, query(ctx, q, 30), "invalidated on commit")
+	require.NoError(t, tx.Commit())
+	expect(30)
+	require.Empty(t, query(ctx, q, 30))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDriver_OptIn(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	drv := NewDriver(sql.OpenDB(dialect.MySQL, db), OptIn())
+	ctx := context.Background()
+	s := sql.Dialect(dialect.MySQL).Select("id").From(sql.Table("users"))
+	CacheQuery(time.Hour)(s)
+	cq, _ := s.Query()
+	queries := []struct {
+		ctx   context.Context
+		query string
+	}{
+		{ctx, "SELECT `id` FROM `users`"},
+		{Cache(ctx, 0), "SELECT `id` FROM `users` WHERE `id` > 1"},
+		{ctx, cq},
+	}
+	for _, q := range queries {
+		mock.ExpectQuery(q.query).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	}
+	mock.ExpectQuery(queries[0].query).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	for range 2 {
+		for _, q := range queries {
+			rows := &sql.Rows{}
+			require.NoError(t, drv.Query(q.ctx, q.query, []any{}, rows))
+			require.NoError(t, rows.Close())
+		}
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// failingBackend is a backend that fails all operations.
+type failingBackend struct{ err error }
+
+func (b failingBackend) Get(context.Context, string) (*Entry, error)              { return nil, b.err }
+func (b failingBackend) Add(context.Context, string, *Entry, time.Duration) error { return b.err }
+func (b failingBackend) Invalidate(context.Context, ...string) error              { return b.err }
+
+func TestDriver_BackendError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	var logs []string
+	drv := NewDriver(
+		sql.OpenDB(dialect.MySQL, db),
+		WithBackend(failingBackend{err: errors.New("connection refused")}),
+		WithLogger(func(v ...any) { logs = append(logs, fmt.Sprint(v...)) }),
+	)
+	const q = "SELECT `id` FROM `users`"
+	for range 2 {
+		mock.ExpectQuery(q).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		rows := &sql.Rows{}
+		require.NoError(t, drv.Query(context.Background(), q, []any{}, rows), "backend errors should not fail queries")
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Close())
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, logs, 4)
+	require.Equal(t, "sqlcache: get cached results: connection refused", logs[0])
+	require.Equal(t, "sqlcache: add cached results: connection refused", logs[1])
+}
+
+func TestKey(t *testing.T) {
+	const q = "SELECT * FROM `users` WHERE `created_at` > ?"
+	now := time.Now()
+	require.Contains(t, fmt.Sprint(now), "m=+", "time.Now holds a monotonic clock reading")
+	require.Equal(t, Key(q, []any{now.Round(0)}), Key(q, []any{now}))
+	require.NotEqual(t, Key(q, []any{now}), Key(q, []any{now.Add(time.Nanosecond)}))
+	require.NotEqual(t, Key(q, []any{1}), Key(q, []any{"1"}))
+}
+
+func TestTables(t *testing.T) {
+	require.Equal(t, []string{"users"}, Tables("SELECT * FROM `users` WHERE `id` = ?"))
+	require.Equal(t, []string{"users", "pets"}, Tables(`SELECT "users"."id" FROM "s1"."users" JOIN "pets" AS "t1" ON "users"."id" = "t1"."owner_id"`))
+	require.Equal(t, []string{"users", "groups"}, Tables("SELECT * FROM users WHERE EXISTS (SELECT * FROM groups WHERE groups.id = users.id)"))
+	require.Empty(t, Tables("SELECT 1"))
+}
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	l := NewLRU(2)
+	require.NoError(t, l.Add(ctx, "a", &Entry{Tables: []string{"users"}}, time.Hour))
+	require.NoError(t, l.Add(ctx, "b", &Entry{Tables: []string{"pets"}}, time.Hour))
+	_, err := l.Get(ctx, "a")
+	require.NoError(t, err)
+	require.NoError(t, l.Add(ctx, "c", &Entry{Tables: []string{"users", "pets"}}, time.Hour))
+	_, err = l.Get(ctx, "b")
+	require.ErrorIs(t, err, ErrNotFound, "least recently used entry was evicted")
+	require.Equal(t, 2, l.Len())
+
+	require.NoError(t, l.Invalidate(ctx, "pets"))
+	_, err = l.Get(ctx, "c")
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = l.Get(ctx, "a")
+	require.NoError(t, err)
+
+	require.NoError(t, l.Add(ctx, "d", &Entry{}, -time.Second))
+	_, err = l.Get(ctx, "d")
+	require.ErrorIs(t, err, ErrNotFound, "expired")
+	require.Equal(t, 1, l.Len())
+}
@@ -0,0 +1,195 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlgeo
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Geometry is the interface implemented by the spatial types of this package. Geometries
+// are passed to the database as WKB, and they require PostGIS in PostgreSQL. In SQLite,
+// they are stored as WKB blobs, and their predicates require the SpatiaLite extension.
+type Geometry interface {
+	driver.Valuer
+	// GeometryType returns the OGC name of the geometry type. e.g. "Point".
+	GeometryType() string
+}
+
+// FormatParam implements the sql.ParamFormatter interface.
+func (p Point) FormatParam(placeholder string, info *sql.StmtInfo) string {
+	return formatParam(placeholder, info, p.SRID)
+}
+
+// FormatParam implements the sql.ParamFormatter interface.
+func (l LineString) FormatParam(placeholder string, info *sql.StmtInfo) string {
+	return formatParam(placeholder, info, l.SRID)
+}
+
+// FormatParam implements the sql.ParamFormatter interface.
+func (p Polygon) FormatParam(placeholder string, info *sql.StmtInfo) string {
+	return formatParam(placeholder, info, p.SRID)
+}
+
+// formatParam converts the WKB placeholder to a geometry in PostgreSQL and MySQL.
+func formatParam(placeholder string, info *sql.StmtInfo, srid int) string {
+	switch {
+	case info.Dialect != dialect.Postgres && info.Dialect != dialect.MySQL:
+		return placeholder
+	case srid == 0:
+		return "ST_GeomFromWKB(" + placeholder + ")"
+	case info.Dialect == dialect.MySQL:
+		// Geographic coordinates are written as (longitude, latitude) in WKB.
+		return fmt.Sprintf("ST_GeomFromWKB(%s, %d, 'axis-order=long-lat')", placeholder, srid)
+	default:
+		return fmt.Sprintf("ST_GeomFromWKB(%s, %d)", placeholder, srid)
+	}
+}
+
+// GeometrySchemaType returns the database types of a column that stores geometries
+// of the same type as g, in the given spatial reference system. For example:
+//
+//	field.Other("location", &sqlgeo.Point{}).
+//		SchemaType(sqlgeo.GeometrySchemaType(sqlgeo.Point{}, 4326))
+//
+// Note that MySQL columns are created without the SRID attribute.
+func GeometrySchemaType(g Geometry, srid int) map[string]string {
+	return schemaType("geometry", g, srid)
+}
+
+// GeographySchemaType returns the database types of a column that stores geographies
+// of the same type as g. In PostgreSQL, distances of geographies are measured in meters
+// on the spheroid. MySQL stores them as geometries, where the distances of geographic
+// spatial reference systems (e.g. 4326) are also measured in meters.
+//
+//	field.Other("location", &sqlgeo.Point{}).
+//		SchemaType(sqlgeo.GeographySchemaType(sqlgeo.Point{}, 4326))
+func GeographySchemaType(g Geometry, srid int) map[string]string {
+	return schemaType("geography", g, srid)
+}
+
+func schemaType(pgType string, g Geometry, srid int) map[string]string {
+	return map[string]string{
+		dialect.Postgres: fmt.Sprintf("%s(%s,%d)", pgType, g.GeometryType(), srid),
+		dialect.MySQL:    strings.ToLower(g.GeometryType()),
+		dialect.SQLite:   "blob",
+	}
+}
+
+// Within returns a predicate for checking that the geometry
+// of the column is completely inside the given geometry.
+//
+//	sqlgeo.Within("location", sqlgeo.Polygon{Rings: rings, SRID: 4326})
+func Within(column string, g Geometry) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			// Casting geography columns to geometry, as ST_Within
+			// does not support geographies. A no-op for geometries.
+			b.WriteString("ST_Within(").Ident(column).WriteString("::geometry").Comma().Arg(g).WriteByte(')')
+		case dialect.SQLite:
+			call(b, "ST_Within", column, g)
+			b.WriteString(" = 1")
+		default:
+			call(b, "ST_Within", column, g)
+		}
+	})
+}
+
+// Intersects returns a predicate for checking that the geometry of
+// the column shares at least one point with the given geometry.
+//
+//	sqlgeo.Intersects("route", sqlgeo.Polygon{Rings: rings, SRID: 4326})
+func Intersects(column string, g Geometry) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		call(b, "ST_Intersects", column, g)
+		if b.Dialect() == dialect.SQLite {
+			b.WriteString(" = 1")
+		}
+	})
+}
+
+// DWithin returns a predicate for checking that the geometry of the column is
+// within the given distance of the given geometry. The distance is measured in
+// the units of the spatial reference system, or in meters for geographies.
+//
+//	sqlgeo.DWithin("location", sqlgeo.Point{X: 13.4, Y: 52.5, SRID: 4326}, 1000)
+func DWithin(column string, g Geometry, distance float64) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.WriteString("ST_DWithin(").Ident(column).Comma().Arg(g).Comma().Arg(distance).WriteByte(')')
+		default:
+			call(b, "ST_Distance", column, g)
+			b.WriteOp(sql.OpLTE).Arg(distance)
+		}
+	})
+}
+
+// Distance returns an SQL expression for getting the distance between
+// the geometry of the column and the given geometry. See DWithin for
+// the units of the distance.
+func Distance(column string, g Geometry) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		call(b, "ST_Distance", column, g)
+	})
+}
+
+// OrderByDistance returns a term to order by the distance between the geometry of the
+// given field and the given geometry. The nearest results are returned first, unless
+// the sql.OrderDesc option is given. Use the sql.OrderSelectAs option to select the
+// distance, and read it using the Value method of the returned entities.
+//
+//	client.Store.Query().
+//		Order(sqlgeo.OrderByDistance(store.FieldLocation, p, sql.OrderSelectAs("distance"))).
+//		Limit(10).
+//		AllX(ctx)
+func OrderByDistance(field string, g Geometry, opts ...sql.OrderTermOption) func(*sql.Selector) {
+	o := sql.NewOrderTermOptions(opts...)
+	return func(s *sql.Selector) {
+		column := s.C(field)
+		switch {
+		case o.Selected && o.As != "":
+			s.AppendSelectExprAs(Distance(column, g), o.As)
+		case o.Selected:
+			s.AppendSelectExpr(Distance(column, g))
+		}
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			switch {
+			case o.Selected && o.As != "":
+				b.Ident(o.As)
+			case b.Dialect() == dialect.Postgres:
+				// The distance operator allows using GiST indexes
+				// for finding the nearest neighbors of the geometry.
+				b.Ident(column).WriteString(" <-> ").Arg(g)
+			default:
+				b.Join(Distance(column, g))
+			}
+			if o.Desc {
+				b.WriteString(" DESC")
+			}
+			if o.NullsFirst {
+				b.WriteString(" NULLS FIRST")
+			} else if o.NullsLast {
+				b.WriteString(" NULLS LAST")
+			}
+		}))
+	}
+}
+
+// call writes a call to the given spatial function with the column and the geometry as its
+// arguments. In SQLite, the WKB blobs are converted to geometries using SpatiaLite functions.
+func call(b *sql.Builder, fn, column string, g Geometry) {
+	b.WriteString(fn).WriteByte('(')
+	if b.Dialect() == dialect.SQLite {
+		b.WriteString("GeomFromWKB(").Ident(column).WriteString("), GeomFromWKB(").Arg(g).WriteString("))")
+		return
+	}
+	b.Ident(column).Comma().Arg(g).WriteByte(')')
+}
@@ -0,0 +1,289 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlgeo_test
+
+import (
+	"database/sql"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgeo"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	point = sqlgeo.Point{X: 13.4, Y: 52.5, SRID: 4326}
+	area  = sqlgeo.Polygon{
+		Rings: [][]sqlgeo.Coord{{{X: 13, Y: 52}, {X: 14, Y: 52}, {X: 14, Y: 53}, {X: 13, Y: 53}, {X: 13, Y: 52}}},
+		SRID:  4326,
+	}
+)
+
+func TestPredicates(t *testing.T) {
+	tests := []struct {
+		input     entsql.Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input: entsql.Dialect(dialect.Postgres).
+				Select("*").
+				From(entsql.Table("stores")).
+				Where(sqlgeo.Within("location", area)),
+			wantQuery: `SELECT * FROM "stores" WHERE ST_Within("location"::geometry, ST_GeomFromWKB($1, 4326))`,
+			wantArgs:  []any{area},
+		},
+		{
+			input: entsql.Dialect(dialect.MySQL).
+				Select("*").
+				From(entsql.Table("stores")).
+				Where(sqlgeo.Within("location", area)),
+			wantQuery: "SELECT * FROM `stores` WHERE ST_Within(`location`, ST_GeomFromWKB(?, 4326, 'axis-order=long-lat'))",
+			wantArgs:  []any{area},
+		},
+		{
+			input: entsql.Dialect(dialect.SQLite).
+				Select("*").
+				From(entsql.Table("stores")).
+				Where(sqlgeo.Within("location", area)),
+			wantQuery: "SELECT * FROM `stores` WHERE ST_Within(GeomFromWKB(`location`), GeomFromWKB(?)) = 1",
+			wantArgs:  []any{area},
+		},
+		{
+			input: entsql.Dialect(dialect.Postgres).
+				Select("*").
+				From(entsql.Table("routes")).
+				Where(sqlgeo.Intersects("path", area)),
+			wantQuery: `SELECT * FROM "routes" WHERE ST_Intersects("path", ST_GeomFromWKB($1, 4326))`,
+			wantArgs:  []any{area},
+		},
+		{
+			input: entsql.Dialect(dialect.MySQL).
+				Select("*").
+				From(entsql.Table("routes")).
+				Where(sqlgeo.Intersects("path", sqlgeo.LineString{Coords: area.Rings[0]})),
+			wantQuery: "SELECT * FROM `routes` WHERE ST_Intersects(`path`, ST_GeomFromWKB(?))",
+			wantArgs:  []any{sqlgeo.LineString{Coords: area.Rings[0]}},
+		},
+		{
+			input: entsql.Dialect(dialect.SQLite).
+				Select("*").
+				From(entsql.Table("routes")).
+				Where(sqlgeo.Intersects("path", area)),
+			wantQuery: "SELECT * FROM `routes` WHERE ST_Intersects(GeomFromWKB(`path`), GeomFromWKB(?)) = 1",
+			wantArgs:  []any{area},
+		},
+		{
+			input: entsql.Dialect(dialect.Postgres).
+				Select("*").
+				From(entsql.Table("stores")).
+				Where(entsql.And(sqlgeo.DWithin("location", point, 1000), entsql.EQ("open", true))),
+			wantQuery: `SELECT * FROM "stores" WHERE ST_DWithin("location", ST_GeomFromWKB($1, 4326), $2) AND "open"`,
+			wantArgs:  []any{point, 1000.0},
+		},
+		{
+			input: entsql.Dialect(dialect.MySQL).
+				Select("*").
+				From(entsql.Table("stores")).
+				Where(sqlgeo.DWithin("location", point, 1000)),
+			wantQuery: "SELECT * FROM `stores` WHERE ST_Distance(`location`, ST_GeomFromWKB(?, 4326, 'axis-order=long-lat')) <= ?",
+			wantArgs:  []any{point, 1000.0},
+		},
+		{
+			input: entsql.Dialect(dialect.SQLite).
+				Select("*").
+				From(entsql.Table("stores")).
+				Where(sqlgeo.DWithin("location", point, 0.5)),
+			wantQuery: "SELECT * FROM `stores` WHERE ST_Distance(GeomFromWKB(`location`), GeomFromWKB(?)) <= ?",
+			wantArgs:  []any{point, 0.5},
+		},
+	}
+	for i, tt := range tests {
+		query, args := tt.input.Query()
+		require.Equal(t, tt.wantQuery, query, i)
+		require.Equal(t, tt.wantArgs, args, i)
+	}
+}
+
+func TestOrderByDistance(t *testing.T) {
+	tests := []struct {
+		dialect   string
+		opts      []entsql.OrderTermOption
+		wantQuery string
+	}{
+		{
+			dialect:   dialect.Postgres,
+			wantQuery: `SELECT "stores"."id" FROM "stores" ORDER BY "stores"."location" <-> ST_GeomFromWKB($1, 4326)`,
+		},
+		{
+			dialect:   dialect.Postgres,
+			opts:      []entsql.OrderTermOption{entsql.OrderSelectAs("distance"), entsql.OrderDesc()},
+			wantQuery: `SELECT "stores"."id", (ST_Distance("stores"."location", ST_GeomFromWKB($1, 4326))) AS "distance" FROM "stores" ORDER BY "distance" DESC`,
+		},
+		{
+			dialect:   dialect.MySQL,
+			wantQuery: "SELECT `stores`.`id` FROM `stores` ORDER BY ST_Distance(`stores`.`location`, ST_GeomFromWKB(?, 4326, 'axis-order=long-lat'))",
+		},
+		{
+			dialect:   dialect.SQLite,
+			opts:      []entsql.OrderTermOption{entsql.OrderDesc()},
+			wantQuery: "SELECT `stores`.`id` FROM `stores` ORDER BY ST_Distance(GeomFromWKB(`stores`.`location`), GeomFromWKB(?)) DESC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			s := entsql.Dialect(tt.dialect).Select().From(entsql.Table("stores"))
+			s.Select(s.C("id"))
+			sqlgeo.OrderByDistance("location", point, tt.opts...)(s)
+			query, args := s.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, []any{point}, args)
+		})
+	}
+}
+
+func TestSchemaType(t *testing.T) {
+	require.Equal(t, map[string]string{
+		dialect.Postgres: "geometry(Point,4326)",
+		dialect.MySQL:    "point",
+		dialect.SQLite:   "blob",
+	}, sqlgeo.GeometrySchemaType(sqlgeo.Point{}, 4326))
+	require.Equal(t, map[string]string{
+		dialect.Postgres: "geography(Polygon,4326)",
+		dialect.MySQL:    "polygon",
+		dialect.SQLite:   "blob",
+	}, sqlgeo.GeographySchemaType(sqlgeo.Polygon{}, 4326))
+}
+
+func TestEncoding(t *testing.T) {
+	// POINT(1 2) encoded as little-endian WKB.
+	v, err := sqlgeo.Point{X: 1, Y: 2}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "0101000000000000000000f03f0000000000000040", hex.EncodeToString(v.([]byte)))
+
+	tests := []struct {
+		value   sqlgeo.Geometry
+		scanner func() sql.Scanner
+	}{
+		{value: point, scanner: func() sql.Scanner { return &sqlgeo.Point{} }},
+		{value: sqlgeo.LineString{Coords: area.Rings[0], SRID: 4326}, scanner: func() sql.Scanner { return &sqlgeo.LineString{} }},
+		{value: sqlgeo.LineString{Coords: []sqlgeo.Coord{}}, scanner: func() sql.Scanner { return &sqlgeo.LineString{} }},
+		{value: area, scanner: func() sql.Scanner { return &sqlgeo.Polygon{} }},
+		{
+			value: sqlgeo.Polygon{
+				Rings: [][]sqlgeo.Coord{
+					area.Rings[0],
+					{{X: 13.2, Y: 52.2}, {X: 13.8, Y: 52.2}, {X: 13.8, Y: 52.8}, {X: 13.2, Y: 52.2}},
+				},
+				SRID: 3857,
+			},
+			scanner: func() sql.Scanner { return &sqlgeo.Polygon{} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value.GeometryType(), func(t *testing.T) {
+			v, err := tt.value.Value()
+			require.NoError(t, err)
+			wkb := v.([]byte)
+			srid := sridOf(t, tt.value)
+
+			// WKB values (as stored in SQLite) do not hold the SRID.
+			s := tt.scanner()
+			require.NoError(t, s.Scan(wkb))
+			require.Equal(t, withoutSRID(tt.value), deref(s))
+
+			// EWKB values are returned by PostgreSQL in their binary or hex-encoded formats.
+			ewkb := append([]byte{1}, binary.LittleEndian.AppendUint32(nil, binary.LittleEndian.Uint32(wkb[1:5])|0x20000000)...)
+			ewkb = binary.LittleEndian.AppendUint32(ewkb, uint32(srid))
+			ewkb = append(ewkb, wkb[5:]...)
+			s = tt.scanner()
+			require.NoError(t, s.Scan(ewkb))
+			require.Equal(t, tt.value, deref(s))
+			s = tt.scanner()
+			require.NoError(t, s.Scan(hex.EncodeToString(ewkb)))
+			require.Equal(t, tt.value, deref(s))
+			s = tt.scanner()
+			require.NoError(t, s.Scan([]byte(hex.EncodeToString(ewkb))))
+			require.Equal(t, tt.value, deref(s))
+
+			// MySQL prefixes the WKB with the SRID.
+			s = tt.scanner()
+			require.NoError(t, s.Scan(append(binary.LittleEndian.AppendUint32(nil, uint32(srid)), wkb...)))
+			require.Equal(t, tt.value, deref(s))
+		})
+	}
+}
+
+func TestEncoding_BigEndian(t *testing.T) {
+	b, err := hex.DecodeString("00000000013ff00000000000004000000000000000")
+	require.NoError(t, err)
+	var p sqlgeo.Point
+	require.NoError(t, p.Scan(b))
+	require.Equal(t, sqlgeo.Point{X: 1, Y: 2}, p)
+	require.NoError(t, p.Scan(nil))
+	require.Equal(t, sqlgeo.Point{}, p)
+}
+
+func TestEncoding_Errors(t *testing.T) {
+	v, err := sqlgeo.LineString{Coords: area.Rings[0]}.Value()
+	require.NoError(t, err)
+	wkb := v.([]byte)
+
+	var p sqlgeo.Point
+	require.EqualError(t, p.Scan(1), "sqlgeo: unexpected type int for Point")
+	require.EqualError(t, p.Scan(wkb), "sqlgeo: unexpected geometry LineString for Point")
+	require.EqualError(t, p.Scan([]byte{2, 1, 0, 0, 0}), "sqlgeo: invalid WKB byte order 2")
+
+	var l sqlgeo.LineString
+	require.EqualError(t, l.Scan(wkb[:len(wkb)-1]), "sqlgeo: unexpected end of WKB value")
+	require.EqualError(t, l.Scan(append(wkb, 0)), "sqlgeo: unexpected 1 bytes after LineString")
+	// A line with a huge number of coordinates.
+	require.EqualError(t, l.Scan([]byte{1, 2, 0, 0, 0, 0xff, 0xff, 0xff, 0x7f}), "sqlgeo: unexpected end of WKB value")
+	// POINT Z (1 2 3) in EWKB.
+	require.EqualError(t, p.Scan("0101000080000000000000f03f00000000000000400000000000000840"), "sqlgeo: unsupported geometry type 2147483649 with Z or M coordinates")
+}
+
+func sridOf(t *testing.T, g sqlgeo.Geometry) int {
+	switch g := g.(type) {
+	case sqlgeo.Point:
+		return g.SRID
+	case sqlgeo.LineString:
+		return g.SRID
+	case sqlgeo.Polygon:
+		return g.SRID
+	}
+	t.Fatalf("unexpected geometry %T", g)
+	return 0
+}
+
+func withoutSRID(g sqlgeo.Geometry) sqlgeo.Geometry {
+	switch g := g.(type) {
+	case sqlgeo.Point:
+		g.SRID = 0
+		return g
+	case sqlgeo.LineString:
+		g.SRID = 0
+		return g
+	case sqlgeo.Polygon:
+		g.SRID = 0
+		return g
+	}
+	return g
+}
+
+func deref(s sql.Scanner) sqlgeo.Geometry {
+	switch s := s.(type) {
+	case *sqlgeo.Point:
+		return *s
+	case *sqlgeo.LineString:
+		return *s
+	case *sqlgeo.Polygon:
+		return *s
+	}
+	return nil
+}
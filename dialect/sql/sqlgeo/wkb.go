@@ -0,0 +1,328 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlgeo
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// WKB geometry type codes.
+const (
+	wkbPoint      uint32 = 1
+	wkbLineString uint32 = 2
+	wkbPolygon    uint32 = 3
+)
+
+// EWKB flags that are set on the geometry type code by PostGIS.
+const (
+	ewkbZ    uint32 = 0x80000000
+	ewkbM    uint32 = 0x40000000
+	ewkbSRID uint32 = 0x20000000
+)
+
+type (
+	// Coord is a 2D coordinate. In geographic spatial reference
+	// systems, X is the longitude and Y is the latitude.
+	Coord struct {
+		X, Y float64
+	}
+
+	// Point is a single location in a coordinate space.
+	Point struct {
+		X, Y float64
+		// SRID is the identifier of the spatial reference system of the point.
+		// e.g. 4326 for WGS 84. Zero means that the SRID is not defined.
+		SRID int
+	}
+
+	// LineString is a curve with linear interpolation between its coordinates.
+	LineString struct {
+		Coords []Coord
+		// SRID is the identifier of the spatial reference system of the line.
+		SRID int
+	}
+
+	// Polygon is a planar surface that is defined by one exterior ring (the first
+	// ring) and zero or more interior rings (holes). Rings are closed line strings.
+	// i.e. their first and last coordinates are equal.
+	Polygon struct {
+		Rings [][]Coord
+		// SRID is the identifier of the spatial reference system of the polygon.
+		SRID int
+	}
+)
+
+// GeometryType returns the OGC name of the geometry type.
+func (Point) GeometryType() string { return "Point" }
+
+// GeometryType returns the OGC name of the geometry type.
+func (LineString) GeometryType() string { return "LineString" }
+
+// GeometryType returns the OGC name of the geometry type.
+func (Polygon) GeometryType() string { return "Polygon" }
+
+// Value implements the driver.Valuer interface. The point is encoded
+// as WKB, and its SRID is passed to the database by FormatParam.
+func (p Point) Value() (driver.Value, error) {
+	var e encoder
+	e.header(wkbPoint)
+	e.coord(Coord{X: p.X, Y: p.Y})
+	return e.Bytes(), nil
+}
+
+// Value implements the driver.Valuer interface. The line is encoded
+// as WKB, and its SRID is passed to the database by FormatParam.
+func (l LineString) Value() (driver.Value, error) {
+	var e encoder
+	e.header(wkbLineString)
+	e.coords(l.Coords)
+	return e.Bytes(), nil
+}
+
+// Value implements the driver.Valuer interface. The polygon is encoded
+// as WKB, and its SRID is passed to the database by FormatParam.
+func (p Polygon) Value() (driver.Value, error) {
+	var e encoder
+	e.header(wkbPolygon)
+	e.uint32(uint32(len(p.Rings)))
+	for _, r := range p.Rings {
+		e.coords(r)
+	}
+	return e.Bytes(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts WKB, PostGIS EWKB (binary
+// or hex-encoded) and the internal geometry format of MySQL, which is the SRID
+// followed by the WKB. Note that SQLite columns store WKB without an SRID.
+func (p *Point) Scan(src any) error {
+	if src == nil {
+		*p = Point{}
+		return nil
+	}
+	var c Coord
+	srid, err := unmarshal(src, wkbPoint, func(d *decoder) {
+		c = d.coord()
+	})
+	if err != nil {
+		return err
+	}
+	*p = Point{X: c.X, Y: c.Y, SRID: srid}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface. See Point.Scan for the supported formats.
+func (l *LineString) Scan(src any) error {
+	if src == nil {
+		*l = LineString{}
+		return nil
+	}
+	var cs []Coord
+	srid, err := unmarshal(src, wkbLineString, func(d *decoder) {
+		cs = d.coords()
+	})
+	if err != nil {
+		return err
+	}
+	*l = LineString{Coords: cs, SRID: srid}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface. See Point.Scan for the supported formats.
+func (p *Polygon) Scan(src any) error {
+	if src == nil {
+		*p = Polygon{}
+		return nil
+	}
+	var rs [][]Coord
+	srid, err := unmarshal(src, wkbPolygon, func(d *decoder) {
+		n := d.count(4)
+		rs = make([][]Coord, 0, n)
+		for i := 0; i < n && d.err == nil; i++ {
+			rs = append(rs, d.coords())
+		}
+	})
+	if err != nil {
+		return err
+	}
+	*p = Polygon{Rings: rs, SRID: srid}
+	return nil
+}
+
+// encoder writes little-endian WKB values.
+type encoder struct {
+	bytes.Buffer
+}
+
+func (e *encoder) header(typ uint32) {
+	e.WriteByte(1)
+	e.uint32(typ)
+}
+
+func (e *encoder) uint32(v uint32) {
+	e.Write(binary.LittleEndian.AppendUint32(nil, v))
+}
+
+func (e *encoder) coord(c Coord) {
+	e.Write(binary.LittleEndian.AppendUint64(nil, math.Float64bits(c.X)))
+	e.Write(binary.LittleEndian.AppendUint64(nil, math.Float64bits(c.Y)))
+}
+
+func (e *encoder) coords(cs []Coord) {
+	e.uint32(uint32(len(cs)))
+	for _, c := range cs {
+		e.coord(c)
+	}
+}
+
+// decoder reads WKB values. The first error
+// is recorded, and stops the decoding.
+type decoder struct {
+	b     []byte
+	order binary.ByteOrder
+	err   error
+}
+
+// errShort is returned when the WKB value ends unexpectedly.
+var errShort = errors.New("sqlgeo: unexpected end of WKB value")
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.b) < n {
+		d.err = errShort
+		return nil
+	}
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b
+}
+
+func (d *decoder) uint32() uint32 {
+	if b := d.next(4); b != nil {
+		return d.order.Uint32(b)
+	}
+	return 0
+}
+
+// count reads the number of elements that follow it, where each of
+// them takes at least size bytes, to avoid allocations on bad input.
+func (d *decoder) count(size int) int {
+	n := int(d.uint32())
+	if d.err == nil && n > len(d.b)/size {
+		d.err = errShort
+		return 0
+	}
+	return n
+}
+
+func (d *decoder) coord() Coord {
+	if b := d.next(16); b != nil {
+		return Coord{
+			X: math.Float64frombits(d.order.Uint64(b[:8])),
+			Y: math.Float64frombits(d.order.Uint64(b[8:])),
+		}
+	}
+	return Coord{}
+}
+
+func (d *decoder) coords() []Coord {
+	n := d.count(16)
+	cs := make([]Coord, 0, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		cs = append(cs, d.coord())
+	}
+	return cs
+}
+
+// header reads the byte order, the type and the optional SRID of an (E)WKB value.
+func (d *decoder) header() (typ uint32, srid int) {
+	switch b := d.next(1); {
+	case b == nil:
+		return 0, 0
+	case b[0] == 0:
+		d.order = binary.BigEndian
+	case b[0] == 1:
+		d.order = binary.LittleEndian
+	default:
+		d.err = fmt.Errorf("sqlgeo: invalid WKB byte order %d", b[0])
+		return 0, 0
+	}
+	typ = d.uint32()
+	if typ&ewkbSRID != 0 {
+		srid = int(d.uint32())
+	}
+	return typ &^ ewkbSRID, srid
+}
+
+// unmarshal decodes the given database value into a geometry of the given type,
+// and returns its SRID. The read function reads the body of the geometry.
+func unmarshal(src any, typ uint32, read func(*decoder)) (int, error) {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return 0, fmt.Errorf("sqlgeo: unexpected type %T for %s", src, typeName(typ))
+	}
+	// PostgreSQL drivers return geometries in their text format, hex-encoded EWKB.
+	if len(b)%2 == 0 && len(b) > 0 && b[0] == '0' {
+		if h, err := hex.DecodeString(string(b)); err == nil {
+			b = h
+		}
+	}
+	srid, err := decode(b, typ, read)
+	// MySQL prefixes the WKB with its 4-byte SRID.
+	if err != nil && len(b) > 4 {
+		if _, err1 := decode(b[4:], typ, read); err1 == nil {
+			return int(binary.LittleEndian.Uint32(b[:4])), nil
+		}
+	}
+	return srid, err
+}
+
+// decode decodes the given (E)WKB value, that must be a geometry of the given type.
+func decode(b []byte, typ uint32, read func(*decoder)) (int, error) {
+	d := &decoder{b: b}
+	t, srid := d.header()
+	switch {
+	case d.err != nil:
+		return 0, d.err
+	case t&(ewkbZ|ewkbM) != 0 || t > 1000:
+		return 0, fmt.Errorf("sqlgeo: unsupported geometry type %d with Z or M coordinates", t)
+	case t != typ:
+		return 0, fmt.Errorf("sqlgeo: unexpected geometry %s for %s", typeName(t), typeName(typ))
+	}
+	read(d)
+	switch {
+	case d.err != nil:
+		return 0, d.err
+	case len(d.b) > 0:
+		return 0, fmt.Errorf("sqlgeo: unexpected %d bytes after %s", len(d.b), typeName(typ))
+	}
+	return srid, nil
+}
+
+// typeName returns the name of the WKB type code.
+func typeName(typ uint32) string {
+	switch typ {
+	case wkbPoint:
+		return Point{}.GeometryType()
+	case wkbLineString:
+		return LineString{}.GeometryType()
+	case wkbPolygon:
+		return Polygon{}.GeometryType()
+	default:
+		return fmt.Sprintf("type %d", typ)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
 
 	"entgo.io/ent/dialect"
@@ -236,6 +237,39 @@ func SetNeighbors(dialect string, s *Step) (q *sql.Selector) {
 	return q
 }
 
+// GroupNeighbors configures the given Selector, that selects the neighbors (i.e. the To
+// side of the step), to group the neighbors of the vertices with the given ids by the
+// vertex they are connected to. The first selected column holds the vertex id of each
+// group, and aggregation functions can be added to the selection using AppendSelect.
+//
+//	s := sqlgraph.GroupNeighbors(sql.Select().From(sql.Table("pets")), step, 1, 2)
+//	s.AppendSelect(sql.Count("*"))
+//
+// Note, edges that are owned by the From side of the step (e.g. M2O) are not supported.
+func GroupNeighbors(q *sql.Selector, s *Step, ids ...driver.Value) *sql.Selector {
+	builder := sql.Dialect(q.Dialect())
+	var key string
+	switch {
+	case s.ThroughEdgeTable():
+		pk1, pk2 := s.Edge.Columns[1], s.Edge.Columns[0]
+		if s.Edge.Inverse {
+			pk1, pk2 = pk2, pk1
+		}
+		join := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
+		q.Join(join).On(q.C(s.To.Column), join.C(pk1))
+		key = join.C(pk2)
+	case s.ToEdgeOwner():
+		key = q.C(s.Edge.Columns[0])
+	default:
+		q.AddError(fmt.Errorf("sqlgraph: grouping neighbors of %s edge %q is not supported", s.Edge.Rel, s.Edge.Table))
+		return q
+	}
+	return q.Where(sql.InValues(key, ids...)).
+		Select(key).
+		GroupBy(key).
+		ClearOrder()
+}
+
 // HasNeighbors applies on the given Selector a neighbors check.
 func HasNeighbors(q *sql.Selector, s *Step) {
 	builder := sql.Dialect(q.Dialect())
@@ -295,6 +329,7 @@ func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
 		matches := builder.Select().From(to)
 		matches.WithContext(q.Context())
 		pred(matches)
+		q.AddError(matches.Err())
 		join.FromSelect(matches)
 		q.Where(sql.In(q.C(s.From.Column), join))
 	case s.FromEdgeOwner():
@@ -322,6 +357,7 @@ func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
 			),
 		)
 		pred(matches)
+		q.AddError(matches.Err())
 		q.Where(sql.Exists(matches))
 	case s.ToEdgeOwner():
 		to := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
@@ -348,6 +384,7 @@ func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
 			),
 		)
 		pred(matches)
+		q.AddError(matches.Err())
 		q.Where(sql.Exists(matches))
 	}
 }
@@ -728,6 +765,23 @@ type (
 		//	}
 		//
 		OnConflict []sql.ConflictOption
+
+		// The Load option configures the nodes to be bulk-loaded.
+		// i.e. in PostgreSQL, they are copied to their table using
+		// the COPY command, if it is supported by the driver. In other
+		// cases, they are inserted in chunks that respect the parameter
+		// limit of the dialect.
+		Load *LoadSpec
+	}
+
+	// LoadSpec holds the information for bulk-loading nodes.
+	LoadSpec struct {
+		// Returning indicates that the IDs of the nodes should be returned
+		// (e.g. for hooks). In this case, or in case the nodes have edges
+		// that are stored in other tables, they are never copied. Nodes
+		// with JSON fields, or values that require binding, are never
+		// copied either.
+		Returning bool
 	}
 )
 
@@ -782,6 +836,14 @@ type (
 		Fields    FieldMut
 		Predicate func(*sql.Selector)
 		Modifiers []func(*sql.UpdateBuilder)
+		// Version holds the optimistic-lock column of the node and its expected
+		// value. If set, UpdateNode updates the node only if the column holds this
+		// value, and fails with a StaleObjectError otherwise.
+		Version *FieldSpec
+		// Returning indicates that UpdateNodes should read back the updated nodes.
+		// If set, the Node.Columns of each updated row are scanned using ScanValues
+		// and Assign, and the number of scanned rows is returned.
+		Returning bool
 
 		ScanValues func(columns []string) ([]any, error)
 		Assign     func(columns []string, values []any) error
@@ -852,7 +914,10 @@ func UpdateNode(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) error
 	return tx.Commit()
 }
 
-// UpdateNodes applies the UpdateSpec on a set of nodes in the graph.
+// UpdateNodes applies the UpdateSpec on a set of nodes in the graph. If the spec
+// is configured with Returning, the updated rows are returned using the RETURNING
+// clause (or OUTPUT in SQL Server). In MySQL, the matched rows are locked, updated
+// and selected again in one transaction.
 func UpdateNodes(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) (int, error) {
 	gr := graph{tx: drv, builder: sql.Dialect(drv.Dialect())}
 	cr := &updater{UpdateSpec: spec, graph: gr}
@@ -870,11 +935,30 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("record with id %v not found in table %s", e.id, e.table)
 }
 
+// StaleObjectError returns when trying to update an entity
+// whose version was changed since it was loaded.
+type StaleObjectError struct {
+	table   string
+	id      driver.Value
+	version driver.Value
+}
+
+func (e *StaleObjectError) Error() string {
+	return fmt.Sprintf("record with id %v in table %s is stale: version %v was changed", e.id, e.table, e.version)
+}
+
 // DeleteSpec holds the information for delete one
 // or more nodes in the graph.
 type DeleteSpec struct {
 	Node      *NodeSpec
 	Predicate func(*sql.Selector)
+	// Returning indicates that DeleteNodes should read back the deleted nodes.
+	// If set, the Node.Columns of each deleted row are scanned using ScanValues
+	// and Assign, and the number of scanned rows is returned.
+	Returning bool
+
+	ScanValues func(columns []string) ([]any, error)
+	Assign     func(columns []string, values []any) error
 }
 
 // NewDeleteSpec creates a new node deletion spec.
@@ -882,7 +966,10 @@ func NewDeleteSpec(table string, id *FieldSpec) *DeleteSpec {
 	return &DeleteSpec{Node: &NodeSpec{Table: table, ID: id}}
 }
 
-// DeleteNodes applies the DeleteSpec on the graph.
+// DeleteNodes applies the DeleteSpec on the graph. If the spec is configured
+// with Returning, the deleted rows are returned using the RETURNING clause (or
+// OUTPUT in SQL Server). In MySQL, the matched rows are locked and selected
+// before they are deleted in one transaction.
 func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int, error) {
 	var (
 		res     sql.Result
@@ -894,6 +981,12 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	if pred := spec.Predicate; pred != nil {
 		pred(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	if spec.Returning {
+		return deleteReturning(ctx, drv, spec, selector)
+	}
 	query, args := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector).Query()
 	if err := drv.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
@@ -905,6 +998,48 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	return int(affected), nil
 }
 
+// deleteReturning deletes the nodes that match the selector, and scans the deleted rows.
+func deleteReturning(ctx context.Context, drv dialect.Driver, spec *DeleteSpec, selector *sql.Selector) (int, error) {
+	var (
+		rows    = &sql.Rows{}
+		builder = sql.Dialect(drv.Dialect())
+		stmt    = builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector)
+	)
+	if drv.Dialect() != dialect.MySQL {
+		query, args := stmt.Returning(spec.Node.Columns...).Query()
+		if err := drv.Query(ctx, query, args, rows); err != nil {
+			return 0, err
+		}
+		return scanNodes(rows, spec.ScanValues, spec.Assign)
+	}
+	// MySQL does not support the RETURNING clause. Hence, the rows are
+	// locked and read before they are deleted in the same transaction.
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := func() (int, error) {
+		query, args := selector.Select(spec.Node.Columns...).ForUpdate().Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return 0, fmt.Errorf("querying table %s: %w", spec.Node.Table, err)
+		}
+		affected, err := scanNodes(rows, spec.ScanValues, spec.Assign)
+		if err != nil || affected == 0 {
+			return 0, err
+		}
+		var res sql.Result
+		query, args = stmt.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return 0, err
+		}
+		return affected, nil
+	}()
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	return affected, tx.Commit()
+}
+
 // QuerySpec holds the information for querying
 // nodes in the graph.
 type QuerySpec struct {
@@ -1004,15 +1139,23 @@ func (q *query) nodes(ctx context.Context, drv dialect.Driver) error {
 	if err := drv.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
+	_, err = scanNodes(rows, q.ScanValues, q.Assign)
+	return err
+}
+
+// scanNodes scans all rows using the given ScanValues and Assign functions,
+// closes them, and returns the number of scanned rows.
+func scanNodes(rows *sql.Rows, scanValues func([]string) ([]any, error), assign func([]string, []any) error) (int, error) {
 	defer rows.Close()
 	columns, err := rows.Columns()
 	if err != nil {
-		return err
+		return 0, err
 	}
-	for rows.Next() {
-		values, err := q.ScanValues(columns)
+	var n int
+	for ; rows.Next(); n++ {
+		values, err := scanValues(columns)
 		if err != nil {
-			return err
+			return 0, err
 		}
 		for i, v := range values {
 			if _, ok := v.(*sql.UnknownType); ok {
@@ -1020,13 +1163,16 @@ func (q *query) nodes(ctx context.Context, drv dialect.Driver) error {
 			}
 		}
 		if err := rows.Scan(values...); err != nil {
-			return err
+			return 0, err
 		}
-		if err := q.Assign(columns, values); err != nil {
-			return err
+		if err := assign(columns, values); err != nil {
+			return 0, err
 		}
 	}
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	return n, rows.Close()
 }
 
 func (q *query) count(ctx context.Context, drv dialect.Driver) (int, error) {
@@ -1107,6 +1253,7 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	var (
 		id         driver.Value
 		idp        *sql.Predicate
+		key        driver.Value
 		addEdges   = EdgeSpecs(u.Edges.Add).GroupRel()
 		clearEdges = EdgeSpecs(u.Edges.Clear).GroupRel()
 	)
@@ -1116,11 +1263,13 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	case u.Node.ID != nil:
 		id = u.Node.ID.Value
 		idp = sql.EQ(u.Node.ID.Column, id)
+		key = id
 	case len(u.Node.CompositeID) == 2:
 		idp = sql.And(
 			sql.EQ(u.Node.CompositeID[0].Column, u.Node.CompositeID[0].Value),
 			sql.EQ(u.Node.CompositeID[1].Column, u.Node.CompositeID[1].Value),
 		)
+		key = []driver.Value{u.Node.CompositeID[0].Value, u.Node.CompositeID[1].Value}
 	case len(u.Node.CompositeID) != 2:
 		return fmt.Errorf("sql/sqlgraph: invalid composite id for update table %q", u.Node.Table)
 	default:
@@ -1128,10 +1277,18 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	}
 	update := u.builder.Update(u.Node.Table).Schema(u.Node.Schema).Where(idp)
 	if pred := u.Predicate; pred != nil {
-		selector := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema))
+		selector := u.builder.Select().
+			From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
+			WithContext(ctx)
 		pred(selector)
+		if err := selector.Err(); err != nil {
+			return err
+		}
 		update.FromSelect(selector)
 	}
+	if v := u.Version; v != nil {
+		update.Where(sql.EQ(v.Column, v.Value))
+	}
 	if err := u.setTableColumns(update, addEdges, clearEdges); err != nil {
 		return err
 	}
@@ -1152,11 +1309,15 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 			return err
 		}
 		// In case there are zero affected rows by this statement, we need to distinguish
-		// between the case of "record was not found" and "record was not changed".
-		if affected == 0 && u.Predicate != nil {
-			if err := u.ensureExists(ctx); err != nil {
+		// between the case of "record was not found" and "record was not changed". In case
+		// the node is versioned, the version always changes. Hence, the record is stale.
+		if affected == 0 && (u.Predicate != nil || u.Version != nil) {
+			if err := u.ensureExists(ctx, idp, key); err != nil {
 				return err
 			}
+			if v := u.Version; v != nil {
+				return &StaleObjectError{table: u.Node.Table, id: key, version: v.Value}
+			}
 		}
 	}
 	if id != nil {
@@ -1189,8 +1350,11 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 		addEdges   = EdgeSpecs(u.Edges.Add).GroupRel()
 		clearEdges = EdgeSpecs(u.Edges.Clear).GroupRel()
 		multiple   = hasExternalEdges(addEdges, clearEdges)
-		update     = u.builder.Update(u.Node.Table).Schema(u.Node.Schema)
-		selector   = u.builder.Select().
+		// MySQL does not support the RETURNING clause. Hence, the updated rows
+		// are locked, updated and selected again in the same transaction.
+		lock     = u.Returning && drv.Dialect() == dialect.MySQL
+		update   = u.builder.Update(u.Node.Table).Schema(u.Node.Schema)
+		selector = u.builder.Select().
 				From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
 				WithContext(ctx)
 	)
@@ -1206,6 +1370,7 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 		if multiple {
 			return 0, fmt.Errorf("sql/sqlgraph: update edge schema table %q cannot update external tables", u.Node.Table)
 		}
+		selector.Select(u.Node.CompositeID[0].Column, u.Node.CompositeID[1].Column)
 	case len(u.Node.CompositeID) != 2:
 		return 0, fmt.Errorf("sql/sqlgraph: invalid composite id for update table %q", u.Node.Table)
 	default:
@@ -1217,8 +1382,11 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 	if pred := u.Predicate; pred != nil {
 		pred(selector)
 	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	// In case of single statement update, avoid opening a transaction manually.
-	if !multiple {
+	if !multiple && !lock {
 		update.FromSelect(selector)
 		return u.updateTable(ctx, update)
 	}
@@ -1228,35 +1396,33 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 	}
 	u.tx = tx
 	affected, err := func() (int, error) {
-		var (
-			ids         []driver.Value
-			rows        = &sql.Rows{}
-			query, args = selector.Query()
-		)
-		if err := u.tx.Query(ctx, query, args, rows); err != nil {
-			return 0, fmt.Errorf("querying table %s: %w", u.Node.Table, err)
+		if lock {
+			selector.ForUpdate()
 		}
-		defer rows.Close()
-		if err := sql.ScanSlice(rows, &ids); err != nil {
-			return 0, fmt.Errorf("scan node ids: %w", err)
-		}
-		if err := rows.Close(); err != nil {
+		ids, err := u.keys(ctx, selector)
+		if err != nil || len(ids) == 0 {
 			return 0, err
 		}
-		if len(ids) == 0 {
-			return 0, nil
-		}
-		update.Where(matchID(u.Node.ID.Column, ids))
-		// In case of multi statement update, that change can
-		// affect more than 1 table, and therefore, we return
-		// the list of ids as number of affected records.
-		if _, err := u.updateTable(ctx, update); err != nil {
+		match := u.matchKeys(ids)
+		update.Where(match)
+		affected, err := u.updateTable(ctx, update)
+		if err != nil {
 			return 0, err
 		}
-		if err := u.setExternalEdges(ctx, ids, addEdges, clearEdges); err != nil {
-			return 0, err
+		if multiple {
+			if err := u.setExternalEdges(ctx, ids, addEdges, clearEdges); err != nil {
+				return 0, err
+			}
+			// In case of multi statement update, that change can
+			// affect more than 1 table, and therefore, we return
+			// the list of ids as number of affected records.
+			affected = len(ids)
+		}
+		// Nodes are read back in case they were not returned by the UPDATE statement.
+		if lock || u.Returning && update.Empty() {
+			return u.selectNodes(ctx, match)
 		}
-		return len(ids), nil
+		return affected, nil
 	}()
 	if err != nil {
 		return 0, rollback(tx, err)
@@ -1274,6 +1440,14 @@ func (u *updater) updateTable(ctx context.Context, stmt *sql.UpdateBuilder) (int
 	if stmt.Empty() {
 		return 0, nil
 	}
+	if u.Returning && stmt.Dialect() != dialect.MySQL {
+		rows := &sql.Rows{}
+		query, args := stmt.Returning(u.Node.Columns...).Query()
+		if err := u.tx.Query(ctx, query, args, rows); err != nil {
+			return 0, err
+		}
+		return scanNodes(rows, u.ScanValues, u.Assign)
+	}
 	var (
 		res         sql.Result
 		query, args = stmt.Query()
@@ -1288,6 +1462,65 @@ func (u *updater) updateTable(ctx context.Context, stmt *sql.UpdateBuilder) (int
 	return int(affected), nil
 }
 
+// keys returns the keys of the nodes that match the selector. The keys of edge
+// schemas (composite identifiers) are returned as pairs of values.
+func (u *updater) keys(ctx context.Context, selector *sql.Selector) ([]driver.Value, error) {
+	var (
+		keys        []driver.Value
+		rows        = &sql.Rows{}
+		query, args = selector.Query()
+	)
+	if err := u.tx.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("querying table %s: %w", u.Node.Table, err)
+	}
+	defer rows.Close()
+	if u.Node.ID != nil {
+		if err := sql.ScanSlice(rows, &keys); err != nil {
+			return nil, fmt.Errorf("scan node ids: %w", err)
+		}
+		return keys, rows.Close()
+	}
+	for rows.Next() {
+		var k1, k2 any
+		if err := rows.Scan(&k1, &k2); err != nil {
+			return nil, fmt.Errorf("scan node ids: %w", err)
+		}
+		keys = append(keys, []driver.Value{k1, k2})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return keys, rows.Close()
+}
+
+// matchKeys returns a predicate that matches the nodes with the given keys.
+func (u *updater) matchKeys(keys []driver.Value) *sql.Predicate {
+	if u.Node.ID != nil {
+		return matchID(u.Node.ID.Column, keys)
+	}
+	ps := make([]*sql.Predicate, len(keys))
+	for i, k := range keys {
+		k := k.([]driver.Value)
+		ps[i] = sql.And(sql.EQ(u.Node.CompositeID[0].Column, k[0]), sql.EQ(u.Node.CompositeID[1].Column, k[1]))
+	}
+	return sql.Or(ps...)
+}
+
+// selectNodes selects and scans the nodes that match the predicate.
+func (u *updater) selectNodes(ctx context.Context, p *sql.Predicate) (int, error) {
+	var (
+		rows        = &sql.Rows{}
+		query, args = u.builder.Select(u.Node.Columns...).
+				From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
+				Where(p).
+				Query()
+	)
+	if err := u.tx.Query(ctx, query, args, rows); err != nil {
+		return 0, err
+	}
+	return scanNodes(rows, u.ScanValues, u.Assign)
+}
+
 func (u *updater) setExternalEdges(ctx context.Context, ids []driver.Value, addEdges, clearEdges map[Rel][]*EdgeSpec) error {
 	if err := u.graph.clearM2MEdges(ctx, ids, clearEdges[M2M]); err != nil {
 		return err
@@ -1375,9 +1608,16 @@ func (u *updater) scan(rows *sql.Rows) error {
 	return nil
 }
 
-func (u *updater) ensureExists(ctx context.Context) error {
-	exists := u.builder.Select().From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).Where(sql.EQ(u.Node.ID.Column, u.Node.ID.Value))
-	u.Predicate(exists)
+// ensureExists returns a NotFoundError in case the node that is identified
+// by the given predicate (and matches the updater predicate) does not exist.
+func (u *updater) ensureExists(ctx context.Context, idp *sql.Predicate, key driver.Value) error {
+	exists := u.builder.Select().
+		From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
+		Where(idp).
+		WithContext(ctx)
+	if u.Predicate != nil {
+		u.Predicate(exists)
+	}
 	query, args := u.builder.SelectExpr(sql.Exists(exists)).Query()
 	rows := &sql.Rows{}
 	if err := u.tx.Query(ctx, query, args, rows); err != nil {
@@ -1389,7 +1629,7 @@ func (u *updater) ensureExists(ctx context.Context) error {
 		return err
 	}
 	if !found {
-		return &NotFoundError{table: u.Node.Table, id: u.Node.ID.Value}
+		return &NotFoundError{table: u.Node.Table, id: key}
 	}
 	return nil
 }
@@ -1508,11 +1748,58 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 	if len(c.Nodes) == 0 {
 		return nil
 	}
+	if c.Load != nil {
+		return c.load(ctx, drv)
+	}
+	sorted, rows, err := c.rows()
+	if err != nil {
+		return err
+	}
+	insert := c.builder.Insert(c.Nodes[0].Table).Schema(c.Nodes[0].Schema).Default().Columns(sorted...)
+	for i := range rows {
+		insert.Values(rows[i]...)
+	}
+	tx, err := c.mayTx(ctx, drv)
+	if err != nil {
+		return err
+	}
+	c.tx = tx
+	if err := func() error {
+		// In case the spec does not contain an ID field, we assume
+		// we interact with an edge-schema with composite primary key.
+		if c.Nodes[0].ID == nil {
+			c.ensureConflict(insert)
+			query, args := insert.Query()
+			return tx.Exec(ctx, query, args, nil)
+		}
+		if err := c.batchInsert(ctx, tx, insert); err != nil {
+			return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
+		}
+		if err := c.batchAddM2M(ctx, c.BatchCreateSpec); err != nil {
+			return err
+		}
+		// FKs that exist in different tables can't be updated in batch (using the CASE
+		// statement), because we rely on RowsAffected to check if the FK column is NULL.
+		for _, node := range c.Nodes {
+			edges := EdgeSpecs(node.Edges).GroupRel()
+			if err := c.graph.addFKEdges(ctx, []driver.Value{node.ID.Value}, append(edges[O2M], edges[O2O]...)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// rows returns the sorted columns of the batch, and the values of its nodes ordered by them.
+func (c *batchCreator) rows() ([]string, [][]any, error) {
 	columns := make(map[string]struct{})
 	values := make([]map[string]driver.Value, len(c.Nodes))
 	for i, node := range c.Nodes {
 		if i > 0 && node.Table != c.Nodes[i-1].Table {
-			return fmt.Errorf("more than 1 table for batch insert: %q != %q", node.Table, c.Nodes[i-1].Table)
+			return nil, nil, fmt.Errorf("more than 1 table for batch insert: %q != %q", node.Table, c.Nodes[i-1].Table)
 		}
 		values[i] = make(map[string]driver.Value)
 		if node.ID != nil && node.ID.Value != nil {
@@ -1525,7 +1812,7 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 			values[i][column] = value
 		})
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
 	for column := range columns {
@@ -1535,7 +1822,7 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 					// If the ID value was provided to one of the nodes, it should be
 					// provided to all others because this affects the way we calculate
 					// their values in MySQL and SQLite dialects.
-					return fmt.Errorf("inconsistent id values for batch insert")
+					return nil, nil, fmt.Errorf("inconsistent id values for batch insert")
 				}
 				// Assign NULL values for empty placeholders.
 				values[i][column] = nil
@@ -1543,48 +1830,131 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 		}
 	}
 	sorted := keys(columns)
-	insert := c.builder.Insert(c.Nodes[0].Table).Schema(c.Nodes[0].Schema).Default().Columns(sorted...)
+	rows := make([][]any, len(values))
 	for i := range values {
-		vs := make([]any, len(sorted))
+		rows[i] = make([]any, len(sorted))
 		for j, c := range sorted {
-			vs[j] = values[i][c]
+			rows[i][j] = values[i][c]
 		}
-		insert.Values(vs...)
 	}
-	tx, err := c.mayTx(ctx, drv)
+	return sorted, rows, nil
+}
+
+// maxParams holds the maximum number of parameters in a statement for each dialect.
+var maxParams = map[string]int{
+	dialect.MySQL:     65535,
+	dialect.Postgres:  65535,
+	dialect.SQLite:    32766,
+	dialect.SQLServer: 2098,
+}
+
+// load bulk-loads the nodes. Nodes whose IDs are not returned (i.e. generated by the
+// database) are copied to their table in PostgreSQL if their values can be copied, or
+// inserted in chunks that respect the parameter limit of the dialect. Other nodes are
+// created in chunks of batches.
+func (c *batchCreator) load(ctx context.Context, drv dialect.Driver) error {
+	columns, rows, err := c.rows()
 	if err != nil {
 		return err
 	}
-	c.tx = tx
-	if err := func() error {
-		// In case the spec does not contain an ID field, we assume
-		// we interact with an edge-schema with composite primary key.
-		if c.Nodes[0].ID == nil {
-			c.ensureConflict(insert)
-			query, args := insert.Query()
-			return tx.Exec(ctx, query, args, nil)
+	size := len(rows)
+	if limit, ok := maxParams[drv.Dialect()]; ok && len(columns) > 0 {
+		size = max(1, limit/len(columns))
+	}
+	returning := c.Load.Returning || len(columns) == 0
+	for _, n := range c.Nodes {
+		returning = returning || slices.ContainsFunc(n.Edges, isExternalEdge)
+	}
+	if cp, ok := drv.(sql.Copier); ok && !returning && drv.Dialect() == dialect.Postgres && len(c.OnConflict) == 0 && c.copyable(rows) {
+		_, err := cp.Copy(ctx, &sql.CopySpec{Schema: c.Nodes[0].Schema, Table: c.Nodes[0].Table, Columns: columns, Rows: rows})
+		// Fall back to chunked inserts in case the driver does not support copying rows.
+		if !errors.Is(err, sql.ErrCopyNotSupported) {
+			return err
 		}
-		if err := c.batchInsert(ctx, tx, insert); err != nil {
-			return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
+	}
+	// A single batch does not require a transaction.
+	if size >= len(rows) {
+		if returning {
+			return c.batch(c.Nodes, drv).nodes(ctx, drv)
 		}
-		if err := c.batchAddM2M(ctx, c.BatchCreateSpec); err != nil {
-			return err
+		return c.insertRows(ctx, drv, columns, rows)
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(rows); i += size {
+		j := min(i+size, len(rows))
+		if returning {
+			txd := &txDriver{ExecQuerier: tx, dialect: drv.Dialect()}
+			err = c.batch(c.Nodes[i:j], txd).nodes(ctx, txd)
+		} else {
+			err = c.insertRows(ctx, tx, columns, rows[i:j])
 		}
-		// FKs that exist in different tables can't be updated in batch (using the CASE
-		// statement), because we rely on RowsAffected to check if the FK column is NULL.
-		for _, node := range c.Nodes {
-			edges := EdgeSpecs(node.Edges).GroupRel()
-			if err := c.graph.addFKEdges(ctx, []driver.Value{node.ID.Value}, append(edges[O2M], edges[O2O]...)); err != nil {
-				return err
-			}
+		if err != nil {
+			return rollback(tx, err)
 		}
-		return nil
-	}(); err != nil {
-		return rollback(tx, err)
 	}
 	return tx.Commit()
 }
 
+// copyable reports if the rows can be copied using the COPY command. JSON values are sent by
+// the COPY protocol as binary data (i.e. bytea), and values that implement the sql.Querier or
+// the driver.Valuer interfaces (e.g. sql.Array) may require binding. Therefore, such rows are
+// inserted using chunked INSERT statements.
+func (c *batchCreator) copyable(rows [][]any) bool {
+	for _, n := range c.Nodes {
+		if slices.ContainsFunc(n.Fields, func(f *FieldSpec) bool { return f.Type == field.TypeJSON }) {
+			return false
+		}
+	}
+	for _, r := range rows {
+		for _, v := range r {
+			switch v.(type) {
+			case sql.Querier, driver.Valuer:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// batch returns a creator for a batch of the given nodes, that are not bulk-loaded.
+func (c *batchCreator) batch(nodes []*CreateSpec, drv dialect.Driver) *batchCreator {
+	return &batchCreator{
+		graph:           graph{tx: drv, builder: c.builder},
+		BatchCreateSpec: &BatchCreateSpec{Nodes: nodes, OnConflict: c.OnConflict},
+	}
+}
+
+// insertRows inserts the given rows without returning the IDs of their nodes.
+func (c *batchCreator) insertRows(ctx context.Context, tx dialect.ExecQuerier, columns []string, rows [][]any) error {
+	insert := c.builder.Insert(c.Nodes[0].Table).Schema(c.Nodes[0].Schema).Columns(columns...)
+	for i := range rows {
+		insert.Values(rows[i]...)
+	}
+	c.ensureConflict(insert)
+	query, args, err := insert.QueryErr()
+	if err != nil {
+		return err
+	}
+	if err := tx.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
+	}
+	return nil
+}
+
+// txDriver wraps a transaction with a dialect.Driver, whose Tx method
+// returns the transaction with nop Commit and Rollback methods.
+type txDriver struct {
+	dialect.ExecQuerier
+	dialect string
+}
+
+func (d *txDriver) Dialect() string                        { return d.dialect }
+func (d *txDriver) Close() error                           { return nil }
+func (d *txDriver) Tx(context.Context) (dialect.Tx, error) { return dialect.NopTx(d), nil }
+
 // mayTx opens a new transaction if the create operation spans across multiple statements.
 func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver) (dialect.Tx, error) {
 	for _, node := range c.Nodes {
@@ -1877,7 +2247,9 @@ func isExternalEdge(e *EdgeSpec) bool {
 func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(string, driver.Value)) (err error) {
 	for _, fi := range fields {
 		value := fi.Value
-		if fi.Type == field.TypeJSON {
+		// JSON values that were already converted to SQL
+		// expressions (e.g. sql.Array) are set as is.
+		if _, ok := value.(sql.Querier); !ok && fi.Type == field.TypeJSON {
 			buf, err := json.Marshal(value)
 			if err != nil {
 				return fmt.Errorf("marshal value for column %s: %w", fi.Column, err)
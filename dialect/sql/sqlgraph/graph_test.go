@@ -442,6 +442,75 @@ JOIN
 	}
 }
 
+func TestGroupNeighbors(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  *sql.Selector
+		input     *Step
+		wantQuery string
+		wantArgs  []any
+		wantErr   bool
+	}{
+		{
+			name:     "O2M/2types",
+			selector: sql.Dialect(dialect.Postgres).Select().From(sql.Table("pets")).Where(sql.EQ("name", "pedro")).OrderBy("name"),
+			input: NewStep(
+				From("users", "id"),
+				To("pets", "id"),
+				Edge(O2M, false, "pets", "owner_id"),
+			),
+			wantQuery: `SELECT "pets"."owner_id", COUNT(*) FROM "pets" WHERE "name" = $1 AND "pets"."owner_id" IN ($2, $3) GROUP BY "pets"."owner_id"`,
+			wantArgs:  []any{"pedro", 1, 2},
+		},
+		{
+			name:     "M2M/2types",
+			selector: sql.Dialect(dialect.Postgres).Select().From(sql.Table("groups")),
+			input: NewStep(
+				From("users", "id"),
+				To("groups", "id"),
+				Edge(M2M, false, "user_groups", "user_id", "group_id"),
+			),
+			wantQuery: `SELECT "t1"."user_id", COUNT(*) FROM "groups" JOIN "user_groups" AS "t1" ON "groups"."id" = "t1"."group_id" WHERE "t1"."user_id" IN ($1, $2) GROUP BY "t1"."user_id"`,
+			wantArgs:  []any{1, 2},
+		},
+		{
+			name:     "M2M/2types/inverse",
+			selector: sql.Dialect(dialect.MySQL).Select().From(sql.Table("users")),
+			input: NewStep(
+				From("groups", "id"),
+				To("users", "id"),
+				Edge(M2M, true, "user_groups", "user_id", "group_id"),
+			),
+			wantQuery: "SELECT `t1`.`group_id`, COUNT(*) FROM `users` JOIN `user_groups` AS `t1` ON `users`.`id` = `t1`.`user_id` WHERE `t1`.`group_id` IN (?, ?) GROUP BY `t1`.`group_id`",
+			wantArgs:  []any{1, 2},
+		},
+		{
+			name:     "M2O/2types",
+			selector: sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			input: NewStep(
+				From("pets", "id"),
+				To("users", "id"),
+				Edge(M2O, true, "pets", "owner_id"),
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector := GroupNeighbors(tt.selector, tt.input, 1, 2)
+			if tt.wantErr {
+				require.Error(t, selector.Err())
+				return
+			}
+			selector.AppendSelect(sql.Count("*"))
+			query, args := selector.Query()
+			require.NoError(t, selector.Err())
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
 func TestHasNeighbors(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -911,6 +980,26 @@ func TestHasNeighborsWithContext(t *testing.T) {
 	}
 }
 
+func TestHasNeighborsWithErr(t *testing.T) {
+	errInvalid := errors.New("invalid")
+	for _, rel := range [...]Rel{M2M, O2M, O2O} {
+		t.Run(rel.String(), func(t *testing.T) {
+			sel := sql.Dialect(dialect.Postgres).
+				Select("*").
+				From(sql.Table("users"))
+			step := NewStep(
+				From("users", "id"),
+				To("groups", "id"),
+				Edge(rel, false, "user_groups", "user_id", "group_id"),
+			)
+			HasNeighborsWith(sel, step, func(s *sql.Selector) {
+				s.AddError(errInvalid)
+			})
+			require.ErrorIs(t, sel.Err(), errInvalid)
+		})
+	}
+}
+
 func TestOrderByNeighborsCount(t *testing.T) {
 	build := sql.Dialect(dialect.Postgres)
 	t1 := build.Table("users")
@@ -1670,6 +1759,121 @@ func TestBatchCreate(t *testing.T) {
 	}
 }
 
+// copyDriver is a driver that implements the sql.Copier interface.
+type copyDriver struct {
+	*sql.Driver
+	spec *sql.CopySpec
+}
+
+func (d *copyDriver) Copy(_ context.Context, spec *sql.CopySpec) (int64, error) {
+	d.spec = spec
+	return int64(len(spec.Rows)), nil
+}
+
+func TestBatchCreate_Load(t *testing.T) {
+	users := func(n int) []*CreateSpec {
+		nodes := make([]*CreateSpec, n)
+		for i := range nodes {
+			nodes[i] = &CreateSpec{
+				Table: "users",
+				ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+				Fields: []*FieldSpec{
+					{Column: "age", Type: field.TypeInt, Value: i},
+					{Column: "name", Type: field.TypeString, Value: "a8m"},
+				},
+			}
+		}
+		return nodes
+	}
+	t.Run("Copy", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		drv := &copyDriver{Driver: sql.OpenDB(dialect.Postgres, db)}
+		spec := &BatchCreateSpec{Nodes: users(2), Load: &LoadSpec{}}
+		require.NoError(t, BatchCreate(context.Background(), drv, spec))
+		require.NoError(t, mock.ExpectationsWereMet())
+		require.Equal(t, &sql.CopySpec{Table: "users", Columns: []string{"age", "name"}, Rows: [][]any{{0, "a8m"}, {1, "a8m"}}}, drv.spec)
+		require.Nil(t, spec.Nodes[0].ID.Value)
+	})
+	t.Run("CopyNotSupported", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		// Only lib/pq supports the COPY FROM STDIN statement. Other drivers fall back to INSERT.
+		mock.ExpectExec(escape(`INSERT INTO "users" ("age", "name") VALUES ($1, $2), ($3, $4)`)).
+			WithArgs(0, "a8m", 1, "a8m").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		spec := &BatchCreateSpec{Nodes: users(2), Load: &LoadSpec{}}
+		require.NoError(t, BatchCreate(context.Background(), sql.OpenDB(dialect.Postgres, db), spec))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("CopyJSON", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		// JSON and slice fields, and values that require binding, are not copied.
+		mock.ExpectExec(escape(`INSERT INTO "users" ("age", "dirs", "name", "tags") VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)`)).
+			WithArgs(0, []byte(`["a"]`), "a8m", "{a,b}", 1, []byte(`["a"]`), "a8m", "{a,b}").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		drv := &copyDriver{Driver: sql.OpenDB(dialect.Postgres, db)}
+		nodes := users(2)
+		for _, n := range nodes {
+			n.Fields = append(n.Fields,
+				&FieldSpec{Column: "dirs", Type: field.TypeJSON, Value: []string{"a"}},
+				&FieldSpec{Column: "tags", Type: field.TypeOther, Value: stringArray{"a", "b"}},
+			)
+		}
+		spec := &BatchCreateSpec{Nodes: nodes, Load: &LoadSpec{}}
+		require.NoError(t, BatchCreate(context.Background(), drv, spec))
+		require.NoError(t, mock.ExpectationsWereMet())
+		require.Nil(t, drv.spec)
+	})
+	t.Run("Returning", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("age", "name") VALUES ($1, $2), ($3, $4) RETURNING "id"`)).
+			WithArgs(0, "a8m", 1, "a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10).AddRow(11))
+		spec := &BatchCreateSpec{Nodes: users(2), Load: &LoadSpec{Returning: true}}
+		require.NoError(t, BatchCreate(context.Background(), sql.OpenDB(dialect.Postgres, db), spec))
+		require.NoError(t, mock.ExpectationsWereMet())
+		require.Equal(t, int64(11), spec.Nodes[1].ID.Value)
+	})
+	t.Run("Chunks", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		// SQL Server supports up to 2098 parameters in a statement, that is 1049 rows of 2 columns.
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO [users] ([age], [name]) VALUES (@p1, @p2),")).
+			WillReturnResult(sqlmock.NewResult(0, 1049))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO [users] ([age], [name]) VALUES (@p1, @p2),")).
+			WillReturnResult(sqlmock.NewResult(0, 51))
+		mock.ExpectCommit()
+		spec := &BatchCreateSpec{Nodes: users(1100), Load: &LoadSpec{}}
+		require.NoError(t, BatchCreate(context.Background(), sql.OpenDB(dialect.SQLServer, db), spec))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("ChunksReturning", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO [users] ([age], [name]) OUTPUT INSERTED.[id] VALUES (@p1, @p2),")).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO [users] ([age], [name]) OUTPUT INSERTED.[id] VALUES (@p1, @p2),")).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1050))
+		mock.ExpectCommit()
+		spec := &BatchCreateSpec{Nodes: users(1100), Load: &LoadSpec{Returning: true}}
+		require.NoError(t, BatchCreate(context.Background(), sql.OpenDB(dialect.SQLServer, db), spec))
+		require.NoError(t, mock.ExpectationsWereMet())
+		require.Equal(t, int64(1050), spec.Nodes[1049].ID.Value)
+	})
+}
+
+// stringArray is a driver.Valuer that encodes strings as a PostgreSQL array.
+type stringArray []string
+
+func (a stringArray) Value() (driver.Value, error) {
+	return "{" + strings.Join(a, ",") + "}", nil
+}
+
 type user struct {
 	id    int
 	age   int
@@ -2040,6 +2244,83 @@ func TestUpdateNode(t *testing.T) {
 	}
 }
 
+func TestUpdateNode_Version(t *testing.T) {
+	spec := func() *UpdateSpec {
+		return &UpdateSpec{
+			Node: &NodeSpec{
+				Table:   "users",
+				Columns: []string{"id", "name", "version"},
+				ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+			},
+			Version: &FieldSpec{Column: "version", Type: field.TypeInt, Value: 1},
+			Fields: FieldMut{
+				Set: []*FieldSpec{
+					{Column: "name", Type: field.TypeString, Value: "a8m"},
+					{Column: "version", Type: field.TypeInt, Value: 2},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		exists  bool
+		wantErr any
+	}{
+		{name: "stale", exists: true, wantErr: &StaleObjectError{}},
+		{name: "not found", exists: false, wantErr: &NotFoundError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			mock.ExpectBegin()
+			mock.ExpectExec(escape("UPDATE `users` SET `name` = ?, `version` = ? WHERE `id` = ? AND `version` = ?")).
+				WithArgs("a8m", 2, 1, 1).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `users` WHERE `id` = ?)")).
+				WithArgs(1).
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).
+					AddRow(tt.exists))
+			mock.ExpectRollback()
+			err = UpdateNode(context.Background(), sql.OpenDB("", db), spec())
+			require.Error(t, err)
+			require.IsType(t, tt.wantErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+	t.Run("composite id", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("UPDATE `group_users` SET `version` = ? WHERE (`user_id` = ? AND `group_id` = ?) AND `version` = ?")).
+			WithArgs(2, 1, 2, 1).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(escape("SELECT EXISTS (SELECT * FROM `group_users` WHERE (`user_id` = ? AND `group_id` = ?))")).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).
+				AddRow(true))
+		mock.ExpectRollback()
+		err = UpdateNode(context.Background(), sql.OpenDB("", db), &UpdateSpec{
+			Node: &NodeSpec{
+				Table:   "group_users",
+				Columns: []string{"user_id", "group_id", "version"},
+				CompositeID: []*FieldSpec{
+					{Column: "user_id", Type: field.TypeInt, Value: 1},
+					{Column: "group_id", Type: field.TypeInt, Value: 2},
+				},
+			},
+			Version: &FieldSpec{Column: "version", Type: field.TypeInt, Value: 1},
+			Fields: FieldMut{
+				Set: []*FieldSpec{
+					{Column: "version", Type: field.TypeInt, Value: 2},
+				},
+			},
+		})
+		require.EqualError(t, err, "record with id [1 2] in table group_users is stale: version 1 was changed")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestExecUpdateNode(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2341,6 +2622,83 @@ func TestUpdateNodes(t *testing.T) {
 	}
 }
 
+func TestUpdateNodes_Returning(t *testing.T) {
+	tests := []struct {
+		dialect string
+		prepare func(mock sqlmock.Sqlmock)
+	}{
+		{
+			dialect: dialect.Postgres,
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(escape(`UPDATE "users" SET "name" = $1 WHERE "age" > $2 RETURNING "id", "name"`)).
+					WithArgs("a8m", 30).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "a8m"))
+			},
+		},
+		{
+			dialect: dialect.SQLServer,
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(escape("UPDATE [users] SET [name] = @p1 OUTPUT INSERTED.[id], INSERTED.[name] WHERE [age] > @p2")).
+					WithArgs("a8m", 30).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "a8m"))
+			},
+		},
+		{
+			dialect: dialect.MySQL,
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `age` > ? FOR UPDATE")).
+					WithArgs(30).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+				mock.ExpectExec(escape("UPDATE `users` SET `name` = ? WHERE `id` IN (?, ?)")).
+					WithArgs("a8m", 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `id` IN (?, ?)")).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "a8m"))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.prepare(mock)
+			var users []*user
+			spec := &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "name", Type: field.TypeString, Value: "a8m"},
+					},
+				},
+				Predicate: func(s *sql.Selector) {
+					s.Where(sql.GT("age", 30))
+				},
+				Returning: true,
+				ScanValues: func(columns []string) ([]any, error) {
+					u := &user{}
+					users = append(users, u)
+					return u.values(columns)
+				},
+				Assign: func(columns []string, values []any) error {
+					return users[len(users)-1].assign(columns, values)
+				},
+			}
+			affected, err := UpdateNodes(context.Background(), sql.OpenDB(tt.dialect, db), spec)
+			require.NoError(t, err)
+			require.Equal(t, 2, affected)
+			require.Equal(t, []*user{{id: 1, name: "a8m"}, {id: 2, name: "a8m"}}, users)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestDeleteNodes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2372,6 +2730,75 @@ func TestDeleteNodesSchema(t *testing.T) {
 	require.Equal(t, 2, affected)
 }
 
+func TestDeleteNodes_Returning(t *testing.T) {
+	tests := []struct {
+		dialect string
+		prepare func(mock sqlmock.Sqlmock)
+	}{
+		{
+			dialect: dialect.SQLite,
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(escape("DELETE FROM `users` WHERE `age` > ? RETURNING `id`, `name`")).
+					WithArgs(30).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "nati"))
+			},
+		},
+		{
+			dialect: dialect.SQLServer,
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(escape("DELETE FROM [users] OUTPUT DELETED.[id], DELETED.[name] WHERE [age] > @p1")).
+					WithArgs(30).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "nati"))
+			},
+		},
+		{
+			dialect: dialect.MySQL,
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `age` > ? FOR UPDATE")).
+					WithArgs(30).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m").AddRow(2, "nati"))
+				mock.ExpectExec(escape("DELETE FROM `users` WHERE `age` > ?")).
+					WithArgs(30).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.prepare(mock)
+			var users []*user
+			spec := &DeleteSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+				},
+				Predicate: func(s *sql.Selector) {
+					s.Where(sql.GT("age", 30))
+				},
+				Returning: true,
+				ScanValues: func(columns []string) ([]any, error) {
+					u := &user{}
+					users = append(users, u)
+					return u.values(columns)
+				},
+				Assign: func(columns []string, values []any) error {
+					return users[len(users)-1].assign(columns, values)
+				},
+			}
+			affected, err := DeleteNodes(context.Background(), sql.OpenDB(tt.dialect, db), spec)
+			require.NoError(t, err)
+			require.Equal(t, 2, affected)
+			require.Equal(t, []*user{{id: 1, name: "a8m"}, {id: 2, name: "nati"}}, users)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestQueryNodes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -7,6 +7,8 @@ package sqljson
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -72,6 +74,70 @@ func (d *sqlite) appendArg(b *sql.Builder, v any) {
 	}
 }
 
+// Set implements the driver.Set method.
+func (*sqlite) Set(b *sql.Builder, doc sql.Querier, path *PathOptions, value any) {
+	b.WriteString("JSON_SET").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, "JSON_OBJECT()").Comma()
+		path.mysqlPath(b)
+		b.Comma().Argf("JSON(?)", marshalArg(value))
+	})
+}
+
+// Remove implements the driver.Remove method.
+func (*sqlite) Remove(b *sql.Builder, doc sql.Querier, path *PathOptions) {
+	b.WriteString("JSON_REMOVE").Wrap(func(b *sql.Builder) {
+		b.Join(doc).Comma()
+		path.mysqlPath(b)
+	})
+}
+
+// Merge implements the driver.Merge method.
+func (*sqlite) Merge(b *sql.Builder, doc sql.Querier, path *PathOptions, patch map[string]any) {
+	mergeAt(b, doc, path, "JSON_OBJECT()", func(b *sql.Builder, target sql.Querier) {
+		b.WriteString("JSON_PATCH").Wrap(func(b *sql.Builder) {
+			coalesce(b, target, "JSON_OBJECT()").Comma().Argf("JSON(?)", marshalArg(patch))
+		})
+	})
+}
+
+// Insert implements the driver.Insert method. SQLite does not provide a function for inserting
+// elements to arrays. Hence, the array is rebuilt from its elements and the inserted value, that
+// is positioned between the elements at index-1 and index.
+func (*sqlite) Insert(b *sql.Builder, doc sql.Querier, path *PathOptions, index int, value any) {
+	b.WriteString("JSON_SET").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, emptyDoc(path, "JSON_ARRAY()", "JSON_OBJECT()")).Comma()
+		path.mysqlPath(b)
+		b.Comma().Wrap(func(b *sql.Builder) {
+			b.WriteString("SELECT JSON_GROUP_ARRAY(JSON(").Ident("v").WriteString(")) FROM ").Wrap(func(b *sql.Builder) {
+				b.WriteString("SELECT ").Ident("key").WriteString(" AS ").Ident("k").Comma()
+				b.Join(doc).WriteString(" -> ").Ident("fullkey").WriteString(" AS ").Ident("v")
+				b.WriteString(" FROM JSON_EACH").Wrap(func(b *sql.Builder) {
+					b.Join(doc).Comma()
+					path.mysqlPath(b)
+				})
+				b.WriteString(" UNION ALL SELECT ").Arg(float64(index)-0.5).Comma().Argf("JSON(?)", marshalArg(value))
+				b.WriteString(" ORDER BY 1")
+			})
+		})
+	})
+}
+
+// Increment implements the driver.Increment method.
+func (*sqlite) Increment(b *sql.Builder, doc sql.Querier, path *PathOptions, n any) {
+	b.WriteString("JSON_SET").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, "JSON_OBJECT()").Comma()
+		path.mysqlPath(b)
+		b.Comma().WriteString("COALESCE").Wrap(func(b *sql.Builder) {
+			b.WriteString("JSON_EXTRACT").Wrap(func(b *sql.Builder) {
+				b.Join(doc).Comma()
+				path.mysqlPath(b)
+			})
+			b.WriteString(", 0")
+		})
+		b.WriteString(" + ").Arg(n)
+	})
+}
+
 type mysql struct{}
 
 // Append implements the driver.Append method.
@@ -135,6 +201,57 @@ func (d *mysql) appendArg(b *sql.Builder, v any) {
 	}
 }
 
+// Set implements the driver.Set method.
+func (*mysql) Set(b *sql.Builder, doc sql.Querier, path *PathOptions, value any) {
+	b.WriteString("JSON_SET").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, "JSON_OBJECT()").Comma()
+		path.mysqlPath(b)
+		b.Comma().Argf("CAST(? AS JSON)", marshalArg(value))
+	})
+}
+
+// Remove implements the driver.Remove method.
+func (*mysql) Remove(b *sql.Builder, doc sql.Querier, path *PathOptions) {
+	b.WriteString("JSON_REMOVE").Wrap(func(b *sql.Builder) {
+		b.Join(doc).Comma()
+		path.mysqlPath(b)
+	})
+}
+
+// Merge implements the driver.Merge method.
+func (*mysql) Merge(b *sql.Builder, doc sql.Querier, path *PathOptions, patch map[string]any) {
+	mergeAt(b, doc, path, "JSON_OBJECT()", func(b *sql.Builder, target sql.Querier) {
+		b.WriteString("JSON_MERGE_PATCH").Wrap(func(b *sql.Builder) {
+			coalesce(b, target, "JSON_OBJECT()").Comma().Argf("CAST(? AS JSON)", marshalArg(patch))
+		})
+	})
+}
+
+// Insert implements the driver.Insert method.
+func (*mysql) Insert(b *sql.Builder, doc sql.Querier, path *PathOptions, index int, value any) {
+	b.WriteString("JSON_ARRAY_INSERT").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, emptyDoc(path, "JSON_ARRAY()", "JSON_OBJECT()")).Comma()
+		elemPath(path, index).mysqlPath(b)
+		b.Comma().Argf("CAST(? AS JSON)", marshalArg(value))
+	})
+}
+
+// Increment implements the driver.Increment method.
+func (*mysql) Increment(b *sql.Builder, doc sql.Querier, path *PathOptions, n any) {
+	b.WriteString("JSON_SET").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, "JSON_OBJECT()").Comma()
+		path.mysqlPath(b)
+		b.Comma().WriteString("COALESCE").Wrap(func(b *sql.Builder) {
+			b.WriteString("JSON_EXTRACT").Wrap(func(b *sql.Builder) {
+				b.Join(doc).Comma()
+				path.mysqlPath(b)
+			})
+			b.WriteString(", 0")
+		})
+		b.WriteString(" + ").Arg(n)
+	})
+}
+
 type postgres struct{}
 
 // Append implements the driver.Append method.
@@ -177,9 +294,112 @@ func (*postgres) Append(u *sql.UpdateBuilder, column string, elems []any, opts .
 	})
 }
 
+// Set implements the driver.Set method.
+func (*postgres) Set(b *sql.Builder, doc sql.Querier, path *PathOptions, value any) {
+	b.WriteString("jsonb_set").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, "'{}'::jsonb").Comma()
+		path.pgArrayPath(b)
+		b.Comma().Arg(marshalArg(value))
+		b.Comma().WriteString("true")
+	})
+}
+
+// Remove implements the driver.Remove method.
+func (*postgres) Remove(b *sql.Builder, doc sql.Querier, path *PathOptions) {
+	b.Join(doc).WriteString(" #- ")
+	path.pgArrayPath(b)
+}
+
+// Merge implements the driver.Merge method. PostgreSQL does not provide a function for
+// merge patches, and the "||" operator merges only the top-level keys. Hence, the patch
+// is applied recursively on the target object (or on an empty one), key by key.
+func (d *postgres) Merge(b *sql.Builder, doc sql.Querier, path *PathOptions, patch map[string]any) {
+	mergeAt(b, doc, path, "'{}'::jsonb", func(b *sql.Builder, target sql.Querier) {
+		d.merge(b, target, patch)
+	})
+}
+
+// merge writes the expression for applying the merge patch on the target.
+//
+//	jsonb_set(CASE WHEN jsonb_typeof(t) = 'object' THEN t ELSE '{}'::jsonb END - $1::text || $2::jsonb, ARRAY[$3::text], <merge(t->$4::text)>, true)
+func (d *postgres) merge(b *sql.Builder, target sql.Querier, patch map[string]any) {
+	var (
+		removed, nested []string
+		values          = make(map[string]any)
+	)
+	for _, k := range sortedKeys(patch) {
+		switch v := patch[k].(type) {
+		case nil:
+			removed = append(removed, k)
+		case map[string]any:
+			nested = append(nested, k)
+		default:
+			values[k] = v
+		}
+	}
+	for range nested {
+		b.WriteString("jsonb_set(")
+	}
+	b.Wrap(func(b *sql.Builder) {
+		b.WriteString("CASE WHEN jsonb_typeof").Wrap(func(b *sql.Builder) {
+			b.Join(target)
+		})
+		b.WriteString(" = 'object' THEN ").Join(target).WriteString(" ELSE '{}'::jsonb END")
+		for _, k := range removed {
+			b.WriteString(" - ").Arg(k).WriteString("::text")
+		}
+		if len(values) > 0 {
+			b.WriteString(" || ").Arg(marshalArg(values)).WriteString("::jsonb")
+		}
+	})
+	for _, k := range nested {
+		b.WriteString(", ARRAY[").Arg(k).WriteString("::text], ")
+		d.merge(b, sql.ExprFunc(func(b *sql.Builder) {
+			b.Wrap(func(b *sql.Builder) {
+				b.Join(target)
+			})
+			b.WriteString("->").Arg(k).WriteString("::text")
+		}), patch[k].(map[string]any))
+		b.WriteString(", true)")
+	}
+}
+
+// Insert implements the driver.Insert method.
+func (*postgres) Insert(b *sql.Builder, doc sql.Querier, path *PathOptions, index int, value any) {
+	b.WriteString("jsonb_insert").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, emptyDoc(path, "'[]'::jsonb", "'{}'::jsonb")).Comma()
+		elemPath(path, index).pgArrayPath(b)
+		b.Comma().Arg(marshalArg(value))
+	})
+}
+
+// Increment implements the driver.Increment method.
+func (*postgres) Increment(b *sql.Builder, doc sql.Querier, path *PathOptions, n any) {
+	b.WriteString("jsonb_set").Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, "'{}'::jsonb").Comma()
+		path.pgArrayPath(b)
+		b.Comma().WriteString("to_jsonb").Wrap(func(b *sql.Builder) {
+			b.WriteString("COALESCE").Wrap(func(b *sql.Builder) {
+				b.Wrap(func(b *sql.Builder) {
+					b.Join(doc).WriteString(" #>> ")
+					path.pgArrayPath(b)
+				})
+				b.WriteString("::numeric, 0")
+			})
+			b.WriteString(" + ").Arg(n)
+		})
+		b.Comma().WriteString("true")
+	})
+}
+
 // driver groups all dialect-specific methods.
 type driver interface {
 	Append(u *sql.UpdateBuilder, column string, elems []any, opts ...Option)
+	Set(b *sql.Builder, doc sql.Querier, path *PathOptions, value any)
+	Remove(b *sql.Builder, doc sql.Querier, path *PathOptions)
+	Merge(b *sql.Builder, doc sql.Querier, path *PathOptions, patch map[string]any)
+	Insert(b *sql.Builder, doc sql.Querier, path *PathOptions, index int, value any)
+	Increment(b *sql.Builder, doc sql.Querier, path *PathOptions, n any)
 }
 
 func newDriver(name string) (driver, error) {
@@ -213,6 +433,73 @@ func setCase(u *sql.UpdateBuilder, column string, w when) {
 	}))
 }
 
+// coalesce writes the given JSON document, or the empty value in case it is NULL.
+func coalesce(b *sql.Builder, doc sql.Querier, empty string) *sql.Builder {
+	return b.WriteString("COALESCE").Wrap(func(b *sql.Builder) {
+		b.Join(doc).Comma().WriteString(empty)
+	})
+}
+
+// emptyDoc returns the empty value of a document that holds an array at the given
+// path. i.e. an empty array if the path is empty, and an empty object otherwise.
+func emptyDoc(path *PathOptions, array, object string) string {
+	if len(path.Path) == 0 {
+		return array
+	}
+	return object
+}
+
+// elemPath returns the path of the array element at the given index.
+func elemPath(path *PathOptions, index int) *PathOptions {
+	elem := *path
+	elem.Path = append(path.Path[:len(path.Path):len(path.Path)], "["+strconv.Itoa(index)+"]")
+	return &elem
+}
+
+// mergeAt writes the expression for merging a patch into the document or into its value at
+// the given path. The merge function receives the target value, which might be NULL.
+func mergeAt(b *sql.Builder, doc sql.Querier, path *PathOptions, empty string, merge func(*sql.Builder, sql.Querier)) {
+	if len(path.Path) == 0 {
+		merge(b, doc)
+		return
+	}
+	fn, writePath := "JSON_SET", path.mysqlPath
+	target := sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("JSON_EXTRACT").Wrap(func(b *sql.Builder) {
+			b.Join(doc).Comma()
+			path.mysqlPath(b)
+		})
+	})
+	if b.Dialect() == dialect.Postgres {
+		fn, writePath = "jsonb_set", path.pgArrayPath
+		target = sql.ExprFunc(func(b *sql.Builder) {
+			b.Wrap(func(b *sql.Builder) {
+				b.Join(doc).WriteString(" #> ")
+				path.pgArrayPath(b)
+			})
+		})
+	}
+	b.WriteString(fn).Wrap(func(b *sql.Builder) {
+		coalesce(b, doc, empty).Comma()
+		writePath(b)
+		b.Comma()
+		merge(b, target)
+		if fn == "jsonb_set" {
+			b.Comma().WriteString("true")
+		}
+	})
+}
+
+// sortedKeys returns the keys of the given map in sorted order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func isPrimitive(v any) bool {
 	switch reflect.TypeOf(v).Kind() {
 	case reflect.Array, reflect.Slice, reflect.Map, reflect.Struct, reflect.Ptr, reflect.Interface:
@@ -5,6 +5,7 @@
 package sqljson
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -405,6 +406,310 @@ func Append[T any](u *sql.UpdateBuilder, column string, elems []T, opts ...Optio
 	drv.Append(u, column, vs, opts...)
 }
 
+// ArrayContains returns a predicate for checking that an array column contains all
+// the given elements. Array columns are stored as native arrays in Postgres (see
+// entsql.Array), and as JSON arrays in MySQL and SQLite.
+//
+//	sqljson.ArrayContains("tags", []string{"a", "b"})
+func ArrayContains[T any](column string, elems []T) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.Ident(column).WriteString(" @> ").Join(sql.Array(elems))
+		case dialect.MySQL:
+			b.WriteString("JSON_CONTAINS").Wrap(func(b *sql.Builder) {
+				b.Ident(column).Comma().Join(jsonArray(elems))
+			})
+		default:
+			notExistsIn(b, jsonArray(elems), sql.ExprFunc(func(b *sql.Builder) { b.Ident(column) }))
+		}
+	})
+}
+
+// ArrayContainedBy returns a predicate for checking that all the elements of an
+// array column are contained in the given elements.
+//
+//	sqljson.ArrayContainedBy("tags", []string{"a", "b"})
+func ArrayContainedBy[T any](column string, elems []T) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.Ident(column).WriteString(" <@ ").Join(sql.Array(elems))
+		case dialect.MySQL:
+			b.WriteString("JSON_CONTAINS").Wrap(func(b *sql.Builder) {
+				b.Join(jsonArray(elems)).Comma().Ident(column)
+			})
+		default:
+			// Unlike Postgres and MySQL, JSON_EACH of NULL returns no rows.
+			b.Ident(column).WriteString(" IS NOT NULL AND ")
+			notExistsIn(b, sql.ExprFunc(func(b *sql.Builder) { b.Ident(column) }), jsonArray(elems))
+		}
+	})
+}
+
+// ArrayOverlaps returns a predicate for checking that an array column
+// contains at least one of the given elements.
+//
+//	sqljson.ArrayOverlaps("tags", []string{"a", "b"})
+func ArrayOverlaps[T any](column string, elems []T) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.Ident(column).WriteString(" && ").Join(sql.Array(elems))
+		case dialect.MySQL:
+			b.WriteString("JSON_OVERLAPS").Wrap(func(b *sql.Builder) {
+				b.Ident(column).Comma().Join(jsonArray(elems))
+			})
+		default:
+			b.WriteString("EXISTS").Wrap(func(b *sql.Builder) {
+				b.WriteString("SELECT * FROM JSON_EACH").Wrap(func(b *sql.Builder) {
+					b.Ident(column)
+				})
+				b.WriteString(" WHERE ").Ident("value").WriteString(" IN ").Wrap(func(b *sql.Builder) {
+					b.WriteString("SELECT ").Ident("value").WriteString(" FROM JSON_EACH").Wrap(func(b *sql.Builder) {
+						b.Join(jsonArray(elems))
+					})
+				})
+			})
+		}
+	})
+}
+
+// ArrayLen returns a predicate for comparing the length of an array column with the given size.
+//
+//	sqljson.ArrayLen("tags", sql.OpGT, 1)
+func ArrayLen(column string, op sql.Op, size int) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Join(ArrayLenPath(column)).WriteOp(op).Arg(size)
+	})
+}
+
+// ArrayLenPath returns an SQL expression for getting the length of an array column.
+func ArrayLenPath(column string) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.WriteString("COALESCE").Wrap(func(b *sql.Builder) {
+				b.WriteString("CARDINALITY").Wrap(func(b *sql.Builder) {
+					b.Ident(column)
+				})
+				b.Comma().WriteString("0")
+			})
+		case dialect.MySQL:
+			b.WriteString("JSON_LENGTH").Wrap(func(b *sql.Builder) {
+				b.Ident(column)
+			})
+		default:
+			b.WriteString("JSON_ARRAY_LENGTH").Wrap(func(b *sql.Builder) {
+				b.Ident(column)
+			})
+		}
+	})
+}
+
+// ArrayAppend writes to the given SQL builder the SQL command for appending elements
+// to an array column. In MySQL and SQLite, it is identical to Append.
+//
+//	ArrayAppend(u, "tags", []string{"a", "b"})
+//	UPDATE "t" SET "tags" = ARRAY_CAT("tags", $1)
+func ArrayAppend[T any](u *sql.UpdateBuilder, column string, elems []T) {
+	if u.Dialect() != dialect.Postgres {
+		Append(u, column, elems)
+		return
+	}
+	if len(elems) == 0 {
+		u.AddError(fmt.Errorf("sqljson: cannot append an empty array to column %q", column))
+		return
+	}
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("ARRAY_CAT").Wrap(func(b *sql.Builder) {
+			b.Ident(column).Comma().Join(sql.Array(elems))
+		})
+	}))
+}
+
+// Set writes to the given SQL builder the SQL command for setting the value at the given
+// path of a JSON column, without rewriting the whole document. A NULL column is treated as
+// an empty JSON object, and only the last element of the path is created if it is missing.
+//
+//	Set(u, "c", "a8m", sqljson.DotPath("a.b"))
+//	UPDATE "t" SET "c" = jsonb_set(COALESCE("c", '{}'::jsonb), '{a,b}', $1, true)
+func Set(u *sql.UpdateBuilder, column string, value any, opts ...Option) {
+	drv, path, ok := updatePath(u, column, "set", opts)
+	if !ok {
+		return
+	}
+	doc := document(u, column)
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		drv.Set(b, doc, path, value)
+	}))
+}
+
+// Remove writes to the given SQL builder the SQL command for removing the value
+// at the given path of a JSON column (e.g. an object key or an array element).
+//
+//	Remove(u, "c", sqljson.DotPath("a.b"))
+//	UPDATE "t" SET "c" = "c" #- '{a,b}'
+func Remove(u *sql.UpdateBuilder, column string, opts ...Option) {
+	drv, path, ok := updatePath(u, column, "remove", opts)
+	if !ok {
+		return
+	}
+	doc := document(u, column)
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		drv.Remove(b, doc, path)
+	}))
+}
+
+// Merge writes to the given SQL builder the SQL command for merging the given patch into
+// a JSON column (or into its value at the given path) as defined in RFC 7386 (JSON Merge
+// Patch). i.e. objects are merged recursively, and keys with null values are removed.
+//
+//	Merge(u, "c", map[string]any{"a": 1, "b": nil})
+//	UPDATE `t` SET `c` = JSON_MERGE_PATCH(COALESCE(`c`, JSON_OBJECT()), CAST(? AS JSON))
+func Merge(u *sql.UpdateBuilder, column string, patch any, opts ...Option) {
+	drv, err := newDriver(u.Dialect())
+	if err != nil {
+		u.AddError(err)
+		return
+	}
+	obj, err := patchObject(patch)
+	if err != nil {
+		u.AddError(fmt.Errorf("sqljson: merging column %q: %w", column, err))
+		return
+	}
+	path := identPath(column, opts...)
+	doc := document(u, column)
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		drv.Merge(b, doc, path, obj)
+	}))
+}
+
+// Insert writes to the given SQL builder the SQL command for inserting a value to the JSON
+// array at the given path of a column, before the element at the given index. The value is
+// appended to the array in case the index is greater than or equal to the array length.
+//
+//	Insert(u, "c", 1, "a8m", sqljson.Path("a"))
+//	UPDATE `t` SET `c` = JSON_ARRAY_INSERT(`c`, '$.a[1]', CAST(? AS JSON))
+func Insert(u *sql.UpdateBuilder, column string, index int, value any, opts ...Option) {
+	if index < 0 {
+		u.AddError(fmt.Errorf("sqljson: invalid index %d for inserting to column %q", index, column))
+		return
+	}
+	drv, err := newDriver(u.Dialect())
+	if err != nil {
+		u.AddError(err)
+		return
+	}
+	path := identPath(column, opts...)
+	doc := document(u, column)
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		drv.Insert(b, doc, path, index, value)
+	}))
+}
+
+// Number is the constraint of the numeric types that are supported by Increment.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Increment writes to the given SQL builder the SQL command for adding n to the numeric
+// value at the given path of a JSON column. Missing (or null) values are treated as 0.
+//
+//	Increment(u, "c", 1, sqljson.Path("a"))
+//	UPDATE `t` SET `c` = JSON_SET(COALESCE(`c`, JSON_OBJECT()), '$.a', COALESCE(JSON_EXTRACT(`c`, '$.a'), 0) + ?)
+func Increment[T Number](u *sql.UpdateBuilder, column string, n T, opts ...Option) {
+	drv, path, ok := updatePath(u, column, "increment", opts)
+	if !ok {
+		return
+	}
+	doc := document(u, column)
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		drv.Increment(b, doc, path, n)
+	}))
+}
+
+// updatePath returns the dialect driver and the path options for
+// updating a JSON column, and reports an error if the path is empty.
+func updatePath(u *sql.UpdateBuilder, column, op string, opts []Option) (driver, *PathOptions, bool) {
+	drv, err := newDriver(u.Dialect())
+	if err != nil {
+		u.AddError(err)
+		return nil, nil, false
+	}
+	path := identPath(column, opts...)
+	if len(path.Path) == 0 {
+		u.AddError(fmt.Errorf("sqljson: missing path to %s in column %q", op, column))
+		return nil, nil, false
+	}
+	return drv, path, true
+}
+
+// document returns the current value of a JSON column in the UPDATE statement. i.e. the value
+// that was set to the column by previous calls (e.g. Set), or the column itself. This allows
+// applying multiple modifications to the same column in one statement.
+func document(u *sql.UpdateBuilder, column string) sql.Querier {
+	v, ok := u.Value(column)
+	return sql.ExprFunc(func(b *sql.Builder) {
+		switch v := v.(type) {
+		case sql.Querier:
+			b.Wrap(func(b *sql.Builder) {
+				b.Join(v)
+			})
+		default:
+			if buf, isBytes := v.([]byte); isBytes {
+				// JSON documents are encoded as text by the
+				// different dialects, and not as binary strings.
+				v = string(buf)
+			}
+			if ok {
+				b.Arg(v)
+			} else {
+				b.Ident(column)
+			}
+		}
+	})
+}
+
+// patchObject returns the JSON object of the given merge patch.
+func patchObject(patch any) (map[string]any, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]any
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil || obj == nil {
+		return nil, fmt.Errorf("merge patch must be a JSON object, got: %s", buf)
+	}
+	return obj, nil
+}
+
+// jsonArray returns an SQL argument for the JSON array of the given elements.
+func jsonArray(elems any) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Arg(marshalArg(elems))
+	})
+}
+
+// notExistsIn writes the SQLite predicate for checking that all
+// the elements of the JSON array x are contained in the JSON array y.
+func notExistsIn(b *sql.Builder, x, y sql.Querier) {
+	b.WriteString("NOT EXISTS").Wrap(func(b *sql.Builder) {
+		b.WriteString("SELECT * FROM JSON_EACH").Wrap(func(b *sql.Builder) {
+			b.Join(x)
+		})
+		b.WriteString(" WHERE ").Ident("value").WriteString(" NOT IN ").Wrap(func(b *sql.Builder) {
+			b.WriteString("SELECT ").Ident("value").WriteString(" FROM JSON_EACH").Wrap(func(b *sql.Builder) {
+				b.Join(y)
+			})
+		})
+	})
+}
+
 // Option allows for calling database JSON paths with functional options.
 type Option func(*PathOptions)
 
@@ -533,3 +533,261 @@ func TestAppend(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		input     sql.Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqljson.Set(u, "c", "a8m", sqljson.DotPath("a.b"))
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = jsonb_set(COALESCE("c", '{}'::jsonb), '{a, b}', $1, true)`,
+			wantArgs:  []any{`"a8m"`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqljson.Remove(u, "c", sqljson.DotPath("a.b[1]"))
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = "c" #- '{a, b, 1}'`,
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqljson.Merge(u, "c", map[string]any{"a": 1, "b": nil, "c": map[string]any{"d": nil, "e": true}})
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = jsonb_set((CASE WHEN jsonb_typeof("c") = 'object' THEN "c" ELSE '{}'::jsonb END - $1::text || $2::jsonb), ARRAY[$3::text], (CASE WHEN jsonb_typeof(("c")->$4::text) = 'object' THEN ("c")->$5::text ELSE '{}'::jsonb END - $6::text || $7::jsonb), true)`,
+			wantArgs:  []any{"b", `{"a":1}`, "c", "c", "c", "d", `{"e":true}`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqljson.Merge(u, "c", map[string]any{"a": 1}, sqljson.Path("x"))
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = jsonb_set(COALESCE("c", '{}'::jsonb), '{x}', (CASE WHEN jsonb_typeof(("c" #> '{x}')) = 'object' THEN ("c" #> '{x}') ELSE '{}'::jsonb END || $1::jsonb), true)`,
+			wantArgs:  []any{`{"a":1}`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqljson.Insert(u, "c", 1, "a8m", sqljson.Path("a"))
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = jsonb_insert(COALESCE("c", '{}'::jsonb), '{a, 1}', $1)`,
+			wantArgs:  []any{`"a8m"`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqljson.Increment(u, "c", 2, sqljson.Path("a"))
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = jsonb_set(COALESCE("c", '{}'::jsonb), '{a}', to_jsonb(COALESCE(("c" #>> '{a}')::numeric, 0) + $1), true)`,
+			wantArgs:  []any{2},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				u.Set("c", []byte(`{}`))
+				sqljson.Set(u, "c", 1, sqljson.Path("a"))
+				sqljson.Remove(u, "c", sqljson.Path("b"))
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = (jsonb_set(COALESCE($1, '{}'::jsonb), '{a}', $2, true)) #- '{b}'`,
+			wantArgs:  []any{"{}", "1"},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.MySQL).Update("t")
+				sqljson.Set(u, "c", "a8m", sqljson.DotPath("a.b"))
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_SET(COALESCE(`c`, JSON_OBJECT()), '$.a.b', CAST(? AS JSON))",
+			wantArgs:  []any{`"a8m"`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.MySQL).Update("t")
+				sqljson.Merge(u, "c", map[string]any{"a": 1}, sqljson.Path("x"))
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_SET(COALESCE(`c`, JSON_OBJECT()), '$.x', JSON_MERGE_PATCH(COALESCE(JSON_EXTRACT(`c`, '$.x'), JSON_OBJECT()), CAST(? AS JSON)))",
+			wantArgs:  []any{`{"a":1}`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.MySQL).Update("t")
+				sqljson.Insert(u, "c", 0, 1)
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_ARRAY_INSERT(COALESCE(`c`, JSON_ARRAY()), '$[0]', CAST(? AS JSON))",
+			wantArgs:  []any{"1"},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.MySQL).Update("t")
+				sqljson.Increment(u, "c", 2, sqljson.Path("a"))
+				sqljson.Remove(u, "c", sqljson.Path("b"))
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_REMOVE((JSON_SET(COALESCE(`c`, JSON_OBJECT()), '$.a', COALESCE(JSON_EXTRACT(`c`, '$.a'), 0) + ?)), '$.b')",
+			wantArgs:  []any{2},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("t")
+				sqljson.Set(u, "c", []int{1}, sqljson.Path("a"))
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_SET(COALESCE(`c`, JSON_OBJECT()), '$.a', JSON(?))",
+			wantArgs:  []any{"[1]"},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("t")
+				sqljson.Remove(u, "c", sqljson.DotPath("a.b[1]"))
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_REMOVE(`c`, '$.a.b[1]')",
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("t")
+				sqljson.Merge(u, "c", struct {
+					A int  `json:"a"`
+					B *int `json:"b"`
+				}{A: 1})
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_PATCH(COALESCE(`c`, JSON_OBJECT()), JSON(?))",
+			wantArgs:  []any{`{"a":1,"b":null}`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("t")
+				sqljson.Insert(u, "c", 1, "a8m", sqljson.Path("a"))
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_SET(COALESCE(`c`, JSON_OBJECT()), '$.a', (SELECT JSON_GROUP_ARRAY(JSON(`v`)) FROM (SELECT `key` AS `k`, `c` -> `fullkey` AS `v` FROM JSON_EACH(`c`, '$.a') UNION ALL SELECT ?, JSON(?) ORDER BY 1)))",
+			wantArgs:  []any{0.5, `"a8m"`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("t")
+				sqljson.Increment(u, "c", 1.5, sqljson.Path("a"))
+				return u
+			}(),
+			wantQuery: "UPDATE `t` SET `c` = JSON_SET(COALESCE(`c`, JSON_OBJECT()), '$.a', COALESCE(JSON_EXTRACT(`c`, '$.a'), 0) + ?)",
+			wantArgs:  []any{1.5},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestUpdate_Errors(t *testing.T) {
+	u := sql.Dialect(dialect.MySQL).Update("t")
+	sqljson.Set(u, "c", 1)
+	require.EqualError(t, u.Err(), `sqljson: missing path to set in column "c"`)
+
+	u = sql.Dialect(dialect.SQLite).Update("t")
+	sqljson.Insert(u, "c", -1, 1)
+	require.EqualError(t, u.Err(), `sqljson: invalid index -1 for inserting to column "c"`)
+
+	u = sql.Dialect(dialect.Postgres).Update("t")
+	sqljson.Merge(u, "c", []int{1})
+	require.EqualError(t, u.Err(), `sqljson: merging column "c": merge patch must be a JSON object, got: [1]`)
+}
+
+func TestArray(t *testing.T) {
+	tests := []struct {
+		input     sql.Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("t")).Where(sqljson.ArrayContains("c", []string{"a", "b"})),
+			wantQuery: `SELECT * FROM "t" WHERE "c" @> $1`,
+			wantArgs:  []any{`{"a","b"}`},
+		},
+		{
+			input:     sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("t")).Where(sqljson.ArrayContains("c", []string{"a", "b"})),
+			wantQuery: "SELECT * FROM `t` WHERE JSON_CONTAINS(`c`, ?)",
+			wantArgs:  []any{`["a","b"]`},
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("t")).Where(sqljson.ArrayContains("c", []int{1})),
+			wantQuery: "SELECT * FROM `t` WHERE NOT EXISTS(SELECT * FROM JSON_EACH(?) WHERE `value` NOT IN (SELECT `value` FROM JSON_EACH(`c`)))",
+			wantArgs:  []any{`[1]`},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("t")).Where(sqljson.ArrayContainedBy("c", []int{1, 2})),
+			wantQuery: `SELECT * FROM "t" WHERE "c" <@ $1`,
+			wantArgs:  []any{`{1,2}`},
+		},
+		{
+			input:     sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("t")).Where(sqljson.ArrayContainedBy("c", []int{1, 2})),
+			wantQuery: "SELECT * FROM `t` WHERE JSON_CONTAINS(?, `c`)",
+			wantArgs:  []any{`[1,2]`},
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("t")).Where(sqljson.ArrayContainedBy("c", []int{1, 2})),
+			wantQuery: "SELECT * FROM `t` WHERE `c` IS NOT NULL AND NOT EXISTS(SELECT * FROM JSON_EACH(`c`) WHERE `value` NOT IN (SELECT `value` FROM JSON_EACH(?)))",
+			wantArgs:  []any{`[1,2]`},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("t")).Where(sqljson.ArrayOverlaps("c", []string{`a"b`})),
+			wantQuery: `SELECT * FROM "t" WHERE "c" && $1`,
+			wantArgs:  []any{`{"a\"b"}`},
+		},
+		{
+			input:     sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("t")).Where(sqljson.ArrayOverlaps("c", []string{"a"})),
+			wantQuery: "SELECT * FROM `t` WHERE JSON_OVERLAPS(`c`, ?)",
+			wantArgs:  []any{`["a"]`},
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("t")).Where(sqljson.ArrayOverlaps("c", []string{"a"})),
+			wantQuery: "SELECT * FROM `t` WHERE EXISTS(SELECT * FROM JSON_EACH(`c`) WHERE `value` IN (SELECT `value` FROM JSON_EACH(?)))",
+			wantArgs:  []any{`["a"]`},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("t")).Where(sqljson.ArrayLen("c", sql.OpGT, 1)),
+			wantQuery: `SELECT * FROM "t" WHERE COALESCE(CARDINALITY("c"), 0) > $1`,
+			wantArgs:  []any{1},
+		},
+		{
+			input:     sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("t")).Where(sqljson.ArrayLen("c", sql.OpEQ, 0)),
+			wantQuery: "SELECT * FROM `t` WHERE JSON_ARRAY_LENGTH(`c`) = ?",
+			wantArgs:  []any{0},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("t")
+				sqljson.ArrayAppend(u, "c", []string{"a"})
+				return u
+			}(),
+			wantQuery: `UPDATE "t" SET "c" = ARRAY_CAT("c", $1)`,
+			wantArgs:  []any{`{"a"}`},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
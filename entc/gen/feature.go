@@ -159,6 +159,148 @@ var (
 		},
 	}
 
+	// FeatureSoftDelete provides a feature-flag for soft-deleting the rows of
+	// schemas annotated with entsql.SoftDelete.
+	FeatureSoftDelete = Feature{
+		Name:        "sql/softdelete",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows soft-deleting rows by setting their deleted_at column and filtering them out from queries",
+	}
+
+	// FeaturePaginate provides a feature-flag for keyset (cursor) pagination.
+	FeaturePaginate = Feature{
+		Name:        "sql/paginate",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows paginating queries using opaque cursors that are based on the query ordering",
+	}
+
+	// FeatureHistory provides a feature-flag for recording the changes of
+	// schemas annotated with entsql.History in companion history tables.
+	FeatureHistory = Feature{
+		Name:        "sql/history",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows recording the changes of entities in companion history tables",
+	}
+
+	// FeatureDataLoader provides a feature-flag for coalescing concurrent Get and
+	// edge queries that share a request-scoped context into batched queries.
+	FeatureDataLoader = Feature{
+		Name:        "sql/dataloader",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows batching and caching Get and edge queries that are executed concurrently within a request-scoped context",
+	}
+
+	// FeatureQueryCache provides a feature-flag for invalidating the query results
+	// that are cached by sqlcache.Driver when the tables they were read from change.
+	FeatureQueryCache = Feature{
+		Name:        "sql/querycache",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds hooks for invalidating the cached query results of a table when it is mutated",
+	}
+
+	// FeatureMultiTenancy provides a feature-flag for scoping the queries and mutations
+	// of schemas with a field annotated with entsql.TenantKey to the tenant of their context.
+	FeatureMultiTenancy = Feature{
+		Name:        "sql/multitenancy",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows filtering queries and scoping mutations by the tenant that is stored in their context",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "dialect/sql/internal/multitenancy",
+				Format: "internal/tenant.go",
+				Skip: func(g *Graph) bool {
+					for _, n := range g.Nodes {
+						if n.tenantKey() != nil {
+							return false
+						}
+					}
+					return true
+				},
+			},
+		},
+		cleanup: func(c *Config) error {
+			return remove(filepath.Join(c.Target, "internal"), "tenant.go")
+		},
+	}
+
+	// FeatureBulkLoad provides a feature-flag for bulk-loading entities using the COPY
+	// command in PostgreSQL, or chunked INSERT statements in other dialects.
+	FeatureBulkLoad = Feature{
+		Name:        "sql/bulkload",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds the Load method to create-bulk builders for loading large amounts of entities efficiently",
+	}
+
+	// FeatureReturning provides a feature-flag for returning the entities that were
+	// updated or deleted by the bulk builders, using the RETURNING clause where supported.
+	FeatureReturning = Feature{
+		Name:        "sql/returning",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds the SaveReturning and DeleteReturning methods to the update and delete builders",
+	}
+
+	// FeatureSavepoint provides a feature-flag for nesting transactions in each
+	// other using savepoints, instead of failing with ErrTxStarted.
+	FeatureSavepoint = Feature{
+		Name:        "sql/savepoint",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows starting nested transactions from transactional clients using savepoints",
+	}
+
+	// FeatureTxRetry provides a feature-flag for running functions in transactions
+	// that are retried on serialization failures and deadlocks.
+	FeatureTxRetry = Feature{
+		Name:        "sql/txretry",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds the WithTx method to the client for running functions in transactions that are retried on serialization failures and deadlocks",
+	}
+
+	// FeatureIter provides a feature-flag for streaming the results of queries
+	// using iterators, and for reading them in batches using keyset pagination.
+	FeatureIter = Feature{
+		Name:        "sql/iter",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds the Iter and EachBatch methods to the query builders for streaming and batching their results",
+	}
+
+	// FeatureEdgeAggregate provides a feature-flag for eager-loading the number of
+	// neighbors of edges, or aggregations on them, without loading the neighbors.
+	FeatureEdgeAggregate = Feature{
+		Name:        "sql/edgeaggregate",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds the With<Edge>Count and With<Edge>Aggregate options to the query builders for loading the counts and aggregations of edges",
+	}
+
+	// FeatureJSONUpdate provides a feature-flag for updating the values of JSON
+	// fields in place, without loading and rewriting their documents.
+	FeatureJSONUpdate = Feature{
+		Name:        "sql/jsonupdate",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Adds methods to the update builders for setting, removing, merging, inserting and incrementing values inside JSON fields in place",
+	}
+
+	// FeatureJSONPath provides a feature-flag for generating typed predicates
+	// on the values inside JSON fields, based on the Go types of the fields.
+	FeatureJSONPath = Feature{
+		Name:        "sql/jsonpath",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generates typed predicates on the values inside JSON fields that are encoded from Go structs (e.g. user.MetaPath().Address().City().EQ(\"Paris\"))",
+	}
+
 	// AllFeatures holds a list of all feature-flags.
 	AllFeatures = []Feature{
 		FeaturePrivacy,
@@ -174,6 +316,20 @@ var (
 		FeatureUpsert,
 		FeatureVersionedMigration,
 		FeatureGlobalID,
+		FeatureSoftDelete,
+		FeaturePaginate,
+		FeatureHistory,
+		FeatureDataLoader,
+		FeatureQueryCache,
+		FeatureMultiTenancy,
+		FeatureBulkLoad,
+		FeatureReturning,
+		FeatureSavepoint,
+		FeatureTxRetry,
+		FeatureIter,
+		FeatureEdgeAggregate,
+		FeatureJSONUpdate,
+		FeatureJSONPath,
 	}
 	// allFeatures includes all public and private features.
 	allFeatures = append(AllFeatures, featureMultiSchema)
@@ -16,10 +16,12 @@ import (
 	"path/filepath"
 	"runtime"
 	"runtime/debug"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template/parse"
 
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
@@ -159,6 +161,14 @@ func (a *Annotations) Set(k string, v any) {
 // It fails if one of the schemas is invalid.
 func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 	defer catch(&err)
+	if enabled, _ := c.FeatureEnabled(FeatureHistory.Name); enabled {
+		hs, err := historySchemas(c, schemas)
+		check(err, "create history schemas")
+		schemas = append(schemas, hs...)
+	}
+	if enabled, _ := c.FeatureEnabled(FeatureJSONPath.Name); !enabled {
+		dropJSONTypes(schemas)
+	}
 	g = &Graph{Config: c, Nodes: make([]*Type, 0, len(schemas)), Schemas: schemas}
 	for i := range schemas {
 		g.addNode(schemas[i])
@@ -176,6 +186,13 @@ func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 		g.addIndexes(schemas[i])
 	}
 	check(g.edgeSchemas(), "resolving edges")
+	for _, t := range g.Nodes {
+		if t.History() {
+			expect(t.HasOneFieldID(), "history is not supported for schema %q with composite identifier", t.Name)
+			t.history, _ = g.typ(t.Name + historySuffix)
+		}
+	}
+	check(g.checkTenantFields(), "multi-tenancy")
 	aliases(g)
 	g.defaults()
 	if c.Storage != nil && c.Storage.Init != nil {
@@ -200,6 +217,32 @@ func (g *Graph) MutableNodes() []*Type {
 	return nodes
 }
 
+// TenantField returns the tenant field of the first multi-tenant node in the graph, or nil
+// if there are none. All tenant fields in the graph share the same type. See TenantKey
+// in entsql for more info.
+func (g *Graph) TenantField() *Field {
+	for _, n := range g.Nodes {
+		if f := n.TenantField(); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// checkTenantFields ensures all tenant fields in the graph share the same type.
+func (g *Graph) checkTenantFields() error {
+	first := g.TenantField()
+	if first == nil {
+		return nil
+	}
+	for _, n := range g.Nodes {
+		if f := n.TenantField(); f != nil && f.Type.String() != first.Type.String() {
+			return fmt.Errorf("tenant field %q of schema %q has type %s, but %q of schema %q has type %s", f.Name, n.Name, f.Type, first.Name, first.typ.Name, first.Type)
+		}
+	}
+	return nil
+}
+
 // defaultIDType holds the default value for IDType.
 var defaultIDType = &field.TypeInfo{Type: field.TypeInt}
 
@@ -305,6 +348,121 @@ func (g *Graph) addNode(schema *load.Schema) {
 	g.Nodes = append(g.Nodes, t)
 }
 
+// historySuffix is appended to the name of a schema to form the name of its history schema.
+const historySuffix = "History"
+
+// historyFields holds the fields that are defined on each history schema.
+var historyFields = []*field.Descriptor{
+	field.Time("history_time").
+		Immutable().
+		Comment("HistoryTime holds the time the change was recorded.").
+		Descriptor(),
+	field.String("operation").
+		Immutable().
+		Comment("Operation holds the mutation operation (ent.Op) that made the change.").
+		Descriptor(),
+	field.String("actor").
+		Optional().
+		Nillable().
+		Immutable().
+		Comment("Actor holds the actor that made the change, as was stored in the context.").
+		Descriptor(),
+	field.Strings("changed_fields").
+		Optional().
+		Immutable().
+		Comment("ChangedFields holds the names of the fields that were changed by the mutation.").
+		Descriptor(),
+	field.JSON("old_values", map[string]any{}).
+		Optional().
+		Immutable().
+		Comment("OldValues holds the values of the changed fields before they were updated.").
+		Descriptor(),
+}
+
+// historySchemas returns the companion history schemas of the schemas that were
+// annotated with entsql.History. A history schema holds the fields that describe
+// the change, the "ref" field that holds the entity ID, and a nillable copy of the
+// entity fields that holds its state after the change (or before it was deleted).
+func historySchemas(c *Config, schemas []*load.Schema) ([]*load.Schema, error) {
+	names := make(map[string]bool, len(schemas))
+	for _, s := range schemas {
+		names[s.Name] = true
+	}
+	var hs []*load.Schema
+	for _, s := range schemas {
+		if ant := sqlAnnotate(s.Annotations); s.View || ant == nil || !ant.History {
+			continue
+		}
+		h := &load.Schema{
+			Name:        s.Name + historySuffix,
+			Annotations: make(map[string]any),
+		}
+		if names[h.Name] {
+			return nil, fmt.Errorf("history schema %q of schema %q already exists", h.Name, s.Name)
+		}
+		if ant := sqlAnnotate(s.Annotations); ant.Schema != "" {
+			h.Annotations[ant.Name()] = &entsql.Annotation{Schema: ant.Schema}
+		}
+		reserved := make(map[string]bool, len(historyFields)+1)
+		for _, fd := range historyFields {
+			f, err := load.NewField(fd)
+			if err != nil {
+				return nil, err
+			}
+			h.Fields = append(h.Fields, f)
+			reserved[f.Name] = true
+		}
+		reserved["ref"] = true
+		ref := &load.Field{
+			Name:      "ref",
+			Info:      c.IDType,
+			Immutable: true,
+			Comment:   "Ref holds the ID of the changed entity.",
+		}
+		if ref.Info == nil {
+			ref.Info = defaultIDType
+		}
+		h.Fields = append(h.Fields, ref)
+		for _, f := range s.Fields {
+			switch {
+			case f.Name == "id":
+				ref.Info = f.Info
+				continue
+			case reserved[f.Name]:
+				return nil, fmt.Errorf("schema %q cannot contain field %q as it is reserved by its history schema", s.Name, f.Name)
+			// Fields with external ValueScanners are skipped, as
+			// their scanners are not available for the history schema.
+			case f.ValueScanner:
+				continue
+			// Sensitive fields are not recorded in the history.
+			case f.Sensitive:
+				continue
+			}
+			hf := &load.Field{
+				Name:       f.Name,
+				Info:       f.Info,
+				Tag:        f.Tag,
+				Size:       f.Size,
+				Nillable:   true,
+				Optional:   true,
+				Immutable:  true,
+				StorageKey: f.StorageKey,
+				SchemaType: f.SchemaType,
+				Comment:    f.Comment,
+			}
+			// Enum values are stored as strings, as enums
+			// can be extended or changed in the future.
+			if f.Info.Type == field.TypeEnum {
+				hf.Info = &field.TypeInfo{Type: field.TypeString}
+			}
+			h.Fields = append(h.Fields, hf)
+		}
+		h.Indexes = append(h.Indexes, &load.Index{Fields: []string{"ref", "history_time"}})
+		hs = append(hs, h)
+	}
+	return hs, nil
+}
+
 // addIndexes adds the indexes for the schema type.
 func (g *Graph) addIndexes(schema *load.Schema) {
 	typ, _ := g.typ(schema.Name)
@@ -608,6 +766,11 @@ func (g *Graph) edgeSchemas() error {
 				}
 			}
 			if edgeT.HasCompositeID() {
+				// The generated mutations of edge schemas with composite
+				// identifiers cannot load the old version of their rows.
+				if v := edgeT.VersionField(); v != nil {
+					return fmt.Errorf("optimistic-lock field %q of edge schema %q cannot be used with a composite primary key", v.Name, edgeT.Name)
+				}
 				continue
 			}
 			hasI := func() bool {
@@ -773,6 +936,11 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 	// Append indexes to tables after all columns were added (including relation columns).
 	for _, n := range g.Nodes {
 		table := tables[n.Table()]
+		if p := n.Partition(); p != nil && table != nil {
+			if err := addPartitionKey(table, p); err != nil {
+				return nil, err
+			}
+		}
 		for _, idx := range n.Indexes {
 			table.AddIndex(idx.Name, idx.Unique, idx.Columns)
 			// Set the entsql.IndexAnnotation from the schema if exists.
@@ -852,6 +1020,22 @@ func addCompositePK(t *schema.Table, n *Type) error {
 	return nil
 }
 
+// addPartitionKey adds the partition key columns to the primary key of a partitioned table,
+// as the unique constraints of partitioned tables must include all partition key columns.
+func addPartitionKey(t *schema.Table, p *entsql.TablePartition) error {
+	for _, name := range p.Columns {
+		c, ok := t.Column(name)
+		if !ok {
+			return fmt.Errorf("missing partition key column %q for table %q", name, t.Name)
+		}
+		if !slices.Contains(t.PrimaryKey, c) {
+			c.Key = schema.PrimaryKey
+			t.PrimaryKey = append(t.PrimaryKey, c)
+		}
+	}
+	return nil
+}
+
 // fkSymbol returns the symbol of the foreign-key constraint for edges of type O2M, M2O and O2O.
 // It returns the symbol of the storage-key if it was provided, and generate custom one otherwise.
 func fkSymbol(e *Edge, ownerT, refT *schema.Table) string {
@@ -379,6 +379,41 @@ func TestAbortDuplicateFK(t *testing.T) {
 	require.EqualError(t, err, `duplicate foreign-key symbol "owner_id" found in tables "cars" and "pets"`)
 }
 
+func TestNewGraphThroughOptimisticLock(t *testing.T) {
+	var (
+		user = &load.Schema{
+			Name: "User",
+			Edges: []*load.Edge{
+				{Name: "groups", Type: "Group", Through: &struct{ N, T string }{N: "joined_groups", T: "UserGroup"}},
+			},
+		}
+		group = &load.Schema{
+			Name: "Group",
+			Edges: []*load.Edge{
+				{Name: "users", Type: "User", Inverse: true, RefName: "groups"},
+			},
+		}
+		userGroup = &load.Schema{
+			Name: "UserGroup",
+			Fields: []*load.Field{
+				{Name: "user_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+				{Name: "group_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+				{Name: "version", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: dict("EntSQL", map[string]any{"optimistic_lock": true})},
+			},
+			Edges: []*load.Edge{
+				{Name: "user", Type: "User", Field: "user_id", Unique: true, Required: true},
+				{Name: "group", Type: "Group", Field: "group_id", Unique: true, Required: true},
+			},
+		}
+	)
+	_, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group, userGroup)
+	require.NoError(t, err, "edge schemas with an id field can be versioned")
+
+	userGroup.Annotations = dict(field.Annotation{}.Name(), map[string]any{"ID": []string{"user_id", "group_id"}})
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, group, userGroup)
+	require.EqualError(t, err, `entc/gen: resolving edges: optimistic-lock field "version" of edge schema "UserGroup" cannot be used with a composite primary key`)
+}
+
 func TestPosition(t *testing.T) {
 	antFn := func(s string) map[string]any {
 		return map[string]any{entsql.Annotation{}.Name(): map[string]string{"schema": s}}
@@ -502,6 +537,74 @@ func TestEnsureCorrectFK(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestHistorySchemas(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+			{Name: "status", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []struct{ N, V string }{{N: "active", V: "active"}}},
+			{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+		},
+		Annotations: map[string]any{entsql.Annotation{}.Name(): entsql.History()},
+	}
+	pet := &load.Schema{Name: "Pet"}
+	c := &Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureHistory}}
+	g, err := NewGraph(c, user, pet)
+	require.NoError(t, err)
+	require.Len(t, g.Nodes, 3)
+	require.True(t, g.Nodes[0].History())
+	require.False(t, g.Nodes[1].History())
+	h, err := g.Nodes[0].HistoryType()
+	require.NoError(t, err)
+	require.Equal(t, "UserHistory", h.Name)
+	require.False(t, h.History())
+	for _, name := range []string{"history_time", "operation", "actor", "changed_fields", "old_values", "ref", "name", "status"} {
+		f, ok := h.fields[name]
+		require.True(t, ok, name)
+		require.False(t, f.Unique)
+	}
+	require.True(t, h.fields["name"].Nillable)
+	require.Equal(t, field.TypeString, h.fields["status"].Type.Type)
+	_, ok := h.fields["password"]
+	require.False(t, ok, "sensitive fields are not recorded")
+	require.Len(t, g.Nodes[0].HistoryFields(), 2)
+	_, err = g.Nodes[1].HistoryType()
+	require.Error(t, err)
+
+	// History is not generated without the feature-flag.
+	g, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet)
+	require.NoError(t, err)
+	require.Len(t, g.Nodes, 2)
+	require.False(t, g.Nodes[0].History())
+
+	// Reserved fields.
+	user.Fields = append(user.Fields, &load.Field{Name: "operation", Info: &field.TypeInfo{Type: field.TypeString}})
+	_, err = NewGraph(c, user, pet)
+	require.EqualError(t, err, `entc/gen: create history schemas: schema "User" cannot contain field "operation" as it is reserved by its history schema`)
+}
+
+func TestNewGraphJSONTypes(t *testing.T) {
+	type Meta struct {
+		City string `json:"city"`
+	}
+	schema := func() *load.Schema {
+		return &load.Schema{
+			Name:   "User",
+			Fields: []*load.Field{{Name: "meta", Info: field.JSON("meta", Meta{}).Descriptor().Info}},
+		}
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureJSONPath}}, schema())
+	require.NoError(t, err)
+	require.NotNil(t, g.Schemas[0].Fields[0].Info.JSON)
+	require.NotNil(t, g.Nodes[0].Fields[0].JSONPath())
+
+	// The structure of JSON fields is not stored in the snapshots without the feature-flag.
+	g, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, schema())
+	require.NoError(t, err)
+	require.Nil(t, g.Schemas[0].Fields[0].Info.JSON)
+	require.Nil(t, g.Nodes[0].Fields[0].JSONPath())
+}
+
 func TestGraph_Gen(t *testing.T) {
 	require := require.New(t)
 	target := filepath.Join(t.TempDir(), "ent")
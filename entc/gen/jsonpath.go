@@ -0,0 +1,290 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+)
+
+type (
+	// JSONPath represents a node in the tree of typed path predicates that
+	// are generated for the values inside JSON fields. Each node is generated
+	// as a Go type that holds the path to the JSON value it represents.
+	JSONPath struct {
+		// Name of the generated Go type.
+		Name string
+		// Kind of the JSON value. See field.JSONType for details.
+		Kind reflect.Kind
+		// Keys holds the keys of JSON objects that are encoded from structs.
+		Keys []*JSONPathKey
+		// Elem holds the elements of JSON arrays or the values of JSON objects
+		// that are encoded from maps.
+		Elem *JSONPath
+		// Root reports if this node represents the field itself.
+		Root bool
+		// nested is the node that is used for the values inside the field
+		// that have the type of the root. i.e. recursive root types.
+		nested *JSONPath
+	}
+
+	// JSONPathKey represents a key of a JSON object in the path tree.
+	JSONPathKey struct {
+		// Func is the name of the method that returns the key node.
+		Func string
+		// Key is the JSON key.
+		Key string
+		// Node represents the value of the key.
+		Node *JSONPath
+	}
+)
+
+// JSONPath returns the root of the typed path predicates tree of the field, or nil if
+// the field is not a JSON field that stores (possibly nested) objects of Go structs.
+func (f Field) JSONPath() *JSONPath {
+	if !f.hasJSONPath() || f.typ == nil {
+		return nil
+	}
+	return f.typ.jsonPaths()[f.Name]
+}
+
+// hasJSONPath reports if typed path predicates are generated for the field.
+func (f Field) hasJSONPath() bool {
+	return f.IsJSON() && !f.IsArray() && f.Type.JSON != nil && hasJSONStruct(f.Type.JSON)
+}
+
+// jsonPaths returns the path trees of the JSON fields of the type, keyed by the field names.
+// The trees are built together to ensure the names of their Go types are unique in the package
+// of the type, and do not conflict with the other identifiers that are generated in it.
+func (t Type) jsonPaths() map[string]*JSONPath {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.hasJSONPath() {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	b := &jsonPathBuilder{
+		names:     t.predicateIdents(),
+		ancestors: make(map[string]*JSONPath),
+	}
+	for _, f := range fields {
+		b.names[f.StructField()+"Path"] = true
+	}
+	// Reserve the names of the roots before building the trees, as nested nodes
+	// of one field may be named after another field. e.g. "meta" and "meta_address".
+	roots := make(map[string]bool)
+	for _, f := range fields {
+		if name := f.StructField() + "JSONPath"; !b.names[name] {
+			b.names[name], roots[name] = true, true
+		}
+	}
+	paths := make(map[string]*JSONPath, len(fields))
+	for _, f := range fields {
+		if name := f.StructField() + "JSONPath"; roots[name] {
+			delete(b.names, name)
+		}
+		paths[f.Name] = b.build(f.StructField(), f.Type.JSON, true)
+	}
+	return paths
+}
+
+// dropJSONTypes removes the structure of the JSON fields from the given schemas. It is used
+// only for generating typed path predicates, and it is not stored in the schema snapshots
+// unless the "sql/jsonpath" feature is enabled.
+func dropJSONTypes(schemas []*load.Schema) {
+	for _, s := range schemas {
+		for _, f := range s.Fields {
+			if f.Info != nil {
+				f.Info.JSON = nil
+			}
+		}
+	}
+}
+
+// predicateIdents returns the identifiers of the predicates that are generated for the ID and
+// the fields of the type, in its package.
+func (t Type) predicateIdents() map[string]bool {
+	idents := map[string]bool{"And": true, "Or": true, "Not": true}
+	fields := t.Fields
+	if t.ID != nil {
+		fields = append([]*Field{t.ID}, fields...)
+	}
+	for _, f := range fields {
+		idents[f.StructField()] = true
+		for _, op := range f.Ops() {
+			idents[f.StructField()+op.Name()] = true
+		}
+		if f.IsArray() {
+			for _, op := range []string{"Contains", "ContainedBy", "Overlaps"} {
+				idents[f.StructField()+op] = true
+			}
+		}
+	}
+	return idents
+}
+
+// checkJSONPaths ensures the functions of the typed path predicates that are generated
+// by the "sql/jsonpath" feature do not conflict with the predicates of the type.
+func (t Type) checkJSONPaths() error {
+	if t.Config == nil || !t.featureEnabled(FeatureJSONPath) {
+		return nil
+	}
+	idents := t.predicateIdents()
+	for _, f := range t.Fields {
+		if name := f.StructField() + "Path"; f.hasJSONPath() && idents[name] {
+			return fmt.Errorf("typed path predicates function %s of JSON field %q of schema %q conflicts with a predicate of the same name", name, f.Name, t.Name)
+		}
+	}
+	return nil
+}
+
+// Nodes returns all nodes of the tree that starts at this node, in depth-first order.
+func (p *JSONPath) Nodes() []*JSONPath {
+	var (
+		nodes []*JSONPath
+		seen  = make(map[*JSONPath]bool)
+		walk  func(*JSONPath)
+	)
+	walk = func(n *JSONPath) {
+		if n == nil || seen[n] {
+			return
+		}
+		seen[n] = true
+		nodes = append(nodes, n)
+		for _, k := range n.Keys {
+			walk(k.Node)
+		}
+		walk(n.Elem)
+	}
+	walk(p)
+	return nodes
+}
+
+// IsObject reports if the node represents a JSON object that is encoded from a Go struct.
+func (p *JSONPath) IsObject() bool { return p.Kind == reflect.Struct }
+
+// IsMap reports if the node represents a JSON object that is encoded from a Go map.
+func (p *JSONPath) IsMap() bool { return p.Kind == reflect.Map }
+
+// IsArray reports if the node represents a JSON array.
+func (p *JSONPath) IsArray() bool { return p.Kind == reflect.Slice }
+
+// IsString reports if the node represents a JSON string.
+func (p *JSONPath) IsString() bool { return p.Kind == reflect.String }
+
+// IsBool reports if the node represents a JSON boolean.
+func (p *JSONPath) IsBool() bool { return p.Kind == reflect.Bool }
+
+// IsNumeric reports if the node represents a JSON number.
+func (p *JSONPath) IsNumeric() bool { return p.Cast() != "" && !p.IsBool() }
+
+// IsScalar reports if the node represents a JSON scalar (string, number or boolean).
+func (p *JSONPath) IsScalar() bool { return p.IsString() || p.IsBool() || p.IsNumeric() }
+
+// Type returns the Go type of the arguments of the node predicates.
+func (p *JSONPath) Type() string { return p.Kind.String() }
+
+// Cast returns the type that the value of the node is cast to in PostgreSQL
+// when it is compared with the predicate arguments. Strings are not cast.
+func (p *JSONPath) Cast() string {
+	switch p.Kind {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return "int"
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32:
+		return "bigint"
+	case reflect.Uint64:
+		return "numeric"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	default:
+		return ""
+	}
+}
+
+// jsonPathBuilder builds the path trees of the JSON fields of a type.
+type jsonPathBuilder struct {
+	// names holds the identifiers that are taken in the package of the type.
+	names map[string]bool
+	// ancestors of the current node that represent named struct
+	// types. Used for referencing them from recursive types.
+	ancestors map[string]*JSONPath
+}
+
+// jsonPathFuncs holds the names of the methods that are
+// generated for object nodes, and cannot be used as keys.
+var jsonPathFuncs = map[string]bool{"Exists": true, "IsNull": true, "NotNull": true}
+
+func (b *jsonPathBuilder) build(prefix string, t *field.JSONType, root bool) *JSONPath {
+	if n, ok := b.ancestors[t.Ident]; ok && t.Kind == reflect.Struct && len(t.Fields) == 0 {
+		if !n.Root {
+			return n
+		}
+		// The root node does not have a path, and cannot represent the
+		// values inside the field. Its keys are shared with a nested node.
+		if n.nested == nil {
+			n.nested = &JSONPath{Name: b.name(prefix), Kind: n.Kind}
+		}
+		return n.nested
+	}
+	n := &JSONPath{Name: b.name(prefix), Kind: t.Kind, Root: root}
+	switch t.Kind {
+	case reflect.Struct:
+		if len(t.Fields) == 0 {
+			// Structs without keys (or with an unknown structure).
+			n.Kind = reflect.Interface
+			break
+		}
+		if t.Ident != "" {
+			b.ancestors[t.Ident] = n
+			defer delete(b.ancestors, t.Ident)
+		}
+		for _, f := range t.Fields {
+			fn := f.Name
+			if jsonPathFuncs[fn] {
+				fn += "Key"
+			}
+			n.Keys = append(n.Keys, &JSONPathKey{Func: fn, Key: f.Key, Node: b.build(prefix+fn, f.Type, false)})
+		}
+		if n.nested != nil {
+			n.nested.Keys = n.Keys
+		}
+	case reflect.Slice:
+		n.Elem = b.build(prefix+"Elem", t.Elem, false)
+	case reflect.Map:
+		n.Elem = b.build(prefix+"Value", t.Elem, false)
+	}
+	return n
+}
+
+// name returns a unique name for the Go type of a node with the given prefix.
+func (b *jsonPathBuilder) name(prefix string) string {
+	name := prefix + "JSONPath"
+	for c := 1; b.names[name]; c++ {
+		name = prefix + strconv.Itoa(c) + "JSONPath"
+	}
+	b.names[name] = true
+	return name
+}
+
+// hasJSONStruct reports if the JSON type (or one of its nested types) is a struct object.
+func hasJSONStruct(t *field.JSONType) bool {
+	switch {
+	case t == nil:
+		return false
+	case t.Kind == reflect.Struct && len(t.Fields) > 0:
+		return true
+	default:
+		return hasJSONStruct(t.Elem)
+	}
+}
@@ -40,6 +40,7 @@ type Storage struct {
 	Name       string             // storage name.
 	Builder    reflect.Type       // query builder type.
 	Dialects   []string           // supported dialects.
+	Drivers    []string           // additional driver names that are accepted by Open.
 	IdentName  string             // identifier name (fields and funcs).
 	Imports    []string           // import packages needed.
 	SchemaMode SchemaMode         // schema mode support.
@@ -54,11 +55,13 @@ var drivers = []*Storage{
 		Name:      "sql",
 		IdentName: "SQL",
 		Builder:   reflect.TypeOf(&sql.Selector{}),
-		Dialects:  []string{"dialect.SQLite", "dialect.MySQL", "dialect.Postgres"},
+		Dialects:  []string{"dialect.SQLite", "dialect.MySQL", "dialect.Postgres", "dialect.SQLServer"},
+		Drivers:   []string{"dialect.SQLiteDriver"},
 		Imports: []string{
 			"database/sql/driver",
 			"entgo.io/ent/dialect/sql",
 			"entgo.io/ent/dialect/sql/sqlgraph",
+			"entgo.io/ent/dialect/sql/sqlgeo",
 			"entgo.io/ent/dialect/sql/sqljson",
 			"entgo.io/ent/schema/field",
 		},
@@ -11,8 +11,10 @@ import (
 	"fmt"
 	"go/token"
 	"go/types"
+	"maps"
 	"path"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"unicode"
@@ -22,6 +24,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/dialect/sql/sqlgeo"
 	"entgo.io/ent/entc/load"
 	entschema "entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
@@ -53,6 +56,8 @@ type (
 		// ForeignKeys are the foreign-keys that resides in the type table.
 		ForeignKeys []*ForeignKey
 		foreignKeys map[string]struct{}
+		// history holds the companion history type, if there is one.
+		history *Type
 		// Annotations that were defined for the field in the schema.
 		// The mapping is from the Annotation.Name() to a JSON decoded object.
 		Annotations Annotations
@@ -272,6 +277,26 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			typ.fields[f.Name] = tf
 		}
 	}
+	if typ.SoftDelete() {
+		if err := typ.setupSoftDelete(); err != nil {
+			return nil, err
+		}
+	}
+	if err := typ.checkVersionField(); err != nil {
+		return nil, err
+	}
+	if err := typ.checkTenantField(); err != nil {
+		return nil, err
+	}
+	if err := typ.checkArrayFields(); err != nil {
+		return nil, err
+	}
+	if err := typ.checkPartition(); err != nil {
+		return nil, err
+	}
+	if err := typ.checkJSONPaths(); err != nil {
+		return nil, err
+	}
 	return typ, nil
 }
 
@@ -317,6 +342,253 @@ func (t Type) EntSQL() *entsql.Annotation {
 	return sqlAnnotate(t.Annotations)
 }
 
+// softDeleteField is the name of the field that marks rows as soft-deleted.
+const softDeleteField = "deleted_at"
+
+// SoftDelete reports if the rows of the type are soft-deleted. i.e. the "sql/softdelete"
+// feature-flag is enabled, and the schema was annotated with entsql.SoftDelete.
+func (t Type) SoftDelete() bool {
+	if t.Config == nil || !t.featureEnabled(FeatureSoftDelete) {
+		return false
+	}
+	ant := t.EntSQL()
+	return ant != nil && ant.SoftDelete
+}
+
+// SoftDeleteField returns the field that holds the deletion time of soft-deleted rows.
+func (t Type) SoftDeleteField() (*Field, error) {
+	f, ok := t.fields[softDeleteField]
+	if !ok || !t.SoftDelete() {
+		return nil, fmt.Errorf("type %q is not configured for soft-deletion", t.Name)
+	}
+	return f, nil
+}
+
+// setupSoftDelete adds the soft-deletion field to the type in case it was not defined
+// explicitly in the schema (e.g. using a mixin), or ensures its definition is valid.
+func (t *Type) setupSoftDelete() error {
+	if f, ok := t.fields[softDeleteField]; ok {
+		if !f.IsTime() || !f.Optional {
+			return fmt.Errorf("soft-delete field %q of schema %q must be an optional time field", softDeleteField, t.Name)
+		}
+		return nil
+	}
+	f := &Field{
+		cfg: t.Config,
+		def: &load.Field{
+			Name:     softDeleteField,
+			Info:     &field.TypeInfo{Type: field.TypeTime},
+			Optional: true,
+			Nillable: true,
+			Comment:  "DeletedAt holds the time the entity was soft-deleted.",
+		},
+		typ:         t,
+		Name:        softDeleteField,
+		Type:        &field.TypeInfo{Type: field.TypeTime},
+		Optional:    true,
+		Nillable:    true,
+		StructTag:   structTag(softDeleteField, ""),
+		UserDefined: true,
+	}
+	t.Fields = append(t.Fields, f)
+	t.fields[f.Name] = f
+	return nil
+}
+
+// History reports if the changes of the type are recorded in a companion history type.
+// i.e. the "sql/history" feature-flag is enabled, and the schema was annotated with
+// entsql.History.
+func (t Type) History() bool {
+	if t.Config == nil || t.IsView() || !t.featureEnabled(FeatureHistory) {
+		return false
+	}
+	ant := t.EntSQL()
+	return ant != nil && ant.History
+}
+
+// HistoryType returns the companion type that holds the history of the type.
+func (t Type) HistoryType() (*Type, error) {
+	if t.history == nil {
+		return nil, fmt.Errorf("type %q has no history type", t.Name)
+	}
+	return t.history, nil
+}
+
+// HistoryFields returns the fields of the type that are recorded in its history type.
+func (t Type) HistoryFields() []*Field {
+	h, err := t.HistoryType()
+	if err != nil {
+		return nil
+	}
+	fields := make([]*Field, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		if _, ok := h.fields[f.Name]; ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// VersionField returns the optimistic-lock (version) field of the type, if there is
+// one. i.e. the field that was annotated with entsql.OptimisticLock.
+func (t Type) VersionField() *Field {
+	for _, f := range t.Fields {
+		if ant := f.EntSQL(); ant != nil && ant.OptimisticLock {
+			return f
+		}
+	}
+	return nil
+}
+
+// checkVersionField ensures the optimistic-lock field of the type, if there is one, is valid.
+func (t Type) checkVersionField() error {
+	var fields []string
+	for _, f := range t.Fields {
+		ant := f.EntSQL()
+		if ant == nil || !ant.OptimisticLock {
+			continue
+		}
+		switch {
+		case !f.Type.Type.Integer():
+			return fmt.Errorf("optimistic-lock field %q of schema %q must be an integer field", f.Name, t.Name)
+		case f.Nillable:
+			return fmt.Errorf("optimistic-lock field %q of schema %q cannot be nillable", f.Name, t.Name)
+		}
+		fields = append(fields, f.Name)
+	}
+	if len(fields) > 1 {
+		return fmt.Errorf("schema %q cannot have multiple optimistic-lock fields: %s", t.Name, strings.Join(fields, ", "))
+	}
+	if v := t.VersionField(); v != nil {
+		for _, f := range t.Fields {
+			// The setter of the expected version is named after the field. e.g. SetExpectedVersion.
+			if f.StructField() == "Expected"+v.StructField() {
+				return fmt.Errorf("field %q of schema %q conflicts with the expected value setter of optimistic-lock field %q", f.Name, t.Name, v.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// TenantField returns the tenant field of the type, if there is one. i.e. the "sql/multitenancy"
+// feature-flag is enabled, and the field was annotated with entsql.TenantKey.
+func (t Type) TenantField() *Field {
+	if t.Config == nil || !t.featureEnabled(FeatureMultiTenancy) {
+		return nil
+	}
+	return t.tenantKey()
+}
+
+// tenantKey returns the field annotated with entsql.TenantKey, if there is one.
+func (t Type) tenantKey() *Field {
+	for _, f := range t.Fields {
+		if ant := f.EntSQL(); ant != nil && ant.TenantKey {
+			return f
+		}
+	}
+	return nil
+}
+
+// TenantEdges returns the edges of the type that point to multi-tenant types. i.e. edges
+// whose neighbors must belong to the tenant of the context when they are added.
+func (t Type) TenantEdges() (edges []*Edge) {
+	for _, e := range t.EdgesWithID() {
+		if e.Type.TenantField() != nil && e.Type.HasOneFieldID() {
+			edges = append(edges, e)
+		}
+	}
+	return
+}
+
+// checkTenantField ensures the tenant field of the type, if there is one, is valid.
+func (t Type) checkTenantField() error {
+	var fields []string
+	for _, f := range t.Fields {
+		ant := f.EntSQL()
+		if ant == nil || !ant.TenantKey {
+			continue
+		}
+		switch {
+		case !f.IsString() && !f.IsUUID() && !f.Type.Numeric():
+			return fmt.Errorf("tenant field %q of schema %q must be a string, numeric or UUID field", f.Name, t.Name)
+		case f.Nillable:
+			return fmt.Errorf("tenant field %q of schema %q cannot be nillable", f.Name, t.Name)
+		}
+		fields = append(fields, f.Name)
+	}
+	if len(fields) > 1 {
+		return fmt.Errorf("schema %q cannot have multiple tenant fields: %s", t.Name, strings.Join(fields, ", "))
+	}
+	return nil
+}
+
+// checkArrayFields ensures the fields that were annotated with entsql.Array are slices of supported element types.
+func (t Type) checkArrayFields() error {
+	for _, f := range t.Fields {
+		ant := f.EntSQL()
+		if ant == nil || !ant.Array {
+			continue
+		}
+		if !f.IsJSON() || f.Type.RType == nil || f.Type.RType.Kind != reflect.Slice || pgArrayTypes[f.ArrayElem()] == "" {
+			return fmt.Errorf("array field %q of schema %q must be a slice of strings, numbers, booleans or UUIDs", f.Name, t.Name)
+		}
+	}
+	return nil
+}
+
+// Partition returns the partitioning of the type table, if it was annotated with entsql.Partition.
+func (t Type) Partition() *entsql.TablePartition {
+	if ant := t.EntSQL(); ant != nil && !t.IsView() {
+		return ant.Partition
+	}
+	return nil
+}
+
+// checkPartition ensures the partition key of the type, if there is one, is valid. The
+// partition key is added to the primary key, and must be included in unique fields.
+func (t Type) checkPartition() error {
+	p := t.Partition()
+	if p == nil {
+		return nil
+	}
+	switch p.Type {
+	case entsql.PartitionRange, entsql.PartitionList, entsql.PartitionHash:
+	default:
+		return fmt.Errorf("invalid partition type %q for schema %q", p.Type, t.Name)
+	}
+	if len(p.Columns) == 0 {
+		return fmt.Errorf("missing partition key columns for schema %q", t.Name)
+	}
+	for _, c := range p.Columns {
+		f, ok := t.fieldByColumn(c)
+		switch {
+		case !ok:
+			return fmt.Errorf("unknown partition key column %q for schema %q", c, t.Name)
+		case f.Optional || f.Nillable:
+			return fmt.Errorf("partition key field %q of schema %q cannot be optional", f.Name, t.Name)
+		}
+	}
+	for _, f := range t.Fields {
+		if f.Unique && (len(p.Columns) != 1 || p.Columns[0] != f.StorageKey()) {
+			return fmt.Errorf("unique field %q of partitioned schema %q must be the partition key. Use a unique index that includes the partition key instead", f.Name, t.Name)
+		}
+	}
+	return nil
+}
+
+// fieldByColumn returns the field (or the ID) that is stored in the given column.
+func (t Type) fieldByColumn(column string) (*Field, bool) {
+	if t.HasOneFieldID() && t.ID.StorageKey() == column {
+		return t.ID, true
+	}
+	for _, f := range t.Fields {
+		if f.StorageKey() == column {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
 // Package returns the package name of this node.
 func (t Type) Package() string {
 	if name := t.PackageAlias(); name != "" {
@@ -439,6 +711,18 @@ func (t Type) HasUpdateCheckers() bool {
 	return false
 }
 
+// MutableJSONFields returns all mutable JSON fields of the type that are
+// stored as JSON documents (i.e. not as database arrays).
+func (t Type) MutableJSONFields() []*Field {
+	var fields []*Field
+	for _, f := range t.MutableFields() {
+		if f.IsJSON() && !f.IsArray() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // FKEdges returns all edges that reside on the type table as foreign-keys.
 func (t Type) FKEdges() (edges []*Edge) {
 	for _, e := range t.Edges {
@@ -551,10 +835,11 @@ func (t Type) NumConstraint() int {
 }
 
 // MutableFields returns all type fields that are mutable (on update).
+// Generated fields are computed by the database, and are not mutable.
 func (t Type) MutableFields() []*Field {
 	fields := make([]*Field, 0, len(t.Fields))
 	for _, f := range t.Fields {
-		if f.Immutable {
+		if f.Immutable || f.IsGenerated() {
 			continue
 		}
 		if e, err := f.Edge(); err == nil && e.Immutable {
@@ -652,7 +937,8 @@ func (t *Type) AddIndex(idx *load.Index) error {
 	if len(idx.Fields) == 0 && len(idx.Edges) == 0 {
 		return errors.New("missing fields or edges")
 	}
-	switch ant := sqlIndexAnnotate(idx.Annotations); {
+	ant := sqlIndexAnnotate(idx.Annotations)
+	switch {
 	case ant == nil:
 	case len(ant.PrefixColumns) != 0 && ant.Prefix != 0:
 		return fmt.Errorf("index %q cannot contain both entsql.Prefix and entsql.PrefixColumn in annotation", index.Name)
@@ -660,6 +946,12 @@ func (t *Type) AddIndex(idx *load.Index) error {
 		return fmt.Errorf("entsql.Prefix is used in a multicolumn index %q. Use entsql.PrefixColumn instead", index.Name)
 	case len(ant.PrefixColumns) > len(idx.Fields)+len(idx.Fields):
 		return fmt.Errorf("index %q has more entsql.PrefixColumn than column in its definitions", index.Name)
+	case ant.FullText && idx.Unique:
+		return fmt.Errorf("full-text index %q cannot be unique", index.Name)
+	case ant.FullText && len(idx.Edges) > 0:
+		return fmt.Errorf("full-text index %q cannot contain edges", index.Name)
+	case ant.Spatial && (idx.Unique || len(idx.Fields) != 1 || len(idx.Edges) > 0):
+		return fmt.Errorf("spatial index %q must be a non-unique index of one field", index.Name)
 	}
 	for _, name := range idx.Fields {
 		var f *Field
@@ -668,6 +960,12 @@ func (t *Type) AddIndex(idx *load.Index) error {
 		} else if f = t.fields[name]; f == nil {
 			return fmt.Errorf("unknown index field %q", name)
 		}
+		if ant != nil && ant.FullText && !f.IsString() {
+			return fmt.Errorf("full-text index %q field %q is not a string", index.Name, name)
+		}
+		if ant != nil && ant.Spatial && !f.IsSpatial() {
+			return fmt.Errorf("spatial index %q field %q is not a geometry", index.Name, name)
+		}
 		index.Columns = append(index.Columns, f.StorageKey())
 	}
 	for _, name := range idx.Edges {
@@ -696,6 +994,13 @@ func (t *Type) AddIndex(idx *load.Index) error {
 		parts := append([]string{strings.ToLower(t.Name)}, index.Columns...)
 		index.Name = strings.Join(parts, "_")
 	}
+	if p := t.Partition(); p != nil && index.Unique {
+		for _, c := range p.Columns {
+			if !slices.Contains(index.Columns, c) {
+				return fmt.Errorf("unique index %q of partitioned schema %q must include the partition key column %q", index.Name, t.Name, c)
+			}
+		}
+	}
 	t.Indexes = append(t.Indexes, index)
 	return nil
 }
@@ -1032,6 +1337,10 @@ func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
 		err = fmt.Errorf("GoType %q for field %q must be converted to the basic %q type for validators", tf.Type, f.Name, tf.Type.Type)
 	case ant != nil && ant.Default != "" && (ant.DefaultExpr != "" || ant.DefaultExprs != nil):
 		err = fmt.Errorf("field %q cannot have both default value and default expression annotations", f.Name)
+	case tf.IsGenerated() && (f.Default || f.UpdateDefault || ant.Default != "" || ant.DefaultExpr != "" || ant.DefaultExprs != nil):
+		err = fmt.Errorf("generated field %q cannot have default values", f.Name)
+	case tf.IsGenerated() && ant.GeneratedType != "" && ant.GeneratedType != entsql.Stored && ant.GeneratedType != entsql.Virtual:
+		err = fmt.Errorf("invalid generated type %q for field %q", ant.GeneratedType, f.Name)
 	case tf.HasValueScanner() && tf.IsJSON():
 		err = fmt.Errorf("json field %q cannot have an external ValueScanner", f.Name)
 	}
@@ -1350,6 +1659,13 @@ func (f Field) IsEnum() bool { return f.Type != nil && f.Type.Type == field.Type
 // that was referenced by one of the edges.
 func (f Field) IsEdgeField() bool { return f.fk != nil }
 
+// IsGenerated reports if the field is stored in a generated column. Generated fields
+// are read-only, and they are not settable on the create and update builders.
+func (f Field) IsGenerated() bool {
+	ant := f.EntSQL()
+	return ant != nil && (ant.GeneratedExpr != "" || len(ant.GeneratedExprs) > 0)
+}
+
 // IsDeprecated returns true if the field is deprecated.
 func (f Field) IsDeprecated() bool { return f.def != nil && f.def.Deprecated }
 
@@ -1587,9 +1903,25 @@ func (f Field) Column() *schema.Column {
 	if ant := f.EntSQL(); ant != nil && ant.Collation != "" {
 		c.Collation = ant.Collation
 	}
+	if ant := f.EntSQL(); f.IsGenerated() {
+		c.Generated = &schema.Generated{
+			Expr:  ant.GeneratedExpr,
+			Exprs: ant.GeneratedExprs,
+			Type:  string(ant.GeneratedType),
+		}
+	}
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
 	}
+	// Array fields are stored as native arrays in Postgres,
+	// unless their schema type was defined explicitly.
+	if f.IsArray() && c.SchemaType[dialect.Postgres] == "" {
+		c.SchemaType = maps.Clone(c.SchemaType)
+		if c.SchemaType == nil {
+			c.SchemaType = make(map[string]string)
+		}
+		c.SchemaType[dialect.Postgres] = pgArrayTypes[f.ArrayElem()]
+	}
 	return c
 }
 
@@ -1669,6 +2001,67 @@ func (f Field) sqlComment() string {
 	return ""
 }
 
+// pgArrayTypes maps the element types of array fields to their Postgres array types.
+var pgArrayTypes = map[string]string{
+	"string":    "text[]",
+	"bool":      "boolean[]",
+	"int8":      "smallint[]",
+	"int16":     "smallint[]",
+	"uint8":     "smallint[]",
+	"int32":     "integer[]",
+	"uint16":    "integer[]",
+	"int":       "bigint[]",
+	"int64":     "bigint[]",
+	"uint":      "bigint[]",
+	"uint32":    "bigint[]",
+	"uint64":    "bigint[]",
+	"float32":   "real[]",
+	"float64":   "double precision[]",
+	"uuid.UUID": "uuid[]",
+}
+
+// IsArray reports if the field is stored as a native array in Postgres.
+// i.e. a slice field that was annotated with entsql.Array.
+func (f Field) IsArray() bool {
+	ant := f.EntSQL()
+	return ant != nil && ant.Array && f.IsJSON()
+}
+
+// ArrayElem returns the element type of an array field. e.g. "string" for []string.
+func (f Field) ArrayElem() string {
+	return strings.TrimPrefix(f.Type.String(), "[]")
+}
+
+// SearchIndex returns the single-column full-text index of the field, if there is one.
+// i.e. an index that was annotated with entsql.FullText or entsql.TextSearchConfig.
+func (f Field) SearchIndex() *Index {
+	if f.typ == nil {
+		return nil
+	}
+	for _, idx := range f.typ.Indexes {
+		if idx.FullText() && len(idx.Columns) == 1 && idx.Columns[0] == f.StorageKey() {
+			return idx
+		}
+	}
+	return nil
+}
+
+// SearchRankName returns the function/option name for ordering by the full-text search rank of this field.
+func (f Field) SearchRankName() string {
+	return f.OrderName() + "SearchRank"
+}
+
+// IsSpatial reports if the field stores geometries of the sqlgeo package, or of
+// other types that implement the sqlgeo.Geometry interface.
+func (f Field) IsSpatial() bool {
+	return f.Type != nil && f.Type.Type == field.TypeOther && f.Type.RType.Implements(geometryType)
+}
+
+// DistanceName returns the function/option name for ordering by the distance of this field from a geometry.
+func (f Field) DistanceName() string {
+	return f.OrderName() + "Distance"
+}
+
 // StorageKey returns the storage name of the field.
 // SQL column or Gremlin property.
 func (f Field) StorageKey() string {
@@ -1770,6 +2163,7 @@ var (
 	nullTimePType   = reflect.TypeOf((*sql.NullTime)(nil))
 	nullStringType  = reflect.TypeOf(sql.NullString{})
 	nullStringPType = reflect.TypeOf((*sql.NullString)(nil))
+	geometryType    = reflect.TypeOf((*sqlgeo.Geometry)(nil)).Elem()
 )
 
 // BasicType returns a Go expression for the given identifier
@@ -1864,7 +2258,7 @@ func (f Field) enums(lf *load.Field) ([]Enum, error) {
 // Ops returns all predicate operations of the field.
 func (f *Field) Ops() []Op {
 	ops := fieldOps(f)
-	if (f.Name != "id" || !f.HasGoType()) && f.cfg != nil && f.cfg.Storage.Ops != nil {
+	if (f.Name != "id" || !f.HasGoType()) && f.cfg != nil && f.cfg.Storage != nil && f.cfg.Storage.Ops != nil {
 		ops = append(ops, f.cfg.Storage.Ops(f)...)
 	}
 	return ops
@@ -2239,6 +2633,20 @@ func sqlAnnotate(annotation map[string]any) *entsql.Annotation {
 	return annotate
 }
 
+// FullText reports if the index is a full-text index.
+func (i Index) FullText() bool {
+	ant := sqlIndexAnnotate(i.Annotations)
+	return ant != nil && ant.FullText
+}
+
+// TextSearchConfig returns the text search configuration of the full-text index, if it was set.
+func (i Index) TextSearchConfig() string {
+	if ant := sqlIndexAnnotate(i.Annotations); ant != nil {
+		return ant.TextSearchConfig
+	}
+	return ""
+}
+
 // sqlIndexAnnotate extracts the entsql annotation from a loaded annotation format.
 func sqlIndexAnnotate(annotation map[string]any) *entsql.IndexAnnotation {
 	annotate := &entsql.IndexAnnotation{}
@@ -5,8 +5,13 @@
 package gen
 
 import (
+	"reflect"
 	"testing"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	sqlschema "entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/dialect/sql/sqlgeo"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
 
@@ -121,6 +126,339 @@ func TestType_Table(t *testing.T) {
 	}
 }
 
+func TestType_SoftDelete(t *testing.T) {
+	require := require.New(t)
+	schema := &load.Schema{
+		Name:        "T",
+		Annotations: dict("EntSQL", map[string]any{"soft_delete": true}),
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.False(typ.SoftDelete(), "feature-flag is disabled")
+	require.Len(typ.Fields, 1)
+	_, err = typ.SoftDeleteField()
+	require.Error(err)
+
+	typ, err = NewType(&Config{Package: "entc/gen", Features: []Feature{FeatureSoftDelete}}, schema)
+	require.NoError(err)
+	require.True(typ.SoftDelete())
+	require.Len(typ.Fields, 2)
+	f, err := typ.SoftDeleteField()
+	require.NoError(err)
+	require.Equal("deleted_at", f.Name)
+	require.True(f.IsTime())
+	require.True(f.Optional)
+	require.True(f.Nillable)
+	require.Equal("FieldDeletedAt", f.Constant())
+
+	schema.Fields = append(schema.Fields, &load.Field{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true})
+	_, err = NewType(&Config{Package: "entc/gen", Features: []Feature{FeatureSoftDelete}}, schema)
+	require.EqualError(err, `soft-delete field "deleted_at" of schema "T" must be an optional time field`)
+	schema.Fields[1].Info = &field.TypeInfo{Type: field.TypeTime}
+	typ, err = NewType(&Config{Package: "entc/gen", Features: []Feature{FeatureSoftDelete}}, schema)
+	require.NoError(err)
+	require.Len(typ.Fields, 2)
+	f, err = typ.SoftDeleteField()
+	require.NoError(err)
+	require.False(f.Nillable, "user-defined field is used as-is")
+}
+
+func TestType_VersionField(t *testing.T) {
+	require := require.New(t)
+	lock := dict("EntSQL", map[string]any{"optimistic_lock": true})
+	schema := &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "version", Info: &field.TypeInfo{Type: field.TypeInt64}, Annotations: lock},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.NotNil(typ.VersionField())
+	require.Equal("version", typ.VersionField().Name)
+
+	schema.Fields[1].Info = &field.TypeInfo{Type: field.TypeString}
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `optimistic-lock field "version" of schema "T" must be an integer field`)
+	schema.Fields[1].Info = &field.TypeInfo{Type: field.TypeInt}
+	schema.Fields[1].Nillable = true
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `optimistic-lock field "version" of schema "T" cannot be nillable`)
+	schema.Fields[1].Nillable = false
+	schema.Fields = append(schema.Fields, &load.Field{Name: "revision", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: lock})
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `schema "T" cannot have multiple optimistic-lock fields: version, revision`)
+	schema.Fields[2] = &load.Field{Name: "expected_version", Info: &field.TypeInfo{Type: field.TypeInt}}
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `field "expected_version" of schema "T" conflicts with the expected value setter of optimistic-lock field "version"`)
+
+	schema.Fields = schema.Fields[:1]
+	typ, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.Nil(typ.VersionField())
+}
+
+func TestType_TenantField(t *testing.T) {
+	require := require.New(t)
+	tenant := dict("EntSQL", map[string]any{"tenant_key": true})
+	schema := &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "tenant_id", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: tenant},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.Nil(typ.TenantField(), "feature-flag is disabled")
+	typ, err = NewType(&Config{Package: "entc/gen", Features: []Feature{FeatureMultiTenancy}}, schema)
+	require.NoError(err)
+	require.NotNil(typ.TenantField())
+	require.Equal("tenant_id", typ.TenantField().Name)
+
+	schema.Fields[1].Info = &field.TypeInfo{Type: field.TypeBool}
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `tenant field "tenant_id" of schema "T" must be a string, numeric or UUID field`)
+	schema.Fields[1].Info = &field.TypeInfo{Type: field.TypeInt}
+	schema.Fields[1].Nillable = true
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `tenant field "tenant_id" of schema "T" cannot be nillable`)
+	schema.Fields[1].Nillable = false
+	schema.Fields = append(schema.Fields, &load.Field{Name: "org_id", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: tenant})
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `schema "T" cannot have multiple tenant fields: tenant_id, org_id`)
+}
+
+func TestType_TenantEdges(t *testing.T) {
+	require := require.New(t)
+	tenant := dict("EntSQL", map[string]any{"tenant_key": true})
+	user := &load.Schema{
+		Name:  "User",
+		Edges: []*load.Edge{{Name: "docs", Type: "Doc"}, {Name: "friends", Type: "User"}},
+	}
+	doc := &load.Schema{
+		Name: "Doc",
+		Fields: []*load.Field{
+			{Name: "tenant", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: tenant},
+		},
+		Edges: []*load.Edge{{Name: "owner", Type: "User", RefName: "docs", Unique: true, Inverse: true}},
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureMultiTenancy}}, user, doc)
+	require.NoError(err)
+	edges := g.Nodes[0].TenantEdges()
+	require.Len(edges, 1)
+	require.Equal("docs", edges[0].Name)
+	require.Empty(g.Nodes[1].TenantEdges())
+}
+
+func TestType_ArrayField(t *testing.T) {
+	require := require.New(t)
+	array := dict("EntSQL", map[string]any{"array": true})
+	tags := &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string", RType: &field.RType{Kind: reflect.Slice}}
+	schema := &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "tags", Info: tags, Annotations: array},
+			{Name: "labels", Info: tags},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.True(typ.Fields[0].IsArray())
+	require.Equal("string", typ.Fields[0].ArrayElem())
+	require.Equal(map[string]string{dialect.Postgres: "text[]"}, typ.Fields[0].Column().SchemaType)
+	require.False(typ.Fields[1].IsArray())
+	require.Empty(typ.Fields[1].Column().SchemaType)
+
+	schema.Fields[0].SchemaType = map[string]string{dialect.Postgres: "varchar[]", dialect.MySQL: "json"}
+	typ, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.Equal(map[string]string{dialect.Postgres: "varchar[]", dialect.MySQL: "json"}, typ.Fields[0].Column().SchemaType)
+
+	schema.Fields[0].Info = &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]int", RType: &field.RType{Kind: reflect.Map}}
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `array field "tags" of schema "T" must be a slice of strings, numbers, booleans or UUIDs`)
+	schema.Fields[0].Info = &field.TypeInfo{Type: field.TypeJSON, Ident: "[]time.Time", RType: &field.RType{Kind: reflect.Slice}}
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `array field "tags" of schema "T" must be a slice of strings, numbers, booleans or UUIDs`)
+}
+
+func TestType_GeneratedField(t *testing.T) {
+	require := require.New(t)
+	generated := dict("EntSQL", map[string]any{"generated_expr": "price * quantity", "generated_type": "STORED"})
+	schema := &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "price", Info: &field.TypeInfo{Type: field.TypeFloat64}},
+			{Name: "quantity", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "total", Info: &field.TypeInfo{Type: field.TypeFloat64}, Annotations: generated},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.False(typ.Fields[0].IsGenerated())
+	require.True(typ.Fields[2].IsGenerated())
+	require.Equal(&sqlschema.Generated{Expr: "price * quantity", Type: "STORED"}, typ.Fields[2].Column().Generated)
+	require.Len(typ.MutableFields(), 2, "generated fields are not mutable")
+
+	schema.Fields[2].Default = true
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `generated field "total" cannot have default values`)
+	schema.Fields[2].Default = false
+	schema.Fields[2].Annotations = dict("EntSQL", map[string]any{"generated_expr": "price * quantity", "generated_type": "PERSISTED"})
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `invalid generated type "PERSISTED" for field "total"`)
+}
+
+func TestType_Partition(t *testing.T) {
+	require := require.New(t)
+	partition := func(typ string, columns ...string) map[string]any {
+		return dict("EntSQL", map[string]any{"partition": map[string]any{"type": typ, "columns": columns}})
+	}
+	schema := &load.Schema{
+		Name: "Event",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}},
+			{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeTime}, Optional: true},
+		},
+		Annotations: partition("RANGE", "created_at"),
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.Equal(&entsql.TablePartition{Type: entsql.PartitionRange, Columns: []string{"created_at"}}, typ.Partition())
+	require.EqualError(typ.AddIndex(&load.Index{Fields: []string{"name"}, Unique: true}), `unique index "event_name" of partitioned schema "Event" must include the partition key column "created_at"`)
+	require.NoError(typ.AddIndex(&load.Index{Fields: []string{"name", "created_at"}, Unique: true}))
+	require.NoError(typ.AddIndex(&load.Index{Fields: []string{"name"}}))
+
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, schema)
+	require.NoError(err)
+	tables, err := g.Tables()
+	require.NoError(err)
+	require.Len(tables[0].PrimaryKey, 2)
+	require.Equal("id", tables[0].PrimaryKey[0].Name, "ID is kept first for foreign keys")
+	require.Equal("created_at", tables[0].PrimaryKey[1].Name)
+	require.Equal(sqlschema.PrimaryKey, tables[0].PrimaryKey[1].Key)
+
+	for _, tt := range []struct {
+		ant map[string]any
+		err string
+	}{
+		{partition("RANGE", "updated_at"), `unknown partition key column "updated_at" for schema "Event"`},
+		{partition("RANGE", "deleted_at"), `partition key field "deleted_at" of schema "Event" cannot be optional`},
+		{partition("INTERVAL", "created_at"), `invalid partition type "INTERVAL" for schema "Event"`},
+		{partition("HASH"), `missing partition key columns for schema "Event"`},
+	} {
+		schema.Annotations = tt.ant
+		_, err = NewType(&Config{Package: "entc/gen"}, schema)
+		require.EqualError(err, tt.err)
+	}
+	schema.Annotations = partition("HASH", "id")
+	schema.Fields[0].Unique = true
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.EqualError(err, `unique field "name" of partitioned schema "Event" must be the partition key. Use a unique index that includes the partition key instead`)
+}
+
+func TestField_JSONPath(t *testing.T) {
+	require := require.New(t)
+	type (
+		Tree struct {
+			Value    int     `json:"value"`
+			Children []*Tree `json:"children"`
+		}
+		Meta struct {
+			City   string          `json:"city"`
+			Exists bool            `json:"exists"`
+			Tags   []string        `json:"tags"`
+			Attrs  map[string]Tree `json:"attrs"`
+		}
+	)
+	schema := &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "meta", Info: field.JSON("meta", Meta{}).Descriptor().Info},
+			{Name: "tags", Info: field.Strings("tags").Descriptor().Info},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	require.Nil(typ.Fields[1].JSONPath(), "JSON fields without structs")
+
+	root := typ.Fields[0].JSONPath()
+	require.NotNil(root)
+	require.True(root.Root)
+	require.True(root.IsObject())
+	require.Equal("MetaJSONPath", root.Name)
+	require.Len(root.Keys, 4)
+	require.Equal("City", root.Keys[0].Func)
+	require.Equal("city", root.Keys[0].Key)
+	require.True(root.Keys[0].Node.IsString())
+	require.Equal("ExistsKey", root.Keys[1].Func, "names of the node methods are suffixed")
+	require.Equal("bool", root.Keys[1].Node.Cast())
+
+	tags := root.Keys[2].Node
+	require.True(tags.IsArray())
+	require.Equal("MetaTagsElemJSONPath", tags.Elem.Name)
+
+	attrs := root.Keys[3].Node
+	require.True(attrs.IsMap())
+	tree := attrs.Elem
+	require.Equal("MetaAttrsValueJSONPath", tree.Name)
+	require.Equal("bigint", tree.Keys[0].Node.Cast())
+	require.True(tree.Keys[0].Node.IsNumeric())
+	require.Equal(tree, tree.Keys[1].Node.Elem, "recursive types reference their ancestors")
+	require.Len(root.Nodes(), 9)
+
+	// Recursive root types.
+	schema.Fields = []*load.Field{{Name: "tree", Info: field.JSON("tree", &Tree{}).Descriptor().Info}}
+	typ, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err)
+	root = typ.Fields[0].JSONPath()
+	children := root.Keys[1].Node
+	require.True(children.IsArray())
+	require.NotEqual(root, children.Elem, "the root node cannot represent nested values")
+	require.False(children.Elem.Root)
+	require.Equal("TreeChildrenElemJSONPath", children.Elem.Name)
+	require.Equal(root.Keys, children.Elem.Keys)
+	require.Len(root.Nodes(), 4)
+}
+
+func TestField_JSONPathConflicts(t *testing.T) {
+	require := require.New(t)
+	type (
+		Address struct {
+			City string `json:"city"`
+		}
+		Meta struct {
+			Address Address `json:"address"`
+		}
+	)
+	schema := &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "meta", Info: field.JSON("meta", Meta{}).Descriptor().Info},
+			{Name: "meta_address", Info: field.JSON("meta_address", Address{}).Descriptor().Info},
+			{Name: "meta_json_path", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen", Features: []Feature{FeatureJSONPath}}, schema)
+	require.NoError(err)
+	root := typ.Fields[0].JSONPath()
+	require.Equal("Meta1JSONPath", root.Name, "conflicts with the predicate of the meta_json_path field")
+	require.Equal("MetaAddress1JSONPath", root.Keys[0].Node.Name, "conflicts with the root of the meta_address field")
+	require.Equal("MetaAddressJSONPath", typ.Fields[1].JSONPath().Name)
+
+	schema.Fields[2] = &load.Field{Name: "meta_path", Info: &field.TypeInfo{Type: field.TypeString}}
+	_, err = NewType(&Config{Package: "entc/gen", Features: []Feature{FeatureJSONPath}}, schema)
+	require.EqualError(err, `typed path predicates function MetaPath of JSON field "meta" of schema "T" conflicts with a predicate of the same name`)
+	_, err = NewType(&Config{Package: "entc/gen"}, schema)
+	require.NoError(err, "typed path predicates are generated only when the feature is enabled")
+}
+
 func TestField_EnumName(t *testing.T) {
 	tests := []struct {
 		name string
@@ -224,6 +562,57 @@ func TestType_AddIndex(t *testing.T) {
 
 	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name"}, Edges: []string{"owner"}})
 	require.NoError(t, err, "valid index on M2O relation and field")
+
+	fullText := map[string]any{entsql.FullText().Name(): entsql.TextSearchConfig("english")}
+	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"text"}, Annotations: fullText})
+	require.Error(t, err, "full-text index cannot be unique")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"text"}, Edges: []string{"owner"}, Annotations: fullText})
+	require.Error(t, err, "full-text index cannot contain edges")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"id"}, Annotations: fullText})
+	require.Error(t, err, "full-text index field must be a string")
+
+	require.Nil(t, typ.Fields[1].SearchIndex())
+	err = typ.AddIndex(&load.Index{Fields: []string{"text"}, Annotations: fullText})
+	require.NoError(t, err, "valid full-text index")
+	idx := typ.Fields[1].SearchIndex()
+	require.NotNil(t, idx)
+	require.True(t, idx.FullText())
+	require.Equal(t, "user_text", idx.Name)
+	require.Equal(t, "english", idx.TextSearchConfig())
+	require.Equal(t, "ByTextSearchRank", typ.Fields[1].SearchRankName())
+	require.Nil(t, typ.Fields[0].SearchIndex())
+}
+
+func TestType_AddSpatialIndex(t *testing.T) {
+	location := field.Other("location", sqlgeo.Point{}).
+		SchemaType(sqlgeo.GeometrySchemaType(sqlgeo.Point{}, 4326)).
+		Descriptor()
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "Store",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "location", Info: location.Info, SchemaType: location.SchemaType},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, typ.Fields[0].IsSpatial())
+	require.True(t, typ.Fields[1].IsSpatial())
+	require.Equal(t, "ByLocationDistance", typ.Fields[1].DistanceName())
+
+	spatial := map[string]any{entsql.Spatial().Name(): entsql.Spatial()}
+	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"location"}, Annotations: spatial})
+	require.Error(t, err, "spatial index cannot be unique")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"name", "location"}, Annotations: spatial})
+	require.Error(t, err, "spatial index must contain one field")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"name"}, Annotations: spatial})
+	require.Error(t, err, "spatial index field must be a geometry")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"location"}, Annotations: spatial})
+	require.NoError(t, err, "valid spatial index")
 }
 
 func TestField_Constant(t *testing.T) {
@@ -600,6 +600,9 @@ func (cs *CommentSelect) sqlScan(ctx context.Context, root *CommentQuery, v any)
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
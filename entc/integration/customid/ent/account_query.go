@@ -602,6 +602,9 @@ func (as *AccountSelect) sqlScan(ctx context.Context, root *AccountQuery, v any)
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := as.driver.Query(ctx, query, args, rows); err != nil {
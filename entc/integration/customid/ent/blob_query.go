@@ -787,6 +787,9 @@ func (bs *BlobSelect) sqlScan(ctx context.Context, root *BlobQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
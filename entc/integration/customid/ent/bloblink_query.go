@@ -597,6 +597,9 @@ func (bls *BlobLinkSelect) sqlScan(ctx context.Context, root *BlobLinkQuery, v a
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := bls.driver.Query(ctx, query, args, rows); err != nil {
@@ -662,6 +662,9 @@ func (ds *DeviceSelect) sqlScan(ctx context.Context, root *DeviceQuery, v any) e
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ds.driver.Query(ctx, query, args, rows); err != nil {
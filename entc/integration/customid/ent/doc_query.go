@@ -787,6 +787,9 @@ func (ds *DocSelect) sqlScan(ctx context.Context, root *DocQuery, v any) error {
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ds.driver.Query(ctx, query, args, rows); err != nil {
@@ -661,6 +661,9 @@ func (iss *IntSIDSelect) sqlScan(ctx context.Context, root *IntSIDQuery, v any)
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := iss.driver.Query(ctx, query, args, rows); err != nil {
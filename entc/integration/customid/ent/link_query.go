@@ -522,6 +522,9 @@ func (ls *LinkSelect) sqlScan(ctx context.Context, root *LinkQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ls.driver.Query(ctx, query, args, rows); err != nil {
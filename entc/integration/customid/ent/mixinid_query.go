@@ -522,6 +522,9 @@ func (mis *MixinIDSelect) sqlScan(ctx context.Context, root *MixinIDQuery, v any
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := mis.driver.Query(ctx, query, args, rows); err != nil {
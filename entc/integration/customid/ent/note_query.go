@@ -683,6 +683,9 @@ func (ns *NoteSelect) sqlScan(ctx context.Context, root *NoteQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
@@ -597,6 +597,9 @@ func (is *InfoSelect) sqlScan(ctx context.Context, root *InfoQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := is.driver.Query(ctx, query, args, rows); err != nil {
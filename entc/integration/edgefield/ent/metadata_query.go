@@ -767,6 +767,9 @@ func (ms *MetadataSelect) sqlScan(ctx context.Context, root *MetadataQuery, v an
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ms.driver.Query(ctx, query, args, rows); err != nil {
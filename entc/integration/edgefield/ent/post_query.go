@@ -603,6 +603,9 @@ func (ps *PostSelect) sqlScan(ctx context.Context, root *PostQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
@@ -676,6 +676,9 @@ func (rs *RentalSelect) sqlScan(ctx context.Context, root *RentalQuery, v any) e
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
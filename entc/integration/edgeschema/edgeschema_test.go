@@ -20,6 +20,7 @@ import (
 	"entgo.io/ent/entc/integration/edgeschema/ent/relationship"
 	"entgo.io/ent/entc/integration/edgeschema/ent/relationshipinfo"
 	_ "entgo.io/ent/entc/integration/edgeschema/ent/runtime"
+	"entgo.io/ent/entc/integration/edgeschema/ent/tweet"
 	"entgo.io/ent/entc/integration/edgeschema/ent/tweetlike"
 	"entgo.io/ent/entc/integration/edgeschema/ent/user"
 	"entgo.io/ent/entql"
@@ -181,6 +182,53 @@ func TestEdgeSchemaCompositeID(t *testing.T) {
 	require.Equal(t, client.TweetLike.Query().CountX(ctx), affected, "should update all edges (table rows)")
 }
 
+func TestEdgeSchemaEdgeAggregate(t *testing.T) {
+	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)))
+
+	tweets := client.Tweet.CreateBulk(
+		client.Tweet.Create().SetText("foo"),
+		client.Tweet.Create().SetText("bar"),
+		client.Tweet.Create().SetText("baz"),
+	).SaveX(ctx)
+	a8m := client.User.Create().SetName("a8m").AddLikedTweets(tweets...).SaveX(ctx)
+	nat := client.User.Create().SetName("nati").AddLikedTweets(tweets[0]).SaveX(ctx)
+	client.User.Create().SetName("alex").ExecX(ctx)
+	g := client.Group.Create().SetName("GitHub").AddUsers(a8m, nat).SaveX(ctx)
+
+	users := client.User.Query().
+		WithLikedTweetsCount().
+		WithLikesCount().
+		WithJoinedGroupsCount().
+		WithLikedTweetsAggregate(ent.Max(tweet.FieldID)).
+		Order(ent.Asc(user.FieldID)).
+		AllX(ctx)
+	require.Len(t, users, 3)
+	for i, c := range []struct{ tweets, groups int }{{3, 1}, {1, 1}, {0, 0}} {
+		require.Equal(t, c.tweets, users[i].Edges.LikedTweetsCount, "count of M2M edges through an edge schema")
+		require.Equal(t, c.tweets, users[i].Edges.LikesCount, "count of edges to an edge schema with a composite identifier")
+		require.Equal(t, c.groups, users[i].Edges.JoinedGroupsCount, "count of edges to an edge schema with an identifier")
+		require.Nil(t, users[i].Edges.LikedTweets, "neighbors should not be loaded")
+	}
+	require.Equal(t, []float64{float64(tweets[2].ID)}, users[0].Edges.LikedTweetsAggregate)
+	require.Equal(t, []float64{float64(tweets[0].ID)}, users[1].Edges.LikedTweetsAggregate)
+	require.Equal(t, []float64{0}, users[2].Edges.LikedTweetsAggregate, "aggregations of nodes without neighbors should be 0")
+
+	ts := client.Tweet.Query().
+		WithLikedUsersCount(func(q *ent.UserQuery) {
+			q.Where(user.HasGroupsWith(group.ID(g.ID)))
+		}).
+		Order(ent.Asc(tweet.FieldID)).
+		AllX(ctx)
+	require.Len(t, ts, 3)
+	require.Equal(t, 2, ts[0].Edges.LikedUsersCount)
+	require.Equal(t, 1, ts[1].Edges.LikedUsersCount)
+	require.Equal(t, 1, ts[2].Edges.LikedUsersCount)
+}
+
 func TestEdgeSchemaDefaultID(t *testing.T) {
 	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
 	require.NoError(t, err)
@@ -675,6 +675,9 @@ func (afs *AttachedFileSelect) sqlScan(ctx context.Context, root *AttachedFileQu
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := afs.driver.Query(ctx, query, args, rows); err != nil {
@@ -169,7 +169,7 @@ func Driver(driver dialect.Driver) Option {
 // Optional parameters can be added for configuring the client.
 func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
 	switch driverName {
-	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+	case dialect.MySQL, dialect.Postgres, dialect.SQLServer, dialect.SQLite, dialect.SQLiteDriver:
 		drv, err := sql.Open(driverName, dataSourceName)
 		if err != nil {
 			return nil, err
@@ -35,6 +35,12 @@ type FileEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [1]bool
+	// ProcessesCount holds the number of nodes that are connected to the "processes" edge.
+	// The value is being populated by the FileQuery when WithProcessesCount is set.
+	ProcessesCount int `json:"processes_count,omitempty"`
+	// ProcessesAggregate holds the results of the aggregation functions that were passed to
+	// WithProcessesAggregate, in the same order, for the nodes of the "processes" edge.
+	ProcessesAggregate []float64 `json:"processes_aggregate,omitempty"`
 }
 
 // ProcessesOrErr returns the Processes value or an error if the edge
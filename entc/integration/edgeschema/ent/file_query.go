@@ -24,11 +24,13 @@ import (
 // FileQuery is the builder for querying File entities.
 type FileQuery struct {
 	config
-	ctx           *QueryContext
-	order         []file.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.File
-	withProcesses *ProcessQuery
+	ctx                    *QueryContext
+	order                  []file.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.File
+	withProcesses          *ProcessQuery
+	withProcessesCount     *ProcessQuery
+	withProcessesAggregate []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -281,8 +283,10 @@ func (_q *FileQuery) Clone() *FileQuery {
 		predicates:    append([]predicate.File{}, _q.predicates...),
 		withProcesses: _q.withProcesses.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:                    _q.sql.Clone(),
+		path:                   _q.path,
+		withProcessesCount:     _q.withProcessesCount.Clone(),
+		withProcessesAggregate: append([]AggregateFunc{}, _q.withProcessesAggregate...),
 	}
 }
 
@@ -404,6 +408,19 @@ func (_q *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 			return nil, err
 		}
 	}
+	if query := _q.withProcessesCount; query != nil {
+		if err := _q.aggregateProcesses(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *File, v []float64) { n.Edges.ProcessesCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withProcessesAggregate; len(fns) > 0 {
+		query := (&ProcessClient{config: _q.config}).Query()
+		if err := _q.aggregateProcesses(ctx, query, nodes, fns,
+			func(n *File, v []float64) { n.Edges.ProcessesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -550,6 +567,81 @@ func (_q *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithProcessesCount tells the query-builder to load the number of nodes that are connected to the
+// "processes" edge into the ProcessesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *FileQuery) WithProcessesCount(opts ...func(*ProcessQuery)) *FileQuery {
+	query := (&ProcessClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withProcessesCount = query
+	return _q
+}
+
+// WithProcessesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "processes" edge, and to load their results into the ProcessesAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.File.Query().
+//		WithProcessesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *FileQuery) WithProcessesAggregate(fns ...AggregateFunc) *FileQuery {
+	_q.withProcessesAggregate = append(_q.withProcessesAggregate, fns...)
+	return _q
+}
+
+func (_q *FileQuery) aggregateProcesses(ctx context.Context, query *ProcessQuery, nodes []*File, fns []AggregateFunc, assign func(*File, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*File, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(file.Table, file.FieldID),
+		sqlgraph.To(process.Table, process.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, file.ProcessesTable, file.ProcessesPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "processes" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	selector
@@ -631,6 +723,9 @@ func (fs *FileSelect) sqlScan(ctx context.Context, root *FileQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
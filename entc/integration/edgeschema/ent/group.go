@@ -41,6 +41,30 @@ type GroupEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [4]bool
+	// UsersCount holds the number of nodes that are connected to the "users" edge.
+	// The value is being populated by the GroupQuery when WithUsersCount is set.
+	UsersCount int `json:"users_count,omitempty"`
+	// UsersAggregate holds the results of the aggregation functions that were passed to
+	// WithUsersAggregate, in the same order, for the nodes of the "users" edge.
+	UsersAggregate []float64 `json:"users_aggregate,omitempty"`
+	// TagsCount holds the number of nodes that are connected to the "tags" edge.
+	// The value is being populated by the GroupQuery when WithTagsCount is set.
+	TagsCount int `json:"tags_count,omitempty"`
+	// TagsAggregate holds the results of the aggregation functions that were passed to
+	// WithTagsAggregate, in the same order, for the nodes of the "tags" edge.
+	TagsAggregate []float64 `json:"tags_aggregate,omitempty"`
+	// JoinedUsersCount holds the number of nodes that are connected to the "joined_users" edge.
+	// The value is being populated by the GroupQuery when WithJoinedUsersCount is set.
+	JoinedUsersCount int `json:"joined_users_count,omitempty"`
+	// JoinedUsersAggregate holds the results of the aggregation functions that were passed to
+	// WithJoinedUsersAggregate, in the same order, for the nodes of the "joined_users" edge.
+	JoinedUsersAggregate []float64 `json:"joined_users_aggregate,omitempty"`
+	// GroupTagsCount holds the number of nodes that are connected to the "group_tags" edge.
+	// The value is being populated by the GroupQuery when WithGroupTagsCount is set.
+	GroupTagsCount int `json:"group_tags_count,omitempty"`
+	// GroupTagsAggregate holds the results of the aggregation functions that were passed to
+	// WithGroupTagsAggregate, in the same order, for the nodes of the "group_tags" edge.
+	GroupTagsAggregate []float64 `json:"group_tags_aggregate,omitempty"`
 }
 
 // UsersOrErr returns the Users value or an error if the edge
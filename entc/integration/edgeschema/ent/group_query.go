@@ -27,14 +27,22 @@ import (
 // GroupQuery is the builder for querying Group entities.
 type GroupQuery struct {
 	config
-	ctx             *QueryContext
-	order           []group.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Group
-	withUsers       *UserQuery
-	withTags        *TagQuery
-	withJoinedUsers *UserGroupQuery
-	withGroupTags   *GroupTagQuery
+	ctx                      *QueryContext
+	order                    []group.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Group
+	withUsers                *UserQuery
+	withTags                 *TagQuery
+	withJoinedUsers          *UserGroupQuery
+	withGroupTags            *GroupTagQuery
+	withUsersCount           *UserQuery
+	withUsersAggregate       []AggregateFunc
+	withTagsCount            *TagQuery
+	withTagsAggregate        []AggregateFunc
+	withJoinedUsersCount     *UserGroupQuery
+	withJoinedUsersAggregate []AggregateFunc
+	withGroupTagsCount       *GroupTagQuery
+	withGroupTagsAggregate   []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -356,8 +364,16 @@ func (_q *GroupQuery) Clone() *GroupQuery {
 		withJoinedUsers: _q.withJoinedUsers.Clone(),
 		withGroupTags:   _q.withGroupTags.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:                      _q.sql.Clone(),
+		path:                     _q.path,
+		withUsersCount:           _q.withUsersCount.Clone(),
+		withUsersAggregate:       append([]AggregateFunc{}, _q.withUsersAggregate...),
+		withTagsCount:            _q.withTagsCount.Clone(),
+		withTagsAggregate:        append([]AggregateFunc{}, _q.withTagsAggregate...),
+		withJoinedUsersCount:     _q.withJoinedUsersCount.Clone(),
+		withJoinedUsersAggregate: append([]AggregateFunc{}, _q.withJoinedUsersAggregate...),
+		withGroupTagsCount:       _q.withGroupTagsCount.Clone(),
+		withGroupTagsAggregate:   append([]AggregateFunc{}, _q.withGroupTagsAggregate...),
 	}
 }
 
@@ -536,6 +552,58 @@ func (_q *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 			return nil, err
 		}
 	}
+	if query := _q.withUsersCount; query != nil {
+		if err := _q.aggregateUsers(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Group, v []float64) { n.Edges.UsersCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withUsersAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateUsers(ctx, query, nodes, fns,
+			func(n *Group, v []float64) { n.Edges.UsersAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTagsCount; query != nil {
+		if err := _q.aggregateTags(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Group, v []float64) { n.Edges.TagsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withTagsAggregate; len(fns) > 0 {
+		query := (&TagClient{config: _q.config}).Query()
+		if err := _q.aggregateTags(ctx, query, nodes, fns,
+			func(n *Group, v []float64) { n.Edges.TagsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withJoinedUsersCount; query != nil {
+		if err := _q.aggregateJoinedUsers(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Group, v []float64) { n.Edges.JoinedUsersCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withJoinedUsersAggregate; len(fns) > 0 {
+		query := (&UserGroupClient{config: _q.config}).Query()
+		if err := _q.aggregateJoinedUsers(ctx, query, nodes, fns,
+			func(n *Group, v []float64) { n.Edges.JoinedUsersAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGroupTagsCount; query != nil {
+		if err := _q.aggregateGroupTags(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Group, v []float64) { n.Edges.GroupTagsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withGroupTagsAggregate; len(fns) > 0 {
+		query := (&GroupTagClient{config: _q.config}).Query()
+		if err := _q.aggregateGroupTags(ctx, query, nodes, fns,
+			func(n *Group, v []float64) { n.Edges.GroupTagsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -803,6 +871,306 @@ func (_q *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithUsersCount tells the query-builder to load the number of nodes that are connected to the
+// "users" edge into the UsersCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *GroupQuery) WithUsersCount(opts ...func(*UserQuery)) *GroupQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUsersCount = query
+	return _q
+}
+
+// WithUsersAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "users" edge, and to load their results into the UsersAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Group.Query().
+//		WithUsersAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *GroupQuery) WithUsersAggregate(fns ...AggregateFunc) *GroupQuery {
+	_q.withUsersAggregate = append(_q.withUsersAggregate, fns...)
+	return _q
+}
+
+func (_q *GroupQuery) aggregateUsers(ctx context.Context, query *UserQuery, nodes []*Group, fns []AggregateFunc, assign func(*Group, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(group.Table, group.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, group.UsersTable, group.UsersPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "users" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithTagsCount tells the query-builder to load the number of nodes that are connected to the
+// "tags" edge into the TagsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *GroupQuery) WithTagsCount(opts ...func(*TagQuery)) *GroupQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTagsCount = query
+	return _q
+}
+
+// WithTagsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "tags" edge, and to load their results into the TagsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Group.Query().
+//		WithTagsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *GroupQuery) WithTagsAggregate(fns ...AggregateFunc) *GroupQuery {
+	_q.withTagsAggregate = append(_q.withTagsAggregate, fns...)
+	return _q
+}
+
+func (_q *GroupQuery) aggregateTags(ctx context.Context, query *TagQuery, nodes []*Group, fns []AggregateFunc, assign func(*Group, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(group.Table, group.FieldID),
+		sqlgraph.To(tag.Table, tag.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, group.TagsTable, group.TagsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithJoinedUsersCount tells the query-builder to load the number of nodes that are connected to the
+// "joined_users" edge into the JoinedUsersCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *GroupQuery) WithJoinedUsersCount(opts ...func(*UserGroupQuery)) *GroupQuery {
+	query := (&UserGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withJoinedUsersCount = query
+	return _q
+}
+
+// WithJoinedUsersAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "joined_users" edge, and to load their results into the JoinedUsersAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Group.Query().
+//		WithJoinedUsersAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *GroupQuery) WithJoinedUsersAggregate(fns ...AggregateFunc) *GroupQuery {
+	_q.withJoinedUsersAggregate = append(_q.withJoinedUsersAggregate, fns...)
+	return _q
+}
+
+func (_q *GroupQuery) aggregateJoinedUsers(ctx context.Context, query *UserGroupQuery, nodes []*Group, fns []AggregateFunc, assign func(*Group, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(group.Table, group.FieldID),
+		sqlgraph.To(usergroup.Table, usergroup.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, group.JoinedUsersTable, group.JoinedUsersColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "joined_users" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithGroupTagsCount tells the query-builder to load the number of nodes that are connected to the
+// "group_tags" edge into the GroupTagsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *GroupQuery) WithGroupTagsCount(opts ...func(*GroupTagQuery)) *GroupQuery {
+	query := (&GroupTagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroupTagsCount = query
+	return _q
+}
+
+// WithGroupTagsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "group_tags" edge, and to load their results into the GroupTagsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Group.Query().
+//		WithGroupTagsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *GroupQuery) WithGroupTagsAggregate(fns ...AggregateFunc) *GroupQuery {
+	_q.withGroupTagsAggregate = append(_q.withGroupTagsAggregate, fns...)
+	return _q
+}
+
+func (_q *GroupQuery) aggregateGroupTags(ctx context.Context, query *GroupTagQuery, nodes []*Group, fns []AggregateFunc, assign func(*Group, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(group.Table, group.FieldID),
+		sqlgraph.To(grouptag.Table, grouptag.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, group.GroupTagsTable, group.GroupTagsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "group_tags" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
 // GroupGroupBy is the group-by builder for Group entities.
 type GroupGroupBy struct {
 	selector
@@ -884,6 +1252,9 @@ func (gs *GroupSelect) sqlScan(ctx context.Context, root *GroupQuery, v any) err
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
@@ -35,6 +35,18 @@ type ProcessEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
+	// FilesCount holds the number of nodes that are connected to the "files" edge.
+	// The value is being populated by the ProcessQuery when WithFilesCount is set.
+	FilesCount int `json:"files_count,omitempty"`
+	// FilesAggregate holds the results of the aggregation functions that were passed to
+	// WithFilesAggregate, in the same order, for the nodes of the "files" edge.
+	FilesAggregate []float64 `json:"files_aggregate,omitempty"`
+	// AttachedFilesCount holds the number of nodes that are connected to the "attached_files" edge.
+	// The value is being populated by the ProcessQuery when WithAttachedFilesCount is set.
+	AttachedFilesCount int `json:"attached_files_count,omitempty"`
+	// AttachedFilesAggregate holds the results of the aggregation functions that were passed to
+	// WithAttachedFilesAggregate, in the same order, for the nodes of the "attached_files" edge.
+	AttachedFilesAggregate []float64 `json:"attached_files_aggregate,omitempty"`
 }
 
 // FilesOrErr returns the Files value or an error if the edge
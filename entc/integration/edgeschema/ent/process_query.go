@@ -25,12 +25,16 @@ import (
 // ProcessQuery is the builder for querying Process entities.
 type ProcessQuery struct {
 	config
-	ctx               *QueryContext
-	order             []process.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Process
-	withFiles         *FileQuery
-	withAttachedFiles *AttachedFileQuery
+	ctx                        *QueryContext
+	order                      []process.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.Process
+	withFiles                  *FileQuery
+	withAttachedFiles          *AttachedFileQuery
+	withFilesCount             *FileQuery
+	withFilesAggregate         []AggregateFunc
+	withAttachedFilesCount     *AttachedFileQuery
+	withAttachedFilesAggregate []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -306,8 +310,12 @@ func (_q *ProcessQuery) Clone() *ProcessQuery {
 		withFiles:         _q.withFiles.Clone(),
 		withAttachedFiles: _q.withAttachedFiles.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:                        _q.sql.Clone(),
+		path:                       _q.path,
+		withFilesCount:             _q.withFilesCount.Clone(),
+		withFilesAggregate:         append([]AggregateFunc{}, _q.withFilesAggregate...),
+		withAttachedFilesCount:     _q.withAttachedFilesCount.Clone(),
+		withAttachedFilesAggregate: append([]AggregateFunc{}, _q.withAttachedFilesAggregate...),
 	}
 }
 
@@ -426,6 +434,32 @@ func (_q *ProcessQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proc
 			return nil, err
 		}
 	}
+	if query := _q.withFilesCount; query != nil {
+		if err := _q.aggregateFiles(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Process, v []float64) { n.Edges.FilesCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFilesAggregate; len(fns) > 0 {
+		query := (&FileClient{config: _q.config}).Query()
+		if err := _q.aggregateFiles(ctx, query, nodes, fns,
+			func(n *Process, v []float64) { n.Edges.FilesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAttachedFilesCount; query != nil {
+		if err := _q.aggregateAttachedFiles(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Process, v []float64) { n.Edges.AttachedFilesCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withAttachedFilesAggregate; len(fns) > 0 {
+		query := (&AttachedFileClient{config: _q.config}).Query()
+		if err := _q.aggregateAttachedFiles(ctx, query, nodes, fns,
+			func(n *Process, v []float64) { n.Edges.AttachedFilesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -602,6 +636,156 @@ func (_q *ProcessQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithFilesCount tells the query-builder to load the number of nodes that are connected to the
+// "files" edge into the FilesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *ProcessQuery) WithFilesCount(opts ...func(*FileQuery)) *ProcessQuery {
+	query := (&FileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFilesCount = query
+	return _q
+}
+
+// WithFilesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "files" edge, and to load their results into the FilesAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Process.Query().
+//		WithFilesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *ProcessQuery) WithFilesAggregate(fns ...AggregateFunc) *ProcessQuery {
+	_q.withFilesAggregate = append(_q.withFilesAggregate, fns...)
+	return _q
+}
+
+func (_q *ProcessQuery) aggregateFiles(ctx context.Context, query *FileQuery, nodes []*Process, fns []AggregateFunc, assign func(*Process, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Process, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(process.Table, process.FieldID),
+		sqlgraph.To(file.Table, file.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, process.FilesTable, process.FilesPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "files" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithAttachedFilesCount tells the query-builder to load the number of nodes that are connected to the
+// "attached_files" edge into the AttachedFilesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *ProcessQuery) WithAttachedFilesCount(opts ...func(*AttachedFileQuery)) *ProcessQuery {
+	query := (&AttachedFileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAttachedFilesCount = query
+	return _q
+}
+
+// WithAttachedFilesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "attached_files" edge, and to load their results into the AttachedFilesAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Process.Query().
+//		WithAttachedFilesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *ProcessQuery) WithAttachedFilesAggregate(fns ...AggregateFunc) *ProcessQuery {
+	_q.withAttachedFilesAggregate = append(_q.withAttachedFilesAggregate, fns...)
+	return _q
+}
+
+func (_q *ProcessQuery) aggregateAttachedFiles(ctx context.Context, query *AttachedFileQuery, nodes []*Process, fns []AggregateFunc, assign func(*Process, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Process, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(process.Table, process.FieldID),
+		sqlgraph.To(attachedfile.Table, attachedfile.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, process.AttachedFilesTable, process.AttachedFilesColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "attached_files" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
 // ProcessGroupBy is the group-by builder for Process entities.
 type ProcessGroupBy struct {
 	selector
@@ -683,6 +867,9 @@ func (ps *ProcessSelect) sqlScan(ctx context.Context, root *ProcessQuery, v any)
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
@@ -678,6 +678,9 @@ func (rs *RelationshipSelect) sqlScan(ctx context.Context, root *RelationshipQue
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
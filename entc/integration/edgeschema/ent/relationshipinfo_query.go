@@ -521,6 +521,9 @@ func (ris *RelationshipInfoSelect) sqlScan(ctx context.Context, root *Relationsh
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ris.driver.Query(ctx, query, args, rows); err != nil {
@@ -40,6 +40,18 @@ type RoleEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
+	// UserCount holds the number of nodes that are connected to the "user" edge.
+	// The value is being populated by the RoleQuery when WithUserCount is set.
+	UserCount int `json:"user_count,omitempty"`
+	// UserAggregate holds the results of the aggregation functions that were passed to
+	// WithUserAggregate, in the same order, for the nodes of the "user" edge.
+	UserAggregate []float64 `json:"user_aggregate,omitempty"`
+	// RolesUsersCount holds the number of nodes that are connected to the "roles_users" edge.
+	// The value is being populated by the RoleQuery when WithRolesUsersCount is set.
+	RolesUsersCount int `json:"roles_users_count,omitempty"`
+	// RolesUsersAggregate holds the results of the aggregation functions that were passed to
+	// WithRolesUsersAggregate, in the same order, for the nodes of the "roles_users" edge.
+	RolesUsersAggregate []float64 `json:"roles_users_aggregate,omitempty"`
 }
 
 // UserOrErr returns the User value or an error if the edge
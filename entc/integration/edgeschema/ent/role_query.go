@@ -25,12 +25,16 @@ import (
 // RoleQuery is the builder for querying Role entities.
 type RoleQuery struct {
 	config
-	ctx            *QueryContext
-	order          []role.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Role
-	withUser       *UserQuery
-	withRolesUsers *RoleUserQuery
+	ctx                     *QueryContext
+	order                   []role.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Role
+	withUser                *UserQuery
+	withRolesUsers          *RoleUserQuery
+	withUserCount           *UserQuery
+	withUserAggregate       []AggregateFunc
+	withRolesUsersCount     *RoleUserQuery
+	withRolesUsersAggregate []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -306,8 +310,12 @@ func (_q *RoleQuery) Clone() *RoleQuery {
 		withUser:       _q.withUser.Clone(),
 		withRolesUsers: _q.withRolesUsers.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:                     _q.sql.Clone(),
+		path:                    _q.path,
+		withUserCount:           _q.withUserCount.Clone(),
+		withUserAggregate:       append([]AggregateFunc{}, _q.withUserAggregate...),
+		withRolesUsersCount:     _q.withRolesUsersCount.Clone(),
+		withRolesUsersAggregate: append([]AggregateFunc{}, _q.withRolesUsersAggregate...),
 	}
 }
 
@@ -448,6 +456,32 @@ func (_q *RoleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Role, e
 			return nil, err
 		}
 	}
+	if query := _q.withUserCount; query != nil {
+		if err := _q.aggregateUser(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Role, v []float64) { n.Edges.UserCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withUserAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateUser(ctx, query, nodes, fns,
+			func(n *Role, v []float64) { n.Edges.UserAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRolesUsersCount; query != nil {
+		if err := _q.aggregateRolesUsers(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Role, v []float64) { n.Edges.RolesUsersCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withRolesUsersAggregate; len(fns) > 0 {
+		query := (&RoleUserClient{config: _q.config}).Query()
+		if err := _q.aggregateRolesUsers(ctx, query, nodes, fns,
+			func(n *Role, v []float64) { n.Edges.RolesUsersAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -624,6 +658,156 @@ func (_q *RoleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithUserCount tells the query-builder to load the number of nodes that are connected to the
+// "user" edge into the UserCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *RoleQuery) WithUserCount(opts ...func(*UserQuery)) *RoleQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUserCount = query
+	return _q
+}
+
+// WithUserAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "user" edge, and to load their results into the UserAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Role.Query().
+//		WithUserAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *RoleQuery) WithUserAggregate(fns ...AggregateFunc) *RoleQuery {
+	_q.withUserAggregate = append(_q.withUserAggregate, fns...)
+	return _q
+}
+
+func (_q *RoleQuery) aggregateUser(ctx context.Context, query *UserQuery, nodes []*Role, fns []AggregateFunc, assign func(*Role, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Role, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(role.Table, role.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, role.UserTable, role.UserPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "user" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithRolesUsersCount tells the query-builder to load the number of nodes that are connected to the
+// "roles_users" edge into the RolesUsersCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *RoleQuery) WithRolesUsersCount(opts ...func(*RoleUserQuery)) *RoleQuery {
+	query := (&RoleUserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRolesUsersCount = query
+	return _q
+}
+
+// WithRolesUsersAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "roles_users" edge, and to load their results into the RolesUsersAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Role.Query().
+//		WithRolesUsersAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *RoleQuery) WithRolesUsersAggregate(fns ...AggregateFunc) *RoleQuery {
+	_q.withRolesUsersAggregate = append(_q.withRolesUsersAggregate, fns...)
+	return _q
+}
+
+func (_q *RoleQuery) aggregateRolesUsers(ctx context.Context, query *RoleUserQuery, nodes []*Role, fns []AggregateFunc, assign func(*Role, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Role, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(role.Table, role.FieldID),
+		sqlgraph.To(roleuser.Table, roleuser.RoleColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, role.RolesUsersTable, role.RolesUsersColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "roles_users" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
 // RoleGroupBy is the group-by builder for Role entities.
 type RoleGroupBy struct {
 	selector
@@ -705,6 +889,9 @@ func (rs *RoleSelect) sqlScan(ctx context.Context, root *RoleQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
@@ -597,6 +597,9 @@ func (rus *RoleUserSelect) sqlScan(ctx context.Context, root *RoleUserQuery, v a
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := rus.driver.Query(ctx, query, args, rows); err != nil {
@@ -41,6 +41,30 @@ type TagEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [4]bool
+	// TweetsCount holds the number of nodes that are connected to the "tweets" edge.
+	// The value is being populated by the TagQuery when WithTweetsCount is set.
+	TweetsCount int `json:"tweets_count,omitempty"`
+	// TweetsAggregate holds the results of the aggregation functions that were passed to
+	// WithTweetsAggregate, in the same order, for the nodes of the "tweets" edge.
+	TweetsAggregate []float64 `json:"tweets_aggregate,omitempty"`
+	// GroupsCount holds the number of nodes that are connected to the "groups" edge.
+	// The value is being populated by the TagQuery when WithGroupsCount is set.
+	GroupsCount int `json:"groups_count,omitempty"`
+	// GroupsAggregate holds the results of the aggregation functions that were passed to
+	// WithGroupsAggregate, in the same order, for the nodes of the "groups" edge.
+	GroupsAggregate []float64 `json:"groups_aggregate,omitempty"`
+	// TweetTagsCount holds the number of nodes that are connected to the "tweet_tags" edge.
+	// The value is being populated by the TagQuery when WithTweetTagsCount is set.
+	TweetTagsCount int `json:"tweet_tags_count,omitempty"`
+	// TweetTagsAggregate holds the results of the aggregation functions that were passed to
+	// WithTweetTagsAggregate, in the same order, for the nodes of the "tweet_tags" edge.
+	TweetTagsAggregate []float64 `json:"tweet_tags_aggregate,omitempty"`
+	// GroupTagsCount holds the number of nodes that are connected to the "group_tags" edge.
+	// The value is being populated by the TagQuery when WithGroupTagsCount is set.
+	GroupTagsCount int `json:"group_tags_count,omitempty"`
+	// GroupTagsAggregate holds the results of the aggregation functions that were passed to
+	// WithGroupTagsAggregate, in the same order, for the nodes of the "group_tags" edge.
+	GroupTagsAggregate []float64 `json:"group_tags_aggregate,omitempty"`
 }
 
 // TweetsOrErr returns the Tweets value or an error if the edge
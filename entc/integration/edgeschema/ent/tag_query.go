@@ -27,14 +27,22 @@ import (
 // TagQuery is the builder for querying Tag entities.
 type TagQuery struct {
 	config
-	ctx           *QueryContext
-	order         []tag.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Tag
-	withTweets    *TweetQuery
-	withGroups    *GroupQuery
-	withTweetTags *TweetTagQuery
-	withGroupTags *GroupTagQuery
+	ctx                    *QueryContext
+	order                  []tag.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Tag
+	withTweets             *TweetQuery
+	withGroups             *GroupQuery
+	withTweetTags          *TweetTagQuery
+	withGroupTags          *GroupTagQuery
+	withTweetsCount        *TweetQuery
+	withTweetsAggregate    []AggregateFunc
+	withGroupsCount        *GroupQuery
+	withGroupsAggregate    []AggregateFunc
+	withTweetTagsCount     *TweetTagQuery
+	withTweetTagsAggregate []AggregateFunc
+	withGroupTagsCount     *GroupTagQuery
+	withGroupTagsAggregate []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -356,8 +364,16 @@ func (_q *TagQuery) Clone() *TagQuery {
 		withTweetTags: _q.withTweetTags.Clone(),
 		withGroupTags: _q.withGroupTags.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:                    _q.sql.Clone(),
+		path:                   _q.path,
+		withTweetsCount:        _q.withTweetsCount.Clone(),
+		withTweetsAggregate:    append([]AggregateFunc{}, _q.withTweetsAggregate...),
+		withGroupsCount:        _q.withGroupsCount.Clone(),
+		withGroupsAggregate:    append([]AggregateFunc{}, _q.withGroupsAggregate...),
+		withTweetTagsCount:     _q.withTweetTagsCount.Clone(),
+		withTweetTagsAggregate: append([]AggregateFunc{}, _q.withTweetTagsAggregate...),
+		withGroupTagsCount:     _q.withGroupTagsCount.Clone(),
+		withGroupTagsAggregate: append([]AggregateFunc{}, _q.withGroupTagsAggregate...),
 	}
 }
 
@@ -536,6 +552,58 @@ func (_q *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, err
 			return nil, err
 		}
 	}
+	if query := _q.withTweetsCount; query != nil {
+		if err := _q.aggregateTweets(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tag, v []float64) { n.Edges.TweetsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withTweetsAggregate; len(fns) > 0 {
+		query := (&TweetClient{config: _q.config}).Query()
+		if err := _q.aggregateTweets(ctx, query, nodes, fns,
+			func(n *Tag, v []float64) { n.Edges.TweetsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGroupsCount; query != nil {
+		if err := _q.aggregateGroups(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tag, v []float64) { n.Edges.GroupsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withGroupsAggregate; len(fns) > 0 {
+		query := (&GroupClient{config: _q.config}).Query()
+		if err := _q.aggregateGroups(ctx, query, nodes, fns,
+			func(n *Tag, v []float64) { n.Edges.GroupsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTweetTagsCount; query != nil {
+		if err := _q.aggregateTweetTags(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tag, v []float64) { n.Edges.TweetTagsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withTweetTagsAggregate; len(fns) > 0 {
+		query := (&TweetTagClient{config: _q.config}).Query()
+		if err := _q.aggregateTweetTags(ctx, query, nodes, fns,
+			func(n *Tag, v []float64) { n.Edges.TweetTagsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGroupTagsCount; query != nil {
+		if err := _q.aggregateGroupTags(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tag, v []float64) { n.Edges.GroupTagsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withGroupTagsAggregate; len(fns) > 0 {
+		query := (&GroupTagClient{config: _q.config}).Query()
+		if err := _q.aggregateGroupTags(ctx, query, nodes, fns,
+			func(n *Tag, v []float64) { n.Edges.GroupTagsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -803,6 +871,306 @@ func (_q *TagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithTweetsCount tells the query-builder to load the number of nodes that are connected to the
+// "tweets" edge into the TweetsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TagQuery) WithTweetsCount(opts ...func(*TweetQuery)) *TagQuery {
+	query := (&TweetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTweetsCount = query
+	return _q
+}
+
+// WithTweetsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "tweets" edge, and to load their results into the TweetsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tag.Query().
+//		WithTweetsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TagQuery) WithTweetsAggregate(fns ...AggregateFunc) *TagQuery {
+	_q.withTweetsAggregate = append(_q.withTweetsAggregate, fns...)
+	return _q
+}
+
+func (_q *TagQuery) aggregateTweets(ctx context.Context, query *TweetQuery, nodes []*Tag, fns []AggregateFunc, assign func(*Tag, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tag, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tag.Table, tag.FieldID),
+		sqlgraph.To(tweet.Table, tweet.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, tag.TweetsTable, tag.TweetsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "tweets" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithGroupsCount tells the query-builder to load the number of nodes that are connected to the
+// "groups" edge into the GroupsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TagQuery) WithGroupsCount(opts ...func(*GroupQuery)) *TagQuery {
+	query := (&GroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroupsCount = query
+	return _q
+}
+
+// WithGroupsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "groups" edge, and to load their results into the GroupsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tag.Query().
+//		WithGroupsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TagQuery) WithGroupsAggregate(fns ...AggregateFunc) *TagQuery {
+	_q.withGroupsAggregate = append(_q.withGroupsAggregate, fns...)
+	return _q
+}
+
+func (_q *TagQuery) aggregateGroups(ctx context.Context, query *GroupQuery, nodes []*Tag, fns []AggregateFunc, assign func(*Tag, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tag, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tag.Table, tag.FieldID),
+		sqlgraph.To(group.Table, group.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, tag.GroupsTable, tag.GroupsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "groups" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithTweetTagsCount tells the query-builder to load the number of nodes that are connected to the
+// "tweet_tags" edge into the TweetTagsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TagQuery) WithTweetTagsCount(opts ...func(*TweetTagQuery)) *TagQuery {
+	query := (&TweetTagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTweetTagsCount = query
+	return _q
+}
+
+// WithTweetTagsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "tweet_tags" edge, and to load their results into the TweetTagsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tag.Query().
+//		WithTweetTagsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TagQuery) WithTweetTagsAggregate(fns ...AggregateFunc) *TagQuery {
+	_q.withTweetTagsAggregate = append(_q.withTweetTagsAggregate, fns...)
+	return _q
+}
+
+func (_q *TagQuery) aggregateTweetTags(ctx context.Context, query *TweetTagQuery, nodes []*Tag, fns []AggregateFunc, assign func(*Tag, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tag, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tag.Table, tag.FieldID),
+		sqlgraph.To(tweettag.Table, tweettag.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, tag.TweetTagsTable, tag.TweetTagsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "tweet_tags" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithGroupTagsCount tells the query-builder to load the number of nodes that are connected to the
+// "group_tags" edge into the GroupTagsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TagQuery) WithGroupTagsCount(opts ...func(*GroupTagQuery)) *TagQuery {
+	query := (&GroupTagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroupTagsCount = query
+	return _q
+}
+
+// WithGroupTagsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "group_tags" edge, and to load their results into the GroupTagsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tag.Query().
+//		WithGroupTagsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TagQuery) WithGroupTagsAggregate(fns ...AggregateFunc) *TagQuery {
+	_q.withGroupTagsAggregate = append(_q.withGroupTagsAggregate, fns...)
+	return _q
+}
+
+func (_q *TagQuery) aggregateGroupTags(ctx context.Context, query *GroupTagQuery, nodes []*Tag, fns []AggregateFunc, assign func(*Tag, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tag, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tag.Table, tag.FieldID),
+		sqlgraph.To(grouptag.Table, grouptag.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, tag.GroupTagsTable, tag.GroupTagsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "group_tags" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
 // TagGroupBy is the group-by builder for Tag entities.
 type TagGroupBy struct {
 	selector
@@ -884,6 +1252,9 @@ func (ts *TagSelect) sqlScan(ctx context.Context, root *TagQuery, v any) error {
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ts.driver.Query(ctx, query, args, rows); err != nil {
@@ -45,6 +45,42 @@ type TweetEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [6]bool
+	// LikedUsersCount holds the number of nodes that are connected to the "liked_users" edge.
+	// The value is being populated by the TweetQuery when WithLikedUsersCount is set.
+	LikedUsersCount int `json:"liked_users_count,omitempty"`
+	// LikedUsersAggregate holds the results of the aggregation functions that were passed to
+	// WithLikedUsersAggregate, in the same order, for the nodes of the "liked_users" edge.
+	LikedUsersAggregate []float64 `json:"liked_users_aggregate,omitempty"`
+	// UserCount holds the number of nodes that are connected to the "user" edge.
+	// The value is being populated by the TweetQuery when WithUserCount is set.
+	UserCount int `json:"user_count,omitempty"`
+	// UserAggregate holds the results of the aggregation functions that were passed to
+	// WithUserAggregate, in the same order, for the nodes of the "user" edge.
+	UserAggregate []float64 `json:"user_aggregate,omitempty"`
+	// TagsCount holds the number of nodes that are connected to the "tags" edge.
+	// The value is being populated by the TweetQuery when WithTagsCount is set.
+	TagsCount int `json:"tags_count,omitempty"`
+	// TagsAggregate holds the results of the aggregation functions that were passed to
+	// WithTagsAggregate, in the same order, for the nodes of the "tags" edge.
+	TagsAggregate []float64 `json:"tags_aggregate,omitempty"`
+	// LikesCount holds the number of nodes that are connected to the "likes" edge.
+	// The value is being populated by the TweetQuery when WithLikesCount is set.
+	LikesCount int `json:"likes_count,omitempty"`
+	// LikesAggregate holds the results of the aggregation functions that were passed to
+	// WithLikesAggregate, in the same order, for the nodes of the "likes" edge.
+	LikesAggregate []float64 `json:"likes_aggregate,omitempty"`
+	// TweetUserCount holds the number of nodes that are connected to the "tweet_user" edge.
+	// The value is being populated by the TweetQuery when WithTweetUserCount is set.
+	TweetUserCount int `json:"tweet_user_count,omitempty"`
+	// TweetUserAggregate holds the results of the aggregation functions that were passed to
+	// WithTweetUserAggregate, in the same order, for the nodes of the "tweet_user" edge.
+	TweetUserAggregate []float64 `json:"tweet_user_aggregate,omitempty"`
+	// TweetTagsCount holds the number of nodes that are connected to the "tweet_tags" edge.
+	// The value is being populated by the TweetQuery when WithTweetTagsCount is set.
+	TweetTagsCount int `json:"tweet_tags_count,omitempty"`
+	// TweetTagsAggregate holds the results of the aggregation functions that were passed to
+	// WithTweetTagsAggregate, in the same order, for the nodes of the "tweet_tags" edge.
+	TweetTagsAggregate []float64 `json:"tweet_tags_aggregate,omitempty"`
 }
 
 // LikedUsersOrErr returns the LikedUsers value or an error if the edge
@@ -28,16 +28,28 @@ import (
 // TweetQuery is the builder for querying Tweet entities.
 type TweetQuery struct {
 	config
-	ctx            *QueryContext
-	order          []tweet.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Tweet
-	withLikedUsers *UserQuery
-	withUser       *UserQuery
-	withTags       *TagQuery
-	withLikes      *TweetLikeQuery
-	withTweetUser  *UserTweetQuery
-	withTweetTags  *TweetTagQuery
+	ctx                     *QueryContext
+	order                   []tweet.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Tweet
+	withLikedUsers          *UserQuery
+	withUser                *UserQuery
+	withTags                *TagQuery
+	withLikes               *TweetLikeQuery
+	withTweetUser           *UserTweetQuery
+	withTweetTags           *TweetTagQuery
+	withLikedUsersCount     *UserQuery
+	withLikedUsersAggregate []AggregateFunc
+	withUserCount           *UserQuery
+	withUserAggregate       []AggregateFunc
+	withTagsCount           *TagQuery
+	withTagsAggregate       []AggregateFunc
+	withLikesCount          *TweetLikeQuery
+	withLikesAggregate      []AggregateFunc
+	withTweetUserCount      *UserTweetQuery
+	withTweetUserAggregate  []AggregateFunc
+	withTweetTagsCount      *TweetTagQuery
+	withTweetTagsAggregate  []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -405,8 +417,20 @@ func (_q *TweetQuery) Clone() *TweetQuery {
 		withTweetUser:  _q.withTweetUser.Clone(),
 		withTweetTags:  _q.withTweetTags.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:                     _q.sql.Clone(),
+		path:                    _q.path,
+		withLikedUsersCount:     _q.withLikedUsersCount.Clone(),
+		withLikedUsersAggregate: append([]AggregateFunc{}, _q.withLikedUsersAggregate...),
+		withUserCount:           _q.withUserCount.Clone(),
+		withUserAggregate:       append([]AggregateFunc{}, _q.withUserAggregate...),
+		withTagsCount:           _q.withTagsCount.Clone(),
+		withTagsAggregate:       append([]AggregateFunc{}, _q.withTagsAggregate...),
+		withLikesCount:          _q.withLikesCount.Clone(),
+		withLikesAggregate:      append([]AggregateFunc{}, _q.withLikesAggregate...),
+		withTweetUserCount:      _q.withTweetUserCount.Clone(),
+		withTweetUserAggregate:  append([]AggregateFunc{}, _q.withTweetUserAggregate...),
+		withTweetTagsCount:      _q.withTweetTagsCount.Clone(),
+		withTweetTagsAggregate:  append([]AggregateFunc{}, _q.withTweetTagsAggregate...),
 	}
 }
 
@@ -623,6 +647,84 @@ func (_q *TweetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tweet,
 			return nil, err
 		}
 	}
+	if query := _q.withLikedUsersCount; query != nil {
+		if err := _q.aggregateLikedUsers(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tweet, v []float64) { n.Edges.LikedUsersCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withLikedUsersAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateLikedUsers(ctx, query, nodes, fns,
+			func(n *Tweet, v []float64) { n.Edges.LikedUsersAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUserCount; query != nil {
+		if err := _q.aggregateUser(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tweet, v []float64) { n.Edges.UserCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withUserAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateUser(ctx, query, nodes, fns,
+			func(n *Tweet, v []float64) { n.Edges.UserAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTagsCount; query != nil {
+		if err := _q.aggregateTags(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tweet, v []float64) { n.Edges.TagsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withTagsAggregate; len(fns) > 0 {
+		query := (&TagClient{config: _q.config}).Query()
+		if err := _q.aggregateTags(ctx, query, nodes, fns,
+			func(n *Tweet, v []float64) { n.Edges.TagsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLikesCount; query != nil {
+		if err := _q.aggregateLikes(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tweet, v []float64) { n.Edges.LikesCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withLikesAggregate; len(fns) > 0 {
+		query := (&TweetLikeClient{config: _q.config}).Query()
+		if err := _q.aggregateLikes(ctx, query, nodes, fns,
+			func(n *Tweet, v []float64) { n.Edges.LikesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTweetUserCount; query != nil {
+		if err := _q.aggregateTweetUser(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tweet, v []float64) { n.Edges.TweetUserCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withTweetUserAggregate; len(fns) > 0 {
+		query := (&UserTweetClient{config: _q.config}).Query()
+		if err := _q.aggregateTweetUser(ctx, query, nodes, fns,
+			func(n *Tweet, v []float64) { n.Edges.TweetUserAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTweetTagsCount; query != nil {
+		if err := _q.aggregateTweetTags(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Tweet, v []float64) { n.Edges.TweetTagsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withTweetTagsAggregate; len(fns) > 0 {
+		query := (&TweetTagClient{config: _q.config}).Query()
+		if err := _q.aggregateTweetTags(ctx, query, nodes, fns,
+			func(n *Tweet, v []float64) { n.Edges.TweetTagsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -981,6 +1083,456 @@ func (_q *TweetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithLikedUsersCount tells the query-builder to load the number of nodes that are connected to the
+// "liked_users" edge into the LikedUsersCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TweetQuery) WithLikedUsersCount(opts ...func(*UserQuery)) *TweetQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLikedUsersCount = query
+	return _q
+}
+
+// WithLikedUsersAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "liked_users" edge, and to load their results into the LikedUsersAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tweet.Query().
+//		WithLikedUsersAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TweetQuery) WithLikedUsersAggregate(fns ...AggregateFunc) *TweetQuery {
+	_q.withLikedUsersAggregate = append(_q.withLikedUsersAggregate, fns...)
+	return _q
+}
+
+func (_q *TweetQuery) aggregateLikedUsers(ctx context.Context, query *UserQuery, nodes []*Tweet, fns []AggregateFunc, assign func(*Tweet, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tweet, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tweet.Table, tweet.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, tweet.LikedUsersTable, tweet.LikedUsersPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "liked_users" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithUserCount tells the query-builder to load the number of nodes that are connected to the
+// "user" edge into the UserCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TweetQuery) WithUserCount(opts ...func(*UserQuery)) *TweetQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUserCount = query
+	return _q
+}
+
+// WithUserAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "user" edge, and to load their results into the UserAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tweet.Query().
+//		WithUserAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TweetQuery) WithUserAggregate(fns ...AggregateFunc) *TweetQuery {
+	_q.withUserAggregate = append(_q.withUserAggregate, fns...)
+	return _q
+}
+
+func (_q *TweetQuery) aggregateUser(ctx context.Context, query *UserQuery, nodes []*Tweet, fns []AggregateFunc, assign func(*Tweet, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tweet, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tweet.Table, tweet.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, tweet.UserTable, tweet.UserPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "user" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithTagsCount tells the query-builder to load the number of nodes that are connected to the
+// "tags" edge into the TagsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TweetQuery) WithTagsCount(opts ...func(*TagQuery)) *TweetQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTagsCount = query
+	return _q
+}
+
+// WithTagsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "tags" edge, and to load their results into the TagsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tweet.Query().
+//		WithTagsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TweetQuery) WithTagsAggregate(fns ...AggregateFunc) *TweetQuery {
+	_q.withTagsAggregate = append(_q.withTagsAggregate, fns...)
+	return _q
+}
+
+func (_q *TweetQuery) aggregateTags(ctx context.Context, query *TagQuery, nodes []*Tweet, fns []AggregateFunc, assign func(*Tweet, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tweet, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tweet.Table, tweet.FieldID),
+		sqlgraph.To(tag.Table, tag.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, tweet.TagsTable, tweet.TagsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithLikesCount tells the query-builder to load the number of nodes that are connected to the
+// "likes" edge into the LikesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TweetQuery) WithLikesCount(opts ...func(*TweetLikeQuery)) *TweetQuery {
+	query := (&TweetLikeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLikesCount = query
+	return _q
+}
+
+// WithLikesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "likes" edge, and to load their results into the LikesAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tweet.Query().
+//		WithLikesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TweetQuery) WithLikesAggregate(fns ...AggregateFunc) *TweetQuery {
+	_q.withLikesAggregate = append(_q.withLikesAggregate, fns...)
+	return _q
+}
+
+func (_q *TweetQuery) aggregateLikes(ctx context.Context, query *TweetLikeQuery, nodes []*Tweet, fns []AggregateFunc, assign func(*Tweet, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tweet, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tweet.Table, tweet.FieldID),
+		sqlgraph.To(tweetlike.Table, tweetlike.TweetColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, tweet.LikesTable, tweet.LikesColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "likes" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithTweetUserCount tells the query-builder to load the number of nodes that are connected to the
+// "tweet_user" edge into the TweetUserCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TweetQuery) WithTweetUserCount(opts ...func(*UserTweetQuery)) *TweetQuery {
+	query := (&UserTweetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTweetUserCount = query
+	return _q
+}
+
+// WithTweetUserAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "tweet_user" edge, and to load their results into the TweetUserAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tweet.Query().
+//		WithTweetUserAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TweetQuery) WithTweetUserAggregate(fns ...AggregateFunc) *TweetQuery {
+	_q.withTweetUserAggregate = append(_q.withTweetUserAggregate, fns...)
+	return _q
+}
+
+func (_q *TweetQuery) aggregateTweetUser(ctx context.Context, query *UserTweetQuery, nodes []*Tweet, fns []AggregateFunc, assign func(*Tweet, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tweet, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tweet.Table, tweet.FieldID),
+		sqlgraph.To(usertweet.Table, usertweet.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, tweet.TweetUserTable, tweet.TweetUserColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "tweet_user" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithTweetTagsCount tells the query-builder to load the number of nodes that are connected to the
+// "tweet_tags" edge into the TweetTagsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *TweetQuery) WithTweetTagsCount(opts ...func(*TweetTagQuery)) *TweetQuery {
+	query := (&TweetTagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTweetTagsCount = query
+	return _q
+}
+
+// WithTweetTagsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "tweet_tags" edge, and to load their results into the TweetTagsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.Tweet.Query().
+//		WithTweetTagsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *TweetQuery) WithTweetTagsAggregate(fns ...AggregateFunc) *TweetQuery {
+	_q.withTweetTagsAggregate = append(_q.withTweetTagsAggregate, fns...)
+	return _q
+}
+
+func (_q *TweetQuery) aggregateTweetTags(ctx context.Context, query *TweetTagQuery, nodes []*Tweet, fns []AggregateFunc, assign func(*Tweet, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tweet, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(tweet.Table, tweet.FieldID),
+		sqlgraph.To(tweettag.Table, tweettag.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, tweet.TweetTagsTable, tweet.TweetTagsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "tweet_tags" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
 // TweetGroupBy is the group-by builder for Tweet entities.
 type TweetGroupBy struct {
 	selector
@@ -1062,6 +1614,9 @@ func (ts *TweetSelect) sqlScan(ctx context.Context, root *TweetQuery, v any) err
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ts.driver.Query(ctx, query, args, rows); err != nil {
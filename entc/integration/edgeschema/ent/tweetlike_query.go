@@ -604,6 +604,9 @@ func (tls *TweetLikeSelect) sqlScan(ctx context.Context, root *TweetLikeQuery, v
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := tls.driver.Query(ctx, query, args, rows); err != nil {
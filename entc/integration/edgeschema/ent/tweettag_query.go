@@ -676,6 +676,9 @@ func (tts *TweetTagSelect) sqlScan(ctx context.Context, root *TweetTagQuery, v a
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := tts.driver.Query(ctx, query, args, rows); err != nil {
@@ -57,6 +57,78 @@ type UserEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [12]bool
+	// GroupsCount holds the number of nodes that are connected to the "groups" edge.
+	// The value is being populated by the UserQuery when WithGroupsCount is set.
+	GroupsCount int `json:"groups_count,omitempty"`
+	// GroupsAggregate holds the results of the aggregation functions that were passed to
+	// WithGroupsAggregate, in the same order, for the nodes of the "groups" edge.
+	GroupsAggregate []float64 `json:"groups_aggregate,omitempty"`
+	// FriendsCount holds the number of nodes that are connected to the "friends" edge.
+	// The value is being populated by the UserQuery when WithFriendsCount is set.
+	FriendsCount int `json:"friends_count,omitempty"`
+	// FriendsAggregate holds the results of the aggregation functions that were passed to
+	// WithFriendsAggregate, in the same order, for the nodes of the "friends" edge.
+	FriendsAggregate []float64 `json:"friends_aggregate,omitempty"`
+	// RelativesCount holds the number of nodes that are connected to the "relatives" edge.
+	// The value is being populated by the UserQuery when WithRelativesCount is set.
+	RelativesCount int `json:"relatives_count,omitempty"`
+	// RelativesAggregate holds the results of the aggregation functions that were passed to
+	// WithRelativesAggregate, in the same order, for the nodes of the "relatives" edge.
+	RelativesAggregate []float64 `json:"relatives_aggregate,omitempty"`
+	// LikedTweetsCount holds the number of nodes that are connected to the "liked_tweets" edge.
+	// The value is being populated by the UserQuery when WithLikedTweetsCount is set.
+	LikedTweetsCount int `json:"liked_tweets_count,omitempty"`
+	// LikedTweetsAggregate holds the results of the aggregation functions that were passed to
+	// WithLikedTweetsAggregate, in the same order, for the nodes of the "liked_tweets" edge.
+	LikedTweetsAggregate []float64 `json:"liked_tweets_aggregate,omitempty"`
+	// TweetsCount holds the number of nodes that are connected to the "tweets" edge.
+	// The value is being populated by the UserQuery when WithTweetsCount is set.
+	TweetsCount int `json:"tweets_count,omitempty"`
+	// TweetsAggregate holds the results of the aggregation functions that were passed to
+	// WithTweetsAggregate, in the same order, for the nodes of the "tweets" edge.
+	TweetsAggregate []float64 `json:"tweets_aggregate,omitempty"`
+	// RolesCount holds the number of nodes that are connected to the "roles" edge.
+	// The value is being populated by the UserQuery when WithRolesCount is set.
+	RolesCount int `json:"roles_count,omitempty"`
+	// RolesAggregate holds the results of the aggregation functions that were passed to
+	// WithRolesAggregate, in the same order, for the nodes of the "roles" edge.
+	RolesAggregate []float64 `json:"roles_aggregate,omitempty"`
+	// JoinedGroupsCount holds the number of nodes that are connected to the "joined_groups" edge.
+	// The value is being populated by the UserQuery when WithJoinedGroupsCount is set.
+	JoinedGroupsCount int `json:"joined_groups_count,omitempty"`
+	// JoinedGroupsAggregate holds the results of the aggregation functions that were passed to
+	// WithJoinedGroupsAggregate, in the same order, for the nodes of the "joined_groups" edge.
+	JoinedGroupsAggregate []float64 `json:"joined_groups_aggregate,omitempty"`
+	// FriendshipsCount holds the number of nodes that are connected to the "friendships" edge.
+	// The value is being populated by the UserQuery when WithFriendshipsCount is set.
+	FriendshipsCount int `json:"friendships_count,omitempty"`
+	// FriendshipsAggregate holds the results of the aggregation functions that were passed to
+	// WithFriendshipsAggregate, in the same order, for the nodes of the "friendships" edge.
+	FriendshipsAggregate []float64 `json:"friendships_aggregate,omitempty"`
+	// RelationshipCount holds the number of nodes that are connected to the "relationship" edge.
+	// The value is being populated by the UserQuery when WithRelationshipCount is set.
+	RelationshipCount int `json:"relationship_count,omitempty"`
+	// RelationshipAggregate holds the results of the aggregation functions that were passed to
+	// WithRelationshipAggregate, in the same order, for the nodes of the "relationship" edge.
+	RelationshipAggregate []float64 `json:"relationship_aggregate,omitempty"`
+	// LikesCount holds the number of nodes that are connected to the "likes" edge.
+	// The value is being populated by the UserQuery when WithLikesCount is set.
+	LikesCount int `json:"likes_count,omitempty"`
+	// LikesAggregate holds the results of the aggregation functions that were passed to
+	// WithLikesAggregate, in the same order, for the nodes of the "likes" edge.
+	LikesAggregate []float64 `json:"likes_aggregate,omitempty"`
+	// UserTweetsCount holds the number of nodes that are connected to the "user_tweets" edge.
+	// The value is being populated by the UserQuery when WithUserTweetsCount is set.
+	UserTweetsCount int `json:"user_tweets_count,omitempty"`
+	// UserTweetsAggregate holds the results of the aggregation functions that were passed to
+	// WithUserTweetsAggregate, in the same order, for the nodes of the "user_tweets" edge.
+	UserTweetsAggregate []float64 `json:"user_tweets_aggregate,omitempty"`
+	// RolesUsersCount holds the number of nodes that are connected to the "roles_users" edge.
+	// The value is being populated by the UserQuery when WithRolesUsersCount is set.
+	RolesUsersCount int `json:"roles_users_count,omitempty"`
+	// RolesUsersAggregate holds the results of the aggregation functions that were passed to
+	// WithRolesUsersAggregate, in the same order, for the nodes of the "roles_users" edge.
+	RolesUsersAggregate []float64 `json:"roles_users_aggregate,omitempty"`
 }
 
 // GroupsOrErr returns the Groups value or an error if the edge
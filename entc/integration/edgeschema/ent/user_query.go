@@ -33,22 +33,46 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx              *QueryContext
-	order            []user.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.User
-	withGroups       *GroupQuery
-	withFriends      *UserQuery
-	withRelatives    *UserQuery
-	withLikedTweets  *TweetQuery
-	withTweets       *TweetQuery
-	withRoles        *RoleQuery
-	withJoinedGroups *UserGroupQuery
-	withFriendships  *FriendshipQuery
-	withRelationship *RelationshipQuery
-	withLikes        *TweetLikeQuery
-	withUserTweets   *UserTweetQuery
-	withRolesUsers   *RoleUserQuery
+	ctx                       *QueryContext
+	order                     []user.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.User
+	withGroups                *GroupQuery
+	withFriends               *UserQuery
+	withRelatives             *UserQuery
+	withLikedTweets           *TweetQuery
+	withTweets                *TweetQuery
+	withRoles                 *RoleQuery
+	withJoinedGroups          *UserGroupQuery
+	withFriendships           *FriendshipQuery
+	withRelationship          *RelationshipQuery
+	withLikes                 *TweetLikeQuery
+	withUserTweets            *UserTweetQuery
+	withRolesUsers            *RoleUserQuery
+	withGroupsCount           *GroupQuery
+	withGroupsAggregate       []AggregateFunc
+	withFriendsCount          *UserQuery
+	withFriendsAggregate      []AggregateFunc
+	withRelativesCount        *UserQuery
+	withRelativesAggregate    []AggregateFunc
+	withLikedTweetsCount      *TweetQuery
+	withLikedTweetsAggregate  []AggregateFunc
+	withTweetsCount           *TweetQuery
+	withTweetsAggregate       []AggregateFunc
+	withRolesCount            *RoleQuery
+	withRolesAggregate        []AggregateFunc
+	withJoinedGroupsCount     *UserGroupQuery
+	withJoinedGroupsAggregate []AggregateFunc
+	withFriendshipsCount      *FriendshipQuery
+	withFriendshipsAggregate  []AggregateFunc
+	withRelationshipCount     *RelationshipQuery
+	withRelationshipAggregate []AggregateFunc
+	withLikesCount            *TweetLikeQuery
+	withLikesAggregate        []AggregateFunc
+	withUserTweetsCount       *UserTweetQuery
+	withUserTweetsAggregate   []AggregateFunc
+	withRolesUsersCount       *RoleUserQuery
+	withRolesUsersAggregate   []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -554,8 +578,32 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withUserTweets:   _q.withUserTweets.Clone(),
 		withRolesUsers:   _q.withRolesUsers.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:                       _q.sql.Clone(),
+		path:                      _q.path,
+		withGroupsCount:           _q.withGroupsCount.Clone(),
+		withGroupsAggregate:       append([]AggregateFunc{}, _q.withGroupsAggregate...),
+		withFriendsCount:          _q.withFriendsCount.Clone(),
+		withFriendsAggregate:      append([]AggregateFunc{}, _q.withFriendsAggregate...),
+		withRelativesCount:        _q.withRelativesCount.Clone(),
+		withRelativesAggregate:    append([]AggregateFunc{}, _q.withRelativesAggregate...),
+		withLikedTweetsCount:      _q.withLikedTweetsCount.Clone(),
+		withLikedTweetsAggregate:  append([]AggregateFunc{}, _q.withLikedTweetsAggregate...),
+		withTweetsCount:           _q.withTweetsCount.Clone(),
+		withTweetsAggregate:       append([]AggregateFunc{}, _q.withTweetsAggregate...),
+		withRolesCount:            _q.withRolesCount.Clone(),
+		withRolesAggregate:        append([]AggregateFunc{}, _q.withRolesAggregate...),
+		withJoinedGroupsCount:     _q.withJoinedGroupsCount.Clone(),
+		withJoinedGroupsAggregate: append([]AggregateFunc{}, _q.withJoinedGroupsAggregate...),
+		withFriendshipsCount:      _q.withFriendshipsCount.Clone(),
+		withFriendshipsAggregate:  append([]AggregateFunc{}, _q.withFriendshipsAggregate...),
+		withRelationshipCount:     _q.withRelationshipCount.Clone(),
+		withRelationshipAggregate: append([]AggregateFunc{}, _q.withRelationshipAggregate...),
+		withLikesCount:            _q.withLikesCount.Clone(),
+		withLikesAggregate:        append([]AggregateFunc{}, _q.withLikesAggregate...),
+		withUserTweetsCount:       _q.withUserTweetsCount.Clone(),
+		withUserTweetsAggregate:   append([]AggregateFunc{}, _q.withUserTweetsAggregate...),
+		withRolesUsersCount:       _q.withRolesUsersCount.Clone(),
+		withRolesUsersAggregate:   append([]AggregateFunc{}, _q.withRolesUsersAggregate...),
 	}
 }
 
@@ -892,6 +940,162 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withGroupsCount; query != nil {
+		if err := _q.aggregateGroups(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.GroupsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withGroupsAggregate; len(fns) > 0 {
+		query := (&GroupClient{config: _q.config}).Query()
+		if err := _q.aggregateGroups(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.GroupsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFriendsCount; query != nil {
+		if err := _q.aggregateFriends(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.FriendsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFriendsAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateFriends(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.FriendsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRelativesCount; query != nil {
+		if err := _q.aggregateRelatives(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.RelativesCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withRelativesAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateRelatives(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.RelativesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLikedTweetsCount; query != nil {
+		if err := _q.aggregateLikedTweets(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.LikedTweetsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withLikedTweetsAggregate; len(fns) > 0 {
+		query := (&TweetClient{config: _q.config}).Query()
+		if err := _q.aggregateLikedTweets(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.LikedTweetsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTweetsCount; query != nil {
+		if err := _q.aggregateTweets(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.TweetsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withTweetsAggregate; len(fns) > 0 {
+		query := (&TweetClient{config: _q.config}).Query()
+		if err := _q.aggregateTweets(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.TweetsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRolesCount; query != nil {
+		if err := _q.aggregateRoles(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.RolesCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withRolesAggregate; len(fns) > 0 {
+		query := (&RoleClient{config: _q.config}).Query()
+		if err := _q.aggregateRoles(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.RolesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withJoinedGroupsCount; query != nil {
+		if err := _q.aggregateJoinedGroups(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.JoinedGroupsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withJoinedGroupsAggregate; len(fns) > 0 {
+		query := (&UserGroupClient{config: _q.config}).Query()
+		if err := _q.aggregateJoinedGroups(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.JoinedGroupsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFriendshipsCount; query != nil {
+		if err := _q.aggregateFriendships(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.FriendshipsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFriendshipsAggregate; len(fns) > 0 {
+		query := (&FriendshipClient{config: _q.config}).Query()
+		if err := _q.aggregateFriendships(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.FriendshipsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRelationshipCount; query != nil {
+		if err := _q.aggregateRelationship(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.RelationshipCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withRelationshipAggregate; len(fns) > 0 {
+		query := (&RelationshipClient{config: _q.config}).Query()
+		if err := _q.aggregateRelationship(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.RelationshipAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLikesCount; query != nil {
+		if err := _q.aggregateLikes(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.LikesCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withLikesAggregate; len(fns) > 0 {
+		query := (&TweetLikeClient{config: _q.config}).Query()
+		if err := _q.aggregateLikes(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.LikesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUserTweetsCount; query != nil {
+		if err := _q.aggregateUserTweets(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.UserTweetsCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withUserTweetsAggregate; len(fns) > 0 {
+		query := (&UserTweetClient{config: _q.config}).Query()
+		if err := _q.aggregateUserTweets(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.UserTweetsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRolesUsersCount; query != nil {
+		if err := _q.aggregateRolesUsers(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []float64) { n.Edges.RolesUsersCount = int(v[0]) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withRolesUsersAggregate; len(fns) > 0 {
+		query := (&RoleUserClient{config: _q.config}).Query()
+		if err := _q.aggregateRolesUsers(ctx, query, nodes, fns,
+			func(n *User, v []float64) { n.Edges.RolesUsersAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1523,6 +1727,906 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithGroupsCount tells the query-builder to load the number of nodes that are connected to the
+// "groups" edge into the GroupsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithGroupsCount(opts ...func(*GroupQuery)) *UserQuery {
+	query := (&GroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroupsCount = query
+	return _q
+}
+
+// WithGroupsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "groups" edge, and to load their results into the GroupsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithGroupsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithGroupsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withGroupsAggregate = append(_q.withGroupsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateGroups(ctx context.Context, query *GroupQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(group.Table, group.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.GroupsTable, user.GroupsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "groups" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithFriendsCount tells the query-builder to load the number of nodes that are connected to the
+// "friends" edge into the FriendsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithFriendsCount(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFriendsCount = query
+	return _q
+}
+
+// WithFriendsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "friends" edge, and to load their results into the FriendsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithFriendsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithFriendsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withFriendsAggregate = append(_q.withFriendsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateFriends(ctx context.Context, query *UserQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "friends" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithRelativesCount tells the query-builder to load the number of nodes that are connected to the
+// "relatives" edge into the RelativesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithRelativesCount(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRelativesCount = query
+	return _q
+}
+
+// WithRelativesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "relatives" edge, and to load their results into the RelativesAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithRelativesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithRelativesAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withRelativesAggregate = append(_q.withRelativesAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateRelatives(ctx context.Context, query *UserQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.RelativesTable, user.RelativesPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "relatives" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithLikedTweetsCount tells the query-builder to load the number of nodes that are connected to the
+// "liked_tweets" edge into the LikedTweetsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithLikedTweetsCount(opts ...func(*TweetQuery)) *UserQuery {
+	query := (&TweetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLikedTweetsCount = query
+	return _q
+}
+
+// WithLikedTweetsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "liked_tweets" edge, and to load their results into the LikedTweetsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithLikedTweetsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithLikedTweetsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withLikedTweetsAggregate = append(_q.withLikedTweetsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateLikedTweets(ctx context.Context, query *TweetQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(tweet.Table, tweet.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.LikedTweetsTable, user.LikedTweetsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "liked_tweets" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithTweetsCount tells the query-builder to load the number of nodes that are connected to the
+// "tweets" edge into the TweetsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithTweetsCount(opts ...func(*TweetQuery)) *UserQuery {
+	query := (&TweetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTweetsCount = query
+	return _q
+}
+
+// WithTweetsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "tweets" edge, and to load their results into the TweetsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithTweetsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithTweetsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withTweetsAggregate = append(_q.withTweetsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateTweets(ctx context.Context, query *TweetQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(tweet.Table, tweet.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.TweetsTable, user.TweetsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "tweets" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithRolesCount tells the query-builder to load the number of nodes that are connected to the
+// "roles" edge into the RolesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithRolesCount(opts ...func(*RoleQuery)) *UserQuery {
+	query := (&RoleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRolesCount = query
+	return _q
+}
+
+// WithRolesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "roles" edge, and to load their results into the RolesAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithRolesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithRolesAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withRolesAggregate = append(_q.withRolesAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateRoles(ctx context.Context, query *RoleQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(role.Table, role.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "roles" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithJoinedGroupsCount tells the query-builder to load the number of nodes that are connected to the
+// "joined_groups" edge into the JoinedGroupsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithJoinedGroupsCount(opts ...func(*UserGroupQuery)) *UserQuery {
+	query := (&UserGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withJoinedGroupsCount = query
+	return _q
+}
+
+// WithJoinedGroupsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "joined_groups" edge, and to load their results into the JoinedGroupsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithJoinedGroupsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithJoinedGroupsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withJoinedGroupsAggregate = append(_q.withJoinedGroupsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateJoinedGroups(ctx context.Context, query *UserGroupQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(usergroup.Table, usergroup.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, user.JoinedGroupsTable, user.JoinedGroupsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "joined_groups" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithFriendshipsCount tells the query-builder to load the number of nodes that are connected to the
+// "friendships" edge into the FriendshipsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithFriendshipsCount(opts ...func(*FriendshipQuery)) *UserQuery {
+	query := (&FriendshipClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFriendshipsCount = query
+	return _q
+}
+
+// WithFriendshipsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "friendships" edge, and to load their results into the FriendshipsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithFriendshipsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithFriendshipsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withFriendshipsAggregate = append(_q.withFriendshipsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateFriendships(ctx context.Context, query *FriendshipQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(friendship.Table, friendship.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, user.FriendshipsTable, user.FriendshipsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "friendships" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithRelationshipCount tells the query-builder to load the number of nodes that are connected to the
+// "relationship" edge into the RelationshipCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithRelationshipCount(opts ...func(*RelationshipQuery)) *UserQuery {
+	query := (&RelationshipClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRelationshipCount = query
+	return _q
+}
+
+// WithRelationshipAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "relationship" edge, and to load their results into the RelationshipAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithRelationshipAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithRelationshipAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withRelationshipAggregate = append(_q.withRelationshipAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateRelationship(ctx context.Context, query *RelationshipQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(relationship.Table, relationship.UserColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, user.RelationshipTable, user.RelationshipColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "relationship" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithLikesCount tells the query-builder to load the number of nodes that are connected to the
+// "likes" edge into the LikesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithLikesCount(opts ...func(*TweetLikeQuery)) *UserQuery {
+	query := (&TweetLikeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLikesCount = query
+	return _q
+}
+
+// WithLikesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "likes" edge, and to load their results into the LikesAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithLikesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithLikesAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withLikesAggregate = append(_q.withLikesAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateLikes(ctx context.Context, query *TweetLikeQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(tweetlike.Table, tweetlike.UserColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, user.LikesTable, user.LikesColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "likes" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithUserTweetsCount tells the query-builder to load the number of nodes that are connected to the
+// "user_tweets" edge into the UserTweetsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithUserTweetsCount(opts ...func(*UserTweetQuery)) *UserQuery {
+	query := (&UserTweetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUserTweetsCount = query
+	return _q
+}
+
+// WithUserTweetsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "user_tweets" edge, and to load their results into the UserTweetsAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithUserTweetsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithUserTweetsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withUserTweetsAggregate = append(_q.withUserTweetsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateUserTweets(ctx context.Context, query *UserTweetQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(usertweet.Table, usertweet.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, user.UserTweetsTable, user.UserTweetsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "user_tweets" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithRolesUsersCount tells the query-builder to load the number of nodes that are connected to the
+// "roles_users" edge into the RolesUsersCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithRolesUsersCount(opts ...func(*RoleUserQuery)) *UserQuery {
+	query := (&RoleUserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRolesUsersCount = query
+	return _q
+}
+
+// WithRolesUsersAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "roles_users" edge, and to load their results into the RolesUsersAggregate
+// field of the edges, without loading the nodes themselves. Aggregations of nodes without neighbors are 0.
+//
+//	client.User.Query().
+//		WithRolesUsersAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithRolesUsersAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withRolesUsersAggregate = append(_q.withRolesUsersAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateRolesUsers(ctx context.Context, query *RoleUserQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []float64)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+		assign(node, make([]float64, len(fns)))
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(roleuser.Table, roleuser.UserColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, user.RolesUsersTable, user.RolesUsersColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	for _, fn := range fns {
+		selector.AppendSelect(fn(selector))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		for range fns {
+			values = append(values, new(sql.NullFloat64))
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "roles_users" aggregation returned for node %v`, id)
+		}
+		result := make([]float64, len(fns))
+		for i := range fns {
+			result[i] = values[i+1].(*sql.NullFloat64).Float64
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -1604,6 +2708,9 @@ func (us *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := us.driver.Query(ctx, query, args, rows); err != nil {
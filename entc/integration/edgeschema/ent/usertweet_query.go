@@ -675,6 +675,9 @@ func (uts *UserTweetSelect) sqlScan(ctx context.Context, root *UserTweetQuery, v
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := uts.driver.Query(ctx, query, args, rows); err != nil {
@@ -24,6 +24,9 @@ type APIUpdate struct {
 	hooks     []Hook
 	mutation  *APIMutation
 	modifiers []func(*sql.UpdateBuilder)
+	fields    []string
+	returning bool
+	nodes     []*Api
 }
 
 // Where appends a list predicates to the APIUpdate builder.
@@ -70,6 +73,33 @@ func (_u *APIUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APIUpdate
 	return _u
 }
 
+// Select allows selecting one or more fields (columns) of the returned entities.
+// The default is selecting all fields defined in the entity schema.
+func (_u *APIUpdate) Select(field string, fields ...string) *APIUpdate {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// SaveReturning executes the query and returns the updated Apis. In PostgreSQL and SQLite,
+// the entities are read from the updated rows using the RETURNING clause (OUTPUT in SQL Server). In MySQL,
+// the matched rows are locked, updated and selected again in one transaction.
+func (_u *APIUpdate) SaveReturning(ctx context.Context) ([]*Api, error) {
+	_u.returning, _u.nodes = true, nil
+	if _, err := _u.Save(ctx); err != nil {
+		return nil, err
+	}
+	return _u.nodes, nil
+}
+
+// SaveReturningX is like SaveReturning, but panics if an error occurs.
+func (_u *APIUpdate) SaveReturningX(ctx context.Context) []*Api {
+	nodes, err := _u.SaveReturning(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (_u *APIUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(api.Table, api.Columns, sqlgraph.NewFieldSpec(api.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -80,6 +110,30 @@ func (_u *APIUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 	}
 	_spec.AddModifiers(_u.modifiers...)
+	if _u.returning {
+		_spec.Node.Columns = api.Columns
+		if fields := _u.fields; len(fields) > 0 {
+			_spec.Node.Columns = make([]string, 0, len(fields)+1)
+			_spec.Node.Columns = append(_spec.Node.Columns, api.FieldID)
+			for _, f := range fields {
+				if !api.ValidColumn(f) {
+					return 0, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				}
+				if f != api.FieldID {
+					_spec.Node.Columns = append(_spec.Node.Columns, f)
+				}
+			}
+		}
+		_spec.Returning = true
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*Api).scanValues(nil, columns)
+		}
+		_spec.Assign = func(columns []string, values []any) error {
+			node := &Api{config: _u.config}
+			_u.nodes = append(_u.nodes, node)
+			return node.assignValues(columns, values)
+		}
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{api.Label}
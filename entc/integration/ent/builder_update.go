@@ -24,6 +24,9 @@ type BuilderUpdate struct {
 	hooks     []Hook
 	mutation  *BuilderMutation
 	modifiers []func(*sql.UpdateBuilder)
+	fields    []string
+	returning bool
+	nodes     []*Builder
 }
 
 // Where appends a list predicates to the BuilderUpdate builder.
@@ -70,6 +73,33 @@ func (_u *BuilderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *Builde
 	return _u
 }
 
+// Select allows selecting one or more fields (columns) of the returned entities.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BuilderUpdate) Select(field string, fields ...string) *BuilderUpdate {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// SaveReturning executes the query and returns the updated Builders. In PostgreSQL and SQLite,
+// the entities are read from the updated rows using the RETURNING clause (OUTPUT in SQL Server). In MySQL,
+// the matched rows are locked, updated and selected again in one transaction.
+func (_u *BuilderUpdate) SaveReturning(ctx context.Context) ([]*Builder, error) {
+	_u.returning, _u.nodes = true, nil
+	if _, err := _u.Save(ctx); err != nil {
+		return nil, err
+	}
+	return _u.nodes, nil
+}
+
+// SaveReturningX is like SaveReturning, but panics if an error occurs.
+func (_u *BuilderUpdate) SaveReturningX(ctx context.Context) []*Builder {
+	nodes, err := _u.SaveReturning(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (_u *BuilderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(builder.Table, builder.Columns, sqlgraph.NewFieldSpec(builder.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -80,6 +110,30 @@ func (_u *BuilderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 	}
 	_spec.AddModifiers(_u.modifiers...)
+	if _u.returning {
+		_spec.Node.Columns = builder.Columns
+		if fields := _u.fields; len(fields) > 0 {
+			_spec.Node.Columns = make([]string, 0, len(fields)+1)
+			_spec.Node.Columns = append(_spec.Node.Columns, builder.FieldID)
+			for _, f := range fields {
+				if !builder.ValidColumn(f) {
+					return 0, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				}
+				if f != builder.FieldID {
+					_spec.Node.Columns = append(_spec.Node.Columns, f)
+				}
+			}
+		}
+		_spec.Returning = true
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*Builder).scanValues(nil, columns)
+		}
+		_spec.Assign = func(columns []string, values []any) error {
+			node := &Builder{config: _u.config}
+			_u.nodes = append(_u.nodes, node)
+			return node.assignValues(columns, values)
+		}
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{builder.Label}
@@ -51,7 +51,14 @@ type CardEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
-	namedSpec   map[string][]*Spec
+	// SpecCount holds the number of nodes that are connected to the "spec" edge.
+	// The value is being populated by the CardQuery when WithSpecCount is set.
+	SpecCount int `json:"spec_count,omitempty"`
+	// SpecAggregate holds the results of the aggregation functions that were passed to
+	// WithSpecAggregate, in the same order, for the nodes of the "spec" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	SpecAggregate []any `json:"spec_aggregate,omitempty"`
+	namedSpec     map[string][]*Spec
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -519,6 +519,7 @@ type CardCreateBulk struct {
 	config
 	err      error
 	builders []*CardCreate
+	load     bool
 	conflict []sql.ConflictOption
 }
 
@@ -549,6 +550,13 @@ func (_c *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					if _c.load {
+						spec.Load = &sqlgraph.LoadSpec{}
+						// Hooks may rely on the IDs of the created entities.
+						for _, b := range _c.builders {
+							spec.Load.Returning = spec.Load.Returning || len(b.hooks) > 0
+						}
+					}
 					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
@@ -604,6 +612,23 @@ func (_c *CardCreateBulk) ExecX(ctx context.Context) {
 	}
 }
 
+// Load loads the Card entities into the database in bulk. Unlike Save, the IDs of the
+// entities are not read back from the database, unless they are required by hooks or edges.
+// In PostgreSQL, the entities are copied into their table using the COPY command, if it is
+// supported by the driver (see sql.Copier). In other cases, they are inserted in chunks that
+// respect the parameter limit of the dialect. Hooks are executed for each mutation, as in Save.
+func (_c *CardCreateBulk) Load(ctx context.Context) error {
+	_c.load = true
+	return _c.Exec(ctx)
+}
+
+// LoadX is like Load, but panics if an error occurs.
+func (_c *CardCreateBulk) LoadX(ctx context.Context) {
+	if err := _c.Load(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
 // of the `INSERT` statement. For example:
 //
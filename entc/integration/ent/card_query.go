@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -26,15 +28,19 @@ import (
 // CardQuery is the builder for querying Card entities.
 type CardQuery struct {
 	config
-	ctx           *QueryContext
-	order         []card.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Card
-	withOwner     *UserQuery
-	withSpec      *SpecQuery
-	withFKs       bool
-	modifiers     []func(*sql.Selector)
-	withNamedSpec map[string]*SpecQuery
+	ctx        *QueryContext
+	order      []card.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Card
+	withOwner  *UserQuery
+	withSpec   *SpecQuery
+	withFKs    bool
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch             func(context.Context, *loader) ([]*Card, error)
+	withSpecCount     *SpecQuery
+	withSpecAggregate []AggregateFunc
+	modifiers         []func(*sql.Selector)
+	withNamedSpec     map[string]*SpecQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -222,6 +228,9 @@ func (_q *CardQuery) All(ctx context.Context) ([]*Card, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*Card, *CardQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*Card, *CardQuery](l, qr)
+	}
 	return withInterceptors[[]*Card](ctx, _q, qr, _q.inters)
 }
 
@@ -310,9 +319,11 @@ func (_q *CardQuery) Clone() *CardQuery {
 		withOwner:  _q.withOwner.Clone(),
 		withSpec:   _q.withSpec.Clone(),
 		// clone intermediate query.
-		sql:       _q.sql.Clone(),
-		path:      _q.path,
-		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+		sql:               _q.sql.Clone(),
+		path:              _q.path,
+		modifiers:         append([]func(*sql.Selector){}, _q.modifiers...),
+		withSpecCount:     _q.withSpecCount.Clone(),
+		withSpecAggregate: append([]AggregateFunc{}, _q.withSpecAggregate...),
 	}
 }
 
@@ -440,6 +451,9 @@ func (_q *CardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Card, e
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -467,6 +481,19 @@ func (_q *CardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Card, e
 			return nil, err
 		}
 	}
+	if query := _q.withSpecCount; query != nil {
+		if err := _q.aggregateSpec(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Card, v []any) { n.Edges.SpecCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withSpecAggregate; len(fns) > 0 {
+		query := (&SpecClient{config: _q.config}).Query()
+		if err := _q.aggregateSpec(ctx, query, nodes, fns,
+			func(n *Card, v []any) { n.Edges.SpecAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedSpec {
 		if err := _q.loadSpec(ctx, query, nodes,
 			func(n *Card) { n.appendNamedSpec(name) },
@@ -576,6 +603,9 @@ func (_q *CardQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -641,6 +671,7 @@ func (_q *CardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -658,6 +689,224 @@ func (_q *CardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *CardQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.withOwner == nil && _q.withSpec == nil && _q.withNamedSpec == nil && _q.modifiers == nil && !_q.withFKs
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *CardQuery) batchAll(ctx context.Context, l *loader) ([]*Card, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*Card, 0, len(nodes)), nodes...), nil
+}
+
+// WithSpecCount tells the query-builder to load the number of nodes that are connected to the
+// "spec" edge into the SpecCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *CardQuery) WithSpecCount(opts ...func(*SpecQuery)) *CardQuery {
+	query := (&SpecClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSpecCount = query
+	return _q
+}
+
+// WithSpecAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "spec" edge, and to load their results into the SpecAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.Card.Query().
+//		WithSpecAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *CardQuery) WithSpecAggregate(fns ...AggregateFunc) *CardQuery {
+	_q.withSpecAggregate = append(_q.withSpecAggregate, fns...)
+	return _q
+}
+
+func (_q *CardQuery) aggregateSpec(ctx context.Context, query *SpecQuery, nodes []*Card, fns []AggregateFunc, assign func(*Card, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Card, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(card.Table, card.FieldID),
+		sqlgraph.To(spec.Table, spec.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, card.SpecTable, card.SpecPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range spec.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*Spec).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "spec" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &Spec{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case spec.FieldID:
+				result[i] = n.ID
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// Iter executes the query and returns an iterator that streams its Cards from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.Card.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *CardQuery) Iter(ctx context.Context) iter.Seq2[*Card, error] {
+	return func(yield func(*Card, error) bool) {
+		if _q.withOwner != nil || _q.withSpec != nil || len(_q.withNamedSpec) > 0 || _q.withSpecCount != nil || len(_q.withSpecAggregate) > 0 {
+			yield(nil, errors.New("ent: eager-loading is not supported by CardQuery.Iter, use EachBatch instead"))
+			return
+		}
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &Card{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of Cards.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.Card.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.Card) error {
+//			// ...
+//		})
+func (_q *CardQuery) EachBatch(ctx context.Context, size int, fn func([]*Card) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.withNamedSpec = _q.withNamedSpec
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(card.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.Card(sql.FieldGT(card.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -704,6 +953,66 @@ func (_q *CardQuery) WithNamedSpec(name string, opts ...func(*SpecQuery)) *CardQ
 	return _q
 }
 
+// CardPage is a page of Cards returned by CardQuery.Paginate.
+type CardPage struct {
+	// Nodes holds the Cards of the page.
+	Nodes []*Card
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more Cards after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" Cards that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.Card.Query().
+//		Order(card.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.Card.Query().
+//		Order(card.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *CardQuery) Paginate(ctx context.Context, after *Cursor, first int) (*CardPage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("card: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		card.FieldID,
+		card.FieldCreateTime,
+		card.FieldUpdateTime,
+		card.FieldBalance,
+		card.FieldNumber,
+	)
+	nodes, err := _q.Clone().
+		Order(card.ByID()).
+		Where(predicate.Card(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &CardPage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // CardGroupBy is the group-by builder for Card entities.
 type CardGroupBy struct {
 	selector
@@ -785,6 +1094,9 @@ func (cs *CardSelect) sqlScan(ctx context.Context, root *CardQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
@@ -27,6 +27,9 @@ type CardUpdate struct {
 	hooks     []Hook
 	mutation  *CardMutation
 	modifiers []func(*sql.UpdateBuilder)
+	fields    []string
+	returning bool
+	nodes     []*Card
 }
 
 // Where appends a list predicates to the CardUpdate builder.
@@ -200,6 +203,33 @@ func (_u *CardUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CardUpdat
 	return _u
 }
 
+// Select allows selecting one or more fields (columns) of the returned entities.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CardUpdate) Select(field string, fields ...string) *CardUpdate {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// SaveReturning executes the query and returns the updated Cards. In PostgreSQL and SQLite,
+// the entities are read from the updated rows using the RETURNING clause (OUTPUT in SQL Server). In MySQL,
+// the matched rows are locked, updated and selected again in one transaction.
+func (_u *CardUpdate) SaveReturning(ctx context.Context) ([]*Card, error) {
+	_u.returning, _u.nodes = true, nil
+	if _, err := _u.Save(ctx); err != nil {
+		return nil, err
+	}
+	return _u.nodes, nil
+}
+
+// SaveReturningX is like SaveReturning, but panics if an error occurs.
+func (_u *CardUpdate) SaveReturningX(ctx context.Context) []*Card {
+	nodes, err := _u.SaveReturning(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (_u *CardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -302,6 +332,30 @@ func (_u *CardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_spec.AddModifiers(_u.modifiers...)
+	if _u.returning {
+		_spec.Node.Columns = card.Columns
+		if fields := _u.fields; len(fields) > 0 {
+			_spec.Node.Columns = make([]string, 0, len(fields)+1)
+			_spec.Node.Columns = append(_spec.Node.Columns, card.FieldID)
+			for _, f := range fields {
+				if !card.ValidColumn(f) {
+					return 0, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				}
+				if f != card.FieldID {
+					_spec.Node.Columns = append(_spec.Node.Columns, f)
+				}
+			}
+		}
+		_spec.Returning = true
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*Card).scanValues(nil, columns)
+		}
+		_spec.Assign = func(columns []string, values []any) error {
+			node := &Card{config: _u.config}
+			_u.nodes = append(_u.nodes, node)
+			return node.assignValues(columns, values)
+		}
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{card.Label}
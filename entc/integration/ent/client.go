@@ -12,9 +12,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/ent/migrate"
+	"entgo.io/ent/entc/integration/ent/predicate"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -33,13 +36,18 @@ import (
 	"entgo.io/ent/entc/integration/ent/item"
 	"entgo.io/ent/entc/integration/ent/license"
 	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/note"
 	"entgo.io/ent/entc/integration/ent/pc"
 	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/post"
+	"entgo.io/ent/entc/integration/ent/posthistory"
 	"entgo.io/ent/entc/integration/ent/spec"
 	enttask "entgo.io/ent/entc/integration/ent/task"
 	"entgo.io/ent/entc/integration/ent/user"
 
 	stdsql "database/sql"
+
+	"entgo.io/ent/entc/integration/ent/internal"
 )
 
 // Client is the client that holds all ent builders.
@@ -75,16 +83,22 @@ type Client struct {
 	License *LicenseClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
+	// Note is the client for interacting with the Note builders.
+	Note *NoteClient
 	// PC is the client for interacting with the PC builders.
 	PC *PCClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
+	// Post is the client for interacting with the Post builders.
+	Post *PostClient
 	// Spec is the client for interacting with the Spec builders.
 	Spec *SpecClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// User is the client for interacting with the User builders.
-	User          *UserClient
+	User *UserClient
+	// PostHistory is the client for interacting with the PostHistory builders.
+	PostHistory   *PostHistoryClient
 	TemplateField struct{}
 }
 
@@ -111,11 +125,14 @@ func (c *Client) init() {
 	c.Item = NewItemClient(c.config)
 	c.License = NewLicenseClient(c.config)
 	c.Node = NewNodeClient(c.config)
+	c.Note = NewNoteClient(c.config)
 	c.PC = NewPCClient(c.config)
 	c.Pet = NewPetClient(c.config)
+	c.Post = NewPostClient(c.config)
 	c.Spec = NewSpecClient(c.config)
 	c.Task = NewTaskClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.PostHistory = NewPostHistoryClient(c.config)
 }
 
 type (
@@ -179,7 +196,7 @@ func Driver(driver dialect.Driver) Option {
 // Optional parameters can be added for configuring the client.
 func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
 	switch driverName {
-	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+	case dialect.MySQL, dialect.Postgres, dialect.SQLServer, dialect.SQLite, dialect.SQLiteDriver:
 		drv, err := sql.Open(driverName, dataSourceName)
 		if err != nil {
 			return nil, err
@@ -195,10 +212,9 @@ var ErrTxStarted = errors.New("ent: cannot start a transaction within a transact
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+// If the client is transactional, the new transaction is nested
+// in its transaction using a savepoint. See Tx.Tx for details.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, ErrTxStarted
-	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
@@ -222,11 +238,14 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Item:        NewItemClient(cfg),
 		License:     NewLicenseClient(cfg),
 		Node:        NewNodeClient(cfg),
+		Note:        NewNoteClient(cfg),
 		PC:          NewPCClient(cfg),
 		Pet:         NewPetClient(cfg),
+		Post:        NewPostClient(cfg),
 		Spec:        NewSpecClient(cfg),
 		Task:        NewTaskClient(cfg),
 		User:        NewUserClient(cfg),
+		PostHistory: NewPostHistoryClient(cfg),
 	}, nil
 }
 
@@ -260,68 +279,1667 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Item:        NewItemClient(cfg),
 		License:     NewLicenseClient(cfg),
 		Node:        NewNodeClient(cfg),
+		Note:        NewNoteClient(cfg),
 		PC:          NewPCClient(cfg),
 		Pet:         NewPetClient(cfg),
+		Post:        NewPostClient(cfg),
 		Spec:        NewSpecClient(cfg),
 		Task:        NewTaskClient(cfg),
 		User:        NewUserClient(cfg),
+		PostHistory: NewPostHistoryClient(cfg),
 	}, nil
 }
 
-// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
-//
-//	client.Debug().
-//		Api.
-//		Query().
-//		Count(ctx)
-func (c *Client) Debug() *Client {
-	if c.debug {
-		return c
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		Api.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Api, c.Builder, c.Card, c.Comment, c.ExValueScan, c.FieldType, c.File,
+		c.FileType, c.Goods, c.Group, c.GroupInfo, c.Item, c.License, c.Node, c.Note,
+		c.PC, c.Pet, c.Post, c.Spec, c.Task, c.User, c.PostHistory,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Api, c.Builder, c.Card, c.Comment, c.ExValueScan, c.FieldType, c.File,
+		c.FileType, c.Goods, c.Group, c.GroupInfo, c.Item, c.License, c.Node, c.Note,
+		c.PC, c.Pet, c.Post, c.Spec, c.Task, c.User, c.PostHistory,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+type (
+	// LoaderOption configures the loader that is created by WithLoader.
+	LoaderOption func(*loader)
+
+	// loader coalesces the Get and edge queries that are executed concurrently
+	// within a short window into batched queries, and caches their results.
+	loader struct {
+		ctx      context.Context
+		wait     time.Duration
+		maxBatch int
+		mu       sync.Mutex
+		batches  map[loaderKey]any
+	}
+
+	// loaderKey identifies a batch of the loader. Callers that use the same loader
+	// and driver are batched together, regardless of the context they derived.
+	loaderKey struct {
+		driver dialect.Driver
+		name   string
+	}
+
+	// loaderBatch holds the pending keys and the results of a batch.
+	loaderBatch[K comparable, V any] struct {
+		mu      sync.Mutex
+		pending []K
+		results map[K]*loaderResult[V]
+	}
+
+	// loaderResult holds the result of a single key of a batch.
+	loaderResult[V any] struct {
+		done  chan struct{}
+		value V
+		found bool
+		err   error
+	}
+)
+
+// loaderCtxKey is the context key for the request-scoped loader.
+type loaderCtxKey struct{}
+
+// WithLoader returns a new context with a request-scoped loader. Get calls and edge queries
+// (e.g. node.Query<Edge>().All(ctx)) that are executed concurrently with this context, or with
+// contexts derived from it, are coalesced into batched queries, and their results are cached for
+// the lifetime of the loader. Batched queries are executed with the returned context, and not with
+// the contexts of their callers. Note, mutations do not invalidate the cached results.
+//
+//	ctx = ent.WithLoader(ctx)
+func WithLoader(ctx context.Context, opts ...LoaderOption) context.Context {
+	l := &loader{
+		wait:     2 * time.Millisecond,
+		maxBatch: 100,
+		batches:  make(map[loaderKey]any),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	l.ctx = context.WithValue(ctx, loaderCtxKey{}, l)
+	return l.ctx
+}
+
+// LoaderWait sets the duration the loader waits for calls to coalesce
+// before it executes a batch. The default is 2ms.
+func LoaderWait(d time.Duration) LoaderOption {
+	return func(l *loader) {
+		l.wait = d
+	}
+}
+
+// LoaderMaxBatch sets the maximum number of keys in a batch. The default is 100.
+func LoaderMaxBatch(n int) LoaderOption {
+	return func(l *loader) {
+		l.maxBatch = n
+	}
+}
+
+// loaderFromContext returns the loader stored in the context, or nil if there is none.
+func loaderFromContext(ctx context.Context) *loader {
+	l, _ := ctx.Value(loaderCtxKey{}).(*loader)
+	return l
+}
+
+// querierBatch returns a Querier that executes batchable queries using the loader. It is
+// executed after the interceptors, and falls back to qr for queries that were modified.
+func querierBatch[V Value, Q interface {
+	batchable() bool
+	batchAll(context.Context, *loader) (V, error)
+}](l *loader, qr Querier) Querier {
+	return QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		query, ok := q.(Q)
+		if !ok || !query.batchable() {
+			return qr.Query(ctx, q)
+		}
+		return query.batchAll(ctx, l)
+	})
+}
+
+// loaderLoad loads the value of the given key in the batch identified by the loader key.
+// Keys are collected until the batch is full or its wait duration is passed, and then
+// they are fetched by a single call to fetch, that is executed with the context of the loader.
+// The given context is only used for waiting on the result. Results are cached, unless fetch failed.
+func loaderLoad[K comparable, V any](ctx context.Context, l *loader, key loaderKey, k K, fetch func(context.Context, []K) (map[K]V, error)) (V, bool, error) {
+	if err := ctx.Err(); err != nil {
+		var zero V
+		return zero, false, err
+	}
+	l.mu.Lock()
+	b, ok := l.batches[key].(*loaderBatch[K, V])
+	if !ok {
+		b = &loaderBatch[K, V]{results: make(map[K]*loaderResult[V])}
+		l.batches[key] = b
+	}
+	l.mu.Unlock()
+	r := b.add(l, k, fetch)
+	select {
+	case <-r.done:
+		return r.value, r.found, r.err
+	case <-ctx.Done():
+		var zero V
+		return zero, false, ctx.Err()
+	}
+}
+
+// add adds the key to the batch, and returns its (possibly cached) result.
+func (b *loaderBatch[K, V]) add(l *loader, k K, fetch func(context.Context, []K) (map[K]V, error)) *loaderResult[V] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if r, ok := b.results[k]; ok {
+		return r
+	}
+	r := &loaderResult[V]{done: make(chan struct{})}
+	b.results[k] = r
+	b.pending = append(b.pending, k)
+	switch {
+	case len(b.pending) >= l.maxBatch:
+		keys := b.pending
+		b.pending = nil
+		go b.exec(l.ctx, keys, fetch)
+	case len(b.pending) == 1:
+		// The batch is shared by callers with different contexts, and therefore,
+		// it is executed with the context of the loader and not with their contexts.
+		time.AfterFunc(l.wait, func() {
+			b.mu.Lock()
+			keys := b.pending
+			b.pending = nil
+			b.mu.Unlock()
+			b.exec(l.ctx, keys, fetch)
+		})
+	}
+	return r
+}
+
+// exec fetches the given keys and stores their results.
+func (b *loaderBatch[K, V]) exec(ctx context.Context, keys []K, fetch func(context.Context, []K) (map[K]V, error)) {
+	if len(keys) == 0 {
+		return
+	}
+	values, err := fetch(ctx, keys)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, k := range keys {
+		r := b.results[k]
+		if err != nil {
+			r.err = err
+			delete(b.results, k)
+		} else {
+			r.value, r.found = values[k]
+		}
+		close(r.done)
+	}
+}
+
+// batchGet returns the Api with the given id using the request-scoped loader.
+func (c *APIClient) batchGet(ctx context.Context, l *loader, id int) (*Api, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Api"}, id, func(ctx context.Context, ids []int) (map[int]*Api, error) {
+		nodes, err := c.Query().Where(api.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Api, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{api.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the Builder with the given id using the request-scoped loader.
+func (c *BuilderClient) batchGet(ctx context.Context, l *loader, id int) (*Builder, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Builder"}, id, func(ctx context.Context, ids []int) (map[int]*Builder, error) {
+		nodes, err := c.Query().Where(builder.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Builder, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{builder.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the Card with the given id using the request-scoped loader.
+func (c *CardClient) batchGet(ctx context.Context, l *loader, id int) (*Card, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Card"}, id, func(ctx context.Context, ids []int) (map[int]*Card, error) {
+		nodes, err := c.Query().Where(card.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Card, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{card.Label}
+	}
+	return _node, err
+}
+
+// batchQueryOwner returns the owner edge of the Card with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using CardQuery.WithOwner.
+func (c *CardClient) batchQueryOwner(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Card.owner"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(card.IDIn(ids...)).WithOwner()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Owner != nil {
+				m[n.ID] = []*User{n.Edges.Owner}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQuerySpec returns the spec edge of the Card with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using CardQuery.WithSpec.
+func (c *CardClient) batchQuerySpec(ctx context.Context, l *loader, id int) ([]*Spec, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Card.spec"}, id, func(ctx context.Context, ids []int) (map[int][]*Spec, error) {
+		query := c.Query().Where(card.IDIn(ids...)).WithSpec()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Spec, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Spec
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the Comment with the given id using the request-scoped loader.
+func (c *CommentClient) batchGet(ctx context.Context, l *loader, id int) (*Comment, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Comment"}, id, func(ctx context.Context, ids []int) (map[int]*Comment, error) {
+		nodes, err := c.Query().Where(comment.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Comment, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{comment.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the ExValueScan with the given id using the request-scoped loader.
+func (c *ExValueScanClient) batchGet(ctx context.Context, l *loader, id int) (*ExValueScan, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "ExValueScan"}, id, func(ctx context.Context, ids []int) (map[int]*ExValueScan, error) {
+		nodes, err := c.Query().Where(exvaluescan.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*ExValueScan, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{exvaluescan.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the FieldType with the given id using the request-scoped loader.
+func (c *FieldTypeClient) batchGet(ctx context.Context, l *loader, id int) (*FieldType, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "FieldType"}, id, func(ctx context.Context, ids []int) (map[int]*FieldType, error) {
+		nodes, err := c.Query().Where(fieldtype.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*FieldType, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{fieldtype.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the File with the given id using the request-scoped loader.
+func (c *FileClient) batchGet(ctx context.Context, l *loader, id int) (*File, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "File"}, id, func(ctx context.Context, ids []int) (map[int]*File, error) {
+		nodes, err := c.Query().Where(file.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*File, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{file.Label}
+	}
+	return _node, err
+}
+
+// batchQueryOwner returns the owner edge of the File with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using FileQuery.WithOwner.
+func (c *FileClient) batchQueryOwner(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "File.owner"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(file.IDIn(ids...)).WithOwner()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Owner != nil {
+				m[n.ID] = []*User{n.Edges.Owner}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryType returns the type edge of the File with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using FileQuery.WithType.
+func (c *FileClient) batchQueryType(ctx context.Context, l *loader, id int) ([]*FileType, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "File.type"}, id, func(ctx context.Context, ids []int) (map[int][]*FileType, error) {
+		query := c.Query().Where(file.IDIn(ids...)).WithType()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*FileType, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Type != nil {
+				m[n.ID] = []*FileType{n.Edges.Type}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryField returns the field edge of the File with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using FileQuery.WithField.
+func (c *FileClient) batchQueryField(ctx context.Context, l *loader, id int) ([]*FieldType, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "File.field"}, id, func(ctx context.Context, ids []int) (map[int][]*FieldType, error) {
+		query := c.Query().Where(file.IDIn(ids...)).WithField()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*FieldType, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Field
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the FileType with the given id using the request-scoped loader.
+func (c *FileTypeClient) batchGet(ctx context.Context, l *loader, id int) (*FileType, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "FileType"}, id, func(ctx context.Context, ids []int) (map[int]*FileType, error) {
+		nodes, err := c.Query().Where(filetype.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*FileType, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{filetype.Label}
+	}
+	return _node, err
+}
+
+// batchQueryFiles returns the files edge of the FileType with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using FileTypeQuery.WithFiles.
+func (c *FileTypeClient) batchQueryFiles(ctx context.Context, l *loader, id int) ([]*File, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "FileType.files"}, id, func(ctx context.Context, ids []int) (map[int][]*File, error) {
+		query := c.Query().Where(filetype.IDIn(ids...)).WithFiles()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*File, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Files
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the Goods with the given id using the request-scoped loader.
+func (c *GoodsClient) batchGet(ctx context.Context, l *loader, id int) (*Goods, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Goods"}, id, func(ctx context.Context, ids []int) (map[int]*Goods, error) {
+		nodes, err := c.Query().Where(goods.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Goods, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{goods.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the Group with the given id using the request-scoped loader.
+func (c *GroupClient) batchGet(ctx context.Context, l *loader, id int) (*Group, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Group"}, id, func(ctx context.Context, ids []int) (map[int]*Group, error) {
+		nodes, err := c.Query().Where(group.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Group, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{group.Label}
+	}
+	return _node, err
+}
+
+// batchQueryFiles returns the files edge of the Group with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using GroupQuery.WithFiles.
+func (c *GroupClient) batchQueryFiles(ctx context.Context, l *loader, id int) ([]*File, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Group.files"}, id, func(ctx context.Context, ids []int) (map[int][]*File, error) {
+		query := c.Query().Where(group.IDIn(ids...)).WithFiles()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*File, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Files
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryBlocked returns the blocked edge of the Group with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using GroupQuery.WithBlocked.
+func (c *GroupClient) batchQueryBlocked(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Group.blocked"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(group.IDIn(ids...)).WithBlocked()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Blocked
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryUsers returns the users edge of the Group with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using GroupQuery.WithUsers.
+func (c *GroupClient) batchQueryUsers(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Group.users"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(group.IDIn(ids...)).WithUsers()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Users
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryInfo returns the info edge of the Group with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using GroupQuery.WithInfo.
+func (c *GroupClient) batchQueryInfo(ctx context.Context, l *loader, id int) ([]*GroupInfo, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Group.info"}, id, func(ctx context.Context, ids []int) (map[int][]*GroupInfo, error) {
+		query := c.Query().Where(group.IDIn(ids...)).WithInfo()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*GroupInfo, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Info != nil {
+				m[n.ID] = []*GroupInfo{n.Edges.Info}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the GroupInfo with the given id using the request-scoped loader.
+func (c *GroupInfoClient) batchGet(ctx context.Context, l *loader, id int) (*GroupInfo, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "GroupInfo"}, id, func(ctx context.Context, ids []int) (map[int]*GroupInfo, error) {
+		nodes, err := c.Query().Where(groupinfo.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*GroupInfo, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{groupinfo.Label}
+	}
+	return _node, err
+}
+
+// batchQueryGroups returns the groups edge of the GroupInfo with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using GroupInfoQuery.WithGroups.
+func (c *GroupInfoClient) batchQueryGroups(ctx context.Context, l *loader, id int) ([]*Group, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "GroupInfo.groups"}, id, func(ctx context.Context, ids []int) (map[int][]*Group, error) {
+		query := c.Query().Where(groupinfo.IDIn(ids...)).WithGroups()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Group, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Groups
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the Item with the given id using the request-scoped loader.
+func (c *ItemClient) batchGet(ctx context.Context, l *loader, id string) (*Item, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Item"}, id, func(ctx context.Context, ids []string) (map[string]*Item, error) {
+		nodes, err := c.Query().Where(item.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]*Item, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{item.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the License with the given id using the request-scoped loader.
+func (c *LicenseClient) batchGet(ctx context.Context, l *loader, id int) (*License, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "License"}, id, func(ctx context.Context, ids []int) (map[int]*License, error) {
+		nodes, err := c.Query().Where(license.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*License, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{license.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the Node with the given id using the request-scoped loader.
+func (c *NodeClient) batchGet(ctx context.Context, l *loader, id int) (*Node, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Node"}, id, func(ctx context.Context, ids []int) (map[int]*Node, error) {
+		nodes, err := c.Query().Where(node.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Node, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{node.Label}
+	}
+	return _node, err
+}
+
+// batchQueryPrev returns the prev edge of the Node with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using NodeQuery.WithPrev.
+func (c *NodeClient) batchQueryPrev(ctx context.Context, l *loader, id int) ([]*Node, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Node.prev"}, id, func(ctx context.Context, ids []int) (map[int][]*Node, error) {
+		query := c.Query().Where(node.IDIn(ids...)).WithPrev()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Node, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Prev != nil {
+				m[n.ID] = []*Node{n.Edges.Prev}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryNext returns the next edge of the Node with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using NodeQuery.WithNext.
+func (c *NodeClient) batchQueryNext(ctx context.Context, l *loader, id int) ([]*Node, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Node.next"}, id, func(ctx context.Context, ids []int) (map[int][]*Node, error) {
+		query := c.Query().Where(node.IDIn(ids...)).WithNext()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Node, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Next != nil {
+				m[n.ID] = []*Node{n.Edges.Next}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the Note with the given id using the request-scoped loader.
+func (c *NoteClient) batchGet(ctx context.Context, l *loader, id int) (*Note, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Note"}, id, func(ctx context.Context, ids []int) (map[int]*Note, error) {
+		nodes, err := c.Query().Where(note.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Note, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{note.Label}
+	}
+	return _node, err
+}
+
+// batchQueryParent returns the parent edge of the Note with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using NoteQuery.WithParent.
+func (c *NoteClient) batchQueryParent(ctx context.Context, l *loader, id int) ([]*Note, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Note.parent"}, id, func(ctx context.Context, ids []int) (map[int][]*Note, error) {
+		query := c.Query().Where(note.IDIn(ids...)).WithParent()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Note, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Parent != nil {
+				m[n.ID] = []*Note{n.Edges.Parent}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryChildren returns the children edge of the Note with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using NoteQuery.WithChildren.
+func (c *NoteClient) batchQueryChildren(ctx context.Context, l *loader, id int) ([]*Note, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Note.children"}, id, func(ctx context.Context, ids []int) (map[int][]*Note, error) {
+		query := c.Query().Where(note.IDIn(ids...)).WithChildren()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Note, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Children
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the PC with the given id using the request-scoped loader.
+func (c *PCClient) batchGet(ctx context.Context, l *loader, id int) (*PC, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "PC"}, id, func(ctx context.Context, ids []int) (map[int]*PC, error) {
+		nodes, err := c.Query().Where(pc.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*PC, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{pc.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the Pet with the given id using the request-scoped loader.
+func (c *PetClient) batchGet(ctx context.Context, l *loader, id int) (*Pet, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Pet"}, id, func(ctx context.Context, ids []int) (map[int]*Pet, error) {
+		nodes, err := c.Query().Where(pet.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Pet, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{pet.Label}
+	}
+	return _node, err
+}
+
+// batchQueryTeam returns the team edge of the Pet with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using PetQuery.WithTeam.
+func (c *PetClient) batchQueryTeam(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Pet.team"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(pet.IDIn(ids...)).WithTeam()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Team != nil {
+				m[n.ID] = []*User{n.Edges.Team}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryOwner returns the owner edge of the Pet with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using PetQuery.WithOwner.
+func (c *PetClient) batchQueryOwner(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Pet.owner"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(pet.IDIn(ids...)).WithOwner()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Owner != nil {
+				m[n.ID] = []*User{n.Edges.Owner}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the Post with the given id using the request-scoped loader.
+func (c *PostClient) batchGet(ctx context.Context, l *loader, id int) (*Post, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Post"}, id, func(ctx context.Context, ids []int) (map[int]*Post, error) {
+		nodes, err := c.Query().Where(post.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Post, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{post.Label}
+	}
+	return _node, err
+}
+
+// batchQueryParent returns the parent edge of the Post with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using PostQuery.WithParent.
+func (c *PostClient) batchQueryParent(ctx context.Context, l *loader, id int) ([]*Post, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Post.parent"}, id, func(ctx context.Context, ids []int) (map[int][]*Post, error) {
+		query := c.Query().Where(post.IDIn(ids...)).WithParent()
+		query.WithDeleted()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Post, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Parent != nil {
+				m[n.ID] = []*Post{n.Edges.Parent}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryReplies returns the replies edge of the Post with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using PostQuery.WithReplies.
+func (c *PostClient) batchQueryReplies(ctx context.Context, l *loader, id int) ([]*Post, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Post.replies"}, id, func(ctx context.Context, ids []int) (map[int][]*Post, error) {
+		query := c.Query().Where(post.IDIn(ids...)).WithReplies()
+		query.WithDeleted()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Post, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Replies
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the Spec with the given id using the request-scoped loader.
+func (c *SpecClient) batchGet(ctx context.Context, l *loader, id int) (*Spec, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Spec"}, id, func(ctx context.Context, ids []int) (map[int]*Spec, error) {
+		nodes, err := c.Query().Where(spec.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Spec, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{spec.Label}
+	}
+	return _node, err
+}
+
+// batchQueryCard returns the card edge of the Spec with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using SpecQuery.WithCard.
+func (c *SpecClient) batchQueryCard(ctx context.Context, l *loader, id int) ([]*Card, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "Spec.card"}, id, func(ctx context.Context, ids []int) (map[int][]*Card, error) {
+		query := c.Query().Where(spec.IDIn(ids...)).WithCard()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Card, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Card
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the Task with the given id using the request-scoped loader.
+func (c *TaskClient) batchGet(ctx context.Context, l *loader, id int) (*Task, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "Task"}, id, func(ctx context.Context, ids []int) (map[int]*Task, error) {
+		nodes, err := c.Query().Where(enttask.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*Task, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{enttask.Label}
+	}
+	return _node, err
+}
+
+// batchGet returns the User with the given id using the request-scoped loader.
+func (c *UserClient) batchGet(ctx context.Context, l *loader, id int) (*User, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "User"}, id, func(ctx context.Context, ids []int) (map[int]*User, error) {
+		nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*User, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{user.Label}
+	}
+	return _node, err
+}
+
+// batchQueryCard returns the card edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithCard.
+func (c *UserClient) batchQueryCard(ctx context.Context, l *loader, id int) ([]*Card, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.card"}, id, func(ctx context.Context, ids []int) (map[int][]*Card, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithCard()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Card, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Card != nil {
+				m[n.ID] = []*Card{n.Edges.Card}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryPets returns the pets edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithPets.
+func (c *UserClient) batchQueryPets(ctx context.Context, l *loader, id int) ([]*Pet, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.pets"}, id, func(ctx context.Context, ids []int) (map[int][]*Pet, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithPets()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Pet, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Pets
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryFiles returns the files edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithFiles.
+func (c *UserClient) batchQueryFiles(ctx context.Context, l *loader, id int) ([]*File, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.files"}, id, func(ctx context.Context, ids []int) (map[int][]*File, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithFiles()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*File, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Files
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryGroups returns the groups edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithGroups.
+func (c *UserClient) batchQueryGroups(ctx context.Context, l *loader, id int) ([]*Group, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.groups"}, id, func(ctx context.Context, ids []int) (map[int][]*Group, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithGroups()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Group, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Groups
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryFriends returns the friends edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithFriends.
+func (c *UserClient) batchQueryFriends(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.friends"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithFriends()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Friends
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryFollowers returns the followers edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithFollowers.
+func (c *UserClient) batchQueryFollowers(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.followers"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithFollowers()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Followers
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryFollowing returns the following edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithFollowing.
+func (c *UserClient) batchQueryFollowing(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.following"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithFollowing()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Following
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryTeam returns the team edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithTeam.
+func (c *UserClient) batchQueryTeam(ctx context.Context, l *loader, id int) ([]*Pet, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.team"}, id, func(ctx context.Context, ids []int) (map[int][]*Pet, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithTeam()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*Pet, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Team != nil {
+				m[n.ID] = []*Pet{n.Edges.Team}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQuerySpouse returns the spouse edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithSpouse.
+func (c *UserClient) batchQuerySpouse(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.spouse"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithSpouse()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Spouse != nil {
+				m[n.ID] = []*User{n.Edges.Spouse}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryChildren returns the children edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithChildren.
+func (c *UserClient) batchQueryChildren(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.children"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithChildren()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Children
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchQueryParent returns the parent edge of the User with the given id using the request-scoped loader.
+// Edges are loaded by batching the ids and eager-loading the edge using UserQuery.WithParent.
+func (c *UserClient) batchQueryParent(ctx context.Context, l *loader, id int) ([]*User, error) {
+	_nodes, _, err := loaderLoad(ctx, l, loaderKey{c.driver, "User.parent"}, id, func(ctx context.Context, ids []int) (map[int][]*User, error) {
+		query := c.Query().Where(user.IDIn(ids...)).WithParent()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int][]*User, len(nodes))
+		for _, n := range nodes {
+			if n.Edges.Parent != nil {
+				m[n.ID] = []*User{n.Edges.Parent}
+			}
+		}
+		return m, nil
+	})
+	return _nodes, err
+}
+
+// batchGet returns the PostHistory with the given id using the request-scoped loader.
+func (c *PostHistoryClient) batchGet(ctx context.Context, l *loader, id int) (*PostHistory, error) {
+	_node, ok, err := loaderLoad(ctx, l, loaderKey{c.driver, "PostHistory"}, id, func(ctx context.Context, ids []int) (map[int]*PostHistory, error) {
+		nodes, err := c.Query().Where(posthistory.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]*PostHistory, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n
+		}
+		return m, nil
+	})
+	if err == nil && !ok {
+		err = &NotFoundError{posthistory.Label}
+	}
+	return _node, err
+}
+
+// Dialect returns the driver dialect.
+func (c *Client) Dialect() string {
+	return c.driver.Dialect()
+}
+
+// Driver returns the underlying driver.
+func (c *Client) Driver() dialect.Driver {
+	return c.driver
+}
+
+// historyActorCtxKey is the context key for the actor recorded in the history tables.
+type historyActorCtxKey struct{}
+
+// WithHistoryActor returns a new context with the given actor. The actor is recorded
+// along with the changes that are made to entities whose history is recorded.
+func WithHistoryActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, historyActorCtxKey{}, actor)
+}
+
+// HistoryActor returns the actor that was stored in the context using WithHistoryActor.
+func HistoryActor(ctx context.Context) (string, bool) {
+	actor, ok := ctx.Value(historyActorCtxKey{}).(string)
+	return actor, ok
+}
+
+// historyChangedFields returns the names of all fields that were changed by the mutation.
+func historyChangedFields(m Mutation) []string {
+	var (
+		fields []string
+		seen   = make(map[string]bool)
+	)
+	for _, names := range [][]string{m.Fields(), m.AddedFields(), m.ClearedFields()} {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				fields = append(fields, name)
+			}
+		}
+	}
+	return fields
+}
+
+// QueryHistory queries the history of the given Post, ordered by the time the changes were recorded.
+func (c *PostClient) QueryHistory(_m *Post) *PostHistoryQuery {
+	return NewPostHistoryClient(c.config).Query().
+		Where(posthistory.Ref(_m.ID)).
+		Order(posthistory.ByHistoryTime(), posthistory.ByID())
+}
+
+// AsOf returns the state of the Post with the given id at the given time, as was recorded
+// in its history. A NotFoundError is returned in case it did not exist at this time.
+func (c *PostClient) AsOf(ctx context.Context, id int, t time.Time) (*Post, error) {
+	h, err := NewPostHistoryClient(c.config).Query().
+		Where(posthistory.Ref(id), posthistory.HistoryTimeLTE(t)).
+		Order(posthistory.ByHistoryTime(sql.OrderDesc()), posthistory.ByID(sql.OrderDesc())).
+		First(ctx)
+	switch {
+	case IsNotFound(err):
+		return nil, &NotFoundError{post.Label}
+	case err != nil:
+		return nil, err
+	case h.Operation == OpDelete.String() || h.Operation == OpDeleteOne.String():
+		return nil, &NotFoundError{post.Label}
+	}
+	return h.Snapshot(), nil
+}
+
+// QueryHistory queries the history of the Post, ordered by the time the changes were recorded.
+func (_m *Post) QueryHistory() *PostHistoryQuery {
+	return NewPostClient(_m.config).QueryHistory(_m)
+}
+
+// AsOf returns the state of the Post at the given time, as was recorded in its history.
+func (_m *Post) AsOf(ctx context.Context, t time.Time) (*Post, error) {
+	return NewPostClient(_m.config).AsOf(ctx, _m.ID, t)
+}
+
+// Snapshot returns the state of the Post that was recorded by the change.
+func (_m *PostHistory) Snapshot() *Post {
+	n := &Post{config: _m.config, ID: _m.Ref}
+	if _m.Title != nil {
+		n.Title = *_m.Title
+	}
+	return n
+}
+
+// historyPost is a hook that records the changes of Posts in the PostHistory table.
+func historyPost(next Mutator) Mutator {
+	return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mu, ok := m.(*PostMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		// Mutations that are not executed in a transaction are wrapped in one, to ensure
+		// the history is recorded only along with the changes, and the rows that are read
+		// by bulk mutations are locked only for the duration of the mutation.
+		if _, ok := mu.driver.(*txDriver); !ok {
+			tx, err := mu.Client().Tx(ctx)
+			if err != nil {
+				return nil, err
+			}
+			drv := mu.driver
+			mu.driver = tx.driver
+			defer func() { mu.driver = drv }()
+			v, err := historyPost(next).Mutate(ctx, mu)
+			if err != nil {
+				if rerr := tx.Rollback(); rerr != nil {
+					err = fmt.Errorf("%w: %v", err, rerr)
+				}
+				return nil, err
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, err
+			}
+			return v, nil
+		}
+		var (
+			olds      []*Post
+			oldValues map[string]any
+			op        = mu.Op()
+			fields    = historyChangedFields(mu)
+			client    = mu.Client()
+		)
+		// Old values are loaded before the mutation is executed.
+		switch {
+		case op.Is(OpUpdateOne):
+			oldValues = make(map[string]any, len(fields))
+			for _, f := range fields {
+				if historyPostSensitive(f) {
+					continue
+				}
+				v, err := mu.OldField(ctx, f)
+				if err != nil {
+					return nil, err
+				}
+				oldValues[f] = v
+			}
+		case op.Is(OpUpdate | OpDelete | OpDeleteOne):
+			q := client.Post.Query().Where(mu.predicates...)
+			if id, exists := mu.ID(); exists {
+				q.Where(post.ID(id))
+			}
+			q.WithDeleted()
+			// The rows are locked until the transaction completes, to ensure their old values are
+			// not changed by concurrent transactions before they are recorded. SQLite does not support
+			// row locks, and its write transactions are serialized by locking the whole database.
+			if mu.driver.Dialect() != dialect.SQLite {
+				q.Where(func(s *sql.Selector) { s.ForUpdate() })
+			}
+			var err error
+			if olds, err = q.All(ctx); err != nil {
+				return nil, err
+			}
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		var news []*Post
+		switch {
+		case op.Is(OpCreate):
+			n, ok := v.(*Post)
+			if !ok {
+				return nil, fmt.Errorf("unexpected node type %T returned from Post creation", v)
+			}
+			news = append(news, n)
+		case op.Is(OpUpdateOne | OpUpdate):
+			ids := make([]int, 0, len(olds))
+			for _, n := range olds {
+				ids = append(ids, n.ID)
+			}
+			if id, exists := mu.ID(); exists && op.Is(OpUpdateOne) {
+				ids = append(ids, id)
+			}
+			q := client.Post.Query().Where(post.IDIn(ids...))
+			q.WithDeleted()
+			if news, err = q.All(ctx); err != nil {
+				return nil, err
+			}
+		case op.Is(OpDelete | OpDeleteOne):
+			news = olds
+		}
+		if len(news) == 0 {
+			return v, nil
+		}
+		byID := make(map[int]*Post, len(olds))
+		for _, n := range olds {
+			byID[n.ID] = n
+		}
+		actor, hasActor := HistoryActor(ctx)
+		now := time.Now()
+		builders := make([]*PostHistoryCreate, len(news))
+		for i, n := range news {
+			b := client.PostHistory.Create().
+				SetHistoryTime(now).
+				SetOperation(op.String()).
+				SetRef(n.ID)
+			if hasActor {
+				b.SetActor(actor)
+			}
+			if len(fields) > 0 {
+				b.SetChangedFields(fields)
+			}
+			switch old, ok := byID[n.ID]; {
+			case oldValues != nil:
+				b.SetOldValues(oldValues)
+			case ok && op.Is(OpUpdate):
+				b.SetOldValues(historyPostValues(old, fields))
+			}
+			b.SetTitle(n.Title)
+			builders[i] = b
+		}
+		if err := client.PostHistory.CreateBulk(builders...).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("post: recording history: %w", err)
+		}
+		return v, nil
+	})
+}
+
+// historyPostValues returns the values of the given fields of the Post.
+// Sensitive fields are not returned.
+func historyPostValues(n *Post, fields []string) map[string]any {
+	values := make(map[string]any, len(fields))
+	for _, f := range fields {
+		switch f {
+		case post.FieldTitle:
+			values[f] = n.Title
+		case post.FieldDeletedAt:
+			values[f] = n.DeletedAt
+		}
 	}
-	cfg := c.config
-	cfg.driver = dialect.Debug(c.driver, c.log)
-	client := &Client{config: cfg}
-	client.init()
-	return client
+	return values
 }
 
-// Close closes the database connection and prevents new queries from starting.
-func (c *Client) Close() error {
-	return c.driver.Close()
+// historyPostSensitive reports if the given field of the Post is sensitive.
+func historyPostSensitive(name string) bool {
+	switch name {
+	case post.FieldSecret:
+		return true
+	}
+	return false
 }
 
-// Use adds the mutation hooks to all the entity clients.
-// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
-func (c *Client) Use(hooks ...Hook) {
-	for _, n := range []interface{ Use(...Hook) }{
-		c.Api, c.Builder, c.Card, c.Comment, c.ExValueScan, c.FieldType, c.File,
-		c.FileType, c.Goods, c.Group, c.GroupInfo, c.Item, c.License, c.Node, c.PC,
-		c.Pet, c.Spec, c.Task, c.User,
-	} {
-		n.Use(hooks...)
-	}
+// errIterStop is returned by the sqlgraph.QuerySpec of the Iter
+// methods to stop scanning the rows of the query.
+var errIterStop = errors.New("ent: iteration stopped")
+
+// ErrMissingTenant is returned by queries and mutations of multi-tenant schemas that were
+// executed with a context that was neither scoped to a tenant using WithTenant, nor
+// explicitly bypassed the tenant scoping using SkipTenant.
+var ErrMissingTenant = internal.ErrMissingTenant
+
+// WithTenant returns a new context that scopes the queries and mutations of multi-tenant
+// schemas that are executed with it to the given tenant. i.e. queries (including edge
+// traversals, edge predicates and eager-loading) are filtered by the tenant, created
+// entities are assigned to it, updates and deletes affect only the entities that belong
+// to it, and edges can be added only to the entities that belong to it.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return internal.NewTenantContext(ctx, tenant)
 }
 
-// Intercept adds the query interceptors to all the entity clients.
-// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
-func (c *Client) Intercept(interceptors ...Interceptor) {
-	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Api, c.Builder, c.Card, c.Comment, c.ExValueScan, c.FieldType, c.File,
-		c.FileType, c.Goods, c.Group, c.GroupInfo, c.Item, c.License, c.Node, c.PC,
-		c.Pet, c.Spec, c.Task, c.User,
-	} {
-		n.Intercept(interceptors...)
+// TenantFromContext returns the tenant stored in the context by WithTenant, if there is one.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	return internal.TenantFromContext(ctx)
+}
+
+// SkipTenant returns a new context that bypasses the tenant scoping of the queries and
+// mutations that are executed with it. It is meant to be used by jobs that operate on
+// the entities of all tenants (e.g. migrations and admin tasks).
+//
+//	ctx = ent.SkipTenant(ctx)
+func SkipTenant(ctx context.Context) context.Context {
+	return internal.NewSkipTenantContext(ctx)
+}
+
+// tenantConflictWhere returns a conflict option that prevents upserts from updating the
+// entities of other tenants. i.e. rows are updated only if they belong to the tenant of
+// the inserted row.
+func tenantConflictWhere(column string) sql.ConflictOption {
+	return sql.AndUpdateWhere(sql.P(func(b *sql.Builder) {
+		b.Ident(column).WriteOp(sql.OpEQ)
+		if b.Dialect() == dialect.MySQL {
+			b.WriteString("VALUES(").WriteString(b.Quote(column)).WriteByte(')')
+		} else {
+			b.WriteString(b.Quote("excluded")).WriteByte('.').WriteString(b.Quote(column))
+		}
+	}))
+}
+
+// tenantFilterNote is an interceptor that filters the Note queries by the tenant of their context.
+var tenantFilterNote = TraverseFunc(func(ctx context.Context, q Query) error {
+	query, ok := q.(*NoteQuery)
+	if !ok {
+		return fmt.Errorf("unexpected query type %T", q)
+	}
+	tenant, scoped, err := internal.TenantOf(ctx)
+	if err != nil || !scoped {
+		return err
+	}
+	query.Where(predicate.Note(sql.FieldEQ(note.FieldTenant, tenant)))
+	return nil
+})
+
+// tenantScopeNote is a hook that assigns the created Notes to the tenant
+// of the context, scopes the updated and deleted Notes to it, and ensures the
+// Notes are connected only to neighbors that belong to the tenant of the context.
+func tenantScopeNote(next Mutator) Mutator {
+	return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mu, ok := m.(*NoteMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		tenant, scoped, err := internal.TenantOf(ctx)
+		switch {
+		case err != nil:
+			return nil, err
+		case !scoped:
+			return next.Mutate(ctx, m)
+		}
+		if v, ok := mu.Tenant(); ok && v != tenant {
+			return nil, fmt.Errorf("note: field %q does not match the tenant of the context", note.FieldTenant)
+		}
+		if mu.Op().Is(OpCreate) {
+			mu.SetTenant(tenant)
+		} else {
+			mu.Where(predicate.Note(sql.FieldEQ(note.FieldTenant, tenant)))
+		}
+		if ids := mu.ParentIDs(); len(ids) > 0 {
+			n, err := mu.Client().Note.Query().Where(note.IDIn(ids...)).Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if n != len(ids) {
+				return nil, fmt.Errorf("note: edge %q contains entities that do not belong to the tenant of the context", note.EdgeParent)
+			}
+		}
+		if ids := mu.ChildrenIDs(); len(ids) > 0 {
+			n, err := mu.Client().Note.Query().Where(note.IDIn(ids...)).Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if n != len(ids) {
+				return nil, fmt.Errorf("note: edge %q contains entities that do not belong to the tenant of the context", note.EdgeChildren)
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// Cursor is an opaque cursor used for paginating queries using their Paginate method.
+// Use its String method to encode it, and DecodeCursor to decode it back.
+type Cursor = sql.Cursor
+
+// DecodeCursor decodes a cursor that was encoded using its String method.
+func DecodeCursor(s string) (*Cursor, error) {
+	return sql.DecodeCursor(s)
+}
+
+// softDeleteMode defines how soft-deleted rows are treated by queries.
+type softDeleteMode uint8
+
+const (
+	softDeleteExclude softDeleteMode = iota // Exclude soft-deleted rows (default).
+	softDeleteInclude                       // Include soft-deleted rows.
+	softDeleteOnly                          // Return only soft-deleted rows.
+)
+
+// where applies the soft-delete filtering on the given selector.
+func (m softDeleteMode) where(s *sql.Selector, column string) {
+	switch m {
+	case softDeleteExclude:
+		s.Where(sql.IsNull(s.C(column)))
+	case softDeleteOnly:
+		s.Where(sql.NotNull(s.C(column)))
 	}
 }
 
-// Dialect returns the driver dialect.
-func (c *Client) Dialect() string {
-	return c.driver.Dialect()
+// Restore returns an update builder for restoring soft-deleted Posts.
+func (c *PostClient) Restore() *PostUpdate {
+	return c.Update().Where(post.DeletedAtNotNil()).ClearDeletedAt()
 }
 
-// Driver returns the underlying driver.
-func (c *Client) Driver() dialect.Driver {
-	return c.driver
+// RestoreOne returns an update builder for restoring the given soft-deleted entity.
+func (c *PostClient) RestoreOne(_m *Post) *PostUpdateOne {
+	return c.RestoreOneID(_m.ID)
+}
+
+// RestoreOneID returns an update builder for restoring the soft-deleted entity with the given id.
+func (c *PostClient) RestoreOneID(id int) *PostUpdateOne {
+	return c.UpdateOneID(id).Where(post.DeletedAtNotNil()).ClearDeletedAt()
+}
+
+// WithTx runs fn in a new transaction, and commits it if fn succeeds or rolls it back otherwise.
+// In case fn or the commit fails with a retryable error (e.g. a serialization failure or a deadlock,
+// see sql.IsRetryable), the transaction is rolled back, and fn is called again in a new transaction
+// after a jittered backoff, until the maximum number of attempts is reached. Therefore, fn should not
+// have side effects outside of the transaction. A nil opts uses the defaults of sql.RetryOptions.
+//
+//	err := client.WithTx(ctx, &sql.RetryOptions{
+//		TxOptions:   sql.TxOptions{Isolation: sql.LevelSerializable},
+//		MaxAttempts: 10,
+//	}, func(tx *ent.Tx) error {
+//		return tx.User.UpdateOneID(id).AddBalance(-amount).Exec(ctx)
+//	})
+func (c *Client) WithTx(ctx context.Context, opts *sql.RetryOptions, fn func(*Tx) error) error {
+	if opts == nil {
+		opts = &sql.RetryOptions{}
+	}
+	return sql.Retry(ctx, opts, func(ctx context.Context) error {
+		tx, err := c.BeginTx(ctx, &opts.TxOptions)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if v := recover(); v != nil {
+				tx.Rollback()
+				panic(v)
+			}
+		}()
+		if err := fn(tx); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+			}
+			return err
+		}
+		return tx.Commit()
+	})
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -355,16 +1973,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.License.mutate(ctx, m)
 	case *NodeMutation:
 		return c.Node.mutate(ctx, m)
+	case *NoteMutation:
+		return c.Note.mutate(ctx, m)
 	case *PCMutation:
 		return c.PC.mutate(ctx, m)
 	case *PetMutation:
 		return c.Pet.mutate(ctx, m)
+	case *PostMutation:
+		return c.Post.mutate(ctx, m)
 	case *SpecMutation:
 		return c.Spec.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *PostHistoryMutation:
+		return c.PostHistory.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -466,6 +2090,9 @@ func (c *APIClient) Query() *APIQuery {
 
 // Get returns a Api entity by its id.
 func (c *APIClient) Get(ctx context.Context, id int) (*Api, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(api.ID(id)).Only(ctx)
 }
 
@@ -599,6 +2226,9 @@ func (c *BuilderClient) Query() *BuilderQuery {
 
 // Get returns a Builder entity by its id.
 func (c *BuilderClient) Get(ctx context.Context, id int) (*Builder, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(builder.ID(id)).Only(ctx)
 }
 
@@ -732,6 +2362,9 @@ func (c *CardClient) Query() *CardQuery {
 
 // Get returns a Card entity by its id.
 func (c *CardClient) Get(ctx context.Context, id int) (*Card, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(card.ID(id)).Only(ctx)
 }
 
@@ -757,6 +2390,9 @@ func (c *CardClient) QueryOwner(_m *Card) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryOwner(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -773,6 +2409,9 @@ func (c *CardClient) QuerySpec(_m *Card) *SpecQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Spec, error) {
+		return c.batchQuerySpec(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -897,6 +2536,9 @@ func (c *CommentClient) Query() *CommentQuery {
 
 // Get returns a Comment entity by its id.
 func (c *CommentClient) Get(ctx context.Context, id int) (*Comment, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(comment.ID(id)).Only(ctx)
 }
 
@@ -1030,6 +2672,9 @@ func (c *ExValueScanClient) Query() *ExValueScanQuery {
 
 // Get returns a ExValueScan entity by its id.
 func (c *ExValueScanClient) Get(ctx context.Context, id int) (*ExValueScan, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(exvaluescan.ID(id)).Only(ctx)
 }
 
@@ -1163,6 +2808,9 @@ func (c *FieldTypeClient) Query() *FieldTypeQuery {
 
 // Get returns a FieldType entity by its id.
 func (c *FieldTypeClient) Get(ctx context.Context, id int) (*FieldType, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(fieldtype.ID(id)).Only(ctx)
 }
 
@@ -1296,6 +2944,9 @@ func (c *FileClient) Query() *FileQuery {
 
 // Get returns a File entity by its id.
 func (c *FileClient) Get(ctx context.Context, id int) (*File, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(file.ID(id)).Only(ctx)
 }
 
@@ -1321,6 +2972,9 @@ func (c *FileClient) QueryOwner(_m *File) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryOwner(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -1337,6 +2991,9 @@ func (c *FileClient) QueryType(_m *File) *FileTypeQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*FileType, error) {
+		return c.batchQueryType(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -1353,6 +3010,9 @@ func (c *FileClient) QueryField(_m *File) *FieldTypeQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*FieldType, error) {
+		return c.batchQueryField(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -1477,6 +3137,9 @@ func (c *FileTypeClient) Query() *FileTypeQuery {
 
 // Get returns a FileType entity by its id.
 func (c *FileTypeClient) Get(ctx context.Context, id int) (*FileType, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(filetype.ID(id)).Only(ctx)
 }
 
@@ -1502,6 +3165,9 @@ func (c *FileTypeClient) QueryFiles(_m *FileType) *FileQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*File, error) {
+		return c.batchQueryFiles(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -1626,6 +3292,9 @@ func (c *GoodsClient) Query() *GoodsQuery {
 
 // Get returns a Goods entity by its id.
 func (c *GoodsClient) Get(ctx context.Context, id int) (*Goods, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(goods.ID(id)).Only(ctx)
 }
 
@@ -1759,6 +3428,9 @@ func (c *GroupClient) Query() *GroupQuery {
 
 // Get returns a Group entity by its id.
 func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(group.ID(id)).Only(ctx)
 }
 
@@ -1784,6 +3456,9 @@ func (c *GroupClient) QueryFiles(_m *Group) *FileQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*File, error) {
+		return c.batchQueryFiles(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -1800,6 +3475,9 @@ func (c *GroupClient) QueryBlocked(_m *Group) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryBlocked(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -1816,6 +3494,9 @@ func (c *GroupClient) QueryUsers(_m *Group) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryUsers(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -1832,6 +3513,9 @@ func (c *GroupClient) QueryInfo(_m *Group) *GroupInfoQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*GroupInfo, error) {
+		return c.batchQueryInfo(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -1956,6 +3640,9 @@ func (c *GroupInfoClient) Query() *GroupInfoQuery {
 
 // Get returns a GroupInfo entity by its id.
 func (c *GroupInfoClient) Get(ctx context.Context, id int) (*GroupInfo, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(groupinfo.ID(id)).Only(ctx)
 }
 
@@ -1981,6 +3668,9 @@ func (c *GroupInfoClient) QueryGroups(_m *GroupInfo) *GroupQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Group, error) {
+		return c.batchQueryGroups(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -2105,6 +3795,9 @@ func (c *ItemClient) Query() *ItemQuery {
 
 // Get returns a Item entity by its id.
 func (c *ItemClient) Get(ctx context.Context, id string) (*Item, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(item.ID(id)).Only(ctx)
 }
 
@@ -2238,6 +3931,9 @@ func (c *LicenseClient) Query() *LicenseQuery {
 
 // Get returns a License entity by its id.
 func (c *LicenseClient) Get(ctx context.Context, id int) (*License, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(license.ID(id)).Only(ctx)
 }
 
@@ -2297,85 +3993,262 @@ func (c *NodeClient) Intercept(interceptors ...Interceptor) {
 	c.inters.Node = append(c.inters.Node, interceptors...)
 }
 
-// Create returns a builder for creating a Node entity.
-func (c *NodeClient) Create() *NodeCreate {
-	mutation := newNodeMutation(c.config, OpCreate)
-	return &NodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Node entity.
+func (c *NodeClient) Create() *NodeCreate {
+	mutation := newNodeMutation(c.config, OpCreate)
+	return &NodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Node entities.
+func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NodeClient) MapCreateBulk(slice any, setFunc func(*NodeCreate, int)) *NodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NodeCreateBulk{err: fmt.Errorf("calling to NodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Node.
+func (c *NodeClient) Update() *NodeUpdate {
+	mutation := newNodeMutation(c.config, OpUpdate)
+	return &NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NodeClient) UpdateOne(_m *Node) *NodeUpdateOne {
+	mutation := newNodeMutation(c.config, OpUpdateOne, withNode(_m))
+	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NodeClient) UpdateOneID(id int) *NodeUpdateOne {
+	mutation := newNodeMutation(c.config, OpUpdateOne, withNodeID(id))
+	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Node.
+func (c *NodeClient) Delete() *NodeDelete {
+	mutation := newNodeMutation(c.config, OpDelete)
+	return &NodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NodeClient) DeleteOne(_m *Node) *NodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NodeClient) DeleteOneID(id int) *NodeDeleteOne {
+	builder := c.Delete().Where(node.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NodeDeleteOne{builder}
+}
+
+// Query returns a query builder for Node.
+func (c *NodeClient) Query() *NodeQuery {
+	return &NodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Node entity by its id.
+func (c *NodeClient) Get(ctx context.Context, id int) (*Node, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
+	return c.Query().Where(node.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPrev queries the prev edge of a Node.
+func (c *NodeClient) QueryPrev(_m *Node) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Node, error) {
+		return c.batchQueryPrev(ctx, l, _m.ID)
+	}
+	return query
+}
+
+// QueryNext queries the next edge of a Node.
+func (c *NodeClient) QueryNext(_m *Node) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Node, error) {
+		return c.batchQueryNext(ctx, l, _m.ID)
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NodeClient) Hooks() []Hook {
+	return c.hooks.Node
+}
+
+// Interceptors returns the client interceptors.
+func (c *NodeClient) Interceptors() []Interceptor {
+	return c.inters.Node
+}
+
+func (c *NodeClient) mutate(ctx context.Context, m *NodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Node mutation op: %q", m.Op())
+	}
+}
+
+// NoteClient is a client for the Note schema.
+type NoteClient struct {
+	config
+}
+
+// NewNoteClient returns a client for the Note from the given config.
+func NewNoteClient(c config) *NoteClient {
+	return &NoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `note.Hooks(f(g(h())))`.
+func (c *NoteClient) Use(hooks ...Hook) {
+	c.hooks.Note = append(c.hooks.Note, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `note.Intercept(f(g(h())))`.
+func (c *NoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Note = append(c.inters.Note, interceptors...)
+}
+
+// Create returns a builder for creating a Note entity.
+func (c *NoteClient) Create() *NoteCreate {
+	mutation := newNoteMutation(c.config, OpCreate)
+	return &NoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Node entities.
-func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
-	return &NodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Note entities.
+func (c *NoteClient) CreateBulk(builders ...*NoteCreate) *NoteCreateBulk {
+	return &NoteCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NodeClient) MapCreateBulk(slice any, setFunc func(*NodeCreate, int)) *NodeCreateBulk {
+func (c *NoteClient) MapCreateBulk(slice any, setFunc func(*NoteCreate, int)) *NoteCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NodeCreateBulk{err: fmt.Errorf("calling to NodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NoteCreateBulk{err: fmt.Errorf("calling to NoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NodeCreate, rv.Len())
+	builders := make([]*NoteCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NodeCreateBulk{config: c.config, builders: builders}
+	return &NoteCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Node.
-func (c *NodeClient) Update() *NodeUpdate {
-	mutation := newNodeMutation(c.config, OpUpdate)
-	return &NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Note.
+func (c *NoteClient) Update() *NoteUpdate {
+	mutation := newNoteMutation(c.config, OpUpdate)
+	return &NoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NodeClient) UpdateOne(_m *Node) *NodeUpdateOne {
-	mutation := newNodeMutation(c.config, OpUpdateOne, withNode(_m))
-	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NoteClient) UpdateOne(_m *Note) *NoteUpdateOne {
+	mutation := newNoteMutation(c.config, OpUpdateOne, withNote(_m))
+	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NodeClient) UpdateOneID(id int) *NodeUpdateOne {
-	mutation := newNodeMutation(c.config, OpUpdateOne, withNodeID(id))
-	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NoteClient) UpdateOneID(id int) *NoteUpdateOne {
+	mutation := newNoteMutation(c.config, OpUpdateOne, withNoteID(id))
+	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Node.
-func (c *NodeClient) Delete() *NodeDelete {
-	mutation := newNodeMutation(c.config, OpDelete)
-	return &NodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Note.
+func (c *NoteClient) Delete() *NoteDelete {
+	mutation := newNoteMutation(c.config, OpDelete)
+	return &NoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NodeClient) DeleteOne(_m *Node) *NodeDeleteOne {
+func (c *NoteClient) DeleteOne(_m *Note) *NoteDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NodeClient) DeleteOneID(id int) *NodeDeleteOne {
-	builder := c.Delete().Where(node.ID(id))
+func (c *NoteClient) DeleteOneID(id int) *NoteDeleteOne {
+	builder := c.Delete().Where(note.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NodeDeleteOne{builder}
+	return &NoteDeleteOne{builder}
 }
 
-// Query returns a query builder for Node.
-func (c *NodeClient) Query() *NodeQuery {
-	return &NodeQuery{
+// Query returns a query builder for Note.
+func (c *NoteClient) Query() *NoteQuery {
+	return &NoteQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNode},
+		ctx:    &QueryContext{Type: TypeNote},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Node entity by its id.
-func (c *NodeClient) Get(ctx context.Context, id int) (*Node, error) {
-	return c.Query().Where(node.ID(id)).Only(ctx)
+// Get returns a Note entity by its id.
+func (c *NoteClient) Get(ctx context.Context, id int) (*Note, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
+	return c.Query().Where(note.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
+func (c *NoteClient) GetX(ctx context.Context, id int) *Note {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2383,60 +4256,69 @@ func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
 	return obj
 }
 
-// QueryPrev queries the prev edge of a Node.
-func (c *NodeClient) QueryPrev(_m *Node) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
+// QueryParent queries the parent edge of a Note.
+func (c *NoteClient) QueryParent(_m *Note) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, note.ParentTable, note.ParentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Note, error) {
+		return c.batchQueryParent(ctx, l, _m.ID)
+	}
 	return query
 }
 
-// QueryNext queries the next edge of a Node.
-func (c *NodeClient) QueryNext(_m *Node) *NodeQuery {
-	query := (&NodeClient{config: c.config}).Query()
+// QueryChildren queries the children edge of a Note.
+func (c *NoteClient) QueryChildren(_m *Note) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(node.Table, node.FieldID, id),
-			sqlgraph.To(node.Table, node.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.ChildrenTable, note.ChildrenColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Note, error) {
+		return c.batchQueryChildren(ctx, l, _m.ID)
+	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NodeClient) Hooks() []Hook {
-	return c.hooks.Node
+func (c *NoteClient) Hooks() []Hook {
+	hooks := c.hooks.Note
+	hooks = append([]Hook{tenantScopeNote}, hooks...)
+	return hooks
 }
 
 // Interceptors returns the client interceptors.
-func (c *NodeClient) Interceptors() []Interceptor {
-	return c.inters.Node
+func (c *NoteClient) Interceptors() []Interceptor {
+	inters := append([]Interceptor{tenantFilterNote}, c.inters.Note...)
+	return inters
 }
 
-func (c *NodeClient) mutate(ctx context.Context, m *NodeMutation) (Value, error) {
+func (c *NoteClient) mutate(ctx context.Context, m *NoteMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Node mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Note mutation op: %q", m.Op())
 	}
 }
 
@@ -2536,6 +4418,9 @@ func (c *PCClient) Query() *PCQuery {
 
 // Get returns a PC entity by its id.
 func (c *PCClient) Get(ctx context.Context, id int) (*PC, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(pc.ID(id)).Only(ctx)
 }
 
@@ -2669,6 +4554,9 @@ func (c *PetClient) Query() *PetQuery {
 
 // Get returns a Pet entity by its id.
 func (c *PetClient) Get(ctx context.Context, id int) (*Pet, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(pet.ID(id)).Only(ctx)
 }
 
@@ -2694,6 +4582,9 @@ func (c *PetClient) QueryTeam(_m *Pet) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryTeam(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -2710,6 +4601,9 @@ func (c *PetClient) QueryOwner(_m *Pet) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryOwner(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -2738,6 +4632,182 @@ func (c *PetClient) mutate(ctx context.Context, m *PetMutation) (Value, error) {
 	}
 }
 
+// PostClient is a client for the Post schema.
+type PostClient struct {
+	config
+}
+
+// NewPostClient returns a client for the Post from the given config.
+func NewPostClient(c config) *PostClient {
+	return &PostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
+func (c *PostClient) Use(hooks ...Hook) {
+	c.hooks.Post = append(c.hooks.Post, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
+}
+
+// Create returns a builder for creating a Post entity.
+func (c *PostClient) Create() *PostCreate {
+	mutation := newPostMutation(c.config, OpCreate)
+	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Post entities.
+func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Post.
+func (c *PostClient) Update() *PostUpdate {
+	mutation := newPostMutation(c.config, OpUpdate)
+	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostClient) UpdateOne(_m *Post) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPost(_m))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Post.
+func (c *PostClient) Delete() *PostDelete {
+	mutation := newPostMutation(c.config, OpDelete)
+	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostClient) DeleteOne(_m *Post) *PostDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
+	builder := c.Delete().Where(post.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostDeleteOne{builder}
+}
+
+// Query returns a query builder for Post.
+func (c *PostClient) Query() *PostQuery {
+	return &PostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Post entity by its id.
+func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
+	return c.Query().Where(post.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostClient) GetX(ctx context.Context, id int) *Post {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParent queries the parent edge of a Post.
+func (c *PostClient) QueryParent(_m *Post) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.ParentTable, post.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Post, error) {
+		return c.batchQueryParent(ctx, l, _m.ID)
+	}
+	return query
+}
+
+// QueryReplies queries the replies edge of a Post.
+func (c *PostClient) QueryReplies(_m *Post) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.RepliesTable, post.RepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Post, error) {
+		return c.batchQueryReplies(ctx, l, _m.ID)
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostClient) Hooks() []Hook {
+	hooks := c.hooks.Post
+	hooks = append(hooks[:len(hooks):len(hooks)], historyPost)
+	return hooks
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
+}
+
+func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+	}
+}
+
 // SpecClient is a client for the Spec schema.
 type SpecClient struct {
 	config
@@ -2834,6 +4904,9 @@ func (c *SpecClient) Query() *SpecQuery {
 
 // Get returns a Spec entity by its id.
 func (c *SpecClient) Get(ctx context.Context, id int) (*Spec, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(spec.ID(id)).Only(ctx)
 }
 
@@ -2859,6 +4932,9 @@ func (c *SpecClient) QueryCard(_m *Spec) *CardQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Card, error) {
+		return c.batchQueryCard(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -2983,6 +5059,9 @@ func (c *TaskClient) Query() *TaskQuery {
 
 // Get returns a Task entity by its id.
 func (c *TaskClient) Get(ctx context.Context, id int) (*Task, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(enttask.ID(id)).Only(ctx)
 }
 
@@ -3116,6 +5195,9 @@ func (c *UserClient) Query() *UserQuery {
 
 // Get returns a User entity by its id.
 func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
 	return c.Query().Where(user.ID(id)).Only(ctx)
 }
 
@@ -3141,6 +5223,9 @@ func (c *UserClient) QueryCard(_m *User) *CardQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Card, error) {
+		return c.batchQueryCard(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3157,6 +5242,9 @@ func (c *UserClient) QueryPets(_m *User) *PetQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Pet, error) {
+		return c.batchQueryPets(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3173,6 +5261,9 @@ func (c *UserClient) QueryFiles(_m *User) *FileQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*File, error) {
+		return c.batchQueryFiles(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3189,6 +5280,9 @@ func (c *UserClient) QueryGroups(_m *User) *GroupQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Group, error) {
+		return c.batchQueryGroups(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3205,6 +5299,9 @@ func (c *UserClient) QueryFriends(_m *User) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryFriends(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3221,6 +5318,9 @@ func (c *UserClient) QueryFollowers(_m *User) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryFollowers(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3237,6 +5337,9 @@ func (c *UserClient) QueryFollowing(_m *User) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryFollowing(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3253,6 +5356,9 @@ func (c *UserClient) QueryTeam(_m *User) *PetQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*Pet, error) {
+		return c.batchQueryTeam(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3269,6 +5375,9 @@ func (c *UserClient) QuerySpouse(_m *User) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQuerySpouse(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3285,6 +5394,9 @@ func (c *UserClient) QueryChildren(_m *User) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryChildren(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3301,6 +5413,9 @@ func (c *UserClient) QueryParent(_m *User) *UserQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
+	query.batch = func(ctx context.Context, l *loader) ([]*User, error) {
+		return c.batchQueryParent(ctx, l, _m.ID)
+	}
 	return query
 }
 
@@ -3329,16 +5444,153 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// PostHistoryClient is a client for the PostHistory schema.
+type PostHistoryClient struct {
+	config
+}
+
+// NewPostHistoryClient returns a client for the PostHistory from the given config.
+func NewPostHistoryClient(c config) *PostHistoryClient {
+	return &PostHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `posthistory.Hooks(f(g(h())))`.
+func (c *PostHistoryClient) Use(hooks ...Hook) {
+	c.hooks.PostHistory = append(c.hooks.PostHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `posthistory.Intercept(f(g(h())))`.
+func (c *PostHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostHistory = append(c.inters.PostHistory, interceptors...)
+}
+
+// Create returns a builder for creating a PostHistory entity.
+func (c *PostHistoryClient) Create() *PostHistoryCreate {
+	mutation := newPostHistoryMutation(c.config, OpCreate)
+	return &PostHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostHistory entities.
+func (c *PostHistoryClient) CreateBulk(builders ...*PostHistoryCreate) *PostHistoryCreateBulk {
+	return &PostHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostHistoryClient) MapCreateBulk(slice any, setFunc func(*PostHistoryCreate, int)) *PostHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostHistoryCreateBulk{err: fmt.Errorf("calling to PostHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostHistory.
+func (c *PostHistoryClient) Update() *PostHistoryUpdate {
+	mutation := newPostHistoryMutation(c.config, OpUpdate)
+	return &PostHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostHistoryClient) UpdateOne(_m *PostHistory) *PostHistoryUpdateOne {
+	mutation := newPostHistoryMutation(c.config, OpUpdateOne, withPostHistory(_m))
+	return &PostHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostHistoryClient) UpdateOneID(id int) *PostHistoryUpdateOne {
+	mutation := newPostHistoryMutation(c.config, OpUpdateOne, withPostHistoryID(id))
+	return &PostHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostHistory.
+func (c *PostHistoryClient) Delete() *PostHistoryDelete {
+	mutation := newPostHistoryMutation(c.config, OpDelete)
+	return &PostHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostHistoryClient) DeleteOne(_m *PostHistory) *PostHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostHistoryClient) DeleteOneID(id int) *PostHistoryDeleteOne {
+	builder := c.Delete().Where(posthistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for PostHistory.
+func (c *PostHistoryClient) Query() *PostHistoryQuery {
+	return &PostHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostHistory entity by its id.
+func (c *PostHistoryClient) Get(ctx context.Context, id int) (*PostHistory, error) {
+	if l := loaderFromContext(ctx); l != nil {
+		return c.batchGet(ctx, l, id)
+	}
+	return c.Query().Where(posthistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostHistoryClient) GetX(ctx context.Context, id int) *PostHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PostHistoryClient) Hooks() []Hook {
+	return c.hooks.PostHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostHistoryClient) Interceptors() []Interceptor {
+	return c.inters.PostHistory
+}
+
+func (c *PostHistoryClient) mutate(ctx context.Context, m *PostHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostHistory mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
 		Api, Builder, Card, Comment, ExValueScan, FieldType, File, FileType, Goods,
-		Group, GroupInfo, Item, License, Node, PC, Pet, Spec, Task, User []ent.Hook
+		Group, GroupInfo, Item, License, Node, Note, PC, Pet, Post, Spec, Task, User,
+		PostHistory []ent.Hook
 	}
 	inters struct {
 		Api, Builder, Card, Comment, ExValueScan, FieldType, File, FileType, Goods,
-		Group, GroupInfo, Item, License, Node, PC, Pet, Spec, Task,
-		User []ent.Interceptor
+		Group, GroupInfo, Item, License, Node, Note, PC, Pet, Post, Spec, Task, User,
+		PostHistory []ent.Interceptor
 	}
 )
 
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -19,8 +20,11 @@ import (
 // CommentDelete is the builder for deleting a Comment entity.
 type CommentDelete struct {
 	config
-	hooks    []Hook
-	mutation *CommentMutation
+	hooks     []Hook
+	mutation  *CommentMutation
+	fields    []string
+	returning bool
+	nodes     []*Comment
 }
 
 // Where appends a list predicates to the CommentDelete builder.
@@ -43,8 +47,59 @@ func (_d *CommentDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Select allows selecting one or more fields (columns) of the returned entities.
+// The default is selecting all fields defined in the entity schema.
+func (_d *CommentDelete) Select(field string, fields ...string) *CommentDelete {
+	_d.fields = append([]string{field}, fields...)
+	return _d
+}
+
+// DeleteReturning executes the deletion query and returns the deleted Comments. In PostgreSQL and
+// SQLite, the entities are read from the deleted rows using the RETURNING clause (OUTPUT in SQL Server).
+// In MySQL, the matched rows are locked and selected before they are deleted in one transaction.
+func (_d *CommentDelete) DeleteReturning(ctx context.Context) ([]*Comment, error) {
+	_d.returning, _d.nodes = true, nil
+	if _, err := _d.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return _d.nodes, nil
+}
+
+// DeleteReturningX is like DeleteReturning, but panics if an error occurs.
+func (_d *CommentDelete) DeleteReturningX(ctx context.Context) []*Comment {
+	nodes, err := _d.DeleteReturning(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (_d *CommentDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(comment.Table, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
+	if _d.returning {
+		_spec.Node.Columns = comment.Columns
+		if fields := _d.fields; len(fields) > 0 {
+			_spec.Node.Columns = make([]string, 0, len(fields)+1)
+			_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
+			for _, f := range fields {
+				if !comment.ValidColumn(f) {
+					return 0, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				}
+				if f != comment.FieldID {
+					_spec.Node.Columns = append(_spec.Node.Columns, f)
+				}
+			}
+		}
+		_spec.Returning = true
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*Comment).scanValues(nil, columns)
+		}
+		_spec.Assign = func(columns []string, values []any) error {
+			node := &Comment{config: _d.config}
+			_d.nodes = append(_d.nodes, node)
+			return node.assignValues(columns, values)
+		}
+	}
 	if ps := _d.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -25,6 +25,9 @@ type CommentUpdate struct {
 	hooks     []Hook
 	mutation  *CommentMutation
 	modifiers []func(*sql.UpdateBuilder)
+	fields    []string
+	returning bool
+	nodes     []*Comment
 }
 
 // Where appends a list predicates to the CommentUpdate builder.
@@ -200,6 +203,33 @@ func (_u *CommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *Commen
 	return _u
 }
 
+// Select allows selecting one or more fields (columns) of the returned entities.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CommentUpdate) Select(field string, fields ...string) *CommentUpdate {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// SaveReturning executes the query and returns the updated Comments. In PostgreSQL and SQLite,
+// the entities are read from the updated rows using the RETURNING clause (OUTPUT in SQL Server). In MySQL,
+// the matched rows are locked, updated and selected again in one transaction.
+func (_u *CommentUpdate) SaveReturning(ctx context.Context) ([]*Comment, error) {
+	_u.returning, _u.nodes = true, nil
+	if _, err := _u.Save(ctx); err != nil {
+		return nil, err
+	}
+	return _u.nodes, nil
+}
+
+// SaveReturningX is like SaveReturning, but panics if an error occurs.
+func (_u *CommentUpdate) SaveReturningX(ctx context.Context) []*Comment {
+	nodes, err := _u.SaveReturning(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -249,6 +279,30 @@ func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.ClearField(comment.FieldClient, field.TypeString)
 	}
 	_spec.AddModifiers(_u.modifiers...)
+	if _u.returning {
+		_spec.Node.Columns = comment.Columns
+		if fields := _u.fields; len(fields) > 0 {
+			_spec.Node.Columns = make([]string, 0, len(fields)+1)
+			_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
+			for _, f := range fields {
+				if !comment.ValidColumn(f) {
+					return 0, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				}
+				if f != comment.FieldID {
+					_spec.Node.Columns = append(_spec.Node.Columns, f)
+				}
+			}
+		}
+		_spec.Returning = true
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*Comment).scanValues(nil, columns)
+		}
+		_spec.Assign = func(columns []string, values []any) error {
+			node := &Comment{config: _u.config}
+			_u.nodes = append(_u.nodes, node)
+			return node.assignValues(columns, values)
+		}
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
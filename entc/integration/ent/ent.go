@@ -30,8 +30,11 @@ import (
 	"entgo.io/ent/entc/integration/ent/item"
 	"entgo.io/ent/entc/integration/ent/license"
 	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/note"
 	"entgo.io/ent/entc/integration/ent/pc"
 	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/post"
+	"entgo.io/ent/entc/integration/ent/posthistory"
 	"entgo.io/ent/entc/integration/ent/spec"
 
 	enttask "entgo.io/ent/entc/integration/ent/task"
@@ -110,11 +113,14 @@ func checkColumn(t, c string) error {
 			item.Table:        item.ValidColumn,
 			license.Table:     license.ValidColumn,
 			node.Table:        node.ValidColumn,
+			note.Table:        note.ValidColumn,
 			pc.Table:          pc.ValidColumn,
 			pet.Table:         pet.ValidColumn,
+			post.Table:        post.ValidColumn,
 			spec.Table:        spec.ValidColumn,
 			enttask.Table:     enttask.ValidColumn,
 			user.Table:        user.ValidColumn,
+			posthistory.Table: posthistory.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -326,6 +332,32 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// StaleObjectError returns when trying to update an entity using an optimistic-lock (version)
+// field, and the entity was modified by another operation since it was loaded.
+type StaleObjectError struct {
+	label string
+	wrap  error
+}
+
+// Error implements the error interface.
+func (e *StaleObjectError) Error() string {
+	return "ent: " + e.label + " was modified since it was loaded"
+}
+
+// Unwrap implements the errors.Wrapper interface.
+func (e *StaleObjectError) Unwrap() error {
+	return e.wrap
+}
+
+// IsStaleObject returns a boolean indicating whether the error is a stale object error.
+func IsStaleObject(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *StaleObjectError
+	return errors.As(err, &e)
+}
+
 // selector embedded by the different Select/GroupBy builders.
 type selector struct {
 	label string
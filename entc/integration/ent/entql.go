@@ -21,8 +21,11 @@ import (
 	"entgo.io/ent/entc/integration/ent/item"
 	"entgo.io/ent/entc/integration/ent/license"
 	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/note"
 	"entgo.io/ent/entc/integration/ent/pc"
 	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/post"
+	"entgo.io/ent/entc/integration/ent/posthistory"
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/spec"
 	enttask "entgo.io/ent/entc/integration/ent/task"
@@ -36,7 +39,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 19)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 22)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   api.Table,
@@ -324,6 +327,22 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 	}
 	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   note.Table,
+			Columns: note.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: note.FieldID,
+			},
+		},
+		Type: "Note",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			note.FieldTenant: {Type: field.TypeString, Column: note.FieldTenant},
+			note.FieldText:   {Type: field.TypeString, Column: note.FieldText},
+			note.FieldSlug:   {Type: field.TypeString, Column: note.FieldSlug},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   pc.Table,
 			Columns: pc.Columns,
@@ -335,7 +354,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Type:   "PC",
 		Fields: map[string]*sqlgraph.FieldSpec{},
 	}
-	graph.Nodes[15] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   pet.Table,
 			Columns: pet.Columns,
@@ -354,7 +373,23 @@ var schemaGraph = func() *sqlgraph.Schema {
 			pet.FieldOptionalTime: {Type: field.TypeTime, Column: pet.FieldOptionalTime},
 		},
 	}
-	graph.Nodes[16] = &sqlgraph.Node{
+	graph.Nodes[17] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   post.Table,
+			Columns: post.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: post.FieldID,
+			},
+		},
+		Type: "Post",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			post.FieldTitle:     {Type: field.TypeString, Column: post.FieldTitle},
+			post.FieldSecret:    {Type: field.TypeString, Column: post.FieldSecret},
+			post.FieldDeletedAt: {Type: field.TypeTime, Column: post.FieldDeletedAt},
+		},
+	}
+	graph.Nodes[18] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   spec.Table,
 			Columns: spec.Columns,
@@ -366,7 +401,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Type:   "Spec",
 		Fields: map[string]*sqlgraph.FieldSpec{},
 	}
-	graph.Nodes[17] = &sqlgraph.Node{
+	graph.Nodes[19] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   enttask.Table,
 			Columns: enttask.Columns,
@@ -385,9 +420,10 @@ var schemaGraph = func() *sqlgraph.Schema {
 			enttask.FieldOrder:       {Type: field.TypeInt, Column: enttask.FieldOrder},
 			enttask.FieldOrderOption: {Type: field.TypeInt, Column: enttask.FieldOrderOption},
 			enttask.FieldOp:          {Type: field.TypeString, Column: enttask.FieldOp},
+			enttask.FieldVersion:     {Type: field.TypeInt, Column: enttask.FieldVersion},
 		},
 	}
-	graph.Nodes[18] = &sqlgraph.Node{
+	graph.Nodes[20] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -412,6 +448,26 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldFilesCount:  {Type: field.TypeInt, Column: user.FieldFilesCount},
 		},
 	}
+	graph.Nodes[21] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   posthistory.Table,
+			Columns: posthistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: posthistory.FieldID,
+			},
+		},
+		Type: "PostHistory",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			posthistory.FieldHistoryTime:   {Type: field.TypeTime, Column: posthistory.FieldHistoryTime},
+			posthistory.FieldOperation:     {Type: field.TypeString, Column: posthistory.FieldOperation},
+			posthistory.FieldActor:         {Type: field.TypeString, Column: posthistory.FieldActor},
+			posthistory.FieldChangedFields: {Type: field.TypeJSON, Column: posthistory.FieldChangedFields},
+			posthistory.FieldOldValues:     {Type: field.TypeJSON, Column: posthistory.FieldOldValues},
+			posthistory.FieldRef:           {Type: field.TypeInt, Column: posthistory.FieldRef},
+			posthistory.FieldTitle:         {Type: field.TypeString, Column: posthistory.FieldTitle},
+		},
+	}
 	graph.MustAddE(
 		"owner",
 		&sqlgraph.EdgeSpec{
@@ -568,6 +624,30 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Node",
 		"Node",
 	)
+	graph.MustAddE(
+		"parent",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   note.ParentTable,
+			Columns: []string{note.ParentColumn},
+			Bidi:    false,
+		},
+		"Note",
+		"Note",
+	)
+	graph.MustAddE(
+		"children",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ChildrenTable,
+			Columns: []string{note.ChildrenColumn},
+			Bidi:    false,
+		},
+		"Note",
+		"Note",
+	)
 	graph.MustAddE(
 		"team",
 		&sqlgraph.EdgeSpec{
@@ -592,6 +672,30 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Pet",
 		"User",
 	)
+	graph.MustAddE(
+		"parent",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.ParentTable,
+			Columns: []string{post.ParentColumn},
+			Bidi:    false,
+		},
+		"Post",
+		"Post",
+	)
+	graph.MustAddE(
+		"replies",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RepliesTable,
+			Columns: []string{post.RepliesColumn},
+			Bidi:    false,
+		},
+		"Post",
+		"Post",
+	)
 	graph.MustAddE(
 		"card",
 		&sqlgraph.EdgeSpec{
@@ -2022,6 +2126,89 @@ func (f *NodeFilter) WhereHasNextWith(preds ...predicate.Node) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *NoteQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the NoteQuery builder.
+func (_q *NoteQuery) Filter() *NoteFilter {
+	return &NoteFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *NoteMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the NoteMutation builder.
+func (m *NoteMutation) Filter() *NoteFilter {
+	return &NoteFilter{config: m.config, predicateAdder: m}
+}
+
+// NoteFilter provides a generic filtering capability at runtime for NoteQuery.
+type NoteFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *NoteFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *NoteFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(note.FieldID))
+}
+
+// WhereTenant applies the entql string predicate on the tenant field.
+func (f *NoteFilter) WhereTenant(p entql.StringP) {
+	f.Where(p.Field(note.FieldTenant))
+}
+
+// WhereText applies the entql string predicate on the text field.
+func (f *NoteFilter) WhereText(p entql.StringP) {
+	f.Where(p.Field(note.FieldText))
+}
+
+// WhereSlug applies the entql string predicate on the slug field.
+func (f *NoteFilter) WhereSlug(p entql.StringP) {
+	f.Where(p.Field(note.FieldSlug))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *NoteFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *NoteFilter) WhereHasParentWith(preds ...predicate.Note) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *NoteFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *NoteFilter) WhereHasChildrenWith(preds ...predicate.Note) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *PCQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2051,7 +2238,7 @@ type PCFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PCFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2091,7 +2278,7 @@ type PetFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PetFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2160,6 +2347,89 @@ func (f *PetFilter) WhereHasOwnerWith(preds ...predicate.User) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *PostQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PostQuery builder.
+func (_q *PostQuery) Filter() *PostFilter {
+	return &PostFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PostMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PostMutation builder.
+func (m *PostMutation) Filter() *PostFilter {
+	return &PostFilter{config: m.config, predicateAdder: m}
+}
+
+// PostFilter provides a generic filtering capability at runtime for PostQuery.
+type PostFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PostFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PostFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(post.FieldID))
+}
+
+// WhereTitle applies the entql string predicate on the title field.
+func (f *PostFilter) WhereTitle(p entql.StringP) {
+	f.Where(p.Field(post.FieldTitle))
+}
+
+// WhereSecret applies the entql string predicate on the secret field.
+func (f *PostFilter) WhereSecret(p entql.StringP) {
+	f.Where(p.Field(post.FieldSecret))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *PostFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(post.FieldDeletedAt))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *PostFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *PostFilter) WhereHasParentWith(preds ...predicate.Post) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasReplies applies a predicate to check if query has an edge replies.
+func (f *PostFilter) WhereHasReplies() {
+	f.Where(entql.HasEdge("replies"))
+}
+
+// WhereHasRepliesWith applies a predicate to check if query has an edge replies with a given conditions (other predicates).
+func (f *PostFilter) WhereHasRepliesWith(preds ...predicate.Post) {
+	f.Where(entql.HasEdgeWith("replies", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *SpecQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2189,7 +2459,7 @@ type SpecFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SpecFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2243,7 +2513,7 @@ type TaskFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TaskFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2294,6 +2564,11 @@ func (f *TaskFilter) WhereOp(p entql.StringP) {
 	f.Where(p.Field(enttask.FieldOp))
 }
 
+// WhereVersion applies the entql int predicate on the version field.
+func (f *TaskFilter) WhereVersion(p entql.IntP) {
+	f.Where(p.Field(enttask.FieldVersion))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2323,7 +2598,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2547,3 +2822,78 @@ func (f *UserFilter) WhereHasParentWith(preds ...predicate.User) {
 		}
 	})))
 }
+
+// addPredicate implements the predicateAdder interface.
+func (_q *PostHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PostHistoryQuery builder.
+func (_q *PostHistoryQuery) Filter() *PostHistoryFilter {
+	return &PostHistoryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PostHistoryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PostHistoryMutation builder.
+func (m *PostHistoryMutation) Filter() *PostHistoryFilter {
+	return &PostHistoryFilter{config: m.config, predicateAdder: m}
+}
+
+// PostHistoryFilter provides a generic filtering capability at runtime for PostHistoryQuery.
+type PostHistoryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PostHistoryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PostHistoryFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(posthistory.FieldID))
+}
+
+// WhereHistoryTime applies the entql time.Time predicate on the history_time field.
+func (f *PostHistoryFilter) WhereHistoryTime(p entql.TimeP) {
+	f.Where(p.Field(posthistory.FieldHistoryTime))
+}
+
+// WhereOperation applies the entql string predicate on the operation field.
+func (f *PostHistoryFilter) WhereOperation(p entql.StringP) {
+	f.Where(p.Field(posthistory.FieldOperation))
+}
+
+// WhereActor applies the entql string predicate on the actor field.
+func (f *PostHistoryFilter) WhereActor(p entql.StringP) {
+	f.Where(p.Field(posthistory.FieldActor))
+}
+
+// WhereChangedFields applies the entql json.RawMessage predicate on the changed_fields field.
+func (f *PostHistoryFilter) WhereChangedFields(p entql.BytesP) {
+	f.Where(p.Field(posthistory.FieldChangedFields))
+}
+
+// WhereOldValues applies the entql json.RawMessage predicate on the old_values field.
+func (f *PostHistoryFilter) WhereOldValues(p entql.BytesP) {
+	f.Where(p.Field(posthistory.FieldOldValues))
+}
+
+// WhereRef applies the entql int predicate on the ref field.
+func (f *PostHistoryFilter) WhereRef(p entql.IntP) {
+	f.Where(p.Field(posthistory.FieldRef))
+}
+
+// WhereTitle applies the entql string predicate on the title field.
+func (f *PostHistoryFilter) WhereTitle(p entql.StringP) {
+	f.Where(p.Field(posthistory.FieldTitle))
+}
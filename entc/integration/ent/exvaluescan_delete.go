@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -19,8 +20,11 @@ import (
 // ExValueScanDelete is the builder for deleting a ExValueScan entity.
 type ExValueScanDelete struct {
 	config
-	hooks    []Hook
-	mutation *ExValueScanMutation
+	hooks     []Hook
+	mutation  *ExValueScanMutation
+	fields    []string
+	returning bool
+	nodes     []*ExValueScan
 }
 
 // Where appends a list predicates to the ExValueScanDelete builder.
@@ -43,8 +47,59 @@ func (_d *ExValueScanDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Select allows selecting one or more fields (columns) of the returned entities.
+// The default is selecting all fields defined in the entity schema.
+func (_d *ExValueScanDelete) Select(field string, fields ...string) *ExValueScanDelete {
+	_d.fields = append([]string{field}, fields...)
+	return _d
+}
+
+// DeleteReturning executes the deletion query and returns the deleted ExValueScans. In PostgreSQL and
+// SQLite, the entities are read from the deleted rows using the RETURNING clause (OUTPUT in SQL Server).
+// In MySQL, the matched rows are locked and selected before they are deleted in one transaction.
+func (_d *ExValueScanDelete) DeleteReturning(ctx context.Context) ([]*ExValueScan, error) {
+	_d.returning, _d.nodes = true, nil
+	if _, err := _d.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return _d.nodes, nil
+}
+
+// DeleteReturningX is like DeleteReturning, but panics if an error occurs.
+func (_d *ExValueScanDelete) DeleteReturningX(ctx context.Context) []*ExValueScan {
+	nodes, err := _d.DeleteReturning(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (_d *ExValueScanDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(exvaluescan.Table, sqlgraph.NewFieldSpec(exvaluescan.FieldID, field.TypeInt))
+	if _d.returning {
+		_spec.Node.Columns = exvaluescan.Columns
+		if fields := _d.fields; len(fields) > 0 {
+			_spec.Node.Columns = make([]string, 0, len(fields)+1)
+			_spec.Node.Columns = append(_spec.Node.Columns, exvaluescan.FieldID)
+			for _, f := range fields {
+				if !exvaluescan.ValidColumn(f) {
+					return 0, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				}
+				if f != exvaluescan.FieldID {
+					_spec.Node.Columns = append(_spec.Node.Columns, f)
+				}
+			}
+		}
+		_spec.Returning = true
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*ExValueScan).scanValues(nil, columns)
+		}
+		_spec.Assign = func(columns []string, values []any) error {
+			node := &ExValueScan{config: _d.config}
+			_d.nodes = append(_d.nodes, node)
+			return node.assignValues(columns, values)
+		}
+	}
 	if ps := _d.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
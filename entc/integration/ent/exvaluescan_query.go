@@ -8,7 +8,9 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -27,7 +29,9 @@ type ExValueScanQuery struct {
 	order      []exvaluescan.OrderOption
 	inters     []Interceptor
 	predicates []predicate.ExValueScan
-	modifiers  []func(*sql.Selector)
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch     func(context.Context, *loader) ([]*ExValueScan, error)
+	modifiers []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -171,6 +175,9 @@ func (_q *ExValueScanQuery) All(ctx context.Context) ([]*ExValueScan, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*ExValueScan, *ExValueScanQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*ExValueScan, *ExValueScanQuery](l, qr)
+	}
 	return withInterceptors[[]*ExValueScan](ctx, _q, qr, _q.inters)
 }
 
@@ -353,6 +360,9 @@ func (_q *ExValueScanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -370,6 +380,9 @@ func (_q *ExValueScanQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -435,6 +448,7 @@ func (_q *ExValueScanQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -452,6 +466,102 @@ func (_q *ExValueScanQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *ExValueScanQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.modifiers == nil
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *ExValueScanQuery) batchAll(ctx context.Context, l *loader) ([]*ExValueScan, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*ExValueScan, 0, len(nodes)), nodes...), nil
+}
+
+// Iter executes the query and returns an iterator that streams its ExValueScans from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.ExValueScan.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *ExValueScanQuery) Iter(ctx context.Context) iter.Seq2[*ExValueScan, error] {
+	return func(yield func(*ExValueScan, error) bool) {
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &ExValueScan{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of ExValueScans.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.ExValueScan.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.ExValueScan) error {
+//			// ...
+//		})
+func (_q *ExValueScanQuery) EachBatch(ctx context.Context, size int, fn func([]*ExValueScan) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(exvaluescan.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.ExValueScan(sql.FieldGT(exvaluescan.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -484,6 +594,67 @@ func (_q *ExValueScanQuery) Modify(modifiers ...func(s *sql.Selector)) *ExValueS
 	return _q.Select()
 }
 
+// ExValueScanPage is a page of ExValueScans returned by ExValueScanQuery.Paginate.
+type ExValueScanPage struct {
+	// Nodes holds the ExValueScans of the page.
+	Nodes []*ExValueScan
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more ExValueScans after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" ExValueScans that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.ExValueScan.Query().
+//		Order(exvaluescan.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.ExValueScan.Query().
+//		Order(exvaluescan.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *ExValueScanQuery) Paginate(ctx context.Context, after *Cursor, first int) (*ExValueScanPage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("exvaluescan: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		exvaluescan.FieldID,
+		exvaluescan.FieldBinary,
+		exvaluescan.FieldBinaryBytes,
+		exvaluescan.FieldText,
+		exvaluescan.FieldBase64,
+		exvaluescan.FieldCustom,
+	)
+	nodes, err := _q.Clone().
+		Order(exvaluescan.ByID()).
+		Where(predicate.ExValueScan(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &ExValueScanPage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // ExValueScanGroupBy is the group-by builder for ExValueScan entities.
 type ExValueScanGroupBy struct {
 	selector
@@ -565,6 +736,9 @@ func (evss *ExValueScanSelect) sqlScan(ctx context.Context, root *ExValueScanQue
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := evss.driver.Query(ctx, query, args, rows); err != nil {
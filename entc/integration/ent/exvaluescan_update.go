@@ -26,6 +26,9 @@ type ExValueScanUpdate struct {
 	hooks     []Hook
 	mutation  *ExValueScanMutation
 	modifiers []func(*sql.UpdateBuilder)
+	fields    []string
+	returning bool
+	nodes     []*ExValueScan
 }
 
 // Where appends a list predicates to the ExValueScanUpdate builder.
@@ -162,6 +165,33 @@ func (_u *ExValueScanUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *Ex
 	return _u
 }
 
+// Select allows selecting one or more fields (columns) of the returned entities.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExValueScanUpdate) Select(field string, fields ...string) *ExValueScanUpdate {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// SaveReturning executes the query and returns the updated ExValueScans. In PostgreSQL and SQLite,
+// the entities are read from the updated rows using the RETURNING clause (OUTPUT in SQL Server). In MySQL,
+// the matched rows are locked, updated and selected again in one transaction.
+func (_u *ExValueScanUpdate) SaveReturning(ctx context.Context) ([]*ExValueScan, error) {
+	_u.returning, _u.nodes = true, nil
+	if _, err := _u.Save(ctx); err != nil {
+		return nil, err
+	}
+	return _u.nodes, nil
+}
+
+// SaveReturningX is like SaveReturning, but panics if an error occurs.
+func (_u *ExValueScanUpdate) SaveReturningX(ctx context.Context) []*ExValueScan {
+	nodes, err := _u.SaveReturning(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (_u *ExValueScanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(exvaluescan.Table, exvaluescan.Columns, sqlgraph.NewFieldSpec(exvaluescan.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -237,6 +267,30 @@ func (_u *ExValueScanUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		_spec.ClearField(exvaluescan.FieldCustomOptional, field.TypeString)
 	}
 	_spec.AddModifiers(_u.modifiers...)
+	if _u.returning {
+		_spec.Node.Columns = exvaluescan.Columns
+		if fields := _u.fields; len(fields) > 0 {
+			_spec.Node.Columns = make([]string, 0, len(fields)+1)
+			_spec.Node.Columns = append(_spec.Node.Columns, exvaluescan.FieldID)
+			for _, f := range fields {
+				if !exvaluescan.ValidColumn(f) {
+					return 0, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				}
+				if f != exvaluescan.FieldID {
+					_spec.Node.Columns = append(_spec.Node.Columns, f)
+				}
+			}
+		}
+		_spec.Returning = true
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*ExValueScan).scanValues(nil, columns)
+		}
+		_spec.Assign = func(columns []string, values []any) error {
+			node := &ExValueScan{config: _u.config}
+			_u.nodes = append(_u.nodes, node)
+			return node.assignValues(columns, values)
+		}
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{exvaluescan.Label}
@@ -8,7 +8,9 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -28,7 +30,9 @@ type FieldTypeQuery struct {
 	inters     []Interceptor
 	predicates []predicate.FieldType
 	withFKs    bool
-	modifiers  []func(*sql.Selector)
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch     func(context.Context, *loader) ([]*FieldType, error)
+	modifiers []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -172,6 +176,9 @@ func (_q *FieldTypeQuery) All(ctx context.Context) ([]*FieldType, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*FieldType, *FieldTypeQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*FieldType, *FieldTypeQuery](l, qr)
+	}
 	return withInterceptors[[]*FieldType](ctx, _q, qr, _q.inters)
 }
 
@@ -358,6 +365,9 @@ func (_q *FieldTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fi
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -375,6 +385,9 @@ func (_q *FieldTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -440,6 +453,7 @@ func (_q *FieldTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -457,6 +471,102 @@ func (_q *FieldTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *FieldTypeQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.modifiers == nil && !_q.withFKs
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *FieldTypeQuery) batchAll(ctx context.Context, l *loader) ([]*FieldType, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*FieldType, 0, len(nodes)), nodes...), nil
+}
+
+// Iter executes the query and returns an iterator that streams its FieldTypes from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.FieldType.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *FieldTypeQuery) Iter(ctx context.Context) iter.Seq2[*FieldType, error] {
+	return func(yield func(*FieldType, error) bool) {
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &FieldType{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of FieldTypes.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.FieldType.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.FieldType) error {
+//			// ...
+//		})
+func (_q *FieldTypeQuery) EachBatch(ctx context.Context, size int, fn func([]*FieldType) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(fieldtype.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.FieldType(sql.FieldGT(fieldtype.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -489,6 +599,72 @@ func (_q *FieldTypeQuery) Modify(modifiers ...func(s *sql.Selector)) *FieldTypeS
 	return _q.Select()
 }
 
+// FieldTypePage is a page of FieldTypes returned by FieldTypeQuery.Paginate.
+type FieldTypePage struct {
+	// Nodes holds the FieldTypes of the page.
+	Nodes []*FieldType
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more FieldTypes after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" FieldTypes that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.FieldType.Query().
+//		Order(fieldtype.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.FieldType.Query().
+//		Order(fieldtype.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *FieldTypeQuery) Paginate(ctx context.Context, after *Cursor, first int) (*FieldTypePage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("fieldtype: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		fieldtype.FieldID,
+		fieldtype.FieldInt,
+		fieldtype.FieldInt8,
+		fieldtype.FieldInt16,
+		fieldtype.FieldInt32,
+		fieldtype.FieldInt64,
+		fieldtype.FieldDir,
+		fieldtype.FieldRole,
+		fieldtype.FieldPair,
+		fieldtype.FieldVstring,
+		fieldtype.FieldTriple,
+	)
+	nodes, err := _q.Clone().
+		Order(fieldtype.ByID()).
+		Where(predicate.FieldType(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &FieldTypePage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // FieldTypeGroupBy is the group-by builder for FieldType entities.
 type FieldTypeGroupBy struct {
 	selector
@@ -570,6 +746,9 @@ func (fts *FieldTypeSelect) sqlScan(ctx context.Context, root *FieldTypeQuery, v
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := fts.driver.Query(ctx, query, args, rows); err != nil {
@@ -59,7 +59,14 @@ type FileEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [3]bool
-	namedField  map[string][]*FieldType
+	// FieldCount holds the number of nodes that are connected to the "field" edge.
+	// The value is being populated by the FileQuery when WithFieldCount is set.
+	FieldCount int `json:"field_count,omitempty"`
+	// FieldAggregate holds the results of the aggregation functions that were passed to
+	// WithFieldAggregate, in the same order, for the nodes of the "field" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	FieldAggregate []any `json:"field_aggregate,omitempty"`
+	namedField     map[string][]*FieldType
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
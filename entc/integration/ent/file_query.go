@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -27,16 +29,20 @@ import (
 // FileQuery is the builder for querying File entities.
 type FileQuery struct {
 	config
-	ctx            *QueryContext
-	order          []file.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.File
-	withOwner      *UserQuery
-	withType       *FileTypeQuery
-	withField      *FieldTypeQuery
-	withFKs        bool
-	modifiers      []func(*sql.Selector)
-	withNamedField map[string]*FieldTypeQuery
+	ctx        *QueryContext
+	order      []file.OrderOption
+	inters     []Interceptor
+	predicates []predicate.File
+	withOwner  *UserQuery
+	withType   *FileTypeQuery
+	withField  *FieldTypeQuery
+	withFKs    bool
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch              func(context.Context, *loader) ([]*File, error)
+	withFieldCount     *FieldTypeQuery
+	withFieldAggregate []AggregateFunc
+	modifiers          []func(*sql.Selector)
+	withNamedField     map[string]*FieldTypeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -246,6 +252,9 @@ func (_q *FileQuery) All(ctx context.Context) ([]*File, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*File, *FileQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*File, *FileQuery](l, qr)
+	}
 	return withInterceptors[[]*File](ctx, _q, qr, _q.inters)
 }
 
@@ -335,9 +344,11 @@ func (_q *FileQuery) Clone() *FileQuery {
 		withType:   _q.withType.Clone(),
 		withField:  _q.withField.Clone(),
 		// clone intermediate query.
-		sql:       _q.sql.Clone(),
-		path:      _q.path,
-		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+		sql:                _q.sql.Clone(),
+		path:               _q.path,
+		modifiers:          append([]func(*sql.Selector){}, _q.modifiers...),
+		withFieldCount:     _q.withFieldCount.Clone(),
+		withFieldAggregate: append([]AggregateFunc{}, _q.withFieldAggregate...),
 	}
 }
 
@@ -477,6 +488,9 @@ func (_q *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -505,6 +519,19 @@ func (_q *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 			return nil, err
 		}
 	}
+	if query := _q.withFieldCount; query != nil {
+		if err := _q.aggregateField(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *File, v []any) { n.Edges.FieldCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFieldAggregate; len(fns) > 0 {
+		query := (&FieldTypeClient{config: _q.config}).Query()
+		if err := _q.aggregateField(ctx, query, nodes, fns,
+			func(n *File, v []any) { n.Edges.FieldAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedField {
 		if err := _q.loadField(ctx, query, nodes,
 			func(n *File) { n.appendNamedField(name) },
@@ -616,6 +643,9 @@ func (_q *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -681,6 +711,7 @@ func (_q *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -698,6 +729,354 @@ func (_q *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *FileQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.withOwner == nil && _q.withType == nil && _q.withField == nil && _q.withNamedField == nil && _q.modifiers == nil && !_q.withFKs
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *FileQuery) batchAll(ctx context.Context, l *loader) ([]*File, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*File, 0, len(nodes)), nodes...), nil
+}
+
+// WithFieldCount tells the query-builder to load the number of nodes that are connected to the
+// "field" edge into the FieldCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *FileQuery) WithFieldCount(opts ...func(*FieldTypeQuery)) *FileQuery {
+	query := (&FieldTypeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFieldCount = query
+	return _q
+}
+
+// WithFieldAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "field" edge, and to load their results into the FieldAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.File.Query().
+//		WithFieldAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *FileQuery) WithFieldAggregate(fns ...AggregateFunc) *FileQuery {
+	_q.withFieldAggregate = append(_q.withFieldAggregate, fns...)
+	return _q
+}
+
+func (_q *FileQuery) aggregateField(ctx context.Context, query *FieldTypeQuery, nodes []*File, fns []AggregateFunc, assign func(*File, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*File, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(file.Table, file.FieldID),
+		sqlgraph.To(fieldtype.Table, fieldtype.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, file.FieldTable, file.FieldColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range fieldtype.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*FieldType).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "field" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &FieldType{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case fieldtype.FieldID:
+				result[i] = n.ID
+			case fieldtype.FieldInt:
+				result[i] = n.Int
+			case fieldtype.FieldInt8:
+				result[i] = n.Int8
+			case fieldtype.FieldInt16:
+				result[i] = n.Int16
+			case fieldtype.FieldInt32:
+				result[i] = n.Int32
+			case fieldtype.FieldInt64:
+				result[i] = n.Int64
+			case fieldtype.FieldOptionalInt:
+				result[i] = n.OptionalInt
+			case fieldtype.FieldOptionalInt8:
+				result[i] = n.OptionalInt8
+			case fieldtype.FieldOptionalInt16:
+				result[i] = n.OptionalInt16
+			case fieldtype.FieldOptionalInt32:
+				result[i] = n.OptionalInt32
+			case fieldtype.FieldOptionalInt64:
+				result[i] = n.OptionalInt64
+			case fieldtype.FieldNillableInt:
+				result[i] = n.NillableInt
+			case fieldtype.FieldNillableInt8:
+				result[i] = n.NillableInt8
+			case fieldtype.FieldNillableInt16:
+				result[i] = n.NillableInt16
+			case fieldtype.FieldNillableInt32:
+				result[i] = n.NillableInt32
+			case fieldtype.FieldNillableInt64:
+				result[i] = n.NillableInt64
+			case fieldtype.FieldValidateOptionalInt32:
+				result[i] = n.ValidateOptionalInt32
+			case fieldtype.FieldOptionalUint:
+				result[i] = n.OptionalUint
+			case fieldtype.FieldOptionalUint8:
+				result[i] = n.OptionalUint8
+			case fieldtype.FieldOptionalUint16:
+				result[i] = n.OptionalUint16
+			case fieldtype.FieldOptionalUint32:
+				result[i] = n.OptionalUint32
+			case fieldtype.FieldOptionalUint64:
+				result[i] = n.OptionalUint64
+			case fieldtype.FieldState:
+				result[i] = n.State
+			case fieldtype.FieldOptionalFloat:
+				result[i] = n.OptionalFloat
+			case fieldtype.FieldOptionalFloat32:
+				result[i] = n.OptionalFloat32
+			case fieldtype.FieldText:
+				result[i] = n.Text
+			case fieldtype.FieldDatetime:
+				result[i] = n.Datetime
+			case fieldtype.FieldDecimal:
+				result[i] = n.Decimal
+			case fieldtype.FieldLinkOther:
+				result[i] = n.LinkOther
+			case fieldtype.FieldLinkOtherFunc:
+				result[i] = n.LinkOtherFunc
+			case fieldtype.FieldMAC:
+				result[i] = n.MAC
+			case fieldtype.FieldStringArray:
+				result[i] = n.StringArray
+			case fieldtype.FieldPassword:
+				result[i] = n.Password
+			case fieldtype.FieldStringScanner:
+				result[i] = n.StringScanner
+			case fieldtype.FieldDuration:
+				result[i] = n.Duration
+			case fieldtype.FieldDir:
+				result[i] = n.Dir
+			case fieldtype.FieldNdir:
+				result[i] = n.Ndir
+			case fieldtype.FieldStr:
+				result[i] = n.Str
+			case fieldtype.FieldNullStr:
+				result[i] = n.NullStr
+			case fieldtype.FieldLink:
+				result[i] = n.Link
+			case fieldtype.FieldNullLink:
+				result[i] = n.NullLink
+			case fieldtype.FieldActive:
+				result[i] = n.Active
+			case fieldtype.FieldNullActive:
+				result[i] = n.NullActive
+			case fieldtype.FieldDeleted:
+				result[i] = n.Deleted
+			case fieldtype.FieldDeletedAt:
+				result[i] = n.DeletedAt
+			case fieldtype.FieldRawData:
+				result[i] = n.RawData
+			case fieldtype.FieldSensitive:
+				result[i] = n.Sensitive
+			case fieldtype.FieldIP:
+				result[i] = n.IP
+			case fieldtype.FieldNullInt64:
+				result[i] = n.NullInt64
+			case fieldtype.FieldSchemaInt:
+				result[i] = n.SchemaInt
+			case fieldtype.FieldSchemaInt8:
+				result[i] = n.SchemaInt8
+			case fieldtype.FieldSchemaInt64:
+				result[i] = n.SchemaInt64
+			case fieldtype.FieldSchemaFloat:
+				result[i] = n.SchemaFloat
+			case fieldtype.FieldSchemaFloat32:
+				result[i] = n.SchemaFloat32
+			case fieldtype.FieldNullFloat:
+				result[i] = n.NullFloat
+			case fieldtype.FieldRole:
+				result[i] = n.Role
+			case fieldtype.FieldPriority:
+				result[i] = n.Priority
+			case fieldtype.FieldOptionalUUID:
+				result[i] = n.OptionalUUID
+			case fieldtype.FieldNillableUUID:
+				result[i] = n.NillableUUID
+			case fieldtype.FieldStrings:
+				result[i] = n.Strings
+			case fieldtype.FieldPair:
+				result[i] = n.Pair
+			case fieldtype.FieldNilPair:
+				result[i] = n.NilPair
+			case fieldtype.FieldVstring:
+				result[i] = n.Vstring
+			case fieldtype.FieldTriple:
+				result[i] = n.Triple
+			case fieldtype.FieldBigInt:
+				result[i] = n.BigInt
+			case fieldtype.FieldPasswordOther:
+				result[i] = n.PasswordOther
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// Iter executes the query and returns an iterator that streams its Files from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.File.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *FileQuery) Iter(ctx context.Context) iter.Seq2[*File, error] {
+	return func(yield func(*File, error) bool) {
+		if _q.withOwner != nil || _q.withType != nil || _q.withField != nil || len(_q.withNamedField) > 0 || _q.withFieldCount != nil || len(_q.withFieldAggregate) > 0 {
+			yield(nil, errors.New("ent: eager-loading is not supported by FileQuery.Iter, use EachBatch instead"))
+			return
+		}
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &File{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of Files.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.File.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.File) error {
+//			// ...
+//		})
+func (_q *FileQuery) EachBatch(ctx context.Context, size int, fn func([]*File) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.withNamedField = _q.withNamedField
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(file.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.File(sql.FieldGT(file.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -744,6 +1123,64 @@ func (_q *FileQuery) WithNamedField(name string, opts ...func(*FieldTypeQuery))
 	return _q
 }
 
+// FilePage is a page of Files returned by FileQuery.Paginate.
+type FilePage struct {
+	// Nodes holds the Files of the page.
+	Nodes []*File
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more Files after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" Files that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.File.Query().
+//		Order(file.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.File.Query().
+//		Order(file.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *FileQuery) Paginate(ctx context.Context, after *Cursor, first int) (*FilePage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("file: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		file.FieldID,
+		file.FieldSize,
+		file.FieldName,
+	)
+	nodes, err := _q.Clone().
+		Order(file.ByID()).
+		Where(predicate.File(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &FilePage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	selector
@@ -825,6 +1262,9 @@ func (fs *FileSelect) sqlScan(ctx context.Context, root *FileQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
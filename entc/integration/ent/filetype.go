@@ -39,7 +39,14 @@ type FileTypeEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [1]bool
-	namedFiles  map[string][]*File
+	// FilesCount holds the number of nodes that are connected to the "files" edge.
+	// The value is being populated by the FileTypeQuery when WithFilesCount is set.
+	FilesCount int `json:"files_count,omitempty"`
+	// FilesAggregate holds the results of the aggregation functions that were passed to
+	// WithFilesAggregate, in the same order, for the nodes of the "files" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	FilesAggregate []any `json:"files_aggregate,omitempty"`
+	namedFiles     map[string][]*File
 }
 
 // FilesOrErr returns the Files value or an error if the edge
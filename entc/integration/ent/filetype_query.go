@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -25,13 +27,17 @@ import (
 // FileTypeQuery is the builder for querying FileType entities.
 type FileTypeQuery struct {
 	config
-	ctx            *QueryContext
-	order          []filetype.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.FileType
-	withFiles      *FileQuery
-	modifiers      []func(*sql.Selector)
-	withNamedFiles map[string]*FileQuery
+	ctx        *QueryContext
+	order      []filetype.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FileType
+	withFiles  *FileQuery
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch              func(context.Context, *loader) ([]*FileType, error)
+	withFilesCount     *FileQuery
+	withFilesAggregate []AggregateFunc
+	modifiers          []func(*sql.Selector)
+	withNamedFiles     map[string]*FileQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -197,6 +203,9 @@ func (_q *FileTypeQuery) All(ctx context.Context) ([]*FileType, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*FileType, *FileTypeQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*FileType, *FileTypeQuery](l, qr)
+	}
 	return withInterceptors[[]*FileType](ctx, _q, qr, _q.inters)
 }
 
@@ -284,9 +293,11 @@ func (_q *FileTypeQuery) Clone() *FileTypeQuery {
 		predicates: append([]predicate.FileType{}, _q.predicates...),
 		withFiles:  _q.withFiles.Clone(),
 		// clone intermediate query.
-		sql:       _q.sql.Clone(),
-		path:      _q.path,
-		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+		sql:                _q.sql.Clone(),
+		path:               _q.path,
+		modifiers:          append([]func(*sql.Selector){}, _q.modifiers...),
+		withFilesCount:     _q.withFilesCount.Clone(),
+		withFilesAggregate: append([]AggregateFunc{}, _q.withFilesAggregate...),
 	}
 }
 
@@ -395,6 +406,9 @@ func (_q *FileTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fil
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -416,6 +430,19 @@ func (_q *FileTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fil
 			return nil, err
 		}
 	}
+	if query := _q.withFilesCount; query != nil {
+		if err := _q.aggregateFiles(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *FileType, v []any) { n.Edges.FilesCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFilesAggregate; len(fns) > 0 {
+		query := (&FileClient{config: _q.config}).Query()
+		if err := _q.aggregateFiles(ctx, query, nodes, fns,
+			func(n *FileType, v []any) { n.Edges.FilesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedFiles {
 		if err := _q.loadFiles(ctx, query, nodes,
 			func(n *FileType) { n.appendNamedFiles(name) },
@@ -468,6 +495,9 @@ func (_q *FileTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -533,6 +563,7 @@ func (_q *FileTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -550,6 +581,240 @@ func (_q *FileTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *FileTypeQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.withFiles == nil && _q.withNamedFiles == nil && _q.modifiers == nil
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *FileTypeQuery) batchAll(ctx context.Context, l *loader) ([]*FileType, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*FileType, 0, len(nodes)), nodes...), nil
+}
+
+// WithFilesCount tells the query-builder to load the number of nodes that are connected to the
+// "files" edge into the FilesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *FileTypeQuery) WithFilesCount(opts ...func(*FileQuery)) *FileTypeQuery {
+	query := (&FileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFilesCount = query
+	return _q
+}
+
+// WithFilesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "files" edge, and to load their results into the FilesAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.FileType.Query().
+//		WithFilesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *FileTypeQuery) WithFilesAggregate(fns ...AggregateFunc) *FileTypeQuery {
+	_q.withFilesAggregate = append(_q.withFilesAggregate, fns...)
+	return _q
+}
+
+func (_q *FileTypeQuery) aggregateFiles(ctx context.Context, query *FileQuery, nodes []*FileType, fns []AggregateFunc, assign func(*FileType, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*FileType, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(filetype.Table, filetype.FieldID),
+		sqlgraph.To(file.Table, file.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, filetype.FilesTable, filetype.FilesColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range file.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*File).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "files" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &File{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case file.FieldID:
+				result[i] = n.ID
+			case file.FieldSetID:
+				result[i] = n.SetID
+			case file.FieldSize:
+				result[i] = n.Size
+			case file.FieldName:
+				result[i] = n.Name
+			case file.FieldUser:
+				result[i] = n.User
+			case file.FieldGroup:
+				result[i] = n.Group
+			case file.FieldOp:
+				result[i] = n.Op
+			case file.FieldFieldID:
+				result[i] = n.FieldID
+			case file.FieldCreateTime:
+				result[i] = n.CreateTime
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// Iter executes the query and returns an iterator that streams its FileTypes from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.FileType.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *FileTypeQuery) Iter(ctx context.Context) iter.Seq2[*FileType, error] {
+	return func(yield func(*FileType, error) bool) {
+		if _q.withFiles != nil || len(_q.withNamedFiles) > 0 || _q.withFilesCount != nil || len(_q.withFilesAggregate) > 0 {
+			yield(nil, errors.New("ent: eager-loading is not supported by FileTypeQuery.Iter, use EachBatch instead"))
+			return
+		}
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &FileType{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of FileTypes.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.FileType.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.FileType) error {
+//			// ...
+//		})
+func (_q *FileTypeQuery) EachBatch(ctx context.Context, size int, fn func([]*FileType) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.withNamedFiles = _q.withNamedFiles
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(filetype.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.FileType(sql.FieldGT(filetype.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -596,6 +861,65 @@ func (_q *FileTypeQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *
 	return _q
 }
 
+// FileTypePage is a page of FileTypes returned by FileTypeQuery.Paginate.
+type FileTypePage struct {
+	// Nodes holds the FileTypes of the page.
+	Nodes []*FileType
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more FileTypes after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" FileTypes that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.FileType.Query().
+//		Order(filetype.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.FileType.Query().
+//		Order(filetype.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *FileTypeQuery) Paginate(ctx context.Context, after *Cursor, first int) (*FileTypePage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("filetype: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		filetype.FieldID,
+		filetype.FieldName,
+		filetype.FieldType,
+		filetype.FieldState,
+	)
+	nodes, err := _q.Clone().
+		Order(filetype.ByID()).
+		Where(predicate.FileType(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &FileTypePage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // FileTypeGroupBy is the group-by builder for FileType entities.
 type FileTypeGroupBy struct {
 	selector
@@ -677,6 +1001,9 @@ func (fts *FileTypeSelect) sqlScan(ctx context.Context, root *FileTypeQuery, v a
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := fts.driver.Query(ctx, query, args, rows); err != nil {
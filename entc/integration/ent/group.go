@@ -51,10 +51,31 @@ type GroupEdges struct {
 	Info *GroupInfo `json:"info,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes  [4]bool
-	namedFiles   map[string][]*File
-	namedBlocked map[string][]*User
-	namedUsers   map[string][]*User
+	loadedTypes [4]bool
+	// FilesCount holds the number of nodes that are connected to the "files" edge.
+	// The value is being populated by the GroupQuery when WithFilesCount is set.
+	FilesCount int `json:"files_count,omitempty"`
+	// FilesAggregate holds the results of the aggregation functions that were passed to
+	// WithFilesAggregate, in the same order, for the nodes of the "files" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	FilesAggregate []any `json:"files_aggregate,omitempty"`
+	// BlockedCount holds the number of nodes that are connected to the "blocked" edge.
+	// The value is being populated by the GroupQuery when WithBlockedCount is set.
+	BlockedCount int `json:"blocked_count,omitempty"`
+	// BlockedAggregate holds the results of the aggregation functions that were passed to
+	// WithBlockedAggregate, in the same order, for the nodes of the "blocked" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	BlockedAggregate []any `json:"blocked_aggregate,omitempty"`
+	// UsersCount holds the number of nodes that are connected to the "users" edge.
+	// The value is being populated by the GroupQuery when WithUsersCount is set.
+	UsersCount int `json:"users_count,omitempty"`
+	// UsersAggregate holds the results of the aggregation functions that were passed to
+	// WithUsersAggregate, in the same order, for the nodes of the "users" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	UsersAggregate []any `json:"users_aggregate,omitempty"`
+	namedFiles     map[string][]*File
+	namedBlocked   map[string][]*User
+	namedUsers     map[string][]*User
 }
 
 // FilesOrErr returns the Files value or an error if the edge
@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -27,19 +29,27 @@ import (
 // GroupQuery is the builder for querying Group entities.
 type GroupQuery struct {
 	config
-	ctx              *QueryContext
-	order            []group.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Group
-	withFiles        *FileQuery
-	withBlocked      *UserQuery
-	withUsers        *UserQuery
-	withInfo         *GroupInfoQuery
-	withFKs          bool
-	modifiers        []func(*sql.Selector)
-	withNamedFiles   map[string]*FileQuery
-	withNamedBlocked map[string]*UserQuery
-	withNamedUsers   map[string]*UserQuery
+	ctx         *QueryContext
+	order       []group.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.Group
+	withFiles   *FileQuery
+	withBlocked *UserQuery
+	withUsers   *UserQuery
+	withInfo    *GroupInfoQuery
+	withFKs     bool
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch                func(context.Context, *loader) ([]*Group, error)
+	withFilesCount       *FileQuery
+	withFilesAggregate   []AggregateFunc
+	withBlockedCount     *UserQuery
+	withBlockedAggregate []AggregateFunc
+	withUsersCount       *UserQuery
+	withUsersAggregate   []AggregateFunc
+	modifiers            []func(*sql.Selector)
+	withNamedFiles       map[string]*FileQuery
+	withNamedBlocked     map[string]*UserQuery
+	withNamedUsers       map[string]*UserQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -271,6 +281,9 @@ func (_q *GroupQuery) All(ctx context.Context) ([]*Group, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*Group, *GroupQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*Group, *GroupQuery](l, qr)
+	}
 	return withInterceptors[[]*Group](ctx, _q, qr, _q.inters)
 }
 
@@ -361,9 +374,15 @@ func (_q *GroupQuery) Clone() *GroupQuery {
 		withUsers:   _q.withUsers.Clone(),
 		withInfo:    _q.withInfo.Clone(),
 		// clone intermediate query.
-		sql:       _q.sql.Clone(),
-		path:      _q.path,
-		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+		sql:                  _q.sql.Clone(),
+		path:                 _q.path,
+		modifiers:            append([]func(*sql.Selector){}, _q.modifiers...),
+		withFilesCount:       _q.withFilesCount.Clone(),
+		withFilesAggregate:   append([]AggregateFunc{}, _q.withFilesAggregate...),
+		withBlockedCount:     _q.withBlockedCount.Clone(),
+		withBlockedAggregate: append([]AggregateFunc{}, _q.withBlockedAggregate...),
+		withUsersCount:       _q.withUsersCount.Clone(),
+		withUsersAggregate:   append([]AggregateFunc{}, _q.withUsersAggregate...),
 	}
 }
 
@@ -515,6 +534,9 @@ func (_q *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -551,6 +573,45 @@ func (_q *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 			return nil, err
 		}
 	}
+	if query := _q.withFilesCount; query != nil {
+		if err := _q.aggregateFiles(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Group, v []any) { n.Edges.FilesCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFilesAggregate; len(fns) > 0 {
+		query := (&FileClient{config: _q.config}).Query()
+		if err := _q.aggregateFiles(ctx, query, nodes, fns,
+			func(n *Group, v []any) { n.Edges.FilesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBlockedCount; query != nil {
+		if err := _q.aggregateBlocked(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Group, v []any) { n.Edges.BlockedCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withBlockedAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateBlocked(ctx, query, nodes, fns,
+			func(n *Group, v []any) { n.Edges.BlockedAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUsersCount; query != nil {
+		if err := _q.aggregateUsers(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Group, v []any) { n.Edges.UsersCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withUsersAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateUsers(ctx, query, nodes, fns,
+			func(n *Group, v []any) { n.Edges.UsersAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedFiles {
 		if err := _q.loadFiles(ctx, query, nodes,
 			func(n *Group) { n.appendNamedFiles(name) },
@@ -736,6 +797,9 @@ func (_q *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -801,6 +865,7 @@ func (_q *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -818,6 +883,524 @@ func (_q *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *GroupQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.withFiles == nil && _q.withBlocked == nil && _q.withUsers == nil && _q.withInfo == nil && _q.withNamedFiles == nil && _q.withNamedBlocked == nil && _q.withNamedUsers == nil && _q.modifiers == nil && !_q.withFKs
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *GroupQuery) batchAll(ctx context.Context, l *loader) ([]*Group, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*Group, 0, len(nodes)), nodes...), nil
+}
+
+// WithFilesCount tells the query-builder to load the number of nodes that are connected to the
+// "files" edge into the FilesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *GroupQuery) WithFilesCount(opts ...func(*FileQuery)) *GroupQuery {
+	query := (&FileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFilesCount = query
+	return _q
+}
+
+// WithFilesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "files" edge, and to load their results into the FilesAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.Group.Query().
+//		WithFilesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *GroupQuery) WithFilesAggregate(fns ...AggregateFunc) *GroupQuery {
+	_q.withFilesAggregate = append(_q.withFilesAggregate, fns...)
+	return _q
+}
+
+func (_q *GroupQuery) aggregateFiles(ctx context.Context, query *FileQuery, nodes []*Group, fns []AggregateFunc, assign func(*Group, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(group.Table, group.FieldID),
+		sqlgraph.To(file.Table, file.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, group.FilesTable, group.FilesColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range file.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*File).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "files" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &File{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case file.FieldID:
+				result[i] = n.ID
+			case file.FieldSetID:
+				result[i] = n.SetID
+			case file.FieldSize:
+				result[i] = n.Size
+			case file.FieldName:
+				result[i] = n.Name
+			case file.FieldUser:
+				result[i] = n.User
+			case file.FieldGroup:
+				result[i] = n.Group
+			case file.FieldOp:
+				result[i] = n.Op
+			case file.FieldFieldID:
+				result[i] = n.FieldID
+			case file.FieldCreateTime:
+				result[i] = n.CreateTime
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithBlockedCount tells the query-builder to load the number of nodes that are connected to the
+// "blocked" edge into the BlockedCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *GroupQuery) WithBlockedCount(opts ...func(*UserQuery)) *GroupQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBlockedCount = query
+	return _q
+}
+
+// WithBlockedAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "blocked" edge, and to load their results into the BlockedAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.Group.Query().
+//		WithBlockedAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *GroupQuery) WithBlockedAggregate(fns ...AggregateFunc) *GroupQuery {
+	_q.withBlockedAggregate = append(_q.withBlockedAggregate, fns...)
+	return _q
+}
+
+func (_q *GroupQuery) aggregateBlocked(ctx context.Context, query *UserQuery, nodes []*Group, fns []AggregateFunc, assign func(*Group, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(group.Table, group.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, group.BlockedTable, group.BlockedColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range user.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*User).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &User{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case user.FieldID:
+				result[i] = n.ID
+			case user.FieldOptionalInt:
+				result[i] = n.OptionalInt
+			case user.FieldAge:
+				result[i] = n.Age
+			case user.FieldName:
+				result[i] = n.Name
+			case user.FieldLast:
+				result[i] = n.Last
+			case user.FieldNickname:
+				result[i] = n.Nickname
+			case user.FieldAddress:
+				result[i] = n.Address
+			case user.FieldPhone:
+				result[i] = n.Phone
+			case user.FieldPassword:
+				result[i] = n.Password
+			case user.FieldRole:
+				result[i] = n.Role
+			case user.FieldEmployment:
+				result[i] = n.Employment
+			case user.FieldSSOCert:
+				result[i] = n.SSOCert
+			case user.FieldFilesCount:
+				result[i] = n.FilesCount
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithUsersCount tells the query-builder to load the number of nodes that are connected to the
+// "users" edge into the UsersCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *GroupQuery) WithUsersCount(opts ...func(*UserQuery)) *GroupQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUsersCount = query
+	return _q
+}
+
+// WithUsersAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "users" edge, and to load their results into the UsersAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.Group.Query().
+//		WithUsersAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *GroupQuery) WithUsersAggregate(fns ...AggregateFunc) *GroupQuery {
+	_q.withUsersAggregate = append(_q.withUsersAggregate, fns...)
+	return _q
+}
+
+func (_q *GroupQuery) aggregateUsers(ctx context.Context, query *UserQuery, nodes []*Group, fns []AggregateFunc, assign func(*Group, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Group, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(group.Table, group.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, group.UsersTable, group.UsersPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range user.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*User).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "users" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &User{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case user.FieldID:
+				result[i] = n.ID
+			case user.FieldOptionalInt:
+				result[i] = n.OptionalInt
+			case user.FieldAge:
+				result[i] = n.Age
+			case user.FieldName:
+				result[i] = n.Name
+			case user.FieldLast:
+				result[i] = n.Last
+			case user.FieldNickname:
+				result[i] = n.Nickname
+			case user.FieldAddress:
+				result[i] = n.Address
+			case user.FieldPhone:
+				result[i] = n.Phone
+			case user.FieldPassword:
+				result[i] = n.Password
+			case user.FieldRole:
+				result[i] = n.Role
+			case user.FieldEmployment:
+				result[i] = n.Employment
+			case user.FieldSSOCert:
+				result[i] = n.SSOCert
+			case user.FieldFilesCount:
+				result[i] = n.FilesCount
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// Iter executes the query and returns an iterator that streams its Groups from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.Group.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *GroupQuery) Iter(ctx context.Context) iter.Seq2[*Group, error] {
+	return func(yield func(*Group, error) bool) {
+		if _q.withFiles != nil || _q.withBlocked != nil || _q.withUsers != nil || _q.withInfo != nil || len(_q.withNamedFiles) > 0 || len(_q.withNamedBlocked) > 0 || len(_q.withNamedUsers) > 0 || _q.withFilesCount != nil || len(_q.withFilesAggregate) > 0 || _q.withBlockedCount != nil || len(_q.withBlockedAggregate) > 0 || _q.withUsersCount != nil || len(_q.withUsersAggregate) > 0 {
+			yield(nil, errors.New("ent: eager-loading is not supported by GroupQuery.Iter, use EachBatch instead"))
+			return
+		}
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &Group{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of Groups.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.Group.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.Group) error {
+//			// ...
+//		})
+func (_q *GroupQuery) EachBatch(ctx context.Context, size int, fn func([]*Group) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.withNamedFiles = _q.withNamedFiles
+		query.withNamedBlocked = _q.withNamedBlocked
+		query.withNamedUsers = _q.withNamedUsers
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(group.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.Group(sql.FieldGT(group.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -892,6 +1475,65 @@ func (_q *GroupQuery) WithNamedUsers(name string, opts ...func(*UserQuery)) *Gro
 	return _q
 }
 
+// GroupPage is a page of Groups returned by GroupQuery.Paginate.
+type GroupPage struct {
+	// Nodes holds the Groups of the page.
+	Nodes []*Group
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more Groups after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" Groups that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.Group.Query().
+//		Order(group.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.Group.Query().
+//		Order(group.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *GroupQuery) Paginate(ctx context.Context, after *Cursor, first int) (*GroupPage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("group: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		group.FieldID,
+		group.FieldActive,
+		group.FieldExpire,
+		group.FieldName,
+	)
+	nodes, err := _q.Clone().
+		Order(group.ByID()).
+		Where(predicate.Group(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &GroupPage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // GroupGroupBy is the group-by builder for Group entities.
 type GroupGroupBy struct {
 	selector
@@ -973,6 +1615,9 @@ func (gs *GroupSelect) sqlScan(ctx context.Context, root *GroupQuery, v any) err
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
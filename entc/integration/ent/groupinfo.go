@@ -37,7 +37,14 @@ type GroupInfoEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [1]bool
-	namedGroups map[string][]*Group
+	// GroupsCount holds the number of nodes that are connected to the "groups" edge.
+	// The value is being populated by the GroupInfoQuery when WithGroupsCount is set.
+	GroupsCount int `json:"groups_count,omitempty"`
+	// GroupsAggregate holds the results of the aggregation functions that were passed to
+	// WithGroupsAggregate, in the same order, for the nodes of the "groups" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	GroupsAggregate []any `json:"groups_aggregate,omitempty"`
+	namedGroups     map[string][]*Group
 }
 
 // GroupsOrErr returns the Groups value or an error if the edge
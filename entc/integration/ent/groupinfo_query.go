@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -25,13 +27,17 @@ import (
 // GroupInfoQuery is the builder for querying GroupInfo entities.
 type GroupInfoQuery struct {
 	config
-	ctx             *QueryContext
-	order           []groupinfo.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.GroupInfo
-	withGroups      *GroupQuery
-	modifiers       []func(*sql.Selector)
-	withNamedGroups map[string]*GroupQuery
+	ctx        *QueryContext
+	order      []groupinfo.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GroupInfo
+	withGroups *GroupQuery
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch               func(context.Context, *loader) ([]*GroupInfo, error)
+	withGroupsCount     *GroupQuery
+	withGroupsAggregate []AggregateFunc
+	modifiers           []func(*sql.Selector)
+	withNamedGroups     map[string]*GroupQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -197,6 +203,9 @@ func (_q *GroupInfoQuery) All(ctx context.Context) ([]*GroupInfo, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*GroupInfo, *GroupInfoQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*GroupInfo, *GroupInfoQuery](l, qr)
+	}
 	return withInterceptors[[]*GroupInfo](ctx, _q, qr, _q.inters)
 }
 
@@ -284,9 +293,11 @@ func (_q *GroupInfoQuery) Clone() *GroupInfoQuery {
 		predicates: append([]predicate.GroupInfo{}, _q.predicates...),
 		withGroups: _q.withGroups.Clone(),
 		// clone intermediate query.
-		sql:       _q.sql.Clone(),
-		path:      _q.path,
-		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+		sql:                 _q.sql.Clone(),
+		path:                _q.path,
+		modifiers:           append([]func(*sql.Selector){}, _q.modifiers...),
+		withGroupsCount:     _q.withGroupsCount.Clone(),
+		withGroupsAggregate: append([]AggregateFunc{}, _q.withGroupsAggregate...),
 	}
 }
 
@@ -395,6 +406,9 @@ func (_q *GroupInfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Gr
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -416,6 +430,19 @@ func (_q *GroupInfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Gr
 			return nil, err
 		}
 	}
+	if query := _q.withGroupsCount; query != nil {
+		if err := _q.aggregateGroups(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *GroupInfo, v []any) { n.Edges.GroupsCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withGroupsAggregate; len(fns) > 0 {
+		query := (&GroupClient{config: _q.config}).Query()
+		if err := _q.aggregateGroups(ctx, query, nodes, fns,
+			func(n *GroupInfo, v []any) { n.Edges.GroupsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedGroups {
 		if err := _q.loadGroups(ctx, query, nodes,
 			func(n *GroupInfo) { n.appendNamedGroups(name) },
@@ -468,6 +495,9 @@ func (_q *GroupInfoQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -533,6 +563,7 @@ func (_q *GroupInfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -550,6 +581,234 @@ func (_q *GroupInfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *GroupInfoQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.withGroups == nil && _q.withNamedGroups == nil && _q.modifiers == nil
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *GroupInfoQuery) batchAll(ctx context.Context, l *loader) ([]*GroupInfo, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*GroupInfo, 0, len(nodes)), nodes...), nil
+}
+
+// WithGroupsCount tells the query-builder to load the number of nodes that are connected to the
+// "groups" edge into the GroupsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *GroupInfoQuery) WithGroupsCount(opts ...func(*GroupQuery)) *GroupInfoQuery {
+	query := (&GroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroupsCount = query
+	return _q
+}
+
+// WithGroupsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "groups" edge, and to load their results into the GroupsAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.GroupInfo.Query().
+//		WithGroupsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *GroupInfoQuery) WithGroupsAggregate(fns ...AggregateFunc) *GroupInfoQuery {
+	_q.withGroupsAggregate = append(_q.withGroupsAggregate, fns...)
+	return _q
+}
+
+func (_q *GroupInfoQuery) aggregateGroups(ctx context.Context, query *GroupQuery, nodes []*GroupInfo, fns []AggregateFunc, assign func(*GroupInfo, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*GroupInfo, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(groupinfo.Table, groupinfo.FieldID),
+		sqlgraph.To(group.Table, group.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, groupinfo.GroupsTable, groupinfo.GroupsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range group.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*Group).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "groups" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &Group{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case group.FieldID:
+				result[i] = n.ID
+			case group.FieldActive:
+				result[i] = n.Active
+			case group.FieldExpire:
+				result[i] = n.Expire
+			case group.FieldType:
+				result[i] = n.Type
+			case group.FieldMaxUsers:
+				result[i] = n.MaxUsers
+			case group.FieldName:
+				result[i] = n.Name
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// Iter executes the query and returns an iterator that streams its GroupInfos from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.GroupInfo.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *GroupInfoQuery) Iter(ctx context.Context) iter.Seq2[*GroupInfo, error] {
+	return func(yield func(*GroupInfo, error) bool) {
+		if _q.withGroups != nil || len(_q.withNamedGroups) > 0 || _q.withGroupsCount != nil || len(_q.withGroupsAggregate) > 0 {
+			yield(nil, errors.New("ent: eager-loading is not supported by GroupInfoQuery.Iter, use EachBatch instead"))
+			return
+		}
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &GroupInfo{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of GroupInfos.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.GroupInfo.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.GroupInfo) error {
+//			// ...
+//		})
+func (_q *GroupInfoQuery) EachBatch(ctx context.Context, size int, fn func([]*GroupInfo) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.withNamedGroups = _q.withNamedGroups
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(groupinfo.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.GroupInfo(sql.FieldGT(groupinfo.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -596,6 +855,64 @@ func (_q *GroupInfoQuery) WithNamedGroups(name string, opts ...func(*GroupQuery)
 	return _q
 }
 
+// GroupInfoPage is a page of GroupInfos returned by GroupInfoQuery.Paginate.
+type GroupInfoPage struct {
+	// Nodes holds the GroupInfos of the page.
+	Nodes []*GroupInfo
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more GroupInfos after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" GroupInfos that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.GroupInfo.Query().
+//		Order(groupinfo.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.GroupInfo.Query().
+//		Order(groupinfo.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *GroupInfoQuery) Paginate(ctx context.Context, after *Cursor, first int) (*GroupInfoPage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("groupinfo: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		groupinfo.FieldID,
+		groupinfo.FieldDesc,
+		groupinfo.FieldMaxUsers,
+	)
+	nodes, err := _q.Clone().
+		Order(groupinfo.ByID()).
+		Where(predicate.GroupInfo(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &GroupInfoPage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // GroupInfoGroupBy is the group-by builder for GroupInfo entities.
 type GroupInfoGroupBy struct {
 	selector
@@ -677,6 +994,9 @@ func (gis *GroupInfoSelect) sqlScan(ctx context.Context, root *GroupInfoQuery, v
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := gis.driver.Query(ctx, query, args, rows); err != nil {
@@ -6,4 +6,4 @@
 
 package internal
 
-const IncrementStarts = "{\"apis\":12884901888,\"builders\":17179869184,\"cards\":21474836480,\"comments\":25769803776,\"ex_value_scans\":30064771072,\"field_types\":34359738368,\"file_types\":42949672960,\"files\":38654705664,\"goods\":47244640256,\"group_infos\":55834574848,\"groups\":51539607552,\"items\":60129542144,\"licenses\":64424509440,\"nodes\":68719476736,\"pcs\":73014444032,\"pet\":77309411328,\"specs\":81604378624,\"tasks\":85899345920,\"users\":8589934592}"
+const IncrementStarts = "{\"apis\":12884901888,\"builders\":17179869184,\"cards\":21474836480,\"comments\":25769803776,\"ex_value_scans\":30064771072,\"field_types\":34359738368,\"file_types\":42949672960,\"files\":38654705664,\"goods\":47244640256,\"group_infos\":55834574848,\"groups\":51539607552,\"items\":60129542144,\"licenses\":64424509440,\"nodes\":68719476736,\"notes\":90194313216,\"pcs\":73014444032,\"pet\":77309411328,\"post_histories\":98784247808,\"posts\":94489280512,\"specs\":81604378624,\"tasks\":85899345920,\"users\":8589934592}"
@@ -363,6 +363,28 @@ var (
 			},
 		},
 	}
+	// NotesColumns holds the columns for the "notes" table.
+	NotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant", Type: field.TypeString},
+		{Name: "text", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "note_children", Type: field.TypeInt, Nullable: true},
+	}
+	// NotesTable holds the schema information for the "notes" table.
+	NotesTable = &schema.Table{
+		Name:       "notes",
+		Columns:    NotesColumns,
+		PrimaryKey: []*schema.Column{NotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notes_notes_children",
+				Columns:    []*schema.Column{NotesColumns[4]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// PcsColumns holds the columns for the "pcs" table.
 	PcsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -417,6 +439,28 @@ var (
 			},
 		},
 	}
+	// PostsColumns holds the columns for the "posts" table.
+	PostsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "post_replies", Type: field.TypeInt, Nullable: true},
+	}
+	// PostsTable holds the schema information for the "posts" table.
+	PostsTable = &schema.Table{
+		Name:       "posts",
+		Columns:    PostsColumns,
+		PrimaryKey: []*schema.Column{PostsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "posts_posts_replies",
+				Columns:    []*schema.Column{PostsColumns[4]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// SpecsColumns holds the columns for the "specs" table.
 	SpecsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -438,6 +482,7 @@ var (
 		{Name: "order", Type: field.TypeInt, Nullable: true},
 		{Name: "order_option", Type: field.TypeInt, Nullable: true},
 		{Name: "op", Type: field.TypeString, Size: 45, Default: ""},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
@@ -497,6 +542,30 @@ var (
 			},
 		},
 	}
+	// PostHistoriesColumns holds the columns for the "post_histories" table.
+	PostHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "history_time", Type: field.TypeTime},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "changed_fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "old_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "ref", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+	}
+	// PostHistoriesTable holds the schema information for the "post_histories" table.
+	PostHistoriesTable = &schema.Table{
+		Name:       "post_histories",
+		Columns:    PostHistoriesColumns,
+		PrimaryKey: []*schema.Column{PostHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "posthistory_ref_history_time",
+				Unique:  false,
+				Columns: []*schema.Column{PostHistoriesColumns[6], PostHistoriesColumns[1]},
+			},
+		},
+	}
 	// SpecCardColumns holds the columns for the "spec_card" table.
 	SpecCardColumns = []*schema.Column{
 		{Name: "spec_id", Type: field.TypeInt},
@@ -613,11 +682,14 @@ var (
 		ItemsTable,
 		LicensesTable,
 		NodesTable,
+		NotesTable,
 		PcsTable,
 		PetTable,
+		PostsTable,
 		SpecsTable,
 		TasksTable,
 		UsersTable,
+		PostHistoriesTable,
 		SpecCardTable,
 		UserGroupsTable,
 		UserFriendsTable,
@@ -675,6 +747,10 @@ func init() {
 	NodesTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(68719476736),
 	}
+	NotesTable.ForeignKeys[0].RefTable = NotesTable
+	NotesTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(90194313216),
+	}
 	PcsTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(73014444032),
 	}
@@ -684,6 +760,10 @@ func init() {
 		Table:          "pet",
 		IncrementStart: func(i int) *int { return &i }(77309411328),
 	}
+	PostsTable.ForeignKeys[0].RefTable = PostsTable
+	PostsTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(94489280512),
+	}
 	SpecsTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(81604378624),
 	}
@@ -696,6 +776,9 @@ func init() {
 	UsersTable.Annotation = &entsql.Annotation{
 		IncrementStart: func(i int) *int { return &i }(8589934592),
 	}
+	PostHistoriesTable.Annotation = &entsql.Annotation{
+		IncrementStart: func(i int) *int { return &i }(98784247808),
+	}
 	SpecCardTable.ForeignKeys[0].RefTable = SpecsTable
 	SpecCardTable.ForeignKeys[1].RefTable = CardsTable
 	UserGroupsTable.ForeignKeys[0].RefTable = UsersTable
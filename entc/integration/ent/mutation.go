@@ -30,7 +30,10 @@ import (
 	"entgo.io/ent/entc/integration/ent/item"
 	"entgo.io/ent/entc/integration/ent/license"
 	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/note"
 	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/post"
+	"entgo.io/ent/entc/integration/ent/posthistory"
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/role"
 	"entgo.io/ent/entc/integration/ent/schema"
@@ -65,11 +68,14 @@ const (
 	TypeItem        = "Item"
 	TypeLicense     = "License"
 	TypeNode        = "Node"
+	TypeNote        = "Note"
 	TypePC          = "PC"
 	TypePet         = "Pet"
+	TypePost        = "Post"
 	TypeSpec        = "Spec"
 	TypeTask        = "Task"
 	TypeUser        = "User"
+	TypePostHistory = "PostHistory"
 )
 
 // APIMutation represents an operation that mutates the Api nodes in the graph.
@@ -13491,29 +13497,37 @@ func (m *NodeMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Node edge %s", name)
 }
 
-// PCMutation represents an operation that mutates the PC nodes in the graph.
-type PCMutation struct {
+// NoteMutation represents an operation that mutates the Note nodes in the graph.
+type NoteMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*PC, error)
-	predicates    []predicate.PC
+	op              Op
+	typ             string
+	id              *int
+	tenant          *string
+	text            *string
+	slug            *string
+	clearedFields   map[string]struct{}
+	parent          *int
+	clearedparent   bool
+	children        map[int]struct{}
+	removedchildren map[int]struct{}
+	clearedchildren bool
+	done            bool
+	oldValue        func(context.Context) (*Note, error)
+	predicates      []predicate.Note
 }
 
-var _ ent.Mutation = (*PCMutation)(nil)
+var _ ent.Mutation = (*NoteMutation)(nil)
 
-// pcOption allows management of the mutation configuration using functional options.
-type pcOption func(*PCMutation)
+// noteOption allows management of the mutation configuration using functional options.
+type noteOption func(*NoteMutation)
 
-// newPCMutation creates new mutation for the PC entity.
-func newPCMutation(c config, op Op, opts ...pcOption) *PCMutation {
-	m := &PCMutation{
+// newNoteMutation creates new mutation for the Note entity.
+func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
+	m := &NoteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePC,
+		typ:           TypeNote,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -13522,20 +13536,20 @@ func newPCMutation(c config, op Op, opts ...pcOption) *PCMutation {
 	return m
 }
 
-// withPCID sets the ID field of the mutation.
-func withPCID(id int) pcOption {
-	return func(m *PCMutation) {
+// withNoteID sets the ID field of the mutation.
+func withNoteID(id int) noteOption {
+	return func(m *NoteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PC
+			value *Note
 		)
-		m.oldValue = func(ctx context.Context) (*PC, error) {
+		m.oldValue = func(ctx context.Context) (*Note, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PC.Get(ctx, id)
+					value, err = m.Client().Note.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -13544,10 +13558,10 @@ func withPCID(id int) pcOption {
 	}
 }
 
-// withPC sets the old PC of the mutation.
-func withPC(node *PC) pcOption {
-	return func(m *PCMutation) {
-		m.oldValue = func(context.Context) (*PC, error) {
+// withNote sets the old Note of the mutation.
+func withNote(node *Note) noteOption {
+	return func(m *NoteMutation) {
+		m.oldValue = func(context.Context) (*Note, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -13556,7 +13570,7 @@ func withPC(node *PC) pcOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PCMutation) Client() *Client {
+func (m NoteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -13564,7 +13578,7 @@ func (m PCMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PCMutation) Tx() (*Tx, error) {
+func (m NoteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -13575,7 +13589,7 @@ func (m PCMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PCMutation) ID() (id int, exists bool) {
+func (m *NoteMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -13586,7 +13600,7 @@ func (m *PCMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PCMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *NoteMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -13595,21 +13609,235 @@ func (m *PCMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PC.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Note.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the PCMutation builder.
-func (m *PCMutation) Where(ps ...predicate.PC) {
+// SetTenant sets the "tenant" field.
+func (m *NoteMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *NoteMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *NoteMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetText sets the "text" field.
+func (m *NoteMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *NoteMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *NoteMutation) ResetText() {
+	m.text = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *NoteMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *NoteMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (m *NoteMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[note.FieldSlug] = struct{}{}
+}
+
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *NoteMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[note.FieldSlug]
+	return ok
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *NoteMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, note.FieldSlug)
+}
+
+// SetParentID sets the "parent" edge to the Note entity by id.
+func (m *NoteMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Note entity.
+func (m *NoteMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Note entity was cleared.
+func (m *NoteMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *NoteMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *NoteMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *NoteMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Note entity by ids.
+func (m *NoteMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Note entity.
+func (m *NoteMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Note entity was cleared.
+func (m *NoteMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Note entity by IDs.
+func (m *NoteMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Note entity.
+func (m *NoteMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *NoteMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *NoteMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the NoteMutation builder.
+func (m *NoteMutation) Where(ps ...predicate.Note) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PCMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NoteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PCMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PC, len(ps))
+func (m *NoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Note, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13617,178 +13845,289 @@ func (m *PCMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PCMutation) Op() Op {
+func (m *NoteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PCMutation) SetOp(op Op) {
+func (m *NoteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PC).
-func (m *PCMutation) Type() string {
+// Type returns the node type of this mutation (Note).
+func (m *NoteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PCMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *NoteMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, note.FieldTenant)
+	}
+	if m.text != nil {
+		fields = append(fields, note.FieldText)
+	}
+	if m.slug != nil {
+		fields = append(fields, note.FieldSlug)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PCMutation) Field(name string) (ent.Value, bool) {
+func (m *NoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case note.FieldTenant:
+		return m.Tenant()
+	case note.FieldText:
+		return m.Text()
+	case note.FieldSlug:
+		return m.Slug()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PCMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown PC field %s", name)
+func (m *NoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case note.FieldTenant:
+		return m.OldTenant(ctx)
+	case note.FieldText:
+		return m.OldText(ctx)
+	case note.FieldSlug:
+		return m.OldSlug(ctx)
+	}
+	return nil, fmt.Errorf("unknown Note field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PCMutation) SetField(name string, value ent.Value) error {
+func (m *NoteMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case note.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case note.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case note.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PC field %s", name)
+	return fmt.Errorf("unknown Note field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PCMutation) AddedFields() []string {
+func (m *NoteMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PCMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NoteMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PCMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown PC numeric field %s", name)
+func (m *NoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Note numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PCMutation) ClearedFields() []string {
-	return nil
+func (m *NoteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(note.FieldSlug) {
+		fields = append(fields, note.FieldSlug)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PCMutation) FieldCleared(name string) bool {
+func (m *NoteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PCMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PC nullable field %s", name)
+func (m *NoteMutation) ClearField(name string) error {
+	switch name {
+	case note.FieldSlug:
+		m.ClearSlug()
+		return nil
+	}
+	return fmt.Errorf("unknown Note nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PCMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown PC field %s", name)
+func (m *NoteMutation) ResetField(name string) error {
+	switch name {
+	case note.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case note.FieldText:
+		m.ResetText()
+		return nil
+	case note.FieldSlug:
+		m.ResetSlug()
+		return nil
+	}
+	return fmt.Errorf("unknown Note field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PCMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
+func (m *NoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.parent != nil {
+		edges = append(edges, note.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, note.EdgeChildren)
+	}
+	return edges
+}
+
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PCMutation) AddedIDs(name string) []ent.Value {
+func (m *NoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case note.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case note.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PCMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *NoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchildren != nil {
+		edges = append(edges, note.EdgeChildren)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PCMutation) RemovedIDs(name string) []ent.Value {
+func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case note.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PCMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *NoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedparent {
+		edges = append(edges, note.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, note.EdgeChildren)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PCMutation) EdgeCleared(name string) bool {
+func (m *NoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case note.EdgeParent:
+		return m.clearedparent
+	case note.EdgeChildren:
+		return m.clearedchildren
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PCMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PC unique edge %s", name)
+func (m *NoteMutation) ClearEdge(name string) error {
+	switch name {
+	case note.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Note unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PCMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PC edge %s", name)
+func (m *NoteMutation) ResetEdge(name string) error {
+	switch name {
+	case note.EdgeParent:
+		m.ResetParent()
+		return nil
+	case note.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Note edge %s", name)
 }
 
-// PetMutation represents an operation that mutates the Pet nodes in the graph.
-type PetMutation struct {
+// PCMutation represents an operation that mutates the PC nodes in the graph.
+type PCMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	age           *float64
-	addage        *float64
-	name          *string
-	uuid          *uuid.UUID
-	nickname      *string
-	trained       *bool
-	optional_time *time.Time
 	clearedFields map[string]struct{}
-	team          *int
-	clearedteam   bool
-	owner         *int
-	clearedowner  bool
 	done          bool
-	oldValue      func(context.Context) (*Pet, error)
-	predicates    []predicate.Pet
+	oldValue      func(context.Context) (*PC, error)
+	predicates    []predicate.PC
 }
 
-var _ ent.Mutation = (*PetMutation)(nil)
+var _ ent.Mutation = (*PCMutation)(nil)
 
-// petOption allows management of the mutation configuration using functional options.
-type petOption func(*PetMutation)
+// pcOption allows management of the mutation configuration using functional options.
+type pcOption func(*PCMutation)
 
-// newPetMutation creates new mutation for the Pet entity.
-func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
-	m := &PetMutation{
+// newPCMutation creates new mutation for the PC entity.
+func newPCMutation(c config, op Op, opts ...pcOption) *PCMutation {
+	m := &PCMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePet,
+		typ:           TypePC,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -13797,20 +14136,20 @@ func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
 	return m
 }
 
-// withPetID sets the ID field of the mutation.
-func withPetID(id int) petOption {
-	return func(m *PetMutation) {
+// withPCID sets the ID field of the mutation.
+func withPCID(id int) pcOption {
+	return func(m *PCMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pet
+			value *PC
 		)
-		m.oldValue = func(ctx context.Context) (*Pet, error) {
+		m.oldValue = func(ctx context.Context) (*PC, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pet.Get(ctx, id)
+					value, err = m.Client().PC.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -13819,10 +14158,10 @@ func withPetID(id int) petOption {
 	}
 }
 
-// withPet sets the old Pet of the mutation.
-func withPet(node *Pet) petOption {
-	return func(m *PetMutation) {
-		m.oldValue = func(context.Context) (*Pet, error) {
+// withPC sets the old PC of the mutation.
+func withPC(node *PC) pcOption {
+	return func(m *PCMutation) {
+		m.oldValue = func(context.Context) (*PC, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -13831,7 +14170,7 @@ func withPet(node *Pet) petOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PetMutation) Client() *Client {
+func (m PCMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -13839,7 +14178,7 @@ func (m PetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PetMutation) Tx() (*Tx, error) {
+func (m PCMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -13850,7 +14189,7 @@ func (m PetMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PetMutation) ID() (id int, exists bool) {
+func (m *PCMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -13861,7 +14200,7 @@ func (m *PetMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PCMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -13870,434 +14209,709 @@ func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PC.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAge sets the "age" field.
-func (m *PetMutation) SetAge(f float64) {
-	m.age = &f
-	m.addage = nil
+// Where appends a list predicates to the PCMutation builder.
+func (m *PCMutation) Where(ps ...predicate.PC) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Age returns the value of the "age" field in the mutation.
-func (m *PetMutation) Age() (r float64, exists bool) {
-	v := m.age
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the PCMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PCMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PC, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldAge returns the old "age" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldAge(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAge is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAge requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAge: %w", err)
-	}
-	return oldValue.Age, nil
+// Op returns the operation name.
+func (m *PCMutation) Op() Op {
+	return m.op
 }
 
-// AddAge adds f to the "age" field.
-func (m *PetMutation) AddAge(f float64) {
-	if m.addage != nil {
-		*m.addage += f
-	} else {
-		m.addage = &f
-	}
+// SetOp allows setting the mutation operation.
+func (m *PCMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// AddedAge returns the value that was added to the "age" field in this mutation.
-func (m *PetMutation) AddedAge() (r float64, exists bool) {
-	v := m.addage
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (PC).
+func (m *PCMutation) Type() string {
+	return m.typ
 }
 
-// ResetAge resets all changes to the "age" field.
-func (m *PetMutation) ResetAge() {
-	m.age = nil
-	m.addage = nil
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PCMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
 }
 
-// SetName sets the "name" field.
-func (m *PetMutation) SetName(s string) {
-	m.name = &s
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PCMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PetMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PCMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown PC field %s", name)
 }
 
-// OldName returns the old "name" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PCMutation) SetField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.Name, nil
+	return fmt.Errorf("unknown PC field %s", name)
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PetMutation) ResetName() {
-	m.name = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PCMutation) AddedFields() []string {
+	return nil
 }
 
-// SetUUID sets the "uuid" field.
-func (m *PetMutation) SetUUID(u uuid.UUID) {
-	m.uuid = &u
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PCMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// UUID returns the value of the "uuid" field in the mutation.
-func (m *PetMutation) UUID() (r uuid.UUID, exists bool) {
-	v := m.uuid
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PCMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown PC numeric field %s", name)
 }
 
-// OldUUID returns the old "uuid" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUUID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
-	}
-	return oldValue.UUID, nil
-}
-
-// ClearUUID clears the value of the "uuid" field.
-func (m *PetMutation) ClearUUID() {
-	m.uuid = nil
-	m.clearedFields[pet.FieldUUID] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PCMutation) ClearedFields() []string {
+	return nil
 }
 
-// UUIDCleared returns if the "uuid" field was cleared in this mutation.
-func (m *PetMutation) UUIDCleared() bool {
-	_, ok := m.clearedFields[pet.FieldUUID]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PCMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetUUID resets all changes to the "uuid" field.
-func (m *PetMutation) ResetUUID() {
-	m.uuid = nil
-	delete(m.clearedFields, pet.FieldUUID)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PCMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PC nullable field %s", name)
 }
 
-// SetNickname sets the "nickname" field.
-func (m *PetMutation) SetNickname(s string) {
-	m.nickname = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PCMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown PC field %s", name)
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *PetMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PCMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldNickname returns the old "nickname" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldNickname(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
-	}
-	return oldValue.Nickname, nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PCMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearNickname clears the value of the "nickname" field.
-func (m *PetMutation) ClearNickname() {
-	m.nickname = nil
-	m.clearedFields[pet.FieldNickname] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PCMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// NicknameCleared returns if the "nickname" field was cleared in this mutation.
-func (m *PetMutation) NicknameCleared() bool {
-	_, ok := m.clearedFields[pet.FieldNickname]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PCMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *PetMutation) ResetNickname() {
-	m.nickname = nil
-	delete(m.clearedFields, pet.FieldNickname)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PCMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetTrained sets the "trained" field.
-func (m *PetMutation) SetTrained(b bool) {
-	m.trained = &b
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PCMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// Trained returns the value of the "trained" field in the mutation.
-func (m *PetMutation) Trained() (r bool, exists bool) {
-	v := m.trained
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PCMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PC unique edge %s", name)
 }
 
-// OldTrained returns the old "trained" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldTrained(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrained is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrained requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrained: %w", err)
-	}
-	return oldValue.Trained, nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PCMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PC edge %s", name)
 }
 
-// ResetTrained resets all changes to the "trained" field.
-func (m *PetMutation) ResetTrained() {
-	m.trained = nil
+// PetMutation represents an operation that mutates the Pet nodes in the graph.
+type PetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	age           *float64
+	addage        *float64
+	name          *string
+	uuid          *uuid.UUID
+	nickname      *string
+	trained       *bool
+	optional_time *time.Time
+	clearedFields map[string]struct{}
+	team          *int
+	clearedteam   bool
+	owner         *int
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*Pet, error)
+	predicates    []predicate.Pet
 }
 
-// SetOptionalTime sets the "optional_time" field.
-func (m *PetMutation) SetOptionalTime(t time.Time) {
-	m.optional_time = &t
-}
+var _ ent.Mutation = (*PetMutation)(nil)
 
-// OptionalTime returns the value of the "optional_time" field in the mutation.
-func (m *PetMutation) OptionalTime() (r time.Time, exists bool) {
-	v := m.optional_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// petOption allows management of the mutation configuration using functional options.
+type petOption func(*PetMutation)
 
-// OldOptionalTime returns the old "optional_time" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldOptionalTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptionalTime is only allowed on UpdateOne operations")
+// newPetMutation creates new mutation for the Pet entity.
+func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
+	m := &PetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePet,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptionalTime requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptionalTime: %w", err)
+	return m
+}
+
+// withPetID sets the ID field of the mutation.
+func withPetID(id int) petOption {
+	return func(m *PetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pet
+		)
+		m.oldValue = func(ctx context.Context) (*Pet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.OptionalTime, nil
 }
 
-// ClearOptionalTime clears the value of the "optional_time" field.
-func (m *PetMutation) ClearOptionalTime() {
-	m.optional_time = nil
-	m.clearedFields[pet.FieldOptionalTime] = struct{}{}
+// withPet sets the old Pet of the mutation.
+func withPet(node *Pet) petOption {
+	return func(m *PetMutation) {
+		m.oldValue = func(context.Context) (*Pet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// OptionalTimeCleared returns if the "optional_time" field was cleared in this mutation.
-func (m *PetMutation) OptionalTimeCleared() bool {
-	_, ok := m.clearedFields[pet.FieldOptionalTime]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetOptionalTime resets all changes to the "optional_time" field.
-func (m *PetMutation) ResetOptionalTime() {
-	m.optional_time = nil
-	delete(m.clearedFields, pet.FieldOptionalTime)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetTeamID sets the "team" edge to the User entity by id.
-func (m *PetMutation) SetTeamID(id int) {
-	m.team = &id
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PetMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ClearTeam clears the "team" edge to the User entity.
-func (m *PetMutation) ClearTeam() {
-	m.clearedteam = true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PetMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// TeamCleared reports if the "team" edge to the User entity was cleared.
-func (m *PetMutation) TeamCleared() bool {
-	return m.clearedteam
+// SetAge sets the "age" field.
+func (m *PetMutation) SetAge(f float64) {
+	m.age = &f
+	m.addage = nil
 }
 
-// TeamID returns the "team" edge ID in the mutation.
-func (m *PetMutation) TeamID() (id int, exists bool) {
-	if m.team != nil {
-		return *m.team, true
+// Age returns the value of the "age" field in the mutation.
+func (m *PetMutation) Age() (r float64, exists bool) {
+	v := m.age
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *PetMutation) TeamIDs() (ids []int) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
+// OldAge returns the old "age" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldAge(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAge is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAge: %w", err)
+	}
+	return oldValue.Age, nil
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *PetMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// AddAge adds f to the "age" field.
+func (m *PetMutation) AddAge(f float64) {
+	if m.addage != nil {
+		*m.addage += f
+	} else {
+		m.addage = &f
+	}
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *PetMutation) SetOwnerID(id int) {
-	m.owner = &id
+// AddedAge returns the value that was added to the "age" field in this mutation.
+func (m *PetMutation) AddedAge() (r float64, exists bool) {
+	v := m.addage
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *PetMutation) ClearOwner() {
-	m.clearedowner = true
+// ResetAge resets all changes to the "age" field.
+func (m *PetMutation) ResetAge() {
+	m.age = nil
+	m.addage = nil
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *PetMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SetName sets the "name" field.
+func (m *PetMutation) SetName(s string) {
+	m.name = &s
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *PetMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// Name returns the value of the "name" field in the mutation.
+func (m *PetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *PetMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldName returns the old "name" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *PetMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetName resets all changes to the "name" field.
+func (m *PetMutation) ResetName() {
+	m.name = nil
 }
 
-// Where appends a list predicates to the PetMutation builder.
-func (m *PetMutation) Where(ps ...predicate.Pet) {
-	m.predicates = append(m.predicates, ps...)
+// SetUUID sets the "uuid" field.
+func (m *PetMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
 }
 
-// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Pet, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *PetMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *PetMutation) Op() Op {
-	return m.op
+// OldUUID returns the old "uuid" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	}
+	return oldValue.UUID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PetMutation) SetOp(op Op) {
-	m.op = op
+// ClearUUID clears the value of the "uuid" field.
+func (m *PetMutation) ClearUUID() {
+	m.uuid = nil
+	m.clearedFields[pet.FieldUUID] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Pet).
-func (m *PetMutation) Type() string {
-	return m.typ
+// UUIDCleared returns if the "uuid" field was cleared in this mutation.
+func (m *PetMutation) UUIDCleared() bool {
+	_, ok := m.clearedFields[pet.FieldUUID]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PetMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.age != nil {
-		fields = append(fields, pet.FieldAge)
-	}
-	if m.name != nil {
-		fields = append(fields, pet.FieldName)
-	}
-	if m.uuid != nil {
-		fields = append(fields, pet.FieldUUID)
+// ResetUUID resets all changes to the "uuid" field.
+func (m *PetMutation) ResetUUID() {
+	m.uuid = nil
+	delete(m.clearedFields, pet.FieldUUID)
+}
+
+// SetNickname sets the "nickname" field.
+func (m *PetMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *PetMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
 	}
-	if m.nickname != nil {
-		fields = append(fields, pet.FieldNickname)
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
 	}
-	if m.trained != nil {
-		fields = append(fields, pet.FieldTrained)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
 	}
-	if m.optional_time != nil {
-		fields = append(fields, pet.FieldOptionalTime)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
 	}
-	return fields
+	return oldValue.Nickname, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PetMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case pet.FieldAge:
-		return m.Age()
-	case pet.FieldName:
-		return m.Name()
-	case pet.FieldUUID:
-		return m.UUID()
-	case pet.FieldNickname:
-		return m.Nickname()
+// ClearNickname clears the value of the "nickname" field.
+func (m *PetMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[pet.FieldNickname] = struct{}{}
+}
+
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *PetMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[pet.FieldNickname]
+	return ok
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *PetMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, pet.FieldNickname)
+}
+
+// SetTrained sets the "trained" field.
+func (m *PetMutation) SetTrained(b bool) {
+	m.trained = &b
+}
+
+// Trained returns the value of the "trained" field in the mutation.
+func (m *PetMutation) Trained() (r bool, exists bool) {
+	v := m.trained
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrained returns the old "trained" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldTrained(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrained is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrained requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrained: %w", err)
+	}
+	return oldValue.Trained, nil
+}
+
+// ResetTrained resets all changes to the "trained" field.
+func (m *PetMutation) ResetTrained() {
+	m.trained = nil
+}
+
+// SetOptionalTime sets the "optional_time" field.
+func (m *PetMutation) SetOptionalTime(t time.Time) {
+	m.optional_time = &t
+}
+
+// OptionalTime returns the value of the "optional_time" field in the mutation.
+func (m *PetMutation) OptionalTime() (r time.Time, exists bool) {
+	v := m.optional_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptionalTime returns the old "optional_time" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldOptionalTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptionalTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptionalTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptionalTime: %w", err)
+	}
+	return oldValue.OptionalTime, nil
+}
+
+// ClearOptionalTime clears the value of the "optional_time" field.
+func (m *PetMutation) ClearOptionalTime() {
+	m.optional_time = nil
+	m.clearedFields[pet.FieldOptionalTime] = struct{}{}
+}
+
+// OptionalTimeCleared returns if the "optional_time" field was cleared in this mutation.
+func (m *PetMutation) OptionalTimeCleared() bool {
+	_, ok := m.clearedFields[pet.FieldOptionalTime]
+	return ok
+}
+
+// ResetOptionalTime resets all changes to the "optional_time" field.
+func (m *PetMutation) ResetOptionalTime() {
+	m.optional_time = nil
+	delete(m.clearedFields, pet.FieldOptionalTime)
+}
+
+// SetTeamID sets the "team" edge to the User entity by id.
+func (m *PetMutation) SetTeamID(id int) {
+	m.team = &id
+}
+
+// ClearTeam clears the "team" edge to the User entity.
+func (m *PetMutation) ClearTeam() {
+	m.clearedteam = true
+}
+
+// TeamCleared reports if the "team" edge to the User entity was cleared.
+func (m *PetMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamID returns the "team" edge ID in the mutation.
+func (m *PetMutation) TeamID() (id int, exists bool) {
+	if m.team != nil {
+		return *m.team, true
+	}
+	return
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *PetMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *PetMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *PetMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *PetMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *PetMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *PetMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *PetMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *PetMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the PetMutation builder.
+func (m *PetMutation) Where(ps ...predicate.Pet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Pet).
+func (m *PetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PetMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.age != nil {
+		fields = append(fields, pet.FieldAge)
+	}
+	if m.name != nil {
+		fields = append(fields, pet.FieldName)
+	}
+	if m.uuid != nil {
+		fields = append(fields, pet.FieldUUID)
+	}
+	if m.nickname != nil {
+		fields = append(fields, pet.FieldNickname)
+	}
+	if m.trained != nil {
+		fields = append(fields, pet.FieldTrained)
+	}
+	if m.optional_time != nil {
+		fields = append(fields, pet.FieldOptionalTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldAge:
+		return m.Age()
+	case pet.FieldName:
+		return m.Name()
+	case pet.FieldUUID:
+		return m.UUID()
+	case pet.FieldNickname:
+		return m.Nickname()
 	case pet.FieldTrained:
 		return m.Trained()
 	case pet.FieldOptionalTime:
@@ -14573,32 +15187,37 @@ func (m *PetMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Pet edge %s", name)
 }
 
-// SpecMutation represents an operation that mutates the Spec nodes in the graph.
-type SpecMutation struct {
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	card          map[int]struct{}
-	removedcard   map[int]struct{}
-	clearedcard   bool
-	done          bool
-	oldValue      func(context.Context) (*Spec, error)
-	predicates    []predicate.Spec
+	op             Op
+	typ            string
+	id             *int
+	title          *string
+	secret         *string
+	deleted_at     *time.Time
+	clearedFields  map[string]struct{}
+	parent         *int
+	clearedparent  bool
+	replies        map[int]struct{}
+	removedreplies map[int]struct{}
+	clearedreplies bool
+	done           bool
+	oldValue       func(context.Context) (*Post, error)
+	predicates     []predicate.Post
 }
 
-var _ ent.Mutation = (*SpecMutation)(nil)
+var _ ent.Mutation = (*PostMutation)(nil)
 
-// specOption allows management of the mutation configuration using functional options.
-type specOption func(*SpecMutation)
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
 
-// newSpecMutation creates new mutation for the Spec entity.
-func newSpecMutation(c config, op Op, opts ...specOption) *SpecMutation {
-	m := &SpecMutation{
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSpec,
+		typ:           TypePost,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -14607,20 +15226,20 @@ func newSpecMutation(c config, op Op, opts ...specOption) *SpecMutation {
 	return m
 }
 
-// withSpecID sets the ID field of the mutation.
-func withSpecID(id int) specOption {
-	return func(m *SpecMutation) {
+// withPostID sets the ID field of the mutation.
+func withPostID(id int) postOption {
+	return func(m *PostMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Spec
+			value *Post
 		)
-		m.oldValue = func(ctx context.Context) (*Spec, error) {
+		m.oldValue = func(ctx context.Context) (*Post, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Spec.Get(ctx, id)
+					value, err = m.Client().Post.Query().WithDeleted().Where(post.ID(id)).Only(ctx)
 				}
 			})
 			return value, err
@@ -14629,10 +15248,10 @@ func withSpecID(id int) specOption {
 	}
 }
 
-// withSpec sets the old Spec of the mutation.
-func withSpec(node *Spec) specOption {
-	return func(m *SpecMutation) {
-		m.oldValue = func(context.Context) (*Spec, error) {
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -14641,7 +15260,7 @@ func withSpec(node *Spec) specOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SpecMutation) Client() *Client {
+func (m PostMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -14649,7 +15268,7 @@ func (m SpecMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SpecMutation) Tx() (*Tx, error) {
+func (m PostMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -14660,7 +15279,7 @@ func (m SpecMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SpecMutation) ID() (id int, exists bool) {
+func (m *PostMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -14671,7 +15290,7 @@ func (m *SpecMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SpecMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PostMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -14680,75 +15299,248 @@ func (m *SpecMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Spec.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// AddCardIDs adds the "card" edge to the Card entity by ids.
-func (m *SpecMutation) AddCardIDs(ids ...int) {
-	if m.card == nil {
-		m.card = make(map[int]struct{})
+// SetTitle sets the "title" field.
+func (m *PostMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PostMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PostMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *PostMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *PostMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (m *PostMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[post.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *PostMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[post.FieldSecret]
+	return ok
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *PostMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, post.FieldSecret)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[post.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, post.FieldDeletedAt)
+}
+
+// SetParentID sets the "parent" edge to the Post entity by id.
+func (m *PostMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Post entity.
+func (m *PostMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Post entity was cleared.
+func (m *PostMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *PostMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *PostMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Post entity by ids.
+func (m *PostMutation) AddReplyIDs(ids ...int) {
+	if m.replies == nil {
+		m.replies = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.card[ids[i]] = struct{}{}
+		m.replies[ids[i]] = struct{}{}
 	}
 }
 
-// ClearCard clears the "card" edge to the Card entity.
-func (m *SpecMutation) ClearCard() {
-	m.clearedcard = true
+// ClearReplies clears the "replies" edge to the Post entity.
+func (m *PostMutation) ClearReplies() {
+	m.clearedreplies = true
 }
 
-// CardCleared reports if the "card" edge to the Card entity was cleared.
-func (m *SpecMutation) CardCleared() bool {
-	return m.clearedcard
+// RepliesCleared reports if the "replies" edge to the Post entity was cleared.
+func (m *PostMutation) RepliesCleared() bool {
+	return m.clearedreplies
 }
 
-// RemoveCardIDs removes the "card" edge to the Card entity by IDs.
-func (m *SpecMutation) RemoveCardIDs(ids ...int) {
-	if m.removedcard == nil {
-		m.removedcard = make(map[int]struct{})
+// RemoveReplyIDs removes the "replies" edge to the Post entity by IDs.
+func (m *PostMutation) RemoveReplyIDs(ids ...int) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.card, ids[i])
-		m.removedcard[ids[i]] = struct{}{}
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedCard returns the removed IDs of the "card" edge to the Card entity.
-func (m *SpecMutation) RemovedCardIDs() (ids []int) {
-	for id := range m.removedcard {
+// RemovedReplies returns the removed IDs of the "replies" edge to the Post entity.
+func (m *PostMutation) RemovedRepliesIDs() (ids []int) {
+	for id := range m.removedreplies {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// CardIDs returns the "card" edge IDs in the mutation.
-func (m *SpecMutation) CardIDs() (ids []int) {
-	for id := range m.card {
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *PostMutation) RepliesIDs() (ids []int) {
+	for id := range m.replies {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCard resets all changes to the "card" edge.
-func (m *SpecMutation) ResetCard() {
-	m.card = nil
-	m.clearedcard = false
-	m.removedcard = nil
+// ResetReplies resets all changes to the "replies" edge.
+func (m *PostMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
 }
 
-// Where appends a list predicates to the SpecMutation builder.
-func (m *SpecMutation) Where(ps ...predicate.Spec) {
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SpecMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SpecMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Spec, len(ps))
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -14756,113 +15548,194 @@ func (m *SpecMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SpecMutation) Op() Op {
+func (m *PostMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SpecMutation) SetOp(op Op) {
+func (m *PostMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Spec).
-func (m *SpecMutation) Type() string {
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SpecMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.title != nil {
+		fields = append(fields, post.FieldTitle)
+	}
+	if m.secret != nil {
+		fields = append(fields, post.FieldSecret)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, post.FieldDeletedAt)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SpecMutation) Field(name string) (ent.Value, bool) {
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldTitle:
+		return m.Title()
+	case post.FieldSecret:
+		return m.Secret()
+	case post.FieldDeletedAt:
+		return m.DeletedAt()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SpecMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown Spec field %s", name)
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case post.FieldTitle:
+		return m.OldTitle(ctx)
+	case post.FieldSecret:
+		return m.OldSecret(ctx)
+	case post.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Post field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SpecMutation) SetField(name string, value ent.Value) error {
+func (m *PostMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case post.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case post.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case post.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Spec field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SpecMutation) AddedFields() []string {
+func (m *PostMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SpecMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SpecMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown Spec numeric field %s", name)
+func (m *PostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Post numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SpecMutation) ClearedFields() []string {
-	return nil
+func (m *PostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(post.FieldSecret) {
+		fields = append(fields, post.FieldSecret)
+	}
+	if m.FieldCleared(post.FieldDeletedAt) {
+		fields = append(fields, post.FieldDeletedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SpecMutation) FieldCleared(name string) bool {
+func (m *PostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SpecMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Spec nullable field %s", name)
+func (m *PostMutation) ClearField(name string) error {
+	switch name {
+	case post.FieldSecret:
+		m.ClearSecret()
+		return nil
+	case post.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Post nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SpecMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown Spec field %s", name)
+func (m *PostMutation) ResetField(name string) error {
+	switch name {
+	case post.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case post.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case post.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SpecMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.card != nil {
-		edges = append(edges, spec.EdgeCard)
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.parent != nil {
+		edges = append(edges, post.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, post.EdgeReplies)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SpecMutation) AddedIDs(name string) []ent.Value {
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case spec.EdgeCard:
-		ids := make([]ent.Value, 0, len(m.card))
-		for id := range m.card {
-			ids = append(ids, id)
+	case post.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
 		}
 		return ids
 	}
@@ -14870,21 +15743,21 @@ func (m *SpecMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SpecMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedcard != nil {
-		edges = append(edges, spec.EdgeCard)
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedreplies != nil {
+		edges = append(edges, post.EdgeReplies)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SpecMutation) RemovedIDs(name string) []ent.Value {
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case spec.EdgeCard:
-		ids := make([]ent.Value, 0, len(m.removedcard))
-		for id := range m.removedcard {
+	case post.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
 			ids = append(ids, id)
 		}
 		return ids
@@ -14893,77 +15766,80 @@ func (m *SpecMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SpecMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcard {
-		edges = append(edges, spec.EdgeCard)
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedparent {
+		edges = append(edges, post.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, post.EdgeReplies)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SpecMutation) EdgeCleared(name string) bool {
+func (m *PostMutation) EdgeCleared(name string) bool {
 	switch name {
-	case spec.EdgeCard:
-		return m.clearedcard
+	case post.EdgeParent:
+		return m.clearedparent
+	case post.EdgeReplies:
+		return m.clearedreplies
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SpecMutation) ClearEdge(name string) error {
+func (m *PostMutation) ClearEdge(name string) error {
 	switch name {
+	case post.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return fmt.Errorf("unknown Spec unique edge %s", name)
+	return fmt.Errorf("unknown Post unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SpecMutation) ResetEdge(name string) error {
+func (m *PostMutation) ResetEdge(name string) error {
 	switch name {
-	case spec.EdgeCard:
-		m.ResetCard()
+	case post.EdgeParent:
+		m.ResetParent()
+		return nil
+	case post.EdgeReplies:
+		m.ResetReplies()
 		return nil
 	}
-	return fmt.Errorf("unknown Spec edge %s", name)
+	return fmt.Errorf("unknown Post edge %s", name)
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// SpecMutation represents an operation that mutates the Spec nodes in the graph.
+type SpecMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	priority        *task.Priority
-	addpriority     *task.Priority
-	priorities      *map[string]task.Priority
-	created_at      *time.Time
-	name            *string
-	owner           *string
-	_order          *int
-	add_order       *int
-	order_option    *int
-	addorder_option *int
-	_op             *string
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Task, error)
-	predicates      []predicate.Task
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	card          map[int]struct{}
+	removedcard   map[int]struct{}
+	clearedcard   bool
+	done          bool
+	oldValue      func(context.Context) (*Spec, error)
+	predicates    []predicate.Spec
 }
 
-var _ ent.Mutation = (*TaskMutation)(nil)
+var _ ent.Mutation = (*SpecMutation)(nil)
 
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
+// specOption allows management of the mutation configuration using functional options.
+type specOption func(*SpecMutation)
 
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+// newSpecMutation creates new mutation for the Spec entity.
+func newSpecMutation(c config, op Op, opts ...specOption) *SpecMutation {
+	m := &SpecMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeSpec,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -14972,20 +15848,20 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id int) taskOption {
-	return func(m *TaskMutation) {
+// withSpecID sets the ID field of the mutation.
+func withSpecID(id int) specOption {
+	return func(m *SpecMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *Spec
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*Spec, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().Spec.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -14994,10 +15870,10 @@ func withTaskID(id int) taskOption {
 	}
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+// withSpec sets the old Spec of the mutation.
+func withSpec(node *Spec) specOption {
+	return func(m *SpecMutation) {
+		m.oldValue = func(context.Context) (*Spec, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -15006,7 +15882,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m SpecMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -15014,7 +15890,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m SpecMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -15025,7 +15901,7 @@ func (m TaskMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id int, exists bool) {
+func (m *SpecMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -15036,7 +15912,7 @@ func (m *TaskMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SpecMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -15045,2780 +15921,4014 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Spec.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPriority sets the "priority" field.
-func (m *TaskMutation) SetPriority(t task.Priority) {
-	m.priority = &t
-	m.addpriority = nil
+// AddCardIDs adds the "card" edge to the Card entity by ids.
+func (m *SpecMutation) AddCardIDs(ids ...int) {
+	if m.card == nil {
+		m.card = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.card[ids[i]] = struct{}{}
+	}
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *TaskMutation) Priority() (r task.Priority, exists bool) {
-	v := m.priority
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearCard clears the "card" edge to the Card entity.
+func (m *SpecMutation) ClearCard() {
+	m.clearedcard = true
 }
 
-// OldPriority returns the old "priority" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPriority(ctx context.Context) (v task.Priority, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
-	}
-	return oldValue.Priority, nil
+// CardCleared reports if the "card" edge to the Card entity was cleared.
+func (m *SpecMutation) CardCleared() bool {
+	return m.clearedcard
 }
 
-// AddPriority adds t to the "priority" field.
-func (m *TaskMutation) AddPriority(t task.Priority) {
-	if m.addpriority != nil {
-		*m.addpriority += t
-	} else {
-		m.addpriority = &t
+// RemoveCardIDs removes the "card" edge to the Card entity by IDs.
+func (m *SpecMutation) RemoveCardIDs(ids ...int) {
+	if m.removedcard == nil {
+		m.removedcard = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.card, ids[i])
+		m.removedcard[ids[i]] = struct{}{}
 	}
 }
 
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *TaskMutation) AddedPriority() (r task.Priority, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
+// RemovedCard returns the removed IDs of the "card" edge to the Card entity.
+func (m *SpecMutation) RemovedCardIDs() (ids []int) {
+	for id := range m.removedcard {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *TaskMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// CardIDs returns the "card" edge IDs in the mutation.
+func (m *SpecMutation) CardIDs() (ids []int) {
+	for id := range m.card {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetPriorities sets the "priorities" field.
-func (m *TaskMutation) SetPriorities(value map[string]task.Priority) {
-	m.priorities = &value
+// ResetCard resets all changes to the "card" edge.
+func (m *SpecMutation) ResetCard() {
+	m.card = nil
+	m.clearedcard = false
+	m.removedcard = nil
 }
 
-// Priorities returns the value of the "priorities" field in the mutation.
-func (m *TaskMutation) Priorities() (r map[string]task.Priority, exists bool) {
-	v := m.priorities
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the SpecMutation builder.
+func (m *SpecMutation) Where(ps ...predicate.Spec) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldPriorities returns the old "priorities" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPriorities(ctx context.Context) (v map[string]task.Priority, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriorities is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriorities requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriorities: %w", err)
+// WhereP appends storage-level predicates to the SpecMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SpecMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Spec, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Priorities, nil
+	m.Where(p...)
 }
 
-// ClearPriorities clears the value of the "priorities" field.
-func (m *TaskMutation) ClearPriorities() {
-	m.priorities = nil
-	m.clearedFields[enttask.FieldPriorities] = struct{}{}
+// Op returns the operation name.
+func (m *SpecMutation) Op() Op {
+	return m.op
 }
 
-// PrioritiesCleared returns if the "priorities" field was cleared in this mutation.
-func (m *TaskMutation) PrioritiesCleared() bool {
-	_, ok := m.clearedFields[enttask.FieldPriorities]
-	return ok
+// SetOp allows setting the mutation operation.
+func (m *SpecMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetPriorities resets all changes to the "priorities" field.
-func (m *TaskMutation) ResetPriorities() {
-	m.priorities = nil
-	delete(m.clearedFields, enttask.FieldPriorities)
+// Type returns the node type of this mutation (Spec).
+func (m *SpecMutation) Type() string {
+	return m.typ
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TaskMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SpecMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SpecMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SpecMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown Spec field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskMutation) ResetCreatedAt() {
-	m.created_at = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpecMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Spec field %s", name)
 }
 
-// SetName sets the "name" field.
-func (m *TaskMutation) SetName(s string) {
-	m.name = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SpecMutation) AddedFields() []string {
+	return nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TaskMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SpecMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldName returns the old "name" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpecMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown Spec numeric field %s", name)
 }
 
-// ClearName clears the value of the "name" field.
-func (m *TaskMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[enttask.FieldName] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SpecMutation) ClearedFields() []string {
+	return nil
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *TaskMutation) NameCleared() bool {
-	_, ok := m.clearedFields[enttask.FieldName]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SpecMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TaskMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, enttask.FieldName)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SpecMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Spec nullable field %s", name)
 }
 
-// SetOwner sets the "owner" field.
-func (m *TaskMutation) SetOwner(s string) {
-	m.owner = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SpecMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown Spec field %s", name)
 }
 
-// Owner returns the value of the "owner" field in the mutation.
-func (m *TaskMutation) Owner() (r string, exists bool) {
-	v := m.owner
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SpecMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.card != nil {
+		edges = append(edges, spec.EdgeCard)
 	}
-	return *v, true
+	return edges
 }
 
-// OldOwner returns the old "owner" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldOwner(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwner requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SpecMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case spec.EdgeCard:
+		ids := make([]ent.Value, 0, len(m.card))
+		for id := range m.card {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.Owner, nil
-}
-
-// ClearOwner clears the value of the "owner" field.
-func (m *TaskMutation) ClearOwner() {
-	m.owner = nil
-	m.clearedFields[enttask.FieldOwner] = struct{}{}
-}
-
-// OwnerCleared returns if the "owner" field was cleared in this mutation.
-func (m *TaskMutation) OwnerCleared() bool {
-	_, ok := m.clearedFields[enttask.FieldOwner]
-	return ok
-}
-
-// ResetOwner resets all changes to the "owner" field.
-func (m *TaskMutation) ResetOwner() {
-	m.owner = nil
-	delete(m.clearedFields, enttask.FieldOwner)
-}
-
-// SetOrder sets the "order" field.
-func (m *TaskMutation) SetOrder(i int) {
-	m._order = &i
-	m.add_order = nil
+	return nil
 }
 
-// Order returns the value of the "order" field in the mutation.
-func (m *TaskMutation) Order() (r int, exists bool) {
-	v := m._order
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SpecMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcard != nil {
+		edges = append(edges, spec.EdgeCard)
 	}
-	return *v, true
+	return edges
 }
 
-// OldOrder returns the old "order" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldOrder(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrder is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrder: %w", err)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SpecMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case spec.EdgeCard:
+		ids := make([]ent.Value, 0, len(m.removedcard))
+		for id := range m.removedcard {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.Order, nil
+	return nil
 }
 
-// AddOrder adds i to the "order" field.
-func (m *TaskMutation) AddOrder(i int) {
-	if m.add_order != nil {
-		*m.add_order += i
-	} else {
-		m.add_order = &i
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SpecMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcard {
+		edges = append(edges, spec.EdgeCard)
 	}
+	return edges
 }
 
-// AddedOrder returns the value that was added to the "order" field in this mutation.
-func (m *TaskMutation) AddedOrder() (r int, exists bool) {
-	v := m.add_order
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SpecMutation) EdgeCleared(name string) bool {
+	switch name {
+	case spec.EdgeCard:
+		return m.clearedcard
 	}
-	return *v, true
+	return false
 }
 
-// ClearOrder clears the value of the "order" field.
-func (m *TaskMutation) ClearOrder() {
-	m._order = nil
-	m.add_order = nil
-	m.clearedFields[enttask.FieldOrder] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SpecMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Spec unique edge %s", name)
 }
 
-// OrderCleared returns if the "order" field was cleared in this mutation.
-func (m *TaskMutation) OrderCleared() bool {
-	_, ok := m.clearedFields[enttask.FieldOrder]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SpecMutation) ResetEdge(name string) error {
+	switch name {
+	case spec.EdgeCard:
+		m.ResetCard()
+		return nil
+	}
+	return fmt.Errorf("unknown Spec edge %s", name)
 }
 
-// ResetOrder resets all changes to the "order" field.
-func (m *TaskMutation) ResetOrder() {
-	m._order = nil
-	m.add_order = nil
-	delete(m.clearedFields, enttask.FieldOrder)
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	priority        *task.Priority
+	addpriority     *task.Priority
+	priorities      *map[string]task.Priority
+	created_at      *time.Time
+	name            *string
+	owner           *string
+	_order          *int
+	add_order       *int
+	order_option    *int
+	addorder_option *int
+	_op             *string
+	version         *int
+	addversion      *int
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Task, error)
+	predicates      []predicate.Task
 }
 
-// SetOrderOption sets the "order_option" field.
-func (m *TaskMutation) SetOrderOption(i int) {
-	m.order_option = &i
-	m.addorder_option = nil
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OrderOption returns the value of the "order_option" field in the mutation.
-func (m *TaskMutation) OrderOption() (r int, exists bool) {
-	v := m.order_option
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id int) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPriority sets the "priority" field.
+func (m *TaskMutation) SetPriority(t task.Priority) {
+	m.priority = &t
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *TaskMutation) Priority() (r task.Priority, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrderOption returns the old "order_option" field's value of the Task entity.
+// OldPriority returns the old "priority" field's value of the Task entity.
 // If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldOrderOption(ctx context.Context) (v int, err error) {
+func (m *TaskMutation) OldPriority(ctx context.Context) (v task.Priority, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderOption is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderOption requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderOption: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.OrderOption, nil
+	return oldValue.Priority, nil
 }
 
-// AddOrderOption adds i to the "order_option" field.
-func (m *TaskMutation) AddOrderOption(i int) {
-	if m.addorder_option != nil {
-		*m.addorder_option += i
+// AddPriority adds t to the "priority" field.
+func (m *TaskMutation) AddPriority(t task.Priority) {
+	if m.addpriority != nil {
+		*m.addpriority += t
 	} else {
-		m.addorder_option = &i
+		m.addpriority = &t
 	}
 }
 
-// AddedOrderOption returns the value that was added to the "order_option" field in this mutation.
-func (m *TaskMutation) AddedOrderOption() (r int, exists bool) {
-	v := m.addorder_option
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *TaskMutation) AddedPriority() (r task.Priority, exists bool) {
+	v := m.addpriority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearOrderOption clears the value of the "order_option" field.
-func (m *TaskMutation) ClearOrderOption() {
-	m.order_option = nil
-	m.addorder_option = nil
-	m.clearedFields[enttask.FieldOrderOption] = struct{}{}
-}
-
-// OrderOptionCleared returns if the "order_option" field was cleared in this mutation.
-func (m *TaskMutation) OrderOptionCleared() bool {
-	_, ok := m.clearedFields[enttask.FieldOrderOption]
-	return ok
-}
-
-// ResetOrderOption resets all changes to the "order_option" field.
-func (m *TaskMutation) ResetOrderOption() {
-	m.order_option = nil
-	m.addorder_option = nil
-	delete(m.clearedFields, enttask.FieldOrderOption)
+// ResetPriority resets all changes to the "priority" field.
+func (m *TaskMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
 }
 
-// SetOpField sets the "op" field.
-func (m *TaskMutation) SetOpField(s string) {
-	m._op = &s
+// SetPriorities sets the "priorities" field.
+func (m *TaskMutation) SetPriorities(value map[string]task.Priority) {
+	m.priorities = &value
 }
 
-// GetOp returns the value of the "op" field in the mutation.
-func (m *TaskMutation) GetOp() (r string, exists bool) {
-	v := m._op
+// Priorities returns the value of the "priorities" field in the mutation.
+func (m *TaskMutation) Priorities() (r map[string]task.Priority, exists bool) {
+	v := m.priorities
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOp returns the old "op" field's value of the Task entity.
+// OldPriorities returns the old "priorities" field's value of the Task entity.
 // If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldOp(ctx context.Context) (v string, err error) {
+func (m *TaskMutation) OldPriorities(ctx context.Context) (v map[string]task.Priority, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOp is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriorities is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOp requires an ID field in the mutation")
+		return v, errors.New("OldPriorities requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOp: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriorities: %w", err)
 	}
-	return oldValue.Op, nil
+	return oldValue.Priorities, nil
 }
 
-// ResetOp resets all changes to the "op" field.
-func (m *TaskMutation) ResetOp() {
-	m._op = nil
+// ClearPriorities clears the value of the "priorities" field.
+func (m *TaskMutation) ClearPriorities() {
+	m.priorities = nil
+	m.clearedFields[enttask.FieldPriorities] = struct{}{}
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
-	m.predicates = append(m.predicates, ps...)
+// PrioritiesCleared returns if the "priorities" field was cleared in this mutation.
+func (m *TaskMutation) PrioritiesCleared() bool {
+	_, ok := m.clearedFields[enttask.FieldPriorities]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ResetPriorities resets all changes to the "priorities" field.
+func (m *TaskMutation) ResetPriorities() {
+	m.priorities = nil
+	delete(m.clearedFields, enttask.FieldPriorities)
 }
 
-// Op returns the operation name.
-func (m *TaskMutation) Op() Op {
-	return m.op
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
-	m.op = op
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.priority != nil {
-		fields = append(fields, enttask.FieldPriority)
-	}
-	if m.priorities != nil {
-		fields = append(fields, enttask.FieldPriorities)
+// OldCreatedAt returns the old "created_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, enttask.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.name != nil {
-		fields = append(fields, enttask.FieldName)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.owner != nil {
-		fields = append(fields, enttask.FieldOwner)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *TaskMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TaskMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	if m._order != nil {
-		fields = append(fields, enttask.FieldOrder)
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.order_option != nil {
-		fields = append(fields, enttask.FieldOrderOption)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m._op != nil {
-		fields = append(fields, enttask.FieldOp)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return fields
+	return oldValue.Name, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case enttask.FieldPriority:
-		return m.Priority()
-	case enttask.FieldPriorities:
-		return m.Priorities()
-	case enttask.FieldCreatedAt:
-		return m.CreatedAt()
-	case enttask.FieldName:
-		return m.Name()
-	case enttask.FieldOwner:
-		return m.Owner()
-	case enttask.FieldOrder:
-		return m.Order()
-	case enttask.FieldOrderOption:
-		return m.OrderOption()
-	case enttask.FieldOp:
-		return m.GetOp()
-	}
-	return nil, false
+// ClearName clears the value of the "name" field.
+func (m *TaskMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[enttask.FieldName] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case enttask.FieldPriority:
-		return m.OldPriority(ctx)
-	case enttask.FieldPriorities:
-		return m.OldPriorities(ctx)
-	case enttask.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case enttask.FieldName:
-		return m.OldName(ctx)
-	case enttask.FieldOwner:
-		return m.OldOwner(ctx)
-	case enttask.FieldOrder:
-		return m.OldOrder(ctx)
-	case enttask.FieldOrderOption:
-		return m.OldOrderOption(ctx)
-	case enttask.FieldOp:
-		return m.OldOp(ctx)
-	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *TaskMutation) NameCleared() bool {
+	_, ok := m.clearedFields[enttask.FieldName]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case enttask.FieldPriority:
-		v, ok := value.(task.Priority)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriority(v)
-		return nil
-	case enttask.FieldPriorities:
-		v, ok := value.(map[string]task.Priority)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriorities(v)
-		return nil
-	case enttask.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case enttask.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case enttask.FieldOwner:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwner(v)
-		return nil
-	case enttask.FieldOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrder(v)
-		return nil
-	case enttask.FieldOrderOption:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrderOption(v)
-		return nil
-	case enttask.FieldOp:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOpField(v)
-		return nil
+// ResetName resets all changes to the "name" field.
+func (m *TaskMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, enttask.FieldName)
+}
+
+// SetOwner sets the "owner" field.
+func (m *TaskMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *TaskMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TaskMutation) AddedFields() []string {
-	var fields []string
-	if m.addpriority != nil {
-		fields = append(fields, enttask.FieldPriority)
+// OldOwner returns the old "owner" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
-	if m.add_order != nil {
-		fields = append(fields, enttask.FieldOrder)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
-	if m.addorder_option != nil {
-		fields = append(fields, enttask.FieldOrderOption)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return fields
+	return oldValue.Owner, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case enttask.FieldPriority:
-		return m.AddedPriority()
-	case enttask.FieldOrder:
-		return m.AddedOrder()
-	case enttask.FieldOrderOption:
-		return m.AddedOrderOption()
-	}
-	return nil, false
+// ClearOwner clears the value of the "owner" field.
+func (m *TaskMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[enttask.FieldOwner] = struct{}{}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case enttask.FieldPriority:
-		v, ok := value.(task.Priority)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriority(v)
-		return nil
-	case enttask.FieldOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOrder(v)
-		return nil
-	case enttask.FieldOrderOption:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOrderOption(v)
-		return nil
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *TaskMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[enttask.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *TaskMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, enttask.FieldOwner)
+}
+
+// SetOrder sets the "order" field.
+func (m *TaskMutation) SetOrder(i int) {
+	m._order = &i
+	m.add_order = nil
+}
+
+// Order returns the value of the "order" field in the mutation.
+func (m *TaskMutation) Order() (r int, exists bool) {
+	v := m._order
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TaskMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(enttask.FieldPriorities) {
-		fields = append(fields, enttask.FieldPriorities)
+// OldOrder returns the old "order" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrder is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(enttask.FieldName) {
-		fields = append(fields, enttask.FieldName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrder requires an ID field in the mutation")
 	}
-	if m.FieldCleared(enttask.FieldOwner) {
-		fields = append(fields, enttask.FieldOwner)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrder: %w", err)
 	}
-	if m.FieldCleared(enttask.FieldOrder) {
-		fields = append(fields, enttask.FieldOrder)
+	return oldValue.Order, nil
+}
+
+// AddOrder adds i to the "order" field.
+func (m *TaskMutation) AddOrder(i int) {
+	if m.add_order != nil {
+		*m.add_order += i
+	} else {
+		m.add_order = &i
 	}
-	if m.FieldCleared(enttask.FieldOrderOption) {
-		fields = append(fields, enttask.FieldOrderOption)
+}
+
+// AddedOrder returns the value that was added to the "order" field in this mutation.
+func (m *TaskMutation) AddedOrder() (r int, exists bool) {
+	v := m.add_order
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearOrder clears the value of the "order" field.
+func (m *TaskMutation) ClearOrder() {
+	m._order = nil
+	m.add_order = nil
+	m.clearedFields[enttask.FieldOrder] = struct{}{}
+}
+
+// OrderCleared returns if the "order" field was cleared in this mutation.
+func (m *TaskMutation) OrderCleared() bool {
+	_, ok := m.clearedFields[enttask.FieldOrder]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
-	switch name {
-	case enttask.FieldPriorities:
-		m.ClearPriorities()
-		return nil
-	case enttask.FieldName:
-		m.ClearName()
-		return nil
-	case enttask.FieldOwner:
-		m.ClearOwner()
-		return nil
-	case enttask.FieldOrder:
-		m.ClearOrder()
-		return nil
-	case enttask.FieldOrderOption:
-		m.ClearOrderOption()
-		return nil
-	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+// ResetOrder resets all changes to the "order" field.
+func (m *TaskMutation) ResetOrder() {
+	m._order = nil
+	m.add_order = nil
+	delete(m.clearedFields, enttask.FieldOrder)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
-	switch name {
-	case enttask.FieldPriority:
-		m.ResetPriority()
-		return nil
-	case enttask.FieldPriorities:
-		m.ResetPriorities()
-		return nil
-	case enttask.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case enttask.FieldName:
-		m.ResetName()
-		return nil
-	case enttask.FieldOwner:
-		m.ResetOwner()
-		return nil
-	case enttask.FieldOrder:
-		m.ResetOrder()
-		return nil
-	case enttask.FieldOrderOption:
-		m.ResetOrderOption()
-		return nil
-	case enttask.FieldOp:
-		m.ResetOp()
-		return nil
+// SetOrderOption sets the "order_option" field.
+func (m *TaskMutation) SetOrderOption(i int) {
+	m.order_option = &i
+	m.addorder_option = nil
+}
+
+// OrderOption returns the value of the "order_option" field in the mutation.
+func (m *TaskMutation) OrderOption() (r int, exists bool) {
+	v := m.order_option
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OldOrderOption returns the old "order_option" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOrderOption(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderOption is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderOption requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderOption: %w", err)
+	}
+	return oldValue.OrderOption, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// AddOrderOption adds i to the "order_option" field.
+func (m *TaskMutation) AddOrderOption(i int) {
+	if m.addorder_option != nil {
+		*m.addorder_option += i
+	} else {
+		m.addorder_option = &i
+	}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AddedOrderOption returns the value that was added to the "order_option" field in this mutation.
+func (m *TaskMutation) AddedOrderOption() (r int, exists bool) {
+	v := m.addorder_option
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ClearOrderOption clears the value of the "order_option" field.
+func (m *TaskMutation) ClearOrderOption() {
+	m.order_option = nil
+	m.addorder_option = nil
+	m.clearedFields[enttask.FieldOrderOption] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OrderOptionCleared returns if the "order_option" field was cleared in this mutation.
+func (m *TaskMutation) OrderOptionCleared() bool {
+	_, ok := m.clearedFields[enttask.FieldOrderOption]
+	return ok
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
-	return false
+// ResetOrderOption resets all changes to the "order_option" field.
+func (m *TaskMutation) ResetOrderOption() {
+	m.order_option = nil
+	m.addorder_option = nil
+	delete(m.clearedFields, enttask.FieldOrderOption)
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Task unique edge %s", name)
+// SetOpField sets the "op" field.
+func (m *TaskMutation) SetOpField(s string) {
+	m._op = &s
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Task edge %s", name)
+// GetOp returns the value of the "op" field in the mutation.
+func (m *TaskMutation) GetOp() (r string, exists bool) {
+	v := m._op
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *int
-	optional_int     *int
-	addoptional_int  *int
-	age              *int
-	addage           *int
-	name             *string
-	last             *string
-	nickname         *string
-	address          *string
-	phone            *string
-	password         *string
-	role             *user.Role
-	employment       *user.Employment
-	_SSOCert         *string
-	files_count      *int
-	addfiles_count   *int
-	clearedFields    map[string]struct{}
-	card             *int
-	clearedcard      bool
-	pets             map[int]struct{}
-	removedpets      map[int]struct{}
-	clearedpets      bool
-	files            map[int]struct{}
-	removedfiles     map[int]struct{}
-	clearedfiles     bool
-	groups           map[int]struct{}
-	removedgroups    map[int]struct{}
-	clearedgroups    bool
-	friends          map[int]struct{}
-	removedfriends   map[int]struct{}
-	clearedfriends   bool
-	followers        map[int]struct{}
-	removedfollowers map[int]struct{}
-	clearedfollowers bool
-	following        map[int]struct{}
-	removedfollowing map[int]struct{}
-	clearedfollowing bool
-	team             *int
-	clearedteam      bool
-	spouse           *int
-	clearedspouse    bool
-	children         map[int]struct{}
-	removedchildren  map[int]struct{}
-	clearedchildren  bool
-	parent           *int
-	clearedparent    bool
-	done             bool
-	oldValue         func(context.Context) (*User, error)
-	predicates       []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldOp returns the old "op" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOp(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOp is only allowed on UpdateOne operations")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOp requires an ID field in the mutation")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOp: %w", err)
 	}
-	return *m.id, true
+	return oldValue.Op, nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetOp resets all changes to the "op" field.
+func (m *TaskMutation) ResetOp() {
+	m._op = nil
 }
 
-// SetOptionalInt sets the "optional_int" field.
-func (m *UserMutation) SetOptionalInt(i int) {
-	m.optional_int = &i
-	m.addoptional_int = nil
+// SetVersion sets the "version" field.
+func (m *TaskMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// OptionalInt returns the value of the "optional_int" field in the mutation.
-func (m *UserMutation) OptionalInt() (r int, exists bool) {
-	v := m.optional_int
+// Version returns the value of the "version" field in the mutation.
+func (m *TaskMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOptionalInt returns the old "optional_int" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldOptionalInt(ctx context.Context) (v int, err error) {
+func (m *TaskMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptionalInt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptionalInt requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptionalInt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.OptionalInt, nil
+	return oldValue.Version, nil
 }
 
-// AddOptionalInt adds i to the "optional_int" field.
-func (m *UserMutation) AddOptionalInt(i int) {
-	if m.addoptional_int != nil {
-		*m.addoptional_int += i
+// AddVersion adds i to the "version" field.
+func (m *TaskMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
 	} else {
-		m.addoptional_int = &i
+		m.addversion = &i
 	}
 }
 
-// AddedOptionalInt returns the value that was added to the "optional_int" field in this mutation.
-func (m *UserMutation) AddedOptionalInt() (r int, exists bool) {
-	v := m.addoptional_int
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TaskMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearOptionalInt clears the value of the "optional_int" field.
-func (m *UserMutation) ClearOptionalInt() {
-	m.optional_int = nil
-	m.addoptional_int = nil
-	m.clearedFields[user.FieldOptionalInt] = struct{}{}
+// ResetVersion resets all changes to the "version" field.
+func (m *TaskMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// OptionalIntCleared returns if the "optional_int" field was cleared in this mutation.
-func (m *UserMutation) OptionalIntCleared() bool {
-	_, ok := m.clearedFields[user.FieldOptionalInt]
-	return ok
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetOptionalInt resets all changes to the "optional_int" field.
-func (m *UserMutation) ResetOptionalInt() {
-	m.optional_int = nil
-	m.addoptional_int = nil
-	delete(m.clearedFields, user.FieldOptionalInt)
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetAge sets the "age" field.
-func (m *UserMutation) SetAge(i int) {
-	m.age = &i
-	m.addage = nil
+// Op returns the operation name.
+func (m *TaskMutation) Op() Op {
+	return m.op
 }
 
-// Age returns the value of the "age" field in the mutation.
-func (m *UserMutation) Age() (r int, exists bool) {
-	v := m.age
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *TaskMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldAge returns the old "age" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAge(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAge is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.priority != nil {
+		fields = append(fields, enttask.FieldPriority)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAge requires an ID field in the mutation")
+	if m.priorities != nil {
+		fields = append(fields, enttask.FieldPriorities)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAge: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, enttask.FieldCreatedAt)
 	}
-	return oldValue.Age, nil
-}
-
-// AddAge adds i to the "age" field.
-func (m *UserMutation) AddAge(i int) {
-	if m.addage != nil {
-		*m.addage += i
-	} else {
-		m.addage = &i
+	if m.name != nil {
+		fields = append(fields, enttask.FieldName)
 	}
-}
-
-// AddedAge returns the value that was added to the "age" field in this mutation.
-func (m *UserMutation) AddedAge() (r int, exists bool) {
-	v := m.addage
-	if v == nil {
-		return
+	if m.owner != nil {
+		fields = append(fields, enttask.FieldOwner)
 	}
-	return *v, true
-}
-
-// ResetAge resets all changes to the "age" field.
-func (m *UserMutation) ResetAge() {
-	m.age = nil
-	m.addage = nil
-}
-
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+	if m._order != nil {
+		fields = append(fields, enttask.FieldOrder)
 	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	if m.order_option != nil {
+		fields = append(fields, enttask.FieldOrderOption)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+	if m._op != nil {
+		fields = append(fields, enttask.FieldOp)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	if m.version != nil {
+		fields = append(fields, enttask.FieldVersion)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
-}
-
-// SetLast sets the "last" field.
-func (m *UserMutation) SetLast(s string) {
-	m.last = &s
+	return fields
 }
 
-// Last returns the value of the "last" field in the mutation.
-func (m *UserMutation) Last() (r string, exists bool) {
-	v := m.last
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case enttask.FieldPriority:
+		return m.Priority()
+	case enttask.FieldPriorities:
+		return m.Priorities()
+	case enttask.FieldCreatedAt:
+		return m.CreatedAt()
+	case enttask.FieldName:
+		return m.Name()
+	case enttask.FieldOwner:
+		return m.Owner()
+	case enttask.FieldOrder:
+		return m.Order()
+	case enttask.FieldOrderOption:
+		return m.OrderOption()
+	case enttask.FieldOp:
+		return m.GetOp()
+	case enttask.FieldVersion:
+		return m.Version()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldLast returns the old "last" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLast(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLast is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLast requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLast: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case enttask.FieldPriority:
+		return m.OldPriority(ctx)
+	case enttask.FieldPriorities:
+		return m.OldPriorities(ctx)
+	case enttask.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case enttask.FieldName:
+		return m.OldName(ctx)
+	case enttask.FieldOwner:
+		return m.OldOwner(ctx)
+	case enttask.FieldOrder:
+		return m.OldOrder(ctx)
+	case enttask.FieldOrderOption:
+		return m.OldOrderOption(ctx)
+	case enttask.FieldOp:
+		return m.OldOp(ctx)
+	case enttask.FieldVersion:
+		return m.OldVersion(ctx)
 	}
-	return oldValue.Last, nil
-}
-
-// ResetLast resets all changes to the "last" field.
-func (m *UserMutation) ResetLast() {
-	m.last = nil
-}
-
-// SetNickname sets the "nickname" field.
-func (m *UserMutation) SetNickname(s string) {
-	m.nickname = &s
+	return nil, fmt.Errorf("unknown Task field %s", name)
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *UserMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case enttask.FieldPriority:
+		v, ok := value.(task.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case enttask.FieldPriorities:
+		v, ok := value.(map[string]task.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriorities(v)
+		return nil
+	case enttask.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case enttask.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case enttask.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case enttask.FieldOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrder(v)
+		return nil
+	case enttask.FieldOrderOption:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderOption(v)
+		return nil
+	case enttask.FieldOp:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpField(v)
+		return nil
+	case enttask.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Task field %s", name)
 }
 
-// OldNickname returns the old "nickname" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, enttask.FieldPriority)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+	if m.add_order != nil {
+		fields = append(fields, enttask.FieldOrder)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	if m.addorder_option != nil {
+		fields = append(fields, enttask.FieldOrderOption)
 	}
-	return oldValue.Nickname, nil
-}
-
-// ClearNickname clears the value of the "nickname" field.
-func (m *UserMutation) ClearNickname() {
-	m.nickname = nil
-	m.clearedFields[user.FieldNickname] = struct{}{}
+	if m.addversion != nil {
+		fields = append(fields, enttask.FieldVersion)
+	}
+	return fields
 }
 
-// NicknameCleared returns if the "nickname" field was cleared in this mutation.
-func (m *UserMutation) NicknameCleared() bool {
-	_, ok := m.clearedFields[user.FieldNickname]
-	return ok
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case enttask.FieldPriority:
+		return m.AddedPriority()
+	case enttask.FieldOrder:
+		return m.AddedOrder()
+	case enttask.FieldOrderOption:
+		return m.AddedOrderOption()
+	case enttask.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *UserMutation) ResetNickname() {
-	m.nickname = nil
-	delete(m.clearedFields, user.FieldNickname)
-}
-
-// SetAddress sets the "address" field.
-func (m *UserMutation) SetAddress(s string) {
-	m.address = &s
-}
-
-// Address returns the value of the "address" field in the mutation.
-func (m *UserMutation) Address() (r string, exists bool) {
-	v := m.address
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case enttask.FieldPriority:
+		v, ok := value.(task.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case enttask.FieldOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrder(v)
+		return nil
+	case enttask.FieldOrderOption:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrderOption(v)
+		return nil
+	case enttask.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Task numeric field %s", name)
 }
 
-// OldAddress returns the old "address" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAddress(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(enttask.FieldPriorities) {
+		fields = append(fields, enttask.FieldPriorities)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddress requires an ID field in the mutation")
+	if m.FieldCleared(enttask.FieldName) {
+		fields = append(fields, enttask.FieldName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	if m.FieldCleared(enttask.FieldOwner) {
+		fields = append(fields, enttask.FieldOwner)
 	}
-	return oldValue.Address, nil
-}
-
-// ClearAddress clears the value of the "address" field.
-func (m *UserMutation) ClearAddress() {
-	m.address = nil
-	m.clearedFields[user.FieldAddress] = struct{}{}
+	if m.FieldCleared(enttask.FieldOrder) {
+		fields = append(fields, enttask.FieldOrder)
+	}
+	if m.FieldCleared(enttask.FieldOrderOption) {
+		fields = append(fields, enttask.FieldOrderOption)
+	}
+	return fields
 }
 
-// AddressCleared returns if the "address" field was cleared in this mutation.
-func (m *UserMutation) AddressCleared() bool {
-	_, ok := m.clearedFields[user.FieldAddress]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetAddress resets all changes to the "address" field.
-func (m *UserMutation) ResetAddress() {
-	m.address = nil
-	delete(m.clearedFields, user.FieldAddress)
-}
-
-// SetPhone sets the "phone" field.
-func (m *UserMutation) SetPhone(s string) {
-	m.phone = &s
-}
-
-// Phone returns the value of the "phone" field in the mutation.
-func (m *UserMutation) Phone() (r string, exists bool) {
-	v := m.phone
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case enttask.FieldPriorities:
+		m.ClearPriorities()
+		return nil
+	case enttask.FieldName:
+		m.ClearName()
+		return nil
+	case enttask.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case enttask.FieldOrder:
+		m.ClearOrder()
+		return nil
+	case enttask.FieldOrderOption:
+		m.ClearOrderOption()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Task nullable field %s", name)
 }
 
-// OldPhone returns the old "phone" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPhone(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhone requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskMutation) ResetField(name string) error {
+	switch name {
+	case enttask.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case enttask.FieldPriorities:
+		m.ResetPriorities()
+		return nil
+	case enttask.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case enttask.FieldName:
+		m.ResetName()
+		return nil
+	case enttask.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case enttask.FieldOrder:
+		m.ResetOrder()
+		return nil
+	case enttask.FieldOrderOption:
+		m.ResetOrderOption()
+		return nil
+	case enttask.FieldOp:
+		m.ResetOp()
+		return nil
+	case enttask.FieldVersion:
+		m.ResetVersion()
+		return nil
 	}
-	return oldValue.Phone, nil
-}
-
-// ClearPhone clears the value of the "phone" field.
-func (m *UserMutation) ClearPhone() {
-	m.phone = nil
-	m.clearedFields[user.FieldPhone] = struct{}{}
+	return fmt.Errorf("unknown Task field %s", name)
 }
 
-// PhoneCleared returns if the "phone" field was cleared in this mutation.
-func (m *UserMutation) PhoneCleared() bool {
-	_, ok := m.clearedFields[user.FieldPhone]
-	return ok
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetPhone resets all changes to the "phone" field.
-func (m *UserMutation) ResetPhone() {
-	m.phone = nil
-	delete(m.clearedFields, user.FieldPhone)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
-	}
-	return oldValue.Password, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearPassword clears the value of the "password" field.
-func (m *UserMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[user.FieldPassword] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *UserMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[user.FieldPassword]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Task unique edge %s", name)
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, user.FieldPassword)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Task edge %s", name)
 }
 
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(u user.Role) {
-	m.role = &u
-}
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	optional_int     *int
+	addoptional_int  *int
+	age              *int
+	addage           *int
+	name             *string
+	last             *string
+	nickname         *string
+	address          *string
+	phone            *string
+	password         *string
+	role             *user.Role
+	employment       *user.Employment
+	_SSOCert         *string
+	files_count      *int
+	addfiles_count   *int
+	clearedFields    map[string]struct{}
+	card             *int
+	clearedcard      bool
+	pets             map[int]struct{}
+	removedpets      map[int]struct{}
+	clearedpets      bool
+	files            map[int]struct{}
+	removedfiles     map[int]struct{}
+	clearedfiles     bool
+	groups           map[int]struct{}
+	removedgroups    map[int]struct{}
+	clearedgroups    bool
+	friends          map[int]struct{}
+	removedfriends   map[int]struct{}
+	clearedfriends   bool
+	followers        map[int]struct{}
+	removedfollowers map[int]struct{}
+	clearedfollowers bool
+	following        map[int]struct{}
+	removedfollowing map[int]struct{}
+	clearedfollowing bool
+	team             *int
+	clearedteam      bool
+	spouse           *int
+	clearedspouse    bool
+	children         map[int]struct{}
+	removedchildren  map[int]struct{}
+	clearedchildren  bool
+	parent           *int
+	clearedparent    bool
+	done             bool
+	oldValue         func(context.Context) (*User, error)
+	predicates       []predicate.User
+}
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r user.Role, exists bool) {
-	v := m.role
-	if v == nil {
-		return
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Role, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetEmployment sets the "employment" field.
-func (m *UserMutation) SetEmployment(u user.Employment) {
-	m.employment = &u
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Employment returns the value of the "employment" field in the mutation.
-func (m *UserMutation) Employment() (r user.Employment, exists bool) {
-	v := m.employment
+// SetOptionalInt sets the "optional_int" field.
+func (m *UserMutation) SetOptionalInt(i int) {
+	m.optional_int = &i
+	m.addoptional_int = nil
+}
+
+// OptionalInt returns the value of the "optional_int" field in the mutation.
+func (m *UserMutation) OptionalInt() (r int, exists bool) {
+	v := m.optional_int
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmployment returns the old "employment" field's value of the User entity.
+// OldOptionalInt returns the old "optional_int" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmployment(ctx context.Context) (v user.Employment, err error) {
+func (m *UserMutation) OldOptionalInt(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmployment is only allowed on UpdateOne operations")
+		return v, errors.New("OldOptionalInt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmployment requires an ID field in the mutation")
+		return v, errors.New("OldOptionalInt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmployment: %w", err)
+		return v, fmt.Errorf("querying old value for OldOptionalInt: %w", err)
 	}
-	return oldValue.Employment, nil
+	return oldValue.OptionalInt, nil
 }
 
-// ResetEmployment resets all changes to the "employment" field.
-func (m *UserMutation) ResetEmployment() {
-	m.employment = nil
+// AddOptionalInt adds i to the "optional_int" field.
+func (m *UserMutation) AddOptionalInt(i int) {
+	if m.addoptional_int != nil {
+		*m.addoptional_int += i
+	} else {
+		m.addoptional_int = &i
+	}
 }
 
-// SetSSOCert sets the "SSOCert" field.
-func (m *UserMutation) SetSSOCert(s string) {
-	m._SSOCert = &s
+// AddedOptionalInt returns the value that was added to the "optional_int" field in this mutation.
+func (m *UserMutation) AddedOptionalInt() (r int, exists bool) {
+	v := m.addoptional_int
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SSOCert returns the value of the "SSOCert" field in the mutation.
-func (m *UserMutation) SSOCert() (r string, exists bool) {
-	v := m._SSOCert
+// ClearOptionalInt clears the value of the "optional_int" field.
+func (m *UserMutation) ClearOptionalInt() {
+	m.optional_int = nil
+	m.addoptional_int = nil
+	m.clearedFields[user.FieldOptionalInt] = struct{}{}
+}
+
+// OptionalIntCleared returns if the "optional_int" field was cleared in this mutation.
+func (m *UserMutation) OptionalIntCleared() bool {
+	_, ok := m.clearedFields[user.FieldOptionalInt]
+	return ok
+}
+
+// ResetOptionalInt resets all changes to the "optional_int" field.
+func (m *UserMutation) ResetOptionalInt() {
+	m.optional_int = nil
+	m.addoptional_int = nil
+	delete(m.clearedFields, user.FieldOptionalInt)
+}
+
+// SetAge sets the "age" field.
+func (m *UserMutation) SetAge(i int) {
+	m.age = &i
+	m.addage = nil
+}
+
+// Age returns the value of the "age" field in the mutation.
+func (m *UserMutation) Age() (r int, exists bool) {
+	v := m.age
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSSOCert returns the old "SSOCert" field's value of the User entity.
+// OldAge returns the old "age" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSSOCert(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldAge(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSSOCert is only allowed on UpdateOne operations")
+		return v, errors.New("OldAge is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSSOCert requires an ID field in the mutation")
+		return v, errors.New("OldAge requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSSOCert: %w", err)
+		return v, fmt.Errorf("querying old value for OldAge: %w", err)
 	}
-	return oldValue.SSOCert, nil
+	return oldValue.Age, nil
 }
 
-// ClearSSOCert clears the value of the "SSOCert" field.
-func (m *UserMutation) ClearSSOCert() {
-	m._SSOCert = nil
-	m.clearedFields[user.FieldSSOCert] = struct{}{}
+// AddAge adds i to the "age" field.
+func (m *UserMutation) AddAge(i int) {
+	if m.addage != nil {
+		*m.addage += i
+	} else {
+		m.addage = &i
+	}
 }
 
-// SSOCertCleared returns if the "SSOCert" field was cleared in this mutation.
-func (m *UserMutation) SSOCertCleared() bool {
-	_, ok := m.clearedFields[user.FieldSSOCert]
-	return ok
+// AddedAge returns the value that was added to the "age" field in this mutation.
+func (m *UserMutation) AddedAge() (r int, exists bool) {
+	v := m.addage
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSSOCert resets all changes to the "SSOCert" field.
-func (m *UserMutation) ResetSSOCert() {
-	m._SSOCert = nil
-	delete(m.clearedFields, user.FieldSSOCert)
+// ResetAge resets all changes to the "age" field.
+func (m *UserMutation) ResetAge() {
+	m.age = nil
+	m.addage = nil
 }
 
-// SetFilesCount sets the "files_count" field.
-func (m *UserMutation) SetFilesCount(i int) {
-	m.files_count = &i
-	m.addfiles_count = nil
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
 }
 
-// FilesCount returns the value of the "files_count" field in the mutation.
-func (m *UserMutation) FilesCount() (r int, exists bool) {
-	v := m.files_count
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilesCount returns the old "files_count" field's value of the User entity.
+// OldName returns the old "name" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldFilesCount(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilesCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilesCount requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilesCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.FilesCount, nil
+	return oldValue.Name, nil
 }
 
-// AddFilesCount adds i to the "files_count" field.
-func (m *UserMutation) AddFilesCount(i int) {
-	if m.addfiles_count != nil {
-		*m.addfiles_count += i
-	} else {
-		m.addfiles_count = &i
-	}
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedFilesCount returns the value that was added to the "files_count" field in this mutation.
-func (m *UserMutation) AddedFilesCount() (r int, exists bool) {
-	v := m.addfiles_count
+// SetLast sets the "last" field.
+func (m *UserMutation) SetLast(s string) {
+	m.last = &s
+}
+
+// Last returns the value of the "last" field in the mutation.
+func (m *UserMutation) Last() (r string, exists bool) {
+	v := m.last
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearFilesCount clears the value of the "files_count" field.
-func (m *UserMutation) ClearFilesCount() {
-	m.files_count = nil
-	m.addfiles_count = nil
-	m.clearedFields[user.FieldFilesCount] = struct{}{}
-}
-
-// FilesCountCleared returns if the "files_count" field was cleared in this mutation.
-func (m *UserMutation) FilesCountCleared() bool {
-	_, ok := m.clearedFields[user.FieldFilesCount]
-	return ok
-}
-
-// ResetFilesCount resets all changes to the "files_count" field.
-func (m *UserMutation) ResetFilesCount() {
-	m.files_count = nil
-	m.addfiles_count = nil
-	delete(m.clearedFields, user.FieldFilesCount)
-}
-
-// SetCardID sets the "card" edge to the Card entity by id.
-func (m *UserMutation) SetCardID(id int) {
-	m.card = &id
+// OldLast returns the old "last" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLast(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLast is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLast requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLast: %w", err)
+	}
+	return oldValue.Last, nil
 }
 
-// ClearCard clears the "card" edge to the Card entity.
-func (m *UserMutation) ClearCard() {
-	m.clearedcard = true
+// ResetLast resets all changes to the "last" field.
+func (m *UserMutation) ResetLast() {
+	m.last = nil
 }
 
-// CardCleared reports if the "card" edge to the Card entity was cleared.
-func (m *UserMutation) CardCleared() bool {
-	return m.clearedcard
+// SetNickname sets the "nickname" field.
+func (m *UserMutation) SetNickname(s string) {
+	m.nickname = &s
 }
 
-// CardID returns the "card" edge ID in the mutation.
-func (m *UserMutation) CardID() (id int, exists bool) {
-	if m.card != nil {
-		return *m.card, true
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *UserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CardIDs returns the "card" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CardID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) CardIDs() (ids []int) {
-	if id := m.card; id != nil {
-		ids = append(ids, *id)
+// OldNickname returns the old "nickname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetCard resets all changes to the "card" edge.
-func (m *UserMutation) ResetCard() {
-	m.card = nil
-	m.clearedcard = false
-}
-
-// AddPetIDs adds the "pets" edge to the Pet entity by ids.
-func (m *UserMutation) AddPetIDs(ids ...int) {
-	if m.pets == nil {
-		m.pets = make(map[int]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.pets[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
 	}
+	return oldValue.Nickname, nil
 }
 
-// ClearPets clears the "pets" edge to the Pet entity.
-func (m *UserMutation) ClearPets() {
-	m.clearedpets = true
+// ClearNickname clears the value of the "nickname" field.
+func (m *UserMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[user.FieldNickname] = struct{}{}
 }
 
-// PetsCleared reports if the "pets" edge to the Pet entity was cleared.
-func (m *UserMutation) PetsCleared() bool {
-	return m.clearedpets
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *UserMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[user.FieldNickname]
+	return ok
 }
 
-// RemovePetIDs removes the "pets" edge to the Pet entity by IDs.
-func (m *UserMutation) RemovePetIDs(ids ...int) {
-	if m.removedpets == nil {
-		m.removedpets = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.pets, ids[i])
-		m.removedpets[ids[i]] = struct{}{}
-	}
+// ResetNickname resets all changes to the "nickname" field.
+func (m *UserMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, user.FieldNickname)
 }
 
-// RemovedPets returns the removed IDs of the "pets" edge to the Pet entity.
-func (m *UserMutation) RemovedPetsIDs() (ids []int) {
-	for id := range m.removedpets {
-		ids = append(ids, id)
-	}
-	return
+// SetAddress sets the "address" field.
+func (m *UserMutation) SetAddress(s string) {
+	m.address = &s
 }
 
-// PetsIDs returns the "pets" edge IDs in the mutation.
-func (m *UserMutation) PetsIDs() (ids []int) {
-	for id := range m.pets {
-		ids = append(ids, id)
+// Address returns the value of the "address" field in the mutation.
+func (m *UserMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetPets resets all changes to the "pets" edge.
-func (m *UserMutation) ResetPets() {
-	m.pets = nil
-	m.clearedpets = false
-	m.removedpets = nil
+	return *v, true
 }
 
-// AddFileIDs adds the "files" edge to the File entity by ids.
-func (m *UserMutation) AddFileIDs(ids ...int) {
-	if m.files == nil {
-		m.files = make(map[int]struct{})
+// OldAddress returns the old "address" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
 	}
+	return oldValue.Address, nil
 }
 
-// ClearFiles clears the "files" edge to the File entity.
-func (m *UserMutation) ClearFiles() {
-	m.clearedfiles = true
+// ClearAddress clears the value of the "address" field.
+func (m *UserMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[user.FieldAddress] = struct{}{}
 }
 
-// FilesCleared reports if the "files" edge to the File entity was cleared.
-func (m *UserMutation) FilesCleared() bool {
-	return m.clearedfiles
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *UserMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[user.FieldAddress]
+	return ok
 }
 
-// RemoveFileIDs removes the "files" edge to the File entity by IDs.
-func (m *UserMutation) RemoveFileIDs(ids ...int) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
-	}
+// ResetAddress resets all changes to the "address" field.
+func (m *UserMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, user.FieldAddress)
 }
 
-// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
-func (m *UserMutation) RemovedFilesIDs() (ids []int) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
+// SetPhone sets the "phone" field.
+func (m *UserMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *UserMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *UserMutation) FilesIDs() (ids []int) {
-	for id := range m.files {
-		ids = append(ids, id)
+// OldPhone returns the old "phone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
 }
 
-// ResetFiles resets all changes to the "files" edge.
-func (m *UserMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
+// ClearPhone clears the value of the "phone" field.
+func (m *UserMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[user.FieldPhone] = struct{}{}
 }
 
-// AddGroupIDs adds the "groups" edge to the Group entity by ids.
-func (m *UserMutation) AddGroupIDs(ids ...int) {
-	if m.groups == nil {
-		m.groups = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.groups[ids[i]] = struct{}{}
-	}
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *UserMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[user.FieldPhone]
+	return ok
 }
 
-// ClearGroups clears the "groups" edge to the Group entity.
-func (m *UserMutation) ClearGroups() {
-	m.clearedgroups = true
+// ResetPhone resets all changes to the "phone" field.
+func (m *UserMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, user.FieldPhone)
 }
 
-// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
-func (m *UserMutation) GroupsCleared() bool {
-	return m.clearedgroups
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
 }
 
-// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
-func (m *UserMutation) RemoveGroupIDs(ids ...int) {
-	if m.removedgroups == nil {
-		m.removedgroups = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.groups, ids[i])
-		m.removedgroups[ids[i]] = struct{}{}
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
-func (m *UserMutation) RemovedGroupsIDs() (ids []int) {
-	for id := range m.removedgroups {
-		ids = append(ids, id)
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
 }
 
-// GroupsIDs returns the "groups" edge IDs in the mutation.
-func (m *UserMutation) GroupsIDs() (ids []int) {
-	for id := range m.groups {
-		ids = append(ids, id)
-	}
-	return
+// ClearPassword clears the value of the "password" field.
+func (m *UserMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[user.FieldPassword] = struct{}{}
 }
 
-// ResetGroups resets all changes to the "groups" edge.
-func (m *UserMutation) ResetGroups() {
-	m.groups = nil
-	m.clearedgroups = false
-	m.removedgroups = nil
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *UserMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[user.FieldPassword]
+	return ok
 }
 
-// AddFriendIDs adds the "friends" edge to the User entity by ids.
-func (m *UserMutation) AddFriendIDs(ids ...int) {
-	if m.friends == nil {
-		m.friends = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.friends[ids[i]] = struct{}{}
-	}
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, user.FieldPassword)
 }
 
-// ClearFriends clears the "friends" edge to the User entity.
-func (m *UserMutation) ClearFriends() {
-	m.clearedfriends = true
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
 }
 
-// FriendsCleared reports if the "friends" edge to the User entity was cleared.
-func (m *UserMutation) FriendsCleared() bool {
-	return m.clearedfriends
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveFriendIDs removes the "friends" edge to the User entity by IDs.
-func (m *UserMutation) RemoveFriendIDs(ids ...int) {
-	if m.removedfriends == nil {
-		m.removedfriends = make(map[int]struct{})
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.friends, ids[i])
-		m.removedfriends[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
+	return oldValue.Role, nil
 }
 
-// RemovedFriends returns the removed IDs of the "friends" edge to the User entity.
-func (m *UserMutation) RemovedFriendsIDs() (ids []int) {
-	for id := range m.removedfriends {
-		ids = append(ids, id)
-	}
-	return
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// FriendsIDs returns the "friends" edge IDs in the mutation.
-func (m *UserMutation) FriendsIDs() (ids []int) {
-	for id := range m.friends {
-		ids = append(ids, id)
-	}
-	return
+// SetEmployment sets the "employment" field.
+func (m *UserMutation) SetEmployment(u user.Employment) {
+	m.employment = &u
 }
 
-// ResetFriends resets all changes to the "friends" edge.
-func (m *UserMutation) ResetFriends() {
-	m.friends = nil
-	m.clearedfriends = false
-	m.removedfriends = nil
+// Employment returns the value of the "employment" field in the mutation.
+func (m *UserMutation) Employment() (r user.Employment, exists bool) {
+	v := m.employment
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddFollowerIDs adds the "followers" edge to the User entity by ids.
-func (m *UserMutation) AddFollowerIDs(ids ...int) {
-	if m.followers == nil {
-		m.followers = make(map[int]struct{})
+// OldEmployment returns the old "employment" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmployment(ctx context.Context) (v user.Employment, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmployment is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.followers[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmployment requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmployment: %w", err)
+	}
+	return oldValue.Employment, nil
 }
 
-// ClearFollowers clears the "followers" edge to the User entity.
-func (m *UserMutation) ClearFollowers() {
-	m.clearedfollowers = true
+// ResetEmployment resets all changes to the "employment" field.
+func (m *UserMutation) ResetEmployment() {
+	m.employment = nil
 }
 
-// FollowersCleared reports if the "followers" edge to the User entity was cleared.
-func (m *UserMutation) FollowersCleared() bool {
-	return m.clearedfollowers
+// SetSSOCert sets the "SSOCert" field.
+func (m *UserMutation) SetSSOCert(s string) {
+	m._SSOCert = &s
 }
 
-// RemoveFollowerIDs removes the "followers" edge to the User entity by IDs.
-func (m *UserMutation) RemoveFollowerIDs(ids ...int) {
-	if m.removedfollowers == nil {
-		m.removedfollowers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.followers, ids[i])
-		m.removedfollowers[ids[i]] = struct{}{}
+// SSOCert returns the value of the "SSOCert" field in the mutation.
+func (m *UserMutation) SSOCert() (r string, exists bool) {
+	v := m._SSOCert
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFollowers returns the removed IDs of the "followers" edge to the User entity.
-func (m *UserMutation) RemovedFollowersIDs() (ids []int) {
-	for id := range m.removedfollowers {
-		ids = append(ids, id)
+// OldSSOCert returns the old "SSOCert" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSSOCert(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSSOCert is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// FollowersIDs returns the "followers" edge IDs in the mutation.
-func (m *UserMutation) FollowersIDs() (ids []int) {
-	for id := range m.followers {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSSOCert requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSSOCert: %w", err)
+	}
+	return oldValue.SSOCert, nil
 }
 
-// ResetFollowers resets all changes to the "followers" edge.
-func (m *UserMutation) ResetFollowers() {
-	m.followers = nil
-	m.clearedfollowers = false
-	m.removedfollowers = nil
+// ClearSSOCert clears the value of the "SSOCert" field.
+func (m *UserMutation) ClearSSOCert() {
+	m._SSOCert = nil
+	m.clearedFields[user.FieldSSOCert] = struct{}{}
 }
 
-// AddFollowingIDs adds the "following" edge to the User entity by ids.
-func (m *UserMutation) AddFollowingIDs(ids ...int) {
-	if m.following == nil {
-		m.following = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.following[ids[i]] = struct{}{}
-	}
+// SSOCertCleared returns if the "SSOCert" field was cleared in this mutation.
+func (m *UserMutation) SSOCertCleared() bool {
+	_, ok := m.clearedFields[user.FieldSSOCert]
+	return ok
 }
 
-// ClearFollowing clears the "following" edge to the User entity.
-func (m *UserMutation) ClearFollowing() {
-	m.clearedfollowing = true
+// ResetSSOCert resets all changes to the "SSOCert" field.
+func (m *UserMutation) ResetSSOCert() {
+	m._SSOCert = nil
+	delete(m.clearedFields, user.FieldSSOCert)
 }
 
-// FollowingCleared reports if the "following" edge to the User entity was cleared.
-func (m *UserMutation) FollowingCleared() bool {
-	return m.clearedfollowing
+// SetFilesCount sets the "files_count" field.
+func (m *UserMutation) SetFilesCount(i int) {
+	m.files_count = &i
+	m.addfiles_count = nil
 }
 
-// RemoveFollowingIDs removes the "following" edge to the User entity by IDs.
-func (m *UserMutation) RemoveFollowingIDs(ids ...int) {
-	if m.removedfollowing == nil {
-		m.removedfollowing = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.following, ids[i])
-		m.removedfollowing[ids[i]] = struct{}{}
+// FilesCount returns the value of the "files_count" field in the mutation.
+func (m *UserMutation) FilesCount() (r int, exists bool) {
+	v := m.files_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFollowing returns the removed IDs of the "following" edge to the User entity.
-func (m *UserMutation) RemovedFollowingIDs() (ids []int) {
-	for id := range m.removedfollowing {
-		ids = append(ids, id)
+// OldFilesCount returns the old "files_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFilesCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilesCount is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// FollowingIDs returns the "following" edge IDs in the mutation.
-func (m *UserMutation) FollowingIDs() (ids []int) {
-	for id := range m.following {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilesCount requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetFollowing resets all changes to the "following" edge.
-func (m *UserMutation) ResetFollowing() {
-	m.following = nil
-	m.clearedfollowing = false
-	m.removedfollowing = nil
-}
-
-// SetTeamID sets the "team" edge to the Pet entity by id.
-func (m *UserMutation) SetTeamID(id int) {
-	m.team = &id
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilesCount: %w", err)
+	}
+	return oldValue.FilesCount, nil
 }
 
-// ClearTeam clears the "team" edge to the Pet entity.
-func (m *UserMutation) ClearTeam() {
-	m.clearedteam = true
+// AddFilesCount adds i to the "files_count" field.
+func (m *UserMutation) AddFilesCount(i int) {
+	if m.addfiles_count != nil {
+		*m.addfiles_count += i
+	} else {
+		m.addfiles_count = &i
+	}
 }
 
-// TeamCleared reports if the "team" edge to the Pet entity was cleared.
-func (m *UserMutation) TeamCleared() bool {
-	return m.clearedteam
+// AddedFilesCount returns the value that was added to the "files_count" field in this mutation.
+func (m *UserMutation) AddedFilesCount() (r int, exists bool) {
+	v := m.addfiles_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TeamID returns the "team" edge ID in the mutation.
-func (m *UserMutation) TeamID() (id int, exists bool) {
-	if m.team != nil {
-		return *m.team, true
-	}
-	return
+// ClearFilesCount clears the value of the "files_count" field.
+func (m *UserMutation) ClearFilesCount() {
+	m.files_count = nil
+	m.addfiles_count = nil
+	m.clearedFields[user.FieldFilesCount] = struct{}{}
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) TeamIDs() (ids []int) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// FilesCountCleared returns if the "files_count" field was cleared in this mutation.
+func (m *UserMutation) FilesCountCleared() bool {
+	_, ok := m.clearedFields[user.FieldFilesCount]
+	return ok
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *UserMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// ResetFilesCount resets all changes to the "files_count" field.
+func (m *UserMutation) ResetFilesCount() {
+	m.files_count = nil
+	m.addfiles_count = nil
+	delete(m.clearedFields, user.FieldFilesCount)
 }
 
-// SetSpouseID sets the "spouse" edge to the User entity by id.
-func (m *UserMutation) SetSpouseID(id int) {
-	m.spouse = &id
+// SetCardID sets the "card" edge to the Card entity by id.
+func (m *UserMutation) SetCardID(id int) {
+	m.card = &id
 }
 
-// ClearSpouse clears the "spouse" edge to the User entity.
-func (m *UserMutation) ClearSpouse() {
-	m.clearedspouse = true
+// ClearCard clears the "card" edge to the Card entity.
+func (m *UserMutation) ClearCard() {
+	m.clearedcard = true
 }
 
-// SpouseCleared reports if the "spouse" edge to the User entity was cleared.
-func (m *UserMutation) SpouseCleared() bool {
-	return m.clearedspouse
+// CardCleared reports if the "card" edge to the Card entity was cleared.
+func (m *UserMutation) CardCleared() bool {
+	return m.clearedcard
 }
 
-// SpouseID returns the "spouse" edge ID in the mutation.
-func (m *UserMutation) SpouseID() (id int, exists bool) {
-	if m.spouse != nil {
-		return *m.spouse, true
+// CardID returns the "card" edge ID in the mutation.
+func (m *UserMutation) CardID() (id int, exists bool) {
+	if m.card != nil {
+		return *m.card, true
 	}
 	return
 }
 
-// SpouseIDs returns the "spouse" edge IDs in the mutation.
+// CardIDs returns the "card" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SpouseID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) SpouseIDs() (ids []int) {
-	if id := m.spouse; id != nil {
+// CardID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) CardIDs() (ids []int) {
+	if id := m.card; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSpouse resets all changes to the "spouse" edge.
-func (m *UserMutation) ResetSpouse() {
-	m.spouse = nil
-	m.clearedspouse = false
+// ResetCard resets all changes to the "card" edge.
+func (m *UserMutation) ResetCard() {
+	m.card = nil
+	m.clearedcard = false
 }
 
-// AddChildIDs adds the "children" edge to the User entity by ids.
-func (m *UserMutation) AddChildIDs(ids ...int) {
-	if m.children == nil {
-		m.children = make(map[int]struct{})
+// AddPetIDs adds the "pets" edge to the Pet entity by ids.
+func (m *UserMutation) AddPetIDs(ids ...int) {
+	if m.pets == nil {
+		m.pets = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+		m.pets[ids[i]] = struct{}{}
 	}
 }
 
-// ClearChildren clears the "children" edge to the User entity.
-func (m *UserMutation) ClearChildren() {
-	m.clearedchildren = true
+// ClearPets clears the "pets" edge to the Pet entity.
+func (m *UserMutation) ClearPets() {
+	m.clearedpets = true
 }
 
-// ChildrenCleared reports if the "children" edge to the User entity was cleared.
-func (m *UserMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// PetsCleared reports if the "pets" edge to the Pet entity was cleared.
+func (m *UserMutation) PetsCleared() bool {
+	return m.clearedpets
 }
 
-// RemoveChildIDs removes the "children" edge to the User entity by IDs.
-func (m *UserMutation) RemoveChildIDs(ids ...int) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[int]struct{})
+// RemovePetIDs removes the "pets" edge to the Pet entity by IDs.
+func (m *UserMutation) RemovePetIDs(ids ...int) {
+	if m.removedpets == nil {
+		m.removedpets = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+		delete(m.pets, ids[i])
+		m.removedpets[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the User entity.
-func (m *UserMutation) RemovedChildrenIDs() (ids []int) {
-	for id := range m.removedchildren {
+// RemovedPets returns the removed IDs of the "pets" edge to the Pet entity.
+func (m *UserMutation) RemovedPetsIDs() (ids []int) {
+	for id := range m.removedpets {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *UserMutation) ChildrenIDs() (ids []int) {
-	for id := range m.children {
+// PetsIDs returns the "pets" edge IDs in the mutation.
+func (m *UserMutation) PetsIDs() (ids []int) {
+	for id := range m.pets {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *UserMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
-}
+// ResetPets resets all changes to the "pets" edge.
+func (m *UserMutation) ResetPets() {
+	m.pets = nil
+	m.clearedpets = false
+	m.removedpets = nil
+}
 
-// SetParentID sets the "parent" edge to the User entity by id.
-func (m *UserMutation) SetParentID(id int) {
-	m.parent = &id
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *UserMutation) AddFileIDs(ids ...int) {
+	if m.files == nil {
+		m.files = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
 }
 
-// ClearParent clears the "parent" edge to the User entity.
-func (m *UserMutation) ClearParent() {
-	m.clearedparent = true
+// ClearFiles clears the "files" edge to the File entity.
+func (m *UserMutation) ClearFiles() {
+	m.clearedfiles = true
 }
 
-// ParentCleared reports if the "parent" edge to the User entity was cleared.
-func (m *UserMutation) ParentCleared() bool {
-	return m.clearedparent
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *UserMutation) FilesCleared() bool {
+	return m.clearedfiles
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *UserMutation) ParentID() (id int, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *UserMutation) RemoveFileIDs(ids ...int) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *UserMutation) RemovedFilesIDs() (ids []int) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) ParentIDs() (ids []int) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *UserMutation) FilesIDs() (ids []int) {
+	for id := range m.files {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *UserMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// ResetFiles resets all changes to the "files" edge.
+func (m *UserMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
-	m.predicates = append(m.predicates, ps...)
+// AddGroupIDs adds the "groups" edge to the Group entity by ids.
+func (m *UserMutation) AddGroupIDs(ids ...int) {
+	if m.groups == nil {
+		m.groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ClearGroups clears the "groups" edge to the Group entity.
+func (m *UserMutation) ClearGroups() {
+	m.clearedgroups = true
+}
+
+// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
+func (m *UserMutation) GroupsCleared() bool {
+	return m.clearedgroups
+}
+
+// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
+func (m *UserMutation) RemoveGroupIDs(ids ...int) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
+func (m *UserMutation) RemovedGroupsIDs() (ids []int) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *UserMutation) GroupsIDs() (ids []int) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroups resets all changes to the "groups" edge.
+func (m *UserMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// AddFriendIDs adds the "friends" edge to the User entity by ids.
+func (m *UserMutation) AddFriendIDs(ids ...int) {
+	if m.friends == nil {
+		m.friends = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.friends[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFriends clears the "friends" edge to the User entity.
+func (m *UserMutation) ClearFriends() {
+	m.clearedfriends = true
+}
+
+// FriendsCleared reports if the "friends" edge to the User entity was cleared.
+func (m *UserMutation) FriendsCleared() bool {
+	return m.clearedfriends
+}
+
+// RemoveFriendIDs removes the "friends" edge to the User entity by IDs.
+func (m *UserMutation) RemoveFriendIDs(ids ...int) {
+	if m.removedfriends == nil {
+		m.removedfriends = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.friends, ids[i])
+		m.removedfriends[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFriends returns the removed IDs of the "friends" edge to the User entity.
+func (m *UserMutation) RemovedFriendsIDs() (ids []int) {
+	for id := range m.removedfriends {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FriendsIDs returns the "friends" edge IDs in the mutation.
+func (m *UserMutation) FriendsIDs() (ids []int) {
+	for id := range m.friends {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFriends resets all changes to the "friends" edge.
+func (m *UserMutation) ResetFriends() {
+	m.friends = nil
+	m.clearedfriends = false
+	m.removedfriends = nil
+}
+
+// AddFollowerIDs adds the "followers" edge to the User entity by ids.
+func (m *UserMutation) AddFollowerIDs(ids ...int) {
+	if m.followers == nil {
+		m.followers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.followers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowers clears the "followers" edge to the User entity.
+func (m *UserMutation) ClearFollowers() {
+	m.clearedfollowers = true
+}
+
+// FollowersCleared reports if the "followers" edge to the User entity was cleared.
+func (m *UserMutation) FollowersCleared() bool {
+	return m.clearedfollowers
+}
+
+// RemoveFollowerIDs removes the "followers" edge to the User entity by IDs.
+func (m *UserMutation) RemoveFollowerIDs(ids ...int) {
+	if m.removedfollowers == nil {
+		m.removedfollowers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.followers, ids[i])
+		m.removedfollowers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowers returns the removed IDs of the "followers" edge to the User entity.
+func (m *UserMutation) RemovedFollowersIDs() (ids []int) {
+	for id := range m.removedfollowers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowersIDs returns the "followers" edge IDs in the mutation.
+func (m *UserMutation) FollowersIDs() (ids []int) {
+	for id := range m.followers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowers resets all changes to the "followers" edge.
+func (m *UserMutation) ResetFollowers() {
+	m.followers = nil
+	m.clearedfollowers = false
+	m.removedfollowers = nil
+}
+
+// AddFollowingIDs adds the "following" edge to the User entity by ids.
+func (m *UserMutation) AddFollowingIDs(ids ...int) {
+	if m.following == nil {
+		m.following = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.following[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowing clears the "following" edge to the User entity.
+func (m *UserMutation) ClearFollowing() {
+	m.clearedfollowing = true
+}
+
+// FollowingCleared reports if the "following" edge to the User entity was cleared.
+func (m *UserMutation) FollowingCleared() bool {
+	return m.clearedfollowing
+}
+
+// RemoveFollowingIDs removes the "following" edge to the User entity by IDs.
+func (m *UserMutation) RemoveFollowingIDs(ids ...int) {
+	if m.removedfollowing == nil {
+		m.removedfollowing = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.following, ids[i])
+		m.removedfollowing[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowing returns the removed IDs of the "following" edge to the User entity.
+func (m *UserMutation) RemovedFollowingIDs() (ids []int) {
+	for id := range m.removedfollowing {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowingIDs returns the "following" edge IDs in the mutation.
+func (m *UserMutation) FollowingIDs() (ids []int) {
+	for id := range m.following {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowing resets all changes to the "following" edge.
+func (m *UserMutation) ResetFollowing() {
+	m.following = nil
+	m.clearedfollowing = false
+	m.removedfollowing = nil
+}
+
+// SetTeamID sets the "team" edge to the Pet entity by id.
+func (m *UserMutation) SetTeamID(id int) {
+	m.team = &id
+}
+
+// ClearTeam clears the "team" edge to the Pet entity.
+func (m *UserMutation) ClearTeam() {
+	m.clearedteam = true
+}
+
+// TeamCleared reports if the "team" edge to the Pet entity was cleared.
+func (m *UserMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamID returns the "team" edge ID in the mutation.
+func (m *UserMutation) TeamID() (id int, exists bool) {
+	if m.team != nil {
+		return *m.team, true
+	}
+	return
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *UserMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetSpouseID sets the "spouse" edge to the User entity by id.
+func (m *UserMutation) SetSpouseID(id int) {
+	m.spouse = &id
+}
+
+// ClearSpouse clears the "spouse" edge to the User entity.
+func (m *UserMutation) ClearSpouse() {
+	m.clearedspouse = true
+}
+
+// SpouseCleared reports if the "spouse" edge to the User entity was cleared.
+func (m *UserMutation) SpouseCleared() bool {
+	return m.clearedspouse
+}
+
+// SpouseID returns the "spouse" edge ID in the mutation.
+func (m *UserMutation) SpouseID() (id int, exists bool) {
+	if m.spouse != nil {
+		return *m.spouse, true
+	}
+	return
+}
+
+// SpouseIDs returns the "spouse" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SpouseID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) SpouseIDs() (ids []int) {
+	if id := m.spouse; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSpouse resets all changes to the "spouse" edge.
+func (m *UserMutation) ResetSpouse() {
+	m.spouse = nil
+	m.clearedspouse = false
+}
+
+// AddChildIDs adds the "children" edge to the User entity by ids.
+func (m *UserMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the User entity.
+func (m *UserMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the User entity was cleared.
+func (m *UserMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the User entity by IDs.
+func (m *UserMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the User entity.
+func (m *UserMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *UserMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *UserMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// SetParentID sets the "parent" edge to the User entity by id.
+func (m *UserMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the User entity.
+func (m *UserMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the User entity was cleared.
+func (m *UserMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *UserMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *UserMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.optional_int != nil {
+		fields = append(fields, user.FieldOptionalInt)
+	}
+	if m.age != nil {
+		fields = append(fields, user.FieldAge)
+	}
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.last != nil {
+		fields = append(fields, user.FieldLast)
+	}
+	if m.nickname != nil {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.address != nil {
+		fields = append(fields, user.FieldAddress)
+	}
+	if m.phone != nil {
+		fields = append(fields, user.FieldPhone)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.employment != nil {
+		fields = append(fields, user.FieldEmployment)
+	}
+	if m._SSOCert != nil {
+		fields = append(fields, user.FieldSSOCert)
+	}
+	if m.files_count != nil {
+		fields = append(fields, user.FieldFilesCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldOptionalInt:
+		return m.OptionalInt()
+	case user.FieldAge:
+		return m.Age()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldLast:
+		return m.Last()
+	case user.FieldNickname:
+		return m.Nickname()
+	case user.FieldAddress:
+		return m.Address()
+	case user.FieldPhone:
+		return m.Phone()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldEmployment:
+		return m.Employment()
+	case user.FieldSSOCert:
+		return m.SSOCert()
+	case user.FieldFilesCount:
+		return m.FilesCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldOptionalInt:
+		return m.OldOptionalInt(ctx)
+	case user.FieldAge:
+		return m.OldAge(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldLast:
+		return m.OldLast(ctx)
+	case user.FieldNickname:
+		return m.OldNickname(ctx)
+	case user.FieldAddress:
+		return m.OldAddress(ctx)
+	case user.FieldPhone:
+		return m.OldPhone(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldEmployment:
+		return m.OldEmployment(ctx)
+	case user.FieldSSOCert:
+		return m.OldSSOCert(ctx)
+	case user.FieldFilesCount:
+		return m.OldFilesCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldOptionalInt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptionalInt(v)
+		return nil
+	case user.FieldAge:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAge(v)
+		return nil
+	case user.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case user.FieldLast:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLast(v)
+		return nil
+	case user.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case user.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case user.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldEmployment:
+		v, ok := value.(user.Employment)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmployment(v)
+		return nil
+	case user.FieldSSOCert:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSSOCert(v)
+		return nil
+	case user.FieldFilesCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilesCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addoptional_int != nil {
+		fields = append(fields, user.FieldOptionalInt)
+	}
+	if m.addage != nil {
+		fields = append(fields, user.FieldAge)
+	}
+	if m.addfiles_count != nil {
+		fields = append(fields, user.FieldFilesCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldOptionalInt:
+		return m.AddedOptionalInt()
+	case user.FieldAge:
+		return m.AddedAge()
+	case user.FieldFilesCount:
+		return m.AddedFilesCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldOptionalInt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOptionalInt(v)
+		return nil
+	case user.FieldAge:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAge(v)
+		return nil
+	case user.FieldFilesCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFilesCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldOptionalInt) {
+		fields = append(fields, user.FieldOptionalInt)
+	}
+	if m.FieldCleared(user.FieldNickname) {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.FieldCleared(user.FieldAddress) {
+		fields = append(fields, user.FieldAddress)
+	}
+	if m.FieldCleared(user.FieldPhone) {
+		fields = append(fields, user.FieldPhone)
+	}
+	if m.FieldCleared(user.FieldPassword) {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.FieldCleared(user.FieldSSOCert) {
+		fields = append(fields, user.FieldSSOCert)
+	}
+	if m.FieldCleared(user.FieldFilesCount) {
+		fields = append(fields, user.FieldFilesCount)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldOptionalInt:
+		m.ClearOptionalInt()
+		return nil
+	case user.FieldNickname:
+		m.ClearNickname()
+		return nil
+	case user.FieldAddress:
+		m.ClearAddress()
+		return nil
+	case user.FieldPhone:
+		m.ClearPhone()
+		return nil
+	case user.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case user.FieldSSOCert:
+		m.ClearSSOCert()
+		return nil
+	case user.FieldFilesCount:
+		m.ClearFilesCount()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldOptionalInt:
+		m.ResetOptionalInt()
+		return nil
+	case user.FieldAge:
+		m.ResetAge()
+		return nil
+	case user.FieldName:
+		m.ResetName()
+		return nil
+	case user.FieldLast:
+		m.ResetLast()
+		return nil
+	case user.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case user.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case user.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldEmployment:
+		m.ResetEmployment()
+		return nil
+	case user.FieldSSOCert:
+		m.ResetSSOCert()
+		return nil
+	case user.FieldFilesCount:
+		m.ResetFilesCount()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.card != nil {
+		edges = append(edges, user.EdgeCard)
+	}
+	if m.pets != nil {
+		edges = append(edges, user.EdgePets)
+	}
+	if m.files != nil {
+		edges = append(edges, user.EdgeFiles)
+	}
+	if m.groups != nil {
+		edges = append(edges, user.EdgeGroups)
+	}
+	if m.friends != nil {
+		edges = append(edges, user.EdgeFriends)
+	}
+	if m.followers != nil {
+		edges = append(edges, user.EdgeFollowers)
+	}
+	if m.following != nil {
+		edges = append(edges, user.EdgeFollowing)
+	}
+	if m.team != nil {
+		edges = append(edges, user.EdgeTeam)
+	}
+	if m.spouse != nil {
+		edges = append(edges, user.EdgeSpouse)
+	}
+	if m.children != nil {
+		edges = append(edges, user.EdgeChildren)
+	}
+	if m.parent != nil {
+		edges = append(edges, user.EdgeParent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeCard:
+		if id := m.card; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgePets:
+		ids := make([]ent.Value, 0, len(m.pets))
+		for id := range m.pets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFriends:
+		ids := make([]ent.Value, 0, len(m.friends))
+		for id := range m.friends {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowers:
+		ids := make([]ent.Value, 0, len(m.followers))
+		for id := range m.followers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowing:
+		ids := make([]ent.Value, 0, len(m.following))
+		for id := range m.following {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeSpouse:
+		if id := m.spouse; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.removedpets != nil {
+		edges = append(edges, user.EdgePets)
+	}
+	if m.removedfiles != nil {
+		edges = append(edges, user.EdgeFiles)
+	}
+	if m.removedgroups != nil {
+		edges = append(edges, user.EdgeGroups)
+	}
+	if m.removedfriends != nil {
+		edges = append(edges, user.EdgeFriends)
+	}
+	if m.removedfollowers != nil {
+		edges = append(edges, user.EdgeFollowers)
+	}
+	if m.removedfollowing != nil {
+		edges = append(edges, user.EdgeFollowing)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, user.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePets:
+		ids := make([]ent.Value, 0, len(m.removedpets))
+		for id := range m.removedpets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFriends:
+		ids := make([]ent.Value, 0, len(m.removedfriends))
+		for id := range m.removedfriends {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowers:
+		ids := make([]ent.Value, 0, len(m.removedfollowers))
+		for id := range m.removedfollowers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowing:
+		ids := make([]ent.Value, 0, len(m.removedfollowing))
+		for id := range m.removedfollowing {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *UserMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
-	return m.typ
+	return nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.optional_int != nil {
-		fields = append(fields, user.FieldOptionalInt)
-	}
-	if m.age != nil {
-		fields = append(fields, user.FieldAge)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.clearedcard {
+		edges = append(edges, user.EdgeCard)
 	}
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
+	if m.clearedpets {
+		edges = append(edges, user.EdgePets)
 	}
-	if m.last != nil {
-		fields = append(fields, user.FieldLast)
+	if m.clearedfiles {
+		edges = append(edges, user.EdgeFiles)
 	}
-	if m.nickname != nil {
-		fields = append(fields, user.FieldNickname)
+	if m.clearedgroups {
+		edges = append(edges, user.EdgeGroups)
 	}
-	if m.address != nil {
-		fields = append(fields, user.FieldAddress)
+	if m.clearedfriends {
+		edges = append(edges, user.EdgeFriends)
 	}
-	if m.phone != nil {
-		fields = append(fields, user.FieldPhone)
+	if m.clearedfollowers {
+		edges = append(edges, user.EdgeFollowers)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.clearedfollowing {
+		edges = append(edges, user.EdgeFollowing)
 	}
-	if m.role != nil {
-		fields = append(fields, user.FieldRole)
+	if m.clearedteam {
+		edges = append(edges, user.EdgeTeam)
 	}
-	if m.employment != nil {
-		fields = append(fields, user.FieldEmployment)
+	if m.clearedspouse {
+		edges = append(edges, user.EdgeSpouse)
 	}
-	if m._SSOCert != nil {
-		fields = append(fields, user.FieldSSOCert)
+	if m.clearedchildren {
+		edges = append(edges, user.EdgeChildren)
 	}
-	if m.files_count != nil {
-		fields = append(fields, user.FieldFilesCount)
+	if m.clearedparent {
+		edges = append(edges, user.EdgeParent)
 	}
-	return fields
+	return edges
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.FieldOptionalInt:
-		return m.OptionalInt()
-	case user.FieldAge:
-		return m.Age()
-	case user.FieldName:
-		return m.Name()
-	case user.FieldLast:
-		return m.Last()
-	case user.FieldNickname:
-		return m.Nickname()
-	case user.FieldAddress:
-		return m.Address()
-	case user.FieldPhone:
-		return m.Phone()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldRole:
-		return m.Role()
-	case user.FieldEmployment:
-		return m.Employment()
-	case user.FieldSSOCert:
-		return m.SSOCert()
-	case user.FieldFilesCount:
-		return m.FilesCount()
+	case user.EdgeCard:
+		return m.clearedcard
+	case user.EdgePets:
+		return m.clearedpets
+	case user.EdgeFiles:
+		return m.clearedfiles
+	case user.EdgeGroups:
+		return m.clearedgroups
+	case user.EdgeFriends:
+		return m.clearedfriends
+	case user.EdgeFollowers:
+		return m.clearedfollowers
+	case user.EdgeFollowing:
+		return m.clearedfollowing
+	case user.EdgeTeam:
+		return m.clearedteam
+	case user.EdgeSpouse:
+		return m.clearedspouse
+	case user.EdgeChildren:
+		return m.clearedchildren
+	case user.EdgeParent:
+		return m.clearedparent
 	}
-	return nil, false
+	return false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case user.FieldOptionalInt:
-		return m.OldOptionalInt(ctx)
-	case user.FieldAge:
-		return m.OldAge(ctx)
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldLast:
-		return m.OldLast(ctx)
-	case user.FieldNickname:
-		return m.OldNickname(ctx)
-	case user.FieldAddress:
-		return m.OldAddress(ctx)
-	case user.FieldPhone:
-		return m.OldPhone(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldRole:
-		return m.OldRole(ctx)
-	case user.FieldEmployment:
-		return m.OldEmployment(ctx)
-	case user.FieldSSOCert:
-		return m.OldSSOCert(ctx)
-	case user.FieldFilesCount:
-		return m.OldFilesCount(ctx)
+	case user.EdgeCard:
+		m.ClearCard()
+		return nil
+	case user.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case user.EdgeSpouse:
+		m.ClearSpouse()
+		return nil
+	case user.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case user.FieldOptionalInt:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOptionalInt(v)
-		return nil
-	case user.FieldAge:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAge(v)
-		return nil
-	case user.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
+	case user.EdgeCard:
+		m.ResetCard()
 		return nil
-	case user.FieldLast:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLast(v)
+	case user.EdgePets:
+		m.ResetPets()
 		return nil
-	case user.FieldNickname:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNickname(v)
+	case user.EdgeFiles:
+		m.ResetFiles()
 		return nil
-	case user.FieldAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAddress(v)
+	case user.EdgeGroups:
+		m.ResetGroups()
 		return nil
-	case user.FieldPhone:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPhone(v)
+	case user.EdgeFriends:
+		m.ResetFriends()
 		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
+	case user.EdgeFollowers:
+		m.ResetFollowers()
 		return nil
-	case user.FieldRole:
-		v, ok := value.(user.Role)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRole(v)
+	case user.EdgeFollowing:
+		m.ResetFollowing()
 		return nil
-	case user.FieldEmployment:
-		v, ok := value.(user.Employment)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmployment(v)
+	case user.EdgeTeam:
+		m.ResetTeam()
 		return nil
-	case user.FieldSSOCert:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSSOCert(v)
+	case user.EdgeSpouse:
+		m.ResetSpouse()
 		return nil
-	case user.FieldFilesCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFilesCount(v)
+	case user.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case user.EdgeParent:
+		m.ResetParent()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *UserMutation) AddedFields() []string {
-	var fields []string
-	if m.addoptional_int != nil {
-		fields = append(fields, user.FieldOptionalInt)
-	}
-	if m.addage != nil {
-		fields = append(fields, user.FieldAge)
+// PostHistoryMutation represents an operation that mutates the PostHistory nodes in the graph.
+type PostHistoryMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	history_time         *time.Time
+	operation            *string
+	actor                *string
+	changed_fields       *[]string
+	appendchanged_fields []string
+	old_values           *map[string]interface{}
+	ref                  *int
+	addref               *int
+	title                *string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*PostHistory, error)
+	predicates           []predicate.PostHistory
+}
+
+var _ ent.Mutation = (*PostHistoryMutation)(nil)
+
+// posthistoryOption allows management of the mutation configuration using functional options.
+type posthistoryOption func(*PostHistoryMutation)
+
+// newPostHistoryMutation creates new mutation for the PostHistory entity.
+func newPostHistoryMutation(c config, op Op, opts ...posthistoryOption) *PostHistoryMutation {
+	m := &PostHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostHistory,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.addfiles_count != nil {
-		fields = append(fields, user.FieldFilesCount)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return fields
+	return m
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldOptionalInt:
-		return m.AddedOptionalInt()
-	case user.FieldAge:
-		return m.AddedAge()
-	case user.FieldFilesCount:
-		return m.AddedFilesCount()
+// withPostHistoryID sets the ID field of the mutation.
+func withPostHistoryID(id int) posthistoryOption {
+	return func(m *PostHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostHistory
+		)
+		m.oldValue = func(ctx context.Context) (*PostHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case user.FieldOptionalInt:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOptionalInt(v)
-		return nil
-	case user.FieldAge:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// withPostHistory sets the old PostHistory of the mutation.
+func withPostHistory(node *PostHistory) posthistoryOption {
+	return func(m *PostHistoryMutation) {
+		m.oldValue = func(context.Context) (*PostHistory, error) {
+			return node, nil
 		}
-		m.AddAge(v)
-		return nil
-	case user.FieldFilesCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
 		}
-		m.AddFilesCount(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldOptionalInt) {
-		fields = append(fields, user.FieldOptionalInt)
+// SetHistoryTime sets the "history_time" field.
+func (m *PostHistoryMutation) SetHistoryTime(t time.Time) {
+	m.history_time = &t
+}
+
+// HistoryTime returns the value of the "history_time" field in the mutation.
+func (m *PostHistoryMutation) HistoryTime() (r time.Time, exists bool) {
+	v := m.history_time
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(user.FieldNickname) {
-		fields = append(fields, user.FieldNickname)
+	return *v, true
+}
+
+// OldHistoryTime returns the old "history_time" field's value of the PostHistory entity.
+// If the PostHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostHistoryMutation) OldHistoryTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHistoryTime is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(user.FieldAddress) {
-		fields = append(fields, user.FieldAddress)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHistoryTime requires an ID field in the mutation")
 	}
-	if m.FieldCleared(user.FieldPhone) {
-		fields = append(fields, user.FieldPhone)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHistoryTime: %w", err)
 	}
-	if m.FieldCleared(user.FieldPassword) {
-		fields = append(fields, user.FieldPassword)
+	return oldValue.HistoryTime, nil
+}
+
+// ResetHistoryTime resets all changes to the "history_time" field.
+func (m *PostHistoryMutation) ResetHistoryTime() {
+	m.history_time = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *PostHistoryMutation) SetOperation(s string) {
+	m.operation = &s
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *PostHistoryMutation) Operation() (r string, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the PostHistory entity.
+// If the PostHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostHistoryMutation) OldOperation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *PostHistoryMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *PostHistoryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *PostHistoryMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the PostHistory entity.
+// If the PostHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostHistoryMutation) OldActor(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ClearActor clears the value of the "actor" field.
+func (m *PostHistoryMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[posthistory.FieldActor] = struct{}{}
+}
+
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *PostHistoryMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[posthistory.FieldActor]
+	return ok
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *PostHistoryMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, posthistory.FieldActor)
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (m *PostHistoryMutation) SetChangedFields(s []string) {
+	m.changed_fields = &s
+	m.appendchanged_fields = nil
+}
+
+// ChangedFields returns the value of the "changed_fields" field in the mutation.
+func (m *PostHistoryMutation) ChangedFields() (r []string, exists bool) {
+	v := m.changed_fields
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedFields returns the old "changed_fields" field's value of the PostHistory entity.
+// If the PostHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostHistoryMutation) OldChangedFields(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedFields is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedFields requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedFields: %w", err)
+	}
+	return oldValue.ChangedFields, nil
+}
+
+// AppendChangedFields adds s to the "changed_fields" field.
+func (m *PostHistoryMutation) AppendChangedFields(s []string) {
+	m.appendchanged_fields = append(m.appendchanged_fields, s...)
+}
+
+// AppendedChangedFields returns the list of values that were appended to the "changed_fields" field in this mutation.
+func (m *PostHistoryMutation) AppendedChangedFields() ([]string, bool) {
+	if len(m.appendchanged_fields) == 0 {
+		return nil, false
+	}
+	return m.appendchanged_fields, true
+}
+
+// ClearChangedFields clears the value of the "changed_fields" field.
+func (m *PostHistoryMutation) ClearChangedFields() {
+	m.changed_fields = nil
+	m.appendchanged_fields = nil
+	m.clearedFields[posthistory.FieldChangedFields] = struct{}{}
+}
+
+// ChangedFieldsCleared returns if the "changed_fields" field was cleared in this mutation.
+func (m *PostHistoryMutation) ChangedFieldsCleared() bool {
+	_, ok := m.clearedFields[posthistory.FieldChangedFields]
+	return ok
+}
+
+// ResetChangedFields resets all changes to the "changed_fields" field.
+func (m *PostHistoryMutation) ResetChangedFields() {
+	m.changed_fields = nil
+	m.appendchanged_fields = nil
+	delete(m.clearedFields, posthistory.FieldChangedFields)
+}
+
+// SetOldValues sets the "old_values" field.
+func (m *PostHistoryMutation) SetOldValues(value map[string]interface{}) {
+	m.old_values = &value
+}
+
+// OldValues returns the value of the "old_values" field in the mutation.
+func (m *PostHistoryMutation) OldValues() (r map[string]interface{}, exists bool) {
+	v := m.old_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldValues returns the old "old_values" field's value of the PostHistory entity.
+// If the PostHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostHistoryMutation) OldOldValues(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldValues is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(user.FieldSSOCert) {
-		fields = append(fields, user.FieldSSOCert)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldValues requires an ID field in the mutation")
 	}
-	if m.FieldCleared(user.FieldFilesCount) {
-		fields = append(fields, user.FieldFilesCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldValues: %w", err)
 	}
-	return fields
+	return oldValue.OldValues, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearOldValues clears the value of the "old_values" field.
+func (m *PostHistoryMutation) ClearOldValues() {
+	m.old_values = nil
+	m.clearedFields[posthistory.FieldOldValues] = struct{}{}
+}
+
+// OldValuesCleared returns if the "old_values" field was cleared in this mutation.
+func (m *PostHistoryMutation) OldValuesCleared() bool {
+	_, ok := m.clearedFields[posthistory.FieldOldValues]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldOptionalInt:
-		m.ClearOptionalInt()
-		return nil
-	case user.FieldNickname:
-		m.ClearNickname()
-		return nil
-	case user.FieldAddress:
-		m.ClearAddress()
-		return nil
-	case user.FieldPhone:
-		m.ClearPhone()
-		return nil
-	case user.FieldPassword:
-		m.ClearPassword()
-		return nil
-	case user.FieldSSOCert:
-		m.ClearSSOCert()
-		return nil
-	case user.FieldFilesCount:
-		m.ClearFilesCount()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+// ResetOldValues resets all changes to the "old_values" field.
+func (m *PostHistoryMutation) ResetOldValues() {
+	m.old_values = nil
+	delete(m.clearedFields, posthistory.FieldOldValues)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
-	switch name {
-	case user.FieldOptionalInt:
-		m.ResetOptionalInt()
-		return nil
-	case user.FieldAge:
-		m.ResetAge()
-		return nil
-	case user.FieldName:
-		m.ResetName()
-		return nil
-	case user.FieldLast:
-		m.ResetLast()
-		return nil
-	case user.FieldNickname:
-		m.ResetNickname()
-		return nil
-	case user.FieldAddress:
-		m.ResetAddress()
-		return nil
-	case user.FieldPhone:
-		m.ResetPhone()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case user.FieldRole:
-		m.ResetRole()
-		return nil
-	case user.FieldEmployment:
-		m.ResetEmployment()
-		return nil
-	case user.FieldSSOCert:
-		m.ResetSSOCert()
-		return nil
-	case user.FieldFilesCount:
-		m.ResetFilesCount()
-		return nil
-	}
-	return fmt.Errorf("unknown User field %s", name)
+// SetRef sets the "ref" field.
+func (m *PostHistoryMutation) SetRef(i int) {
+	m.ref = &i
+	m.addref = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 11)
-	if m.card != nil {
-		edges = append(edges, user.EdgeCard)
-	}
-	if m.pets != nil {
-		edges = append(edges, user.EdgePets)
+// Ref returns the value of the "ref" field in the mutation.
+func (m *PostHistoryMutation) Ref() (r int, exists bool) {
+	v := m.ref
+	if v == nil {
+		return
 	}
-	if m.files != nil {
-		edges = append(edges, user.EdgeFiles)
+	return *v, true
+}
+
+// OldRef returns the old "ref" field's value of the PostHistory entity.
+// If the PostHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostHistoryMutation) OldRef(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRef is only allowed on UpdateOne operations")
 	}
-	if m.groups != nil {
-		edges = append(edges, user.EdgeGroups)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRef requires an ID field in the mutation")
 	}
-	if m.friends != nil {
-		edges = append(edges, user.EdgeFriends)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRef: %w", err)
 	}
-	if m.followers != nil {
-		edges = append(edges, user.EdgeFollowers)
+	return oldValue.Ref, nil
+}
+
+// AddRef adds i to the "ref" field.
+func (m *PostHistoryMutation) AddRef(i int) {
+	if m.addref != nil {
+		*m.addref += i
+	} else {
+		m.addref = &i
 	}
-	if m.following != nil {
-		edges = append(edges, user.EdgeFollowing)
+}
+
+// AddedRef returns the value that was added to the "ref" field in this mutation.
+func (m *PostHistoryMutation) AddedRef() (r int, exists bool) {
+	v := m.addref
+	if v == nil {
+		return
 	}
-	if m.team != nil {
-		edges = append(edges, user.EdgeTeam)
+	return *v, true
+}
+
+// ResetRef resets all changes to the "ref" field.
+func (m *PostHistoryMutation) ResetRef() {
+	m.ref = nil
+	m.addref = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *PostHistoryMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PostHistoryMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
 	}
-	if m.spouse != nil {
-		edges = append(edges, user.EdgeSpouse)
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the PostHistory entity.
+// If the PostHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostHistoryMutation) OldTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
-	if m.children != nil {
-		edges = append(edges, user.EdgeChildren)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
-	if m.parent != nil {
-		edges = append(edges, user.EdgeParent)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return edges
+	return oldValue.Title, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeCard:
-		if id := m.card; id != nil {
-			return []ent.Value{*id}
-		}
-	case user.EdgePets:
-		ids := make([]ent.Value, 0, len(m.pets))
-		for id := range m.pets {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.groups))
-		for id := range m.groups {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFriends:
-		ids := make([]ent.Value, 0, len(m.friends))
-		for id := range m.friends {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFollowers:
-		ids := make([]ent.Value, 0, len(m.followers))
-		for id := range m.followers {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFollowing:
-		ids := make([]ent.Value, 0, len(m.following))
-		for id := range m.following {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case user.EdgeSpouse:
-		if id := m.spouse; id != nil {
-			return []ent.Value{*id}
-		}
-	case user.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
+// ClearTitle clears the value of the "title" field.
+func (m *PostHistoryMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[posthistory.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *PostHistoryMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[posthistory.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PostHistoryMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, posthistory.FieldTitle)
+}
+
+// Where appends a list predicates to the PostHistoryMutation builder.
+func (m *PostHistoryMutation) Where(ps ...predicate.PostHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return nil
+	m.Where(p...)
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 11)
-	if m.removedpets != nil {
-		edges = append(edges, user.EdgePets)
+// Op returns the operation name.
+func (m *PostHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostHistory).
+func (m *PostHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.history_time != nil {
+		fields = append(fields, posthistory.FieldHistoryTime)
 	}
-	if m.removedfiles != nil {
-		edges = append(edges, user.EdgeFiles)
+	if m.operation != nil {
+		fields = append(fields, posthistory.FieldOperation)
 	}
-	if m.removedgroups != nil {
-		edges = append(edges, user.EdgeGroups)
+	if m.actor != nil {
+		fields = append(fields, posthistory.FieldActor)
 	}
-	if m.removedfriends != nil {
-		edges = append(edges, user.EdgeFriends)
+	if m.changed_fields != nil {
+		fields = append(fields, posthistory.FieldChangedFields)
 	}
-	if m.removedfollowers != nil {
-		edges = append(edges, user.EdgeFollowers)
+	if m.old_values != nil {
+		fields = append(fields, posthistory.FieldOldValues)
 	}
-	if m.removedfollowing != nil {
-		edges = append(edges, user.EdgeFollowing)
+	if m.ref != nil {
+		fields = append(fields, posthistory.FieldRef)
 	}
-	if m.removedchildren != nil {
-		edges = append(edges, user.EdgeChildren)
+	if m.title != nil {
+		fields = append(fields, posthistory.FieldTitle)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case posthistory.FieldHistoryTime:
+		return m.HistoryTime()
+	case posthistory.FieldOperation:
+		return m.Operation()
+	case posthistory.FieldActor:
+		return m.Actor()
+	case posthistory.FieldChangedFields:
+		return m.ChangedFields()
+	case posthistory.FieldOldValues:
+		return m.OldValues()
+	case posthistory.FieldRef:
+		return m.Ref()
+	case posthistory.FieldTitle:
+		return m.Title()
 	}
-	return edges
+	return nil, false
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.EdgePets:
-		ids := make([]ent.Value, 0, len(m.removedpets))
-		for id := range m.removedpets {
-			ids = append(ids, id)
+	case posthistory.FieldHistoryTime:
+		return m.OldHistoryTime(ctx)
+	case posthistory.FieldOperation:
+		return m.OldOperation(ctx)
+	case posthistory.FieldActor:
+		return m.OldActor(ctx)
+	case posthistory.FieldChangedFields:
+		return m.OldChangedFields(ctx)
+	case posthistory.FieldOldValues:
+		return m.OldOldValues(ctx)
+	case posthistory.FieldRef:
+		return m.OldRef(ctx)
+	case posthistory.FieldTitle:
+		return m.OldTitle(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case posthistory.FieldHistoryTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
+		m.SetHistoryTime(v)
+		return nil
+	case posthistory.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.removedgroups))
-		for id := range m.removedgroups {
-			ids = append(ids, id)
+		m.SetOperation(v)
+		return nil
+	case posthistory.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeFriends:
-		ids := make([]ent.Value, 0, len(m.removedfriends))
-		for id := range m.removedfriends {
-			ids = append(ids, id)
+		m.SetActor(v)
+		return nil
+	case posthistory.FieldChangedFields:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeFollowers:
-		ids := make([]ent.Value, 0, len(m.removedfollowers))
-		for id := range m.removedfollowers {
-			ids = append(ids, id)
+		m.SetChangedFields(v)
+		return nil
+	case posthistory.FieldOldValues:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeFollowing:
-		ids := make([]ent.Value, 0, len(m.removedfollowing))
-		for id := range m.removedfollowing {
-			ids = append(ids, id)
+		m.SetOldValues(v)
+		return nil
+	case posthistory.FieldRef:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case user.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
+		m.SetRef(v)
+		return nil
+	case posthistory.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
+		m.SetTitle(v)
+		return nil
 	}
-	return nil
+	return fmt.Errorf("unknown PostHistory field %s", name)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 11)
-	if m.clearedcard {
-		edges = append(edges, user.EdgeCard)
-	}
-	if m.clearedpets {
-		edges = append(edges, user.EdgePets)
-	}
-	if m.clearedfiles {
-		edges = append(edges, user.EdgeFiles)
-	}
-	if m.clearedgroups {
-		edges = append(edges, user.EdgeGroups)
-	}
-	if m.clearedfriends {
-		edges = append(edges, user.EdgeFriends)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addref != nil {
+		fields = append(fields, posthistory.FieldRef)
 	}
-	if m.clearedfollowers {
-		edges = append(edges, user.EdgeFollowers)
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case posthistory.FieldRef:
+		return m.AddedRef()
 	}
-	if m.clearedfollowing {
-		edges = append(edges, user.EdgeFollowing)
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case posthistory.FieldRef:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRef(v)
+		return nil
 	}
-	if m.clearedteam {
-		edges = append(edges, user.EdgeTeam)
+	return fmt.Errorf("unknown PostHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(posthistory.FieldActor) {
+		fields = append(fields, posthistory.FieldActor)
 	}
-	if m.clearedspouse {
-		edges = append(edges, user.EdgeSpouse)
+	if m.FieldCleared(posthistory.FieldChangedFields) {
+		fields = append(fields, posthistory.FieldChangedFields)
 	}
-	if m.clearedchildren {
-		edges = append(edges, user.EdgeChildren)
+	if m.FieldCleared(posthistory.FieldOldValues) {
+		fields = append(fields, posthistory.FieldOldValues)
 	}
-	if m.clearedparent {
-		edges = append(edges, user.EdgeParent)
+	if m.FieldCleared(posthistory.FieldTitle) {
+		fields = append(fields, posthistory.FieldTitle)
 	}
-	return edges
+	return fields
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeCard:
-		return m.clearedcard
-	case user.EdgePets:
-		return m.clearedpets
-	case user.EdgeFiles:
-		return m.clearedfiles
-	case user.EdgeGroups:
-		return m.clearedgroups
-	case user.EdgeFriends:
-		return m.clearedfriends
-	case user.EdgeFollowers:
-		return m.clearedfollowers
-	case user.EdgeFollowing:
-		return m.clearedfollowing
-	case user.EdgeTeam:
-		return m.clearedteam
-	case user.EdgeSpouse:
-		return m.clearedspouse
-	case user.EdgeChildren:
-		return m.clearedchildren
-	case user.EdgeParent:
-		return m.clearedparent
-	}
-	return false
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostHistoryMutation) ClearField(name string) error {
 	switch name {
-	case user.EdgeCard:
-		m.ClearCard()
+	case posthistory.FieldActor:
+		m.ClearActor()
 		return nil
-	case user.EdgeTeam:
-		m.ClearTeam()
+	case posthistory.FieldChangedFields:
+		m.ClearChangedFields()
 		return nil
-	case user.EdgeSpouse:
-		m.ClearSpouse()
+	case posthistory.FieldOldValues:
+		m.ClearOldValues()
 		return nil
-	case user.EdgeParent:
-		m.ClearParent()
+	case posthistory.FieldTitle:
+		m.ClearTitle()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown PostHistory nullable field %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostHistoryMutation) ResetField(name string) error {
 	switch name {
-	case user.EdgeCard:
-		m.ResetCard()
-		return nil
-	case user.EdgePets:
-		m.ResetPets()
-		return nil
-	case user.EdgeFiles:
-		m.ResetFiles()
-		return nil
-	case user.EdgeGroups:
-		m.ResetGroups()
-		return nil
-	case user.EdgeFriends:
-		m.ResetFriends()
+	case posthistory.FieldHistoryTime:
+		m.ResetHistoryTime()
 		return nil
-	case user.EdgeFollowers:
-		m.ResetFollowers()
+	case posthistory.FieldOperation:
+		m.ResetOperation()
 		return nil
-	case user.EdgeFollowing:
-		m.ResetFollowing()
+	case posthistory.FieldActor:
+		m.ResetActor()
 		return nil
-	case user.EdgeTeam:
-		m.ResetTeam()
+	case posthistory.FieldChangedFields:
+		m.ResetChangedFields()
 		return nil
-	case user.EdgeSpouse:
-		m.ResetSpouse()
+	case posthistory.FieldOldValues:
+		m.ResetOldValues()
 		return nil
-	case user.EdgeChildren:
-		m.ResetChildren()
+	case posthistory.FieldRef:
+		m.ResetRef()
 		return nil
-	case user.EdgeParent:
-		m.ResetParent()
+	case posthistory.FieldTitle:
+		m.ResetTitle()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown PostHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PostHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PostHistory edge %s", name)
 }
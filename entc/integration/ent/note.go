@@ -0,0 +1,215 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/ent/note"
+)
+
+// Note is the model entity for the Note schema.
+type Note struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NoteQuery when eager-loading is set.
+	Edges         NoteEdges `json:"edges"`
+	note_children *int
+	selectValues  sql.SelectValues
+}
+
+// NoteEdges holds the relations/edges for other nodes in the graph.
+type NoteEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Note `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Note `json:"children,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// ChildrenCount holds the number of nodes that are connected to the "children" edge.
+	// The value is being populated by the NoteQuery when WithChildrenCount is set.
+	ChildrenCount int `json:"children_count,omitempty"`
+	// ChildrenAggregate holds the results of the aggregation functions that were passed to
+	// WithChildrenAggregate, in the same order, for the nodes of the "children" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	ChildrenAggregate []any `json:"children_aggregate,omitempty"`
+	namedChildren     map[string][]*Note
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteEdges) ParentOrErr() (*Note, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) ChildrenOrErr() ([]*Note, error) {
+	if e.loadedTypes[1] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Note) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case note.FieldID:
+			values[i] = new(sql.NullInt64)
+		case note.FieldTenant, note.FieldText, note.FieldSlug:
+			values[i] = new(sql.NullString)
+		case note.ForeignKeys[0]: // note_children
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Note fields.
+func (_m *Note) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case note.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case note.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				_m.Tenant = value.String
+			}
+		case note.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case note.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
+		case note.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field note_children", value)
+			} else if value.Valid {
+				_m.note_children = new(int)
+				*_m.note_children = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Note.
+// This includes values selected through modifiers, order, etc.
+func (_m *Note) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryParent queries the "parent" edge of the Note entity.
+func (_m *Note) QueryParent() *NoteQuery {
+	return NewNoteClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Note entity.
+func (_m *Note) QueryChildren() *NoteQuery {
+	return NewNoteClient(_m.config).QueryChildren(_m)
+}
+
+// Update returns a builder for updating this Note.
+// Note that you need to call Note.Unwrap() before calling this method if this Note
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Note) Update() *NoteUpdateOne {
+	return NewNoteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Note entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Note) Unwrap() *Note {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Note is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Note) String() string {
+	var builder strings.Builder
+	builder.WriteString("Note(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedChildren returns the Children named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Note) NamedChildren(name string) ([]*Note, error) {
+	if _m.Edges.namedChildren == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedChildren[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Note) appendNamedChildren(name string, edges ...*Note) {
+	if _m.Edges.namedChildren == nil {
+		_m.Edges.namedChildren = make(map[string][]*Note)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedChildren[name] = []*Note{}
+	} else {
+		_m.Edges.namedChildren[name] = append(_m.Edges.namedChildren[name], edges...)
+	}
+}
+
+// Notes is a parsable slice of Note.
+type Notes []*Note
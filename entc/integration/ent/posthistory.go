@@ -0,0 +1,189 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/ent/posthistory"
+)
+
+// PostHistory is the model entity for the PostHistory schema.
+type PostHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// HistoryTime holds the time the change was recorded.
+	HistoryTime time.Time `json:"history_time,omitempty"`
+	// Operation holds the mutation operation (ent.Op) that made the change.
+	Operation string `json:"operation,omitempty"`
+	// Actor holds the actor that made the change, as was stored in the context.
+	Actor *string `json:"actor,omitempty"`
+	// ChangedFields holds the names of the fields that were changed by the mutation.
+	ChangedFields []string `json:"changed_fields,omitempty"`
+	// OldValues holds the values of the changed fields before they were updated.
+	OldValues map[string]interface{} `json:"old_values,omitempty"`
+	// Ref holds the ID of the changed entity.
+	Ref int `json:"ref,omitempty"`
+	// Title holds the value of the "title" field.
+	Title        *string `json:"title,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PostHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case posthistory.FieldChangedFields, posthistory.FieldOldValues:
+			values[i] = new([]byte)
+		case posthistory.FieldID, posthistory.FieldRef:
+			values[i] = new(sql.NullInt64)
+		case posthistory.FieldOperation, posthistory.FieldActor, posthistory.FieldTitle:
+			values[i] = new(sql.NullString)
+		case posthistory.FieldHistoryTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PostHistory fields.
+func (_m *PostHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case posthistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case posthistory.FieldHistoryTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field history_time", values[i])
+			} else if value.Valid {
+				_m.HistoryTime = value.Time
+			}
+		case posthistory.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				_m.Operation = value.String
+			}
+		case posthistory.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = new(string)
+				*_m.Actor = value.String
+			}
+		case posthistory.FieldChangedFields:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_fields", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ChangedFields); err != nil {
+					return fmt.Errorf("unmarshal field changed_fields: %w", err)
+				}
+			}
+		case posthistory.FieldOldValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field old_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OldValues); err != nil {
+					return fmt.Errorf("unmarshal field old_values: %w", err)
+				}
+			}
+		case posthistory.FieldRef:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ref", values[i])
+			} else if value.Valid {
+				_m.Ref = int(value.Int64)
+			}
+		case posthistory.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = new(string)
+				*_m.Title = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PostHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *PostHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PostHistory.
+// Note that you need to call PostHistory.Unwrap() before calling this method if this PostHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PostHistory) Update() *PostHistoryUpdateOne {
+	return NewPostHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PostHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PostHistory) Unwrap() *PostHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PostHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PostHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("PostHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("history_time=")
+	builder.WriteString(_m.HistoryTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(_m.Operation)
+	builder.WriteString(", ")
+	if v := _m.Actor; v != nil {
+		builder.WriteString("actor=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("changed_fields=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChangedFields))
+	builder.WriteString(", ")
+	builder.WriteString("old_values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OldValues))
+	builder.WriteString(", ")
+	builder.WriteString("ref=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ref))
+	builder.WriteString(", ")
+	if v := _m.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PostHistories is a parsable slice of PostHistory.
+type PostHistories []*PostHistory
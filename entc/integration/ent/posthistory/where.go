@@ -0,0 +1,414 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package posthistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLTE(FieldID, id))
+}
+
+// HistoryTime applies equality check predicate on the "history_time" field. It's identical to HistoryTimeEQ.
+func HistoryTime(v time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldHistoryTime, v))
+}
+
+// Operation applies equality check predicate on the "operation" field. It's identical to OperationEQ.
+func Operation(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldOperation, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// Ref applies equality check predicate on the "ref" field. It's identical to RefEQ.
+func Ref(v int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldRef, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldTitle, v))
+}
+
+// HistoryTimeEQ applies the EQ predicate on the "history_time" field.
+func HistoryTimeEQ(v time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldHistoryTime, v))
+}
+
+// HistoryTimeNEQ applies the NEQ predicate on the "history_time" field.
+func HistoryTimeNEQ(v time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNEQ(FieldHistoryTime, v))
+}
+
+// HistoryTimeIn applies the In predicate on the "history_time" field.
+func HistoryTimeIn(vs ...time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIn(FieldHistoryTime, vs...))
+}
+
+// HistoryTimeNotIn applies the NotIn predicate on the "history_time" field.
+func HistoryTimeNotIn(vs ...time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotIn(FieldHistoryTime, vs...))
+}
+
+// HistoryTimeGT applies the GT predicate on the "history_time" field.
+func HistoryTimeGT(v time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGT(FieldHistoryTime, v))
+}
+
+// HistoryTimeGTE applies the GTE predicate on the "history_time" field.
+func HistoryTimeGTE(v time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGTE(FieldHistoryTime, v))
+}
+
+// HistoryTimeLT applies the LT predicate on the "history_time" field.
+func HistoryTimeLT(v time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLT(FieldHistoryTime, v))
+}
+
+// HistoryTimeLTE applies the LTE predicate on the "history_time" field.
+func HistoryTimeLTE(v time.Time) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLTE(FieldHistoryTime, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// OperationGT applies the GT predicate on the "operation" field.
+func OperationGT(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGT(FieldOperation, v))
+}
+
+// OperationGTE applies the GTE predicate on the "operation" field.
+func OperationGTE(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGTE(FieldOperation, v))
+}
+
+// OperationLT applies the LT predicate on the "operation" field.
+func OperationLT(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLT(FieldOperation, v))
+}
+
+// OperationLTE applies the LTE predicate on the "operation" field.
+func OperationLTE(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLTE(FieldOperation, v))
+}
+
+// OperationContains applies the Contains predicate on the "operation" field.
+func OperationContains(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldContains(FieldOperation, v))
+}
+
+// OperationHasPrefix applies the HasPrefix predicate on the "operation" field.
+func OperationHasPrefix(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldHasPrefix(FieldOperation, v))
+}
+
+// OperationHasSuffix applies the HasSuffix predicate on the "operation" field.
+func OperationHasSuffix(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldHasSuffix(FieldOperation, v))
+}
+
+// OperationEqualFold applies the EqualFold predicate on the "operation" field.
+func OperationEqualFold(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEqualFold(FieldOperation, v))
+}
+
+// OperationContainsFold applies the ContainsFold predicate on the "operation" field.
+func OperationContainsFold(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldContainsFold(FieldOperation, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIsNull(FieldActor))
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotNull(FieldActor))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldContainsFold(FieldActor, v))
+}
+
+// ChangedFieldsIsNil applies the IsNil predicate on the "changed_fields" field.
+func ChangedFieldsIsNil() predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIsNull(FieldChangedFields))
+}
+
+// ChangedFieldsNotNil applies the NotNil predicate on the "changed_fields" field.
+func ChangedFieldsNotNil() predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotNull(FieldChangedFields))
+}
+
+// OldValuesIsNil applies the IsNil predicate on the "old_values" field.
+func OldValuesIsNil() predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIsNull(FieldOldValues))
+}
+
+// OldValuesNotNil applies the NotNil predicate on the "old_values" field.
+func OldValuesNotNil() predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotNull(FieldOldValues))
+}
+
+// RefEQ applies the EQ predicate on the "ref" field.
+func RefEQ(v int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldRef, v))
+}
+
+// RefNEQ applies the NEQ predicate on the "ref" field.
+func RefNEQ(v int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNEQ(FieldRef, v))
+}
+
+// RefIn applies the In predicate on the "ref" field.
+func RefIn(vs ...int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIn(FieldRef, vs...))
+}
+
+// RefNotIn applies the NotIn predicate on the "ref" field.
+func RefNotIn(vs ...int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotIn(FieldRef, vs...))
+}
+
+// RefGT applies the GT predicate on the "ref" field.
+func RefGT(v int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGT(FieldRef, v))
+}
+
+// RefGTE applies the GTE predicate on the "ref" field.
+func RefGTE(v int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGTE(FieldRef, v))
+}
+
+// RefLT applies the LT predicate on the "ref" field.
+func RefLT(v int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLT(FieldRef, v))
+}
+
+// RefLTE applies the LTE predicate on the "ref" field.
+func RefLTE(v int) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLTE(FieldRef, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.PostHistory {
+	return predicate.PostHistory(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PostHistory) predicate.PostHistory {
+	return predicate.PostHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PostHistory) predicate.PostHistory {
+	return predicate.PostHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PostHistory) predicate.PostHistory {
+	return predicate.PostHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,574 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/ent/posthistory"
+	"entgo.io/ent/schema/field"
+)
+
+// PostHistoryCreate is the builder for creating a PostHistory entity.
+type PostHistoryCreate struct {
+	config
+	mutation *PostHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHistoryTime sets the "history_time" field.
+func (_c *PostHistoryCreate) SetHistoryTime(v time.Time) *PostHistoryCreate {
+	_c.mutation.SetHistoryTime(v)
+	return _c
+}
+
+// SetOperation sets the "operation" field.
+func (_c *PostHistoryCreate) SetOperation(v string) *PostHistoryCreate {
+	_c.mutation.SetOperation(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *PostHistoryCreate) SetActor(v string) *PostHistoryCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *PostHistoryCreate) SetNillableActor(v *string) *PostHistoryCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (_c *PostHistoryCreate) SetChangedFields(v []string) *PostHistoryCreate {
+	_c.mutation.SetChangedFields(v)
+	return _c
+}
+
+// SetOldValues sets the "old_values" field.
+func (_c *PostHistoryCreate) SetOldValues(v map[string]interface{}) *PostHistoryCreate {
+	_c.mutation.SetOldValues(v)
+	return _c
+}
+
+// SetRef sets the "ref" field.
+func (_c *PostHistoryCreate) SetRef(v int) *PostHistoryCreate {
+	_c.mutation.SetRef(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *PostHistoryCreate) SetTitle(v string) *PostHistoryCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *PostHistoryCreate) SetNillableTitle(v *string) *PostHistoryCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PostHistoryMutation object of the builder.
+func (_c *PostHistoryCreate) Mutation() *PostHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the PostHistory in the database.
+func (_c *PostHistoryCreate) Save(ctx context.Context) (*PostHistory, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostHistoryCreate) SaveX(ctx context.Context) *PostHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostHistoryCreate) check() error {
+	if _, ok := _c.mutation.HistoryTime(); !ok {
+		return &ValidationError{Name: "history_time", err: errors.New(`ent: missing required field "PostHistory.history_time"`)}
+	}
+	if _, ok := _c.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "PostHistory.operation"`)}
+	}
+	if _, ok := _c.mutation.Ref(); !ok {
+		return &ValidationError{Name: "ref", err: errors.New(`ent: missing required field "PostHistory.ref"`)}
+	}
+	return nil
+}
+
+func (_c *PostHistoryCreate) sqlSave(ctx context.Context) (*PostHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostHistoryCreate) createSpec() (*PostHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(posthistory.Table, sqlgraph.NewFieldSpec(posthistory.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.HistoryTime(); ok {
+		_spec.SetField(posthistory.FieldHistoryTime, field.TypeTime, value)
+		_node.HistoryTime = value
+	}
+	if value, ok := _c.mutation.Operation(); ok {
+		_spec.SetField(posthistory.FieldOperation, field.TypeString, value)
+		_node.Operation = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(posthistory.FieldActor, field.TypeString, value)
+		_node.Actor = &value
+	}
+	if value, ok := _c.mutation.ChangedFields(); ok {
+		_spec.SetField(posthistory.FieldChangedFields, field.TypeJSON, value)
+		_node.ChangedFields = value
+	}
+	if value, ok := _c.mutation.OldValues(); ok {
+		_spec.SetField(posthistory.FieldOldValues, field.TypeJSON, value)
+		_node.OldValues = value
+	}
+	if value, ok := _c.mutation.Ref(); ok {
+		_spec.SetField(posthistory.FieldRef, field.TypeInt, value)
+		_node.Ref = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(posthistory.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostHistory.Create().
+//		SetHistoryTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostHistoryUpsert) {
+//			SetHistoryTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostHistoryCreate) OnConflict(opts ...sql.ConflictOption) *PostHistoryUpsertOne {
+	_c.conflict = opts
+	return &PostHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostHistoryCreate) OnConflictColumns(columns ...string) *PostHistoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PostHistoryUpsertOne is the builder for "upsert"-ing
+	//  one PostHistory node.
+	PostHistoryUpsertOne struct {
+		create *PostHistoryCreate
+	}
+
+	// PostHistoryUpsert is the "OnConflict" setter.
+	PostHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PostHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostHistoryUpsertOne) UpdateNewValues() *PostHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.HistoryTime(); exists {
+			s.SetIgnore(posthistory.FieldHistoryTime)
+		}
+		if _, exists := u.create.mutation.Operation(); exists {
+			s.SetIgnore(posthistory.FieldOperation)
+		}
+		if _, exists := u.create.mutation.Actor(); exists {
+			s.SetIgnore(posthistory.FieldActor)
+		}
+		if _, exists := u.create.mutation.ChangedFields(); exists {
+			s.SetIgnore(posthistory.FieldChangedFields)
+		}
+		if _, exists := u.create.mutation.OldValues(); exists {
+			s.SetIgnore(posthistory.FieldOldValues)
+		}
+		if _, exists := u.create.mutation.Ref(); exists {
+			s.SetIgnore(posthistory.FieldRef)
+		}
+		if _, exists := u.create.mutation.Title(); exists {
+			s.SetIgnore(posthistory.FieldTitle)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostHistoryUpsertOne) Ignore() *PostHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostHistoryUpsertOne) DoNothing() *PostHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostHistoryCreate.OnConflict
+// documentation for more info.
+func (u *PostHistoryUpsertOne) Update(set func(*PostHistoryUpsert)) *PostHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PostHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostHistoryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostHistoryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostHistoryCreateBulk is the builder for creating many PostHistory entities in bulk.
+type PostHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*PostHistoryCreate
+	load     bool
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PostHistory entities in the database.
+func (_c *PostHistoryCreateBulk) Save(ctx context.Context) ([]*PostHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PostHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					if _c.load {
+						spec.Load = &sqlgraph.LoadSpec{}
+						// Hooks may rely on the IDs of the created entities.
+						for _, b := range _c.builders {
+							spec.Load.Returning = spec.Load.Returning || len(b.hooks) > 0
+						}
+					}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostHistoryCreateBulk) SaveX(ctx context.Context) []*PostHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Load loads the PostHistory entities into the database in bulk. Unlike Save, the IDs of the
+// entities are not read back from the database, unless they are required by hooks or edges.
+// In PostgreSQL, the entities are copied into their table using the COPY command, if it is
+// supported by the driver (see sql.Copier). In other cases, they are inserted in chunks that
+// respect the parameter limit of the dialect. Hooks are executed for each mutation, as in Save.
+func (_c *PostHistoryCreateBulk) Load(ctx context.Context) error {
+	_c.load = true
+	return _c.Exec(ctx)
+}
+
+// LoadX is like Load, but panics if an error occurs.
+func (_c *PostHistoryCreateBulk) LoadX(ctx context.Context) {
+	if err := _c.Load(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostHistoryUpsert) {
+//			SetHistoryTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostHistoryUpsertBulk {
+	_c.conflict = opts
+	return &PostHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostHistoryCreateBulk) OnConflictColumns(columns ...string) *PostHistoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// PostHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostHistory nodes.
+type PostHistoryUpsertBulk struct {
+	create *PostHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostHistoryUpsertBulk) UpdateNewValues() *PostHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.HistoryTime(); exists {
+				s.SetIgnore(posthistory.FieldHistoryTime)
+			}
+			if _, exists := b.mutation.Operation(); exists {
+				s.SetIgnore(posthistory.FieldOperation)
+			}
+			if _, exists := b.mutation.Actor(); exists {
+				s.SetIgnore(posthistory.FieldActor)
+			}
+			if _, exists := b.mutation.ChangedFields(); exists {
+				s.SetIgnore(posthistory.FieldChangedFields)
+			}
+			if _, exists := b.mutation.OldValues(); exists {
+				s.SetIgnore(posthistory.FieldOldValues)
+			}
+			if _, exists := b.mutation.Ref(); exists {
+				s.SetIgnore(posthistory.FieldRef)
+			}
+			if _, exists := b.mutation.Title(); exists {
+				s.SetIgnore(posthistory.FieldTitle)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostHistoryUpsertBulk) Ignore() *PostHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostHistoryUpsertBulk) DoNothing() *PostHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostHistoryUpsertBulk) Update(set func(*PostHistoryUpsert)) *PostHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PostHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -63,12 +63,18 @@ type License func(*sql.Selector)
 // Node is the predicate function for node builders.
 type Node func(*sql.Selector)
 
+// Note is the predicate function for note builders.
+type Note func(*sql.Selector)
+
 // PC is the predicate function for pc builders.
 type PC func(*sql.Selector)
 
 // Pet is the predicate function for pet builders.
 type Pet func(*sql.Selector)
 
+// Post is the predicate function for post builders.
+type Post func(*sql.Selector)
+
 // Spec is the predicate function for spec builders.
 type Spec func(*sql.Selector)
 
@@ -77,3 +83,6 @@ type Task func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// PostHistory is the predicate function for posthistory builders.
+type PostHistory func(*sql.Selector)
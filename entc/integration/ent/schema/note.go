@@ -0,0 +1,39 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Note holds the schema definition for the Note entity.
+type Note struct {
+	ent.Schema
+}
+
+// Fields of the Note.
+func (Note) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tenant").
+			Immutable().
+			Annotations(entsql.TenantKey()),
+		field.String("text"),
+		field.String("slug").
+			Optional().
+			Unique(),
+	}
+}
+
+// Edges of the Note.
+func (Note) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Note.Type).
+			From("parent").
+			Unique(),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 
@@ -43,6 +44,11 @@ func (Task) Fields() []ent.Field {
 		field.String("op").
 			MaxLen(45).
 			Default(""),
+		field.Int("version").
+			Default(1).
+			Annotations(
+				entsql.OptimisticLock(),
+			),
 	}
 }
 
@@ -33,7 +33,14 @@ type SpecEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [1]bool
-	namedCard   map[string][]*Card
+	// CardCount holds the number of nodes that are connected to the "card" edge.
+	// The value is being populated by the SpecQuery when WithCardCount is set.
+	CardCount int `json:"card_count,omitempty"`
+	// CardAggregate holds the results of the aggregation functions that were passed to
+	// WithCardAggregate, in the same order, for the nodes of the "card" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	CardAggregate []any `json:"card_aggregate,omitempty"`
+	namedCard     map[string][]*Card
 }
 
 // CardOrErr returns the Card value or an error if the edge
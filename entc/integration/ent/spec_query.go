@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -25,13 +27,17 @@ import (
 // SpecQuery is the builder for querying Spec entities.
 type SpecQuery struct {
 	config
-	ctx           *QueryContext
-	order         []spec.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Spec
-	withCard      *CardQuery
-	modifiers     []func(*sql.Selector)
-	withNamedCard map[string]*CardQuery
+	ctx        *QueryContext
+	order      []spec.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Spec
+	withCard   *CardQuery
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch             func(context.Context, *loader) ([]*Spec, error)
+	withCardCount     *CardQuery
+	withCardAggregate []AggregateFunc
+	modifiers         []func(*sql.Selector)
+	withNamedCard     map[string]*CardQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -197,6 +203,9 @@ func (_q *SpecQuery) All(ctx context.Context) ([]*Spec, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*Spec, *SpecQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*Spec, *SpecQuery](l, qr)
+	}
 	return withInterceptors[[]*Spec](ctx, _q, qr, _q.inters)
 }
 
@@ -284,9 +293,11 @@ func (_q *SpecQuery) Clone() *SpecQuery {
 		predicates: append([]predicate.Spec{}, _q.predicates...),
 		withCard:   _q.withCard.Clone(),
 		// clone intermediate query.
-		sql:       _q.sql.Clone(),
-		path:      _q.path,
-		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+		sql:               _q.sql.Clone(),
+		path:              _q.path,
+		modifiers:         append([]func(*sql.Selector){}, _q.modifiers...),
+		withCardCount:     _q.withCardCount.Clone(),
+		withCardAggregate: append([]AggregateFunc{}, _q.withCardAggregate...),
 	}
 }
 
@@ -373,6 +384,9 @@ func (_q *SpecQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Spec, e
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -389,6 +403,19 @@ func (_q *SpecQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Spec, e
 			return nil, err
 		}
 	}
+	if query := _q.withCardCount; query != nil {
+		if err := _q.aggregateCard(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *Spec, v []any) { n.Edges.CardCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withCardAggregate; len(fns) > 0 {
+		query := (&CardClient{config: _q.config}).Query()
+		if err := _q.aggregateCard(ctx, query, nodes, fns,
+			func(n *Spec, v []any) { n.Edges.CardAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedCard {
 		if err := _q.loadCard(ctx, query, nodes,
 			func(n *Spec) { n.appendNamedCard(name) },
@@ -466,6 +493,9 @@ func (_q *SpecQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -531,6 +561,7 @@ func (_q *SpecQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -548,6 +579,234 @@ func (_q *SpecQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *SpecQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.withCard == nil && _q.withNamedCard == nil && _q.modifiers == nil
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *SpecQuery) batchAll(ctx context.Context, l *loader) ([]*Spec, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*Spec, 0, len(nodes)), nodes...), nil
+}
+
+// WithCardCount tells the query-builder to load the number of nodes that are connected to the
+// "card" edge into the CardCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *SpecQuery) WithCardCount(opts ...func(*CardQuery)) *SpecQuery {
+	query := (&CardClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCardCount = query
+	return _q
+}
+
+// WithCardAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "card" edge, and to load their results into the CardAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.Spec.Query().
+//		WithCardAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *SpecQuery) WithCardAggregate(fns ...AggregateFunc) *SpecQuery {
+	_q.withCardAggregate = append(_q.withCardAggregate, fns...)
+	return _q
+}
+
+func (_q *SpecQuery) aggregateCard(ctx context.Context, query *CardQuery, nodes []*Spec, fns []AggregateFunc, assign func(*Spec, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Spec, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(spec.Table, spec.FieldID),
+		sqlgraph.To(card.Table, card.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, spec.CardTable, spec.CardPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range card.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*Card).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "card" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &Card{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case card.FieldID:
+				result[i] = n.ID
+			case card.FieldCreateTime:
+				result[i] = n.CreateTime
+			case card.FieldUpdateTime:
+				result[i] = n.UpdateTime
+			case card.FieldBalance:
+				result[i] = n.Balance
+			case card.FieldNumber:
+				result[i] = n.Number
+			case card.FieldName:
+				result[i] = n.Name
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// Iter executes the query and returns an iterator that streams its Specs from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.Spec.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *SpecQuery) Iter(ctx context.Context) iter.Seq2[*Spec, error] {
+	return func(yield func(*Spec, error) bool) {
+		if _q.withCard != nil || len(_q.withNamedCard) > 0 || _q.withCardCount != nil || len(_q.withCardAggregate) > 0 {
+			yield(nil, errors.New("ent: eager-loading is not supported by SpecQuery.Iter, use EachBatch instead"))
+			return
+		}
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &Spec{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of Specs.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.Spec.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.Spec) error {
+//			// ...
+//		})
+func (_q *SpecQuery) EachBatch(ctx context.Context, size int, fn func([]*Spec) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.withNamedCard = _q.withNamedCard
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(spec.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.Spec(sql.FieldGT(spec.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -594,6 +853,62 @@ func (_q *SpecQuery) WithNamedCard(name string, opts ...func(*CardQuery)) *SpecQ
 	return _q
 }
 
+// SpecPage is a page of Specs returned by SpecQuery.Paginate.
+type SpecPage struct {
+	// Nodes holds the Specs of the page.
+	Nodes []*Spec
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more Specs after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" Specs that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.Spec.Query().
+//		Order(spec.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.Spec.Query().
+//		Order(spec.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *SpecQuery) Paginate(ctx context.Context, after *Cursor, first int) (*SpecPage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("spec: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		spec.FieldID,
+	)
+	nodes, err := _q.Clone().
+		Order(spec.ByID()).
+		Where(predicate.Spec(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &SpecPage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // SpecGroupBy is the group-by builder for Spec entities.
 type SpecGroupBy struct {
 	selector
@@ -675,6 +990,9 @@ func (ss *SpecSelect) sqlScan(ctx context.Context, root *SpecQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ss.driver.Query(ctx, query, args, rows); err != nil {
@@ -40,7 +40,9 @@ type Task struct {
 	// OrderOption holds the value of the "order_option" field.
 	OrderOption int `json:"order_option,omitempty"`
 	// Op holds the value of the "op" field.
-	Op           string `json:"op,omitempty"`
+	Op string `json:"op,omitempty"`
+	// Version holds the value of the "version" field.
+	Version      int `json:"version,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -51,7 +53,7 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case enttask.FieldPriorities:
 			values[i] = new([]byte)
-		case enttask.FieldID, enttask.FieldPriority, enttask.FieldOrder, enttask.FieldOrderOption:
+		case enttask.FieldID, enttask.FieldPriority, enttask.FieldOrder, enttask.FieldOrderOption, enttask.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case enttask.FieldName, enttask.FieldOwner, enttask.FieldOp:
 			values[i] = new(sql.NullString)
@@ -129,6 +131,12 @@ func (_m *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Op = value.String
 			}
+		case enttask.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -190,6 +198,9 @@ func (_m *Task) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("op=")
 	builder.WriteString(_m.Op)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
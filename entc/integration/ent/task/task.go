@@ -34,6 +34,8 @@ const (
 	FieldOrderOption = "order_option"
 	// FieldOp holds the string denoting the op field in the database.
 	FieldOp = "op"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// Table holds the table name of the task in the database.
 	Table = "tasks"
 )
@@ -48,6 +50,7 @@ var Columns = []string{
 	FieldOrder,
 	FieldOrderOption,
 	FieldOp,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,6 +77,8 @@ var (
 	DefaultOp string
 	// OpValidator is a validator for the "op" field. It is called by the builders before save.
 	OpValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 )
 
 // OrderOption defines the ordering options for the Task queries.
@@ -119,4 +124,9 @@ func ByOp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOp, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // comment from another template.
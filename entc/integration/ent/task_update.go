@@ -22,9 +22,13 @@ import (
 // TaskUpdate is the builder for updating Task entities.
 type TaskUpdate struct {
 	config
-	hooks     []Hook
-	mutation  *TaskMutation
-	modifiers []func(*sql.UpdateBuilder)
+	hooks       []Hook
+	mutation    *TaskMutation
+	skipVersion bool
+	modifiers   []func(*sql.UpdateBuilder)
+	fields      []string
+	returning   bool
+	nodes       []*Task
 }
 
 // Where appends a list predicates to the TaskUpdate builder.
@@ -174,6 +178,27 @@ func (_u *TaskUpdate) SetNillableOp(v *string) *TaskUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *TaskUpdate) SetVersion(v int) *TaskUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableVersion(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TaskUpdate) AddVersion(v int) *TaskUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdate) Mutation() *TaskMutation {
 	return _u.mutation
@@ -221,12 +246,46 @@ func (_u *TaskUpdate) check() error {
 	return nil
 }
 
+// SkipVersion skips incrementing the "version" field of the updated Tasks.
+// By default, bulk updates increment the version of all rows they update.
+func (_u *TaskUpdate) SkipVersion() *TaskUpdate {
+	_u.skipVersion = true
+	return _u
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *TaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskUpdate {
 	_u.modifiers = append(_u.modifiers, modifiers...)
 	return _u
 }
 
+// Select allows selecting one or more fields (columns) of the returned entities.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskUpdate) Select(field string, fields ...string) *TaskUpdate {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// SaveReturning executes the query and returns the updated Tasks. In PostgreSQL and SQLite,
+// the entities are read from the updated rows using the RETURNING clause (OUTPUT in SQL Server). In MySQL,
+// the matched rows are locked, updated and selected again in one transaction.
+func (_u *TaskUpdate) SaveReturning(ctx context.Context) ([]*Task, error) {
+	_u.returning, _u.nodes = true, nil
+	if _, err := _u.Save(ctx); err != nil {
+		return nil, err
+	}
+	return _u.nodes, nil
+}
+
+// SaveReturningX is like SaveReturning, but panics if an error occurs.
+func (_u *TaskUpdate) SaveReturningX(ctx context.Context) []*Task {
+	nodes, err := _u.SaveReturning(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -284,7 +343,44 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.GetOp(); ok {
 		_spec.SetField(enttask.FieldOp, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(enttask.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(enttask.FieldVersion, field.TypeInt, value)
+	}
+	if !_u.skipVersion {
+		_, set := _u.mutation.Version()
+		_, added := _u.mutation.AddedVersion()
+		if !set && !added {
+			_spec.AddField(enttask.FieldVersion, field.TypeInt, 1)
+		}
+	}
 	_spec.AddModifiers(_u.modifiers...)
+	if _u.returning {
+		_spec.Node.Columns = enttask.Columns
+		if fields := _u.fields; len(fields) > 0 {
+			_spec.Node.Columns = make([]string, 0, len(fields)+1)
+			_spec.Node.Columns = append(_spec.Node.Columns, enttask.FieldID)
+			for _, f := range fields {
+				if !enttask.ValidColumn(f) {
+					return 0, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				}
+				if f != enttask.FieldID {
+					_spec.Node.Columns = append(_spec.Node.Columns, f)
+				}
+			}
+		}
+		_spec.Returning = true
+		_spec.ScanValues = func(columns []string) ([]any, error) {
+			return (*Task).scanValues(nil, columns)
+		}
+		_spec.Assign = func(columns []string, values []any) error {
+			node := &Task{config: _u.config}
+			_u.nodes = append(_u.nodes, node)
+			return node.assignValues(columns, values)
+		}
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{enttask.Label}
@@ -300,10 +396,11 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // TaskUpdateOne is the builder for updating a single Task entity.
 type TaskUpdateOne struct {
 	config
-	fields    []string
-	hooks     []Hook
-	mutation  *TaskMutation
-	modifiers []func(*sql.UpdateBuilder)
+	fields          []string
+	hooks           []Hook
+	mutation        *TaskMutation
+	expectedVersion *int
+	modifiers       []func(*sql.UpdateBuilder)
 }
 
 // SetPriority sets the "priority" field.
@@ -447,6 +544,27 @@ func (_u *TaskUpdateOne) SetNillableOp(v *string) *TaskUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *TaskUpdateOne) SetVersion(v int) *TaskUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableVersion(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TaskUpdateOne) AddVersion(v int) *TaskUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdateOne) Mutation() *TaskMutation {
 	return _u.mutation
@@ -507,6 +625,14 @@ func (_u *TaskUpdateOne) check() error {
 	return nil
 }
 
+// SetExpectedVersion sets the expected value of the "version" field of the updated Task. The row is
+// updated only if its version was not changed from the given one. By default, the expected version is
+// the one of the loaded entity, or the current version in the database when using UpdateOneID.
+func (_u *TaskUpdateOne) SetExpectedVersion(v int) *TaskUpdateOne {
+	_u.expectedVersion = &v
+	return _u
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *TaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskUpdateOne {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -587,6 +713,22 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 	if value, ok := _u.mutation.GetOp(); ok {
 		_spec.SetField(enttask.FieldOp, field.TypeString, value)
 	}
+	// Update the row only if its version was not changed since it was loaded.
+	var version int
+	if v := _u.expectedVersion; v != nil {
+		version = *v
+	} else if version, err = _u.mutation.OldVersion(ctx); err != nil {
+		return nil, err
+	}
+	_spec.Version = sqlgraph.NewFieldSpec(enttask.FieldVersion, field.TypeInt)
+	_spec.Version.Value = version
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(enttask.FieldVersion, field.TypeInt, value)
+	} else if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.SetField(enttask.FieldVersion, field.TypeInt, version+value)
+	} else {
+		_spec.SetField(enttask.FieldVersion, field.TypeInt, version+1)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Task{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -594,6 +736,8 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{enttask.Label}
+		} else if _, ok := err.(*sqlgraph.StaleObjectError); ok {
+			err = &StaleObjectError{label: enttask.Label, wrap: err}
 		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
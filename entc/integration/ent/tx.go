@@ -13,6 +13,7 @@ import (
 	"sync"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 )
 
 // Tx is a transactional client that is created by calling Client.Tx().
@@ -46,16 +47,22 @@ type Tx struct {
 	License *LicenseClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
+	// Note is the client for interacting with the Note builders.
+	Note *NoteClient
 	// PC is the client for interacting with the PC builders.
 	PC *PCClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
+	// Post is the client for interacting with the Post builders.
+	Post *PostClient
 	// Spec is the client for interacting with the Spec builders.
 	Spec *SpecClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// PostHistory is the client for interacting with the PostHistory builders.
+	PostHistory *PostHistoryClient
 
 	// lazily loaded.
 	client     *Client
@@ -101,8 +108,12 @@ func (f CommitFunc) Commit(ctx context.Context, tx *Tx) error {
 // Commit commits the transaction.
 func (tx *Tx) Commit() error {
 	txDriver := tx.config.driver.(*txDriver)
-	var fn Committer = CommitFunc(func(context.Context, *Tx) error {
-		return txDriver.tx.Commit()
+	// The commit hooks of nested transactions are executed by their enclosing transaction.
+	if txDriver.parent != nil {
+		return txDriver.release(tx.ctx)
+	}
+	var fn Committer = CommitFunc(func(ctx context.Context, _ *Tx) error {
+		return txDriver.commit(ctx)
 	})
 	txDriver.mu.Lock()
 	hooks := append([]CommitHook(nil), txDriver.onCommit...)
@@ -157,8 +168,8 @@ func (f RollbackFunc) Rollback(ctx context.Context, tx *Tx) error {
 // Rollback rollbacks the transaction.
 func (tx *Tx) Rollback() error {
 	txDriver := tx.config.driver.(*txDriver)
-	var fn Rollbacker = RollbackFunc(func(context.Context, *Tx) error {
-		return txDriver.tx.Rollback()
+	var fn Rollbacker = RollbackFunc(func(ctx context.Context, _ *Tx) error {
+		return txDriver.rollback(ctx)
 	})
 	txDriver.mu.Lock()
 	hooks := append([]RollbackHook(nil), txDriver.onRollback...)
@@ -201,11 +212,14 @@ func (tx *Tx) init() {
 	tx.Item = NewItemClient(tx.config)
 	tx.License = NewLicenseClient(tx.config)
 	tx.Node = NewNodeClient(tx.config)
+	tx.Note = NewNoteClient(tx.config)
 	tx.PC = NewPCClient(tx.config)
 	tx.Pet = NewPetClient(tx.config)
+	tx.Post = NewPostClient(tx.config)
 	tx.Spec = NewSpecClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.PostHistory = NewPostHistoryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -228,10 +242,20 @@ type txDriver struct {
 	mu         sync.Mutex
 	onCommit   []CommitHook
 	onRollback []RollbackHook
+	// savepoint holds the savepoint name of a nested transaction.
+	savepoint string
+	// savepoints counts the transactions that were nested in the transaction.
+	savepoints int
+	// parent is the enclosing transaction of a nested transaction.
+	parent *txDriver
 }
 
 // newTx creates a new transactional driver.
 func newTx(ctx context.Context, drv dialect.Driver) (*txDriver, error) {
+	// Transactions that are started from a transactional client are nested in it.
+	if tx, ok := drv.(*txDriver); ok {
+		return tx.nested(ctx)
+	}
 	tx, err := drv.Tx(ctx)
 	if err != nil {
 		return nil, err
@@ -269,6 +293,16 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 
 var _ dialect.Driver = (*txDriver)(nil)
 
+// Copy calls tx.Copy if it is supported by the underlying transaction.
+// It is used by the create-bulk builders to load entities in bulk.
+func (tx *txDriver) Copy(ctx context.Context, spec *sql.CopySpec) (int64, error) {
+	cp, ok := tx.tx.(sql.Copier)
+	if !ok {
+		return 0, sql.ErrCopyNotSupported
+	}
+	return cp.Copy(ctx, spec)
+}
+
 // ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
 // See, database/sql#Tx.ExecContext for more information.
 func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
@@ -292,3 +326,76 @@ func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any)
 	}
 	return q.QueryContext(ctx, query, args...)
 }
+
+// Tx returns a transactional client that is nested in the transaction using a savepoint.
+// Committing the nested transaction releases its savepoint, and rolling it back discards
+// only the changes that were made in it. The rollback hooks of the nested transaction are
+// executed when it is rolled back. When it is committed, its commit and rollback hooks are
+// moved to the enclosing transaction, because its changes are committed or rolled back with
+// it. i.e. commit hooks are executed only when the outermost transaction is committed.
+//
+//	nested, err := tx.Tx(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	if err := nested.User.Create().SetName("a8m").Exec(ctx); err != nil {
+//		// Only the changes of the nested transaction are rolled back.
+//		return nested.Rollback()
+//	}
+//	return nested.Commit()
+func (tx *Tx) Tx(ctx context.Context) (*Tx, error) {
+	return tx.Client().Tx(ctx)
+}
+
+// nested starts a new transaction that is nested in the transaction by creating a savepoint.
+func (tx *txDriver) nested(ctx context.Context) (*txDriver, error) {
+	sp, ok := tx.tx.(sql.Savepointer)
+	if !ok {
+		return nil, fmt.Errorf("ent: savepoints are not supported by %T", tx.tx)
+	}
+	tx.mu.Lock()
+	tx.savepoints++
+	name := fmt.Sprintf("%s_%d", tx.savepoint, tx.savepoints)
+	if tx.savepoint == "" {
+		name = fmt.Sprintf("ent_savepoint_%d", tx.savepoints)
+	}
+	tx.mu.Unlock()
+	if err := sp.Savepoint(ctx, name); err != nil {
+		return nil, err
+	}
+	return &txDriver{tx: tx.tx, drv: tx.drv, savepoint: name, parent: tx}, nil
+}
+
+// commit commits the transaction. Nested transactions are committed using release.
+func (tx *txDriver) commit(context.Context) error {
+	return tx.tx.Commit()
+}
+
+// release releases the savepoint of a nested transaction, and moves its hooks to
+// the enclosing transaction, to be executed when the enclosing transaction completes.
+func (tx *txDriver) release(ctx context.Context) error {
+	if err := tx.tx.(sql.Savepointer).Release(ctx, tx.savepoint); err != nil {
+		return err
+	}
+	tx.mu.Lock()
+	onCommit, onRollback := tx.onCommit, tx.onRollback
+	tx.onCommit, tx.onRollback = nil, nil
+	tx.mu.Unlock()
+	tx.parent.mu.Lock()
+	tx.parent.onCommit = append(tx.parent.onCommit, onCommit...)
+	tx.parent.onRollback = append(tx.parent.onRollback, onRollback...)
+	tx.parent.mu.Unlock()
+	return nil
+}
+
+// rollback rolls back the transaction, or rolls back a nested transaction to its savepoint.
+func (tx *txDriver) rollback(ctx context.Context) error {
+	if tx.savepoint == "" {
+		return tx.tx.Rollback()
+	}
+	sp := tx.tx.(sql.Savepointer)
+	if err := sp.RollbackTo(ctx, tx.savepoint); err != nil {
+		return err
+	}
+	return sp.Release(ctx, tx.savepoint)
+}
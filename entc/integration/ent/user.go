@@ -81,14 +81,63 @@ type UserEdges struct {
 	Parent *User `json:"parent,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes    [11]bool
-	namedPets      map[string][]*Pet
-	namedFiles     map[string][]*File
-	namedGroups    map[string][]*Group
-	namedFriends   map[string][]*User
-	namedFollowers map[string][]*User
-	namedFollowing map[string][]*User
-	namedChildren  map[string][]*User
+	loadedTypes [11]bool
+	// PetsCount holds the number of nodes that are connected to the "pets" edge.
+	// The value is being populated by the UserQuery when WithPetsCount is set.
+	PetsCount int `json:"pets_count,omitempty"`
+	// PetsAggregate holds the results of the aggregation functions that were passed to
+	// WithPetsAggregate, in the same order, for the nodes of the "pets" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	PetsAggregate []any `json:"pets_aggregate,omitempty"`
+	// FilesCount holds the number of nodes that are connected to the "files" edge.
+	// The value is being populated by the UserQuery when WithFilesCount is set.
+	FilesCount int `json:"files_count,omitempty"`
+	// FilesAggregate holds the results of the aggregation functions that were passed to
+	// WithFilesAggregate, in the same order, for the nodes of the "files" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	FilesAggregate []any `json:"files_aggregate,omitempty"`
+	// GroupsCount holds the number of nodes that are connected to the "groups" edge.
+	// The value is being populated by the UserQuery when WithGroupsCount is set.
+	GroupsCount int `json:"groups_count,omitempty"`
+	// GroupsAggregate holds the results of the aggregation functions that were passed to
+	// WithGroupsAggregate, in the same order, for the nodes of the "groups" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	GroupsAggregate []any `json:"groups_aggregate,omitempty"`
+	// FriendsCount holds the number of nodes that are connected to the "friends" edge.
+	// The value is being populated by the UserQuery when WithFriendsCount is set.
+	FriendsCount int `json:"friends_count,omitempty"`
+	// FriendsAggregate holds the results of the aggregation functions that were passed to
+	// WithFriendsAggregate, in the same order, for the nodes of the "friends" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	FriendsAggregate []any `json:"friends_aggregate,omitempty"`
+	// FollowersCount holds the number of nodes that are connected to the "followers" edge.
+	// The value is being populated by the UserQuery when WithFollowersCount is set.
+	FollowersCount int `json:"followers_count,omitempty"`
+	// FollowersAggregate holds the results of the aggregation functions that were passed to
+	// WithFollowersAggregate, in the same order, for the nodes of the "followers" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	FollowersAggregate []any `json:"followers_aggregate,omitempty"`
+	// FollowingCount holds the number of nodes that are connected to the "following" edge.
+	// The value is being populated by the UserQuery when WithFollowingCount is set.
+	FollowingCount int `json:"following_count,omitempty"`
+	// FollowingAggregate holds the results of the aggregation functions that were passed to
+	// WithFollowingAggregate, in the same order, for the nodes of the "following" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	FollowingAggregate []any `json:"following_aggregate,omitempty"`
+	// ChildrenCount holds the number of nodes that are connected to the "children" edge.
+	// The value is being populated by the UserQuery when WithChildrenCount is set.
+	ChildrenCount int `json:"children_count,omitempty"`
+	// ChildrenAggregate holds the results of the aggregation functions that were passed to
+	// WithChildrenAggregate, in the same order, for the nodes of the "children" edge.
+	// The results of Min and Max have the type of their field, and other results are float64.
+	ChildrenAggregate []any `json:"children_aggregate,omitempty"`
+	namedPets         map[string][]*Pet
+	namedFiles        map[string][]*File
+	namedGroups       map[string][]*Group
+	namedFriends      map[string][]*User
+	namedFollowers    map[string][]*User
+	namedFollowing    map[string][]*User
+	namedChildren     map[string][]*User
 }
 
 // CardOrErr returns the Card value or an error if the edge
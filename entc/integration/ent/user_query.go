@@ -9,7 +9,9 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"iter"
 	"math"
 
 	"entgo.io/ent"
@@ -28,30 +30,46 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx                *QueryContext
-	order              []user.OrderOption
-	inters             []Interceptor
-	predicates         []predicate.User
-	withCard           *CardQuery
-	withPets           *PetQuery
-	withFiles          *FileQuery
-	withGroups         *GroupQuery
-	withFriends        *UserQuery
-	withFollowers      *UserQuery
-	withFollowing      *UserQuery
-	withTeam           *PetQuery
-	withSpouse         *UserQuery
-	withChildren       *UserQuery
-	withParent         *UserQuery
-	withFKs            bool
-	modifiers          []func(*sql.Selector)
-	withNamedPets      map[string]*PetQuery
-	withNamedFiles     map[string]*FileQuery
-	withNamedGroups    map[string]*GroupQuery
-	withNamedFriends   map[string]*UserQuery
-	withNamedFollowers map[string]*UserQuery
-	withNamedFollowing map[string]*UserQuery
-	withNamedChildren  map[string]*UserQuery
+	ctx           *QueryContext
+	order         []user.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.User
+	withCard      *CardQuery
+	withPets      *PetQuery
+	withFiles     *FileQuery
+	withGroups    *GroupQuery
+	withFriends   *UserQuery
+	withFollowers *UserQuery
+	withFollowing *UserQuery
+	withTeam      *PetQuery
+	withSpouse    *UserQuery
+	withChildren  *UserQuery
+	withParent    *UserQuery
+	withFKs       bool
+	// batch loads the edge that is queried by the builder using the request-scoped loader.
+	batch                  func(context.Context, *loader) ([]*User, error)
+	withPetsCount          *PetQuery
+	withPetsAggregate      []AggregateFunc
+	withFilesCount         *FileQuery
+	withFilesAggregate     []AggregateFunc
+	withGroupsCount        *GroupQuery
+	withGroupsAggregate    []AggregateFunc
+	withFriendsCount       *UserQuery
+	withFriendsAggregate   []AggregateFunc
+	withFollowersCount     *UserQuery
+	withFollowersAggregate []AggregateFunc
+	withFollowingCount     *UserQuery
+	withFollowingAggregate []AggregateFunc
+	withChildrenCount      *UserQuery
+	withChildrenAggregate  []AggregateFunc
+	modifiers              []func(*sql.Selector)
+	withNamedPets          map[string]*PetQuery
+	withNamedFiles         map[string]*FileQuery
+	withNamedGroups        map[string]*GroupQuery
+	withNamedFriends       map[string]*UserQuery
+	withNamedFollowers     map[string]*UserQuery
+	withNamedFollowing     map[string]*UserQuery
+	withNamedChildren      map[string]*UserQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -437,6 +455,9 @@ func (_q *UserQuery) All(ctx context.Context) ([]*User, error) {
 		return nil, err
 	}
 	qr := querierAll[[]*User, *UserQuery]()
+	if l := loaderFromContext(ctx); l != nil && _q.batchable() {
+		qr = querierBatch[[]*User, *UserQuery](l, qr)
+	}
 	return withInterceptors[[]*User](ctx, _q, qr, _q.inters)
 }
 
@@ -534,9 +555,23 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withChildren:  _q.withChildren.Clone(),
 		withParent:    _q.withParent.Clone(),
 		// clone intermediate query.
-		sql:       _q.sql.Clone(),
-		path:      _q.path,
-		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+		sql:                    _q.sql.Clone(),
+		path:                   _q.path,
+		modifiers:              append([]func(*sql.Selector){}, _q.modifiers...),
+		withPetsCount:          _q.withPetsCount.Clone(),
+		withPetsAggregate:      append([]AggregateFunc{}, _q.withPetsAggregate...),
+		withFilesCount:         _q.withFilesCount.Clone(),
+		withFilesAggregate:     append([]AggregateFunc{}, _q.withFilesAggregate...),
+		withGroupsCount:        _q.withGroupsCount.Clone(),
+		withGroupsAggregate:    append([]AggregateFunc{}, _q.withGroupsAggregate...),
+		withFriendsCount:       _q.withFriendsCount.Clone(),
+		withFriendsAggregate:   append([]AggregateFunc{}, _q.withFriendsAggregate...),
+		withFollowersCount:     _q.withFollowersCount.Clone(),
+		withFollowersAggregate: append([]AggregateFunc{}, _q.withFollowersAggregate...),
+		withFollowingCount:     _q.withFollowingCount.Clone(),
+		withFollowingAggregate: append([]AggregateFunc{}, _q.withFollowingAggregate...),
+		withChildrenCount:      _q.withChildrenCount.Clone(),
+		withChildrenAggregate:  append([]AggregateFunc{}, _q.withChildrenAggregate...),
 	}
 }
 
@@ -772,6 +807,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -879,6 +917,97 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withPetsCount; query != nil {
+		if err := _q.aggregatePets(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []any) { n.Edges.PetsCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withPetsAggregate; len(fns) > 0 {
+		query := (&PetClient{config: _q.config}).Query()
+		if err := _q.aggregatePets(ctx, query, nodes, fns,
+			func(n *User, v []any) { n.Edges.PetsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFilesCount; query != nil {
+		if err := _q.aggregateFiles(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []any) { n.Edges.FilesCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFilesAggregate; len(fns) > 0 {
+		query := (&FileClient{config: _q.config}).Query()
+		if err := _q.aggregateFiles(ctx, query, nodes, fns,
+			func(n *User, v []any) { n.Edges.FilesAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGroupsCount; query != nil {
+		if err := _q.aggregateGroups(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []any) { n.Edges.GroupsCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withGroupsAggregate; len(fns) > 0 {
+		query := (&GroupClient{config: _q.config}).Query()
+		if err := _q.aggregateGroups(ctx, query, nodes, fns,
+			func(n *User, v []any) { n.Edges.GroupsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFriendsCount; query != nil {
+		if err := _q.aggregateFriends(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []any) { n.Edges.FriendsCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFriendsAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateFriends(ctx, query, nodes, fns,
+			func(n *User, v []any) { n.Edges.FriendsAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFollowersCount; query != nil {
+		if err := _q.aggregateFollowers(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []any) { n.Edges.FollowersCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFollowersAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateFollowers(ctx, query, nodes, fns,
+			func(n *User, v []any) { n.Edges.FollowersAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFollowingCount; query != nil {
+		if err := _q.aggregateFollowing(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []any) { n.Edges.FollowingCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withFollowingAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateFollowing(ctx, query, nodes, fns,
+			func(n *User, v []any) { n.Edges.FollowingAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChildrenCount; query != nil {
+		if err := _q.aggregateChildren(ctx, query, nodes, []AggregateFunc{Count()},
+			func(n *User, v []any) { n.Edges.ChildrenCount = int(v[0].(float64)) }); err != nil {
+			return nil, err
+		}
+	}
+	if fns := _q.withChildrenAggregate; len(fns) > 0 {
+		query := (&UserClient{config: _q.config}).Query()
+		if err := _q.aggregateChildren(ctx, query, nodes, fns,
+			func(n *User, v []any) { n.Edges.ChildrenAggregate = v }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedPets {
 		if err := _q.loadPets(ctx, query, nodes,
 			func(n *User) { n.appendNamedPets(name) },
@@ -1409,6 +1538,9 @@ func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(_q.modifiers) > 0 {
 		_spec.Modifiers = _q.modifiers
 	}
+	if _spec.From != nil {
+		_spec.From.WithContext(ctx)
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -1474,6 +1606,7 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	for _, m := range _q.modifiers {
 		m(selector)
 	}
+	selector.WithContext(ctx)
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -1491,6 +1624,1066 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// batchable reports if the query can be executed by the request-scoped loader.
+// i.e. it is an edge query that was not modified, except for its limit. Note,
+// it is checked again after the interceptors and the privacy policy were executed.
+func (_q *UserQuery) batchable() bool {
+	return _q.batch != nil &&
+		len(_q.predicates) == 0 && len(_q.order) == 0 &&
+		_q.ctx.Offset == nil && _q.ctx.Unique == nil && len(_q.ctx.Fields) == 0 && _q.withCard == nil && _q.withPets == nil && _q.withFiles == nil && _q.withGroups == nil && _q.withFriends == nil && _q.withFollowers == nil && _q.withFollowing == nil && _q.withTeam == nil && _q.withSpouse == nil && _q.withChildren == nil && _q.withParent == nil && _q.withNamedPets == nil && _q.withNamedFiles == nil && _q.withNamedGroups == nil && _q.withNamedFriends == nil && _q.withNamedFollowers == nil && _q.withNamedFollowing == nil && _q.withNamedChildren == nil && _q.modifiers == nil && !_q.withFKs
+}
+
+// batchAll executes the query using the request-scoped loader. The given
+// context is the context of the caller, that is used for its cancellation.
+func (_q *UserQuery) batchAll(ctx context.Context, l *loader) ([]*User, error) {
+	nodes, err := _q.batch(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if limit := _q.ctx.Limit; limit != nil && len(nodes) > *limit {
+		nodes = nodes[:*limit]
+	}
+	// Loaded nodes are cached, and shared between the callers.
+	return append(make([]*User, 0, len(nodes)), nodes...), nil
+}
+
+// WithPetsCount tells the query-builder to load the number of nodes that are connected to the
+// "pets" edge into the PetsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithPetsCount(opts ...func(*PetQuery)) *UserQuery {
+	query := (&PetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPetsCount = query
+	return _q
+}
+
+// WithPetsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "pets" edge, and to load their results into the PetsAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.User.Query().
+//		WithPetsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithPetsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withPetsAggregate = append(_q.withPetsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregatePets(ctx context.Context, query *PetQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(pet.Table, pet.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, user.PetsTable, user.PetsColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range pet.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*Pet).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "pets" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &Pet{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case pet.FieldID:
+				result[i] = n.ID
+			case pet.FieldAge:
+				result[i] = n.Age
+			case pet.FieldName:
+				result[i] = n.Name
+			case pet.FieldUUID:
+				result[i] = n.UUID
+			case pet.FieldNickname:
+				result[i] = n.Nickname
+			case pet.FieldTrained:
+				result[i] = n.Trained
+			case pet.FieldOptionalTime:
+				result[i] = n.OptionalTime
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithFilesCount tells the query-builder to load the number of nodes that are connected to the
+// "files" edge into the FilesCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithFilesCount(opts ...func(*FileQuery)) *UserQuery {
+	query := (&FileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFilesCount = query
+	return _q
+}
+
+// WithFilesAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "files" edge, and to load their results into the FilesAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.User.Query().
+//		WithFilesAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithFilesAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withFilesAggregate = append(_q.withFilesAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateFiles(ctx context.Context, query *FileQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(file.Table, file.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, user.FilesTable, user.FilesColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range file.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*File).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "files" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &File{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case file.FieldID:
+				result[i] = n.ID
+			case file.FieldSetID:
+				result[i] = n.SetID
+			case file.FieldSize:
+				result[i] = n.Size
+			case file.FieldName:
+				result[i] = n.Name
+			case file.FieldUser:
+				result[i] = n.User
+			case file.FieldGroup:
+				result[i] = n.Group
+			case file.FieldOp:
+				result[i] = n.Op
+			case file.FieldFieldID:
+				result[i] = n.FieldID
+			case file.FieldCreateTime:
+				result[i] = n.CreateTime
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithGroupsCount tells the query-builder to load the number of nodes that are connected to the
+// "groups" edge into the GroupsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithGroupsCount(opts ...func(*GroupQuery)) *UserQuery {
+	query := (&GroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroupsCount = query
+	return _q
+}
+
+// WithGroupsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "groups" edge, and to load their results into the GroupsAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.User.Query().
+//		WithGroupsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithGroupsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withGroupsAggregate = append(_q.withGroupsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateGroups(ctx context.Context, query *GroupQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(group.Table, group.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.GroupsTable, user.GroupsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range group.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*Group).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "groups" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &Group{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case group.FieldID:
+				result[i] = n.ID
+			case group.FieldActive:
+				result[i] = n.Active
+			case group.FieldExpire:
+				result[i] = n.Expire
+			case group.FieldType:
+				result[i] = n.Type
+			case group.FieldMaxUsers:
+				result[i] = n.MaxUsers
+			case group.FieldName:
+				result[i] = n.Name
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithFriendsCount tells the query-builder to load the number of nodes that are connected to the
+// "friends" edge into the FriendsCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithFriendsCount(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFriendsCount = query
+	return _q
+}
+
+// WithFriendsAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "friends" edge, and to load their results into the FriendsAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.User.Query().
+//		WithFriendsAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithFriendsAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withFriendsAggregate = append(_q.withFriendsAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateFriends(ctx context.Context, query *UserQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range user.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*User).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "friends" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &User{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case user.FieldID:
+				result[i] = n.ID
+			case user.FieldOptionalInt:
+				result[i] = n.OptionalInt
+			case user.FieldAge:
+				result[i] = n.Age
+			case user.FieldName:
+				result[i] = n.Name
+			case user.FieldLast:
+				result[i] = n.Last
+			case user.FieldNickname:
+				result[i] = n.Nickname
+			case user.FieldAddress:
+				result[i] = n.Address
+			case user.FieldPhone:
+				result[i] = n.Phone
+			case user.FieldPassword:
+				result[i] = n.Password
+			case user.FieldRole:
+				result[i] = n.Role
+			case user.FieldEmployment:
+				result[i] = n.Employment
+			case user.FieldSSOCert:
+				result[i] = n.SSOCert
+			case user.FieldFilesCount:
+				result[i] = n.FilesCount
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithFollowersCount tells the query-builder to load the number of nodes that are connected to the
+// "followers" edge into the FollowersCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithFollowersCount(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFollowersCount = query
+	return _q
+}
+
+// WithFollowersAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "followers" edge, and to load their results into the FollowersAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.User.Query().
+//		WithFollowersAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithFollowersAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withFollowersAggregate = append(_q.withFollowersAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateFollowers(ctx context.Context, query *UserQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, user.FollowersTable, user.FollowersPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range user.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*User).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "followers" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &User{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case user.FieldID:
+				result[i] = n.ID
+			case user.FieldOptionalInt:
+				result[i] = n.OptionalInt
+			case user.FieldAge:
+				result[i] = n.Age
+			case user.FieldName:
+				result[i] = n.Name
+			case user.FieldLast:
+				result[i] = n.Last
+			case user.FieldNickname:
+				result[i] = n.Nickname
+			case user.FieldAddress:
+				result[i] = n.Address
+			case user.FieldPhone:
+				result[i] = n.Phone
+			case user.FieldPassword:
+				result[i] = n.Password
+			case user.FieldRole:
+				result[i] = n.Role
+			case user.FieldEmployment:
+				result[i] = n.Employment
+			case user.FieldSSOCert:
+				result[i] = n.SSOCert
+			case user.FieldFilesCount:
+				result[i] = n.FilesCount
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithFollowingCount tells the query-builder to load the number of nodes that are connected to the
+// "following" edge into the FollowingCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithFollowingCount(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFollowingCount = query
+	return _q
+}
+
+// WithFollowingAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "following" edge, and to load their results into the FollowingAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.User.Query().
+//		WithFollowingAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithFollowingAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withFollowingAggregate = append(_q.withFollowingAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateFollowing(ctx context.Context, query *UserQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, user.FollowingTable, user.FollowingPrimaryKey...),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range user.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*User).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "following" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &User{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case user.FieldID:
+				result[i] = n.ID
+			case user.FieldOptionalInt:
+				result[i] = n.OptionalInt
+			case user.FieldAge:
+				result[i] = n.Age
+			case user.FieldName:
+				result[i] = n.Name
+			case user.FieldLast:
+				result[i] = n.Last
+			case user.FieldNickname:
+				result[i] = n.Nickname
+			case user.FieldAddress:
+				result[i] = n.Address
+			case user.FieldPhone:
+				result[i] = n.Phone
+			case user.FieldPassword:
+				result[i] = n.Password
+			case user.FieldRole:
+				result[i] = n.Role
+			case user.FieldEmployment:
+				result[i] = n.Employment
+			case user.FieldSSOCert:
+				result[i] = n.SSOCert
+			case user.FieldFilesCount:
+				result[i] = n.FilesCount
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// WithChildrenCount tells the query-builder to load the number of nodes that are connected to the
+// "children" edge into the ChildrenCount field of the edges, without loading the nodes themselves. The
+// optional arguments are used to configure the query builder of the edge (e.g. for counting only some of them).
+func (_q *UserQuery) WithChildrenCount(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChildrenCount = query
+	return _q
+}
+
+// WithChildrenAggregate tells the query-builder to compute the given aggregation functions on the
+// nodes that are connected to the "children" edge, and to load their results into the ChildrenAggregate
+// field of the edges, without loading the nodes themselves. The results of Min and Max have the type of
+// their field (or nil for nodes without neighbors), and other results are float64 (or 0 for nodes without neighbors).
+//
+//	client.User.Query().
+//		WithChildrenAggregate(ent.Sum(field1), ent.Max(field2)).
+//		All(ctx)
+func (_q *UserQuery) WithChildrenAggregate(fns ...AggregateFunc) *UserQuery {
+	_q.withChildrenAggregate = append(_q.withChildrenAggregate, fns...)
+	return _q
+}
+
+func (_q *UserQuery) aggregateChildren(ctx context.Context, query *UserQuery, nodes []*User, fns []AggregateFunc, assign func(*User, []any)) error {
+	ids := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		byID[node.ID] = node
+	}
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	step := sqlgraph.NewStep(
+		sqlgraph.From(user.Table, user.FieldID),
+		sqlgraph.To(user.Table, user.FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, user.ChildrenTable, user.ChildrenColumn),
+	)
+	selector := sqlgraph.GroupNeighbors(query.sqlQuery(ctx), step, ids...)
+	// The results of Min and Max are scanned by the type of their field, and other results as float64.
+	columns := make([]string, len(fns))
+	for i, fn := range fns {
+		expr := fn(selector)
+		for _, c := range user.Columns {
+			if expr == sql.Min(selector.C(c)) || expr == sql.Max(selector.C(c)) {
+				columns[i] = c
+			}
+		}
+		selector.AppendSelect(expr)
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		zero := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				zero[i] = float64(0)
+			}
+		}
+		assign(node, zero)
+	}
+	rows := &sql.Rows{}
+	q, args := selector.Query()
+	if err := _q.driver.Query(ctx, q, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := []any{new(sql.NullInt64)}
+		fields := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				values = append(values, new(sql.NullFloat64))
+				continue
+			}
+			v, err := (*User).scanValues(nil, []string{c})
+			if err != nil {
+				return err
+			}
+			fields[i] = v[0]
+			// Time values of aggregations may be returned as text (e.g. in SQLite).
+			if t, ok := v[0].(*sql.NullTime); ok {
+				v[0] = &sql.TextTimeScanner{T: t}
+			}
+			values = append(values, v[0])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		id := int(values[0].(*sql.NullInt64).Int64)
+		node, ok := byID[id]
+		if !ok {
+			return fmt.Errorf(`unexpected "children" aggregation returned for node %v`, id)
+		}
+		result := make([]any, len(fns))
+		for i, c := range columns {
+			if c == "" {
+				result[i] = values[i+1].(*sql.NullFloat64).Float64
+				continue
+			}
+			n := &User{}
+			if err := n.assignValues([]string{c}, fields[i:i+1]); err != nil {
+				return err
+			}
+			switch c {
+			case user.FieldID:
+				result[i] = n.ID
+			case user.FieldOptionalInt:
+				result[i] = n.OptionalInt
+			case user.FieldAge:
+				result[i] = n.Age
+			case user.FieldName:
+				result[i] = n.Name
+			case user.FieldLast:
+				result[i] = n.Last
+			case user.FieldNickname:
+				result[i] = n.Nickname
+			case user.FieldAddress:
+				result[i] = n.Address
+			case user.FieldPhone:
+				result[i] = n.Phone
+			case user.FieldPassword:
+				result[i] = n.Password
+			case user.FieldRole:
+				result[i] = n.Role
+			case user.FieldEmployment:
+				result[i] = n.Employment
+			case user.FieldSSOCert:
+				result[i] = n.SSOCert
+			case user.FieldFilesCount:
+				result[i] = n.FilesCount
+			}
+		}
+		assign(node, result)
+	}
+	return rows.Err()
+}
+
+// Iter executes the query and returns an iterator that streams its Users from the database
+// rows one by one, instead of loading all of them into memory. The rows are held open until the
+// iteration completes, and errors are yielded as the last element of the iteration. Eager-loading
+// is not supported by Iter, and EachBatch should be used instead. Note that only the traversal
+// interceptors (Traverser) are executed on the query.
+//
+//	for n, err := range client.User.Query().Iter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (_q *UserQuery) Iter(ctx context.Context) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		if _q.withCard != nil || _q.withPets != nil || _q.withFiles != nil || _q.withGroups != nil || _q.withFriends != nil || _q.withFollowers != nil || _q.withFollowing != nil || _q.withTeam != nil || _q.withSpouse != nil || _q.withChildren != nil || _q.withParent != nil || len(_q.withNamedPets) > 0 || len(_q.withNamedFiles) > 0 || len(_q.withNamedGroups) > 0 || len(_q.withNamedFriends) > 0 || len(_q.withNamedFollowers) > 0 || len(_q.withNamedFollowing) > 0 || len(_q.withNamedChildren) > 0 || _q.withPetsCount != nil || len(_q.withPetsAggregate) > 0 || _q.withFilesCount != nil || len(_q.withFilesAggregate) > 0 || _q.withGroupsCount != nil || len(_q.withGroupsAggregate) > 0 || _q.withFriendsCount != nil || len(_q.withFriendsAggregate) > 0 || _q.withFollowersCount != nil || len(_q.withFollowersAggregate) > 0 || _q.withFollowingCount != nil || len(_q.withFollowingAggregate) > 0 || _q.withChildrenCount != nil || len(_q.withChildrenAggregate) > 0 {
+			yield(nil, errors.New("ent: eager-loading is not supported by UserQuery.Iter, use EachBatch instead"))
+			return
+		}
+		ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+		if err := _q.prepareQuery(ctx); err != nil {
+			yield(nil, err)
+			return
+		}
+		_, err := _q.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			spec.Assign = func(columns []string, values []any) error {
+				node := &User{config: _q.config}
+				if err := node.assignValues(columns, values); err != nil {
+					return err
+				}
+				if !yield(node, nil) {
+					return errIterStop
+				}
+				return nil
+			}
+		})
+		if err != nil && !errors.Is(err, errIterStop) {
+			yield(nil, err)
+		}
+	}
+}
+
+// EachBatch executes the query in batches of the given size, and calls fn with each batch of Users.
+// The batches are read using keyset pagination over the id field (in ascending order), and therefore
+// no cursor is held open between them. The edges that are configured for eager-loading are loaded for each
+// batch separately. The ordering, limit and offset of the query are ignored.
+//
+//	err := client.User.Query().
+//		EachBatch(ctx, 1000, func(nodes []*ent.User) error {
+//			// ...
+//		})
+func (_q *UserQuery) EachBatch(ctx context.Context, size int, fn func([]*User) error) error {
+	if size <= 0 {
+		return fmt.Errorf("ent: invalid batch size %d", size)
+	}
+	var last *int
+	for {
+		query := _q.Clone()
+		query.withNamedPets = _q.withNamedPets
+		query.withNamedFiles = _q.withNamedFiles
+		query.withNamedGroups = _q.withNamedGroups
+		query.withNamedFriends = _q.withNamedFriends
+		query.withNamedFollowers = _q.withNamedFollowers
+		query.withNamedFollowing = _q.withNamedFollowing
+		query.withNamedChildren = _q.withNamedChildren
+		query.order, query.ctx.Offset = nil, nil
+		query.Order(user.ByID()).Limit(size)
+		if last != nil {
+			query.Where(predicate.User(sql.FieldGT(user.FieldID, *last)))
+		}
+		nodes, err := query.All(ctx)
+		if err != nil || len(nodes) == 0 {
+			return err
+		}
+		if err := fn(nodes); err != nil {
+			return err
+		}
+		if len(nodes) < size {
+			return nil
+		}
+		id := nodes[len(nodes)-1].ID
+		last = &id
+	}
+}
+
 // ForUpdate locks the selected rows against concurrent updates, and prevent them from being
 // updated, deleted or "selected ... for update" by other sessions, until the transaction is
 // either committed or rolled-back.
@@ -1621,6 +2814,67 @@ func (_q *UserQuery) WithNamedChildren(name string, opts ...func(*UserQuery)) *U
 	return _q
 }
 
+// UserPage is a page of Users returned by UserQuery.Paginate.
+type UserPage struct {
+	// Nodes holds the Users of the page.
+	Nodes []*User
+	// Cursors holds the cursor of each node in Nodes.
+	Cursors []*Cursor
+	// StartCursor and EndCursor hold the cursors of the first and last nodes
+	// of the page. Both are nil in case the page is empty.
+	StartCursor, EndCursor *Cursor
+	// HasNextPage reports if there are more Users after EndCursor.
+	HasNextPage bool
+}
+
+// Paginate executes the query and returns a page of at most "first" Users that
+// come after the given cursor according to the query ordering. A nil cursor returns the first
+// page. The id field is appended to the ordering to make it deterministic.
+//
+//	page, err := client.User.Query().
+//		Order(user.ByID()).
+//		Paginate(ctx, nil, 10)
+//
+//	next, err := client.User.Query().
+//		Order(user.ByID()).
+//		Paginate(ctx, page.EndCursor, 10)
+func (_q *UserQuery) Paginate(ctx context.Context, after *Cursor, first int) (*UserPage, error) {
+	if first <= 0 {
+		return nil, fmt.Errorf("user: invalid pagination size %d", first)
+	}
+	// Values of required fields cannot be NULL, and therefore, do not require NULL handling.
+	p := sql.NewPaginator(after).NotNull(
+		user.FieldID,
+		user.FieldAge,
+		user.FieldName,
+		user.FieldLast,
+		user.FieldRole,
+		user.FieldEmployment,
+	)
+	nodes, err := _q.Clone().
+		Order(user.ByID()).
+		Where(predicate.User(p.Where)).
+		Limit(first + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page := &UserPage{Nodes: nodes}
+	if len(nodes) > first {
+		page.Nodes, page.HasNextPage = nodes[:first], true
+	}
+	page.Cursors = make([]*Cursor, len(page.Nodes))
+	for i, n := range page.Nodes {
+		if page.Cursors[i], err = p.Cursor(func(c string) (any, error) { return n.Value(c) }); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(page.Cursors); n > 0 {
+		page.StartCursor, page.EndCursor = page.Cursors[0], page.Cursors[n-1]
+	}
+	return page, nil
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -1702,6 +2956,9 @@ func (us *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := us.driver.Query(ctx, query, args, rows); err != nil {
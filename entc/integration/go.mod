@@ -9,7 +9,7 @@ require (
 	ariga.io/atlas-go-sdk v0.6.9
 	entgo.io/ent v0.0.0-00010101000000-000000000000
 	github.com/go-sql-driver/mysql v1.7.0
-	github.com/google/uuid v1.3.0
+	github.com/google/uuid v1.6.0
 	github.com/lib/pq v1.10.7
 	github.com/mattn/go-sqlite3 v1.14.17
 	github.com/stretchr/testify v1.8.4
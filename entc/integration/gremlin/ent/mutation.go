@@ -35,7 +35,9 @@ import (
 	"entgo.io/ent/entc/integration/gremlin/ent/item"
 	"entgo.io/ent/entc/integration/gremlin/ent/license"
 	"entgo.io/ent/entc/integration/gremlin/ent/node"
+	"entgo.io/ent/entc/integration/gremlin/ent/note"
 	"entgo.io/ent/entc/integration/gremlin/ent/pet"
+	"entgo.io/ent/entc/integration/gremlin/ent/post"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
 	"entgo.io/ent/entc/integration/gremlin/ent/spec"
 	enttask "entgo.io/ent/entc/integration/gremlin/ent/task"
@@ -66,8 +68,10 @@ const (
 	TypeItem        = "Item"
 	TypeLicense     = "License"
 	TypeNode        = "Node"
+	TypeNote        = "Note"
 	TypePC          = "PC"
 	TypePet         = "Pet"
+	TypePost        = "Post"
 	TypeSpec        = "Spec"
 	TypeTask        = "Task"
 	TypeUser        = "User"
@@ -13492,29 +13496,37 @@ func (m *NodeMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Node edge %s", name)
 }
 
-// PCMutation represents an operation that mutates the PC nodes in the graph.
-type PCMutation struct {
+// NoteMutation represents an operation that mutates the Note nodes in the graph.
+type NoteMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*PC, error)
-	predicates    []predicate.PC
+	op              Op
+	typ             string
+	id              *string
+	tenant          *string
+	text            *string
+	slug            *string
+	clearedFields   map[string]struct{}
+	parent          *string
+	clearedparent   bool
+	children        map[string]struct{}
+	removedchildren map[string]struct{}
+	clearedchildren bool
+	done            bool
+	oldValue        func(context.Context) (*Note, error)
+	predicates      []predicate.Note
 }
 
-var _ ent.Mutation = (*PCMutation)(nil)
+var _ ent.Mutation = (*NoteMutation)(nil)
 
-// pcOption allows management of the mutation configuration using functional options.
-type pcOption func(*PCMutation)
+// noteOption allows management of the mutation configuration using functional options.
+type noteOption func(*NoteMutation)
 
-// newPCMutation creates new mutation for the PC entity.
-func newPCMutation(c config, op Op, opts ...pcOption) *PCMutation {
-	m := &PCMutation{
+// newNoteMutation creates new mutation for the Note entity.
+func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
+	m := &NoteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePC,
+		typ:           TypeNote,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -13523,20 +13535,20 @@ func newPCMutation(c config, op Op, opts ...pcOption) *PCMutation {
 	return m
 }
 
-// withPCID sets the ID field of the mutation.
-func withPCID(id string) pcOption {
-	return func(m *PCMutation) {
+// withNoteID sets the ID field of the mutation.
+func withNoteID(id string) noteOption {
+	return func(m *NoteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PC
+			value *Note
 		)
-		m.oldValue = func(ctx context.Context) (*PC, error) {
+		m.oldValue = func(ctx context.Context) (*Note, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PC.Get(ctx, id)
+					value, err = m.Client().Note.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -13545,10 +13557,10 @@ func withPCID(id string) pcOption {
 	}
 }
 
-// withPC sets the old PC of the mutation.
-func withPC(node *PC) pcOption {
-	return func(m *PCMutation) {
-		m.oldValue = func(context.Context) (*PC, error) {
+// withNote sets the old Note of the mutation.
+func withNote(node *Note) noteOption {
+	return func(m *NoteMutation) {
+		m.oldValue = func(context.Context) (*Note, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -13557,7 +13569,7 @@ func withPC(node *PC) pcOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PCMutation) Client() *Client {
+func (m NoteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -13565,7 +13577,7 @@ func (m PCMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PCMutation) Tx() (*Tx, error) {
+func (m NoteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -13576,7 +13588,7 @@ func (m PCMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PCMutation) ID() (id string, exists bool) {
+func (m *NoteMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -13587,7 +13599,7 @@ func (m *PCMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PCMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *NoteMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -13596,21 +13608,235 @@ func (m *PCMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PC.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Note.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the PCMutation builder.
-func (m *PCMutation) Where(ps ...predicate.PC) {
+// SetTenant sets the "tenant" field.
+func (m *NoteMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *NoteMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *NoteMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetText sets the "text" field.
+func (m *NoteMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *NoteMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *NoteMutation) ResetText() {
+	m.text = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *NoteMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *NoteMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (m *NoteMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[note.FieldSlug] = struct{}{}
+}
+
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *NoteMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[note.FieldSlug]
+	return ok
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *NoteMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, note.FieldSlug)
+}
+
+// SetParentID sets the "parent" edge to the Note entity by id.
+func (m *NoteMutation) SetParentID(id string) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Note entity.
+func (m *NoteMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Note entity was cleared.
+func (m *NoteMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *NoteMutation) ParentID() (id string, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *NoteMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *NoteMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Note entity by ids.
+func (m *NoteMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Note entity.
+func (m *NoteMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Note entity was cleared.
+func (m *NoteMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Note entity by IDs.
+func (m *NoteMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Note entity.
+func (m *NoteMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *NoteMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *NoteMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the NoteMutation builder.
+func (m *NoteMutation) Where(ps ...predicate.Note) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PCMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NoteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PCMutation) WhereP(ps ...func(*dsl.Traversal)) {
-	p := make([]predicate.PC, len(ps))
+func (m *NoteMutation) WhereP(ps ...func(*dsl.Traversal)) {
+	p := make([]predicate.Note, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13618,178 +13844,289 @@ func (m *PCMutation) WhereP(ps ...func(*dsl.Traversal)) {
 }
 
 // Op returns the operation name.
-func (m *PCMutation) Op() Op {
+func (m *NoteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PCMutation) SetOp(op Op) {
+func (m *NoteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PC).
-func (m *PCMutation) Type() string {
+// Type returns the node type of this mutation (Note).
+func (m *NoteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PCMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *NoteMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, note.FieldTenant)
+	}
+	if m.text != nil {
+		fields = append(fields, note.FieldText)
+	}
+	if m.slug != nil {
+		fields = append(fields, note.FieldSlug)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PCMutation) Field(name string) (ent.Value, bool) {
+func (m *NoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case note.FieldTenant:
+		return m.Tenant()
+	case note.FieldText:
+		return m.Text()
+	case note.FieldSlug:
+		return m.Slug()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PCMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown PC field %s", name)
+func (m *NoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case note.FieldTenant:
+		return m.OldTenant(ctx)
+	case note.FieldText:
+		return m.OldText(ctx)
+	case note.FieldSlug:
+		return m.OldSlug(ctx)
+	}
+	return nil, fmt.Errorf("unknown Note field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PCMutation) SetField(name string, value ent.Value) error {
+func (m *NoteMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case note.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case note.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case note.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PC field %s", name)
+	return fmt.Errorf("unknown Note field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PCMutation) AddedFields() []string {
+func (m *NoteMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PCMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NoteMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PCMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown PC numeric field %s", name)
+func (m *NoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Note numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PCMutation) ClearedFields() []string {
-	return nil
+func (m *NoteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(note.FieldSlug) {
+		fields = append(fields, note.FieldSlug)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PCMutation) FieldCleared(name string) bool {
+func (m *NoteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PCMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PC nullable field %s", name)
+func (m *NoteMutation) ClearField(name string) error {
+	switch name {
+	case note.FieldSlug:
+		m.ClearSlug()
+		return nil
+	}
+	return fmt.Errorf("unknown Note nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PCMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown PC field %s", name)
+func (m *NoteMutation) ResetField(name string) error {
+	switch name {
+	case note.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case note.FieldText:
+		m.ResetText()
+		return nil
+	case note.FieldSlug:
+		m.ResetSlug()
+		return nil
+	}
+	return fmt.Errorf("unknown Note field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PCMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
+func (m *NoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.parent != nil {
+		edges = append(edges, note.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, note.EdgeChildren)
+	}
+	return edges
+}
+
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PCMutation) AddedIDs(name string) []ent.Value {
+func (m *NoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case note.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case note.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PCMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *NoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchildren != nil {
+		edges = append(edges, note.EdgeChildren)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PCMutation) RemovedIDs(name string) []ent.Value {
+func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case note.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PCMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *NoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedparent {
+		edges = append(edges, note.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, note.EdgeChildren)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PCMutation) EdgeCleared(name string) bool {
+func (m *NoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case note.EdgeParent:
+		return m.clearedparent
+	case note.EdgeChildren:
+		return m.clearedchildren
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PCMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PC unique edge %s", name)
+func (m *NoteMutation) ClearEdge(name string) error {
+	switch name {
+	case note.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Note unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PCMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PC edge %s", name)
+func (m *NoteMutation) ResetEdge(name string) error {
+	switch name {
+	case note.EdgeParent:
+		m.ResetParent()
+		return nil
+	case note.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Note edge %s", name)
 }
 
-// PetMutation represents an operation that mutates the Pet nodes in the graph.
-type PetMutation struct {
+// PCMutation represents an operation that mutates the PC nodes in the graph.
+type PCMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	age           *float64
-	addage        *float64
-	name          *string
-	uuid          *uuid.UUID
-	nickname      *string
-	trained       *bool
-	optional_time *time.Time
 	clearedFields map[string]struct{}
-	team          *string
-	clearedteam   bool
-	owner         *string
-	clearedowner  bool
 	done          bool
-	oldValue      func(context.Context) (*Pet, error)
-	predicates    []predicate.Pet
+	oldValue      func(context.Context) (*PC, error)
+	predicates    []predicate.PC
 }
 
-var _ ent.Mutation = (*PetMutation)(nil)
+var _ ent.Mutation = (*PCMutation)(nil)
 
-// petOption allows management of the mutation configuration using functional options.
-type petOption func(*PetMutation)
+// pcOption allows management of the mutation configuration using functional options.
+type pcOption func(*PCMutation)
 
-// newPetMutation creates new mutation for the Pet entity.
-func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
-	m := &PetMutation{
+// newPCMutation creates new mutation for the PC entity.
+func newPCMutation(c config, op Op, opts ...pcOption) *PCMutation {
+	m := &PCMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePet,
+		typ:           TypePC,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -13798,20 +14135,20 @@ func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
 	return m
 }
 
-// withPetID sets the ID field of the mutation.
-func withPetID(id string) petOption {
-	return func(m *PetMutation) {
+// withPCID sets the ID field of the mutation.
+func withPCID(id string) pcOption {
+	return func(m *PCMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pet
+			value *PC
 		)
-		m.oldValue = func(ctx context.Context) (*Pet, error) {
+		m.oldValue = func(ctx context.Context) (*PC, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pet.Get(ctx, id)
+					value, err = m.Client().PC.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -13820,10 +14157,10 @@ func withPetID(id string) petOption {
 	}
 }
 
-// withPet sets the old Pet of the mutation.
-func withPet(node *Pet) petOption {
-	return func(m *PetMutation) {
-		m.oldValue = func(context.Context) (*Pet, error) {
+// withPC sets the old PC of the mutation.
+func withPC(node *PC) pcOption {
+	return func(m *PCMutation) {
+		m.oldValue = func(context.Context) (*PC, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -13832,7 +14169,7 @@ func withPet(node *Pet) petOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PetMutation) Client() *Client {
+func (m PCMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -13840,7 +14177,7 @@ func (m PetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PetMutation) Tx() (*Tx, error) {
+func (m PCMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -13851,7 +14188,7 @@ func (m PetMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PetMutation) ID() (id string, exists bool) {
+func (m *PCMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -13862,7 +14199,7 @@ func (m *PetMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PetMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *PCMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -13871,374 +14208,1288 @@ func (m *PetMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PC.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAge sets the "age" field.
-func (m *PetMutation) SetAge(f float64) {
-	m.age = &f
-	m.addage = nil
+// Where appends a list predicates to the PCMutation builder.
+func (m *PCMutation) Where(ps ...predicate.PC) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Age returns the value of the "age" field in the mutation.
-func (m *PetMutation) Age() (r float64, exists bool) {
-	v := m.age
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the PCMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PCMutation) WhereP(ps ...func(*dsl.Traversal)) {
+	p := make([]predicate.PC, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldAge returns the old "age" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldAge(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAge is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAge requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAge: %w", err)
-	}
-	return oldValue.Age, nil
+// Op returns the operation name.
+func (m *PCMutation) Op() Op {
+	return m.op
 }
 
-// AddAge adds f to the "age" field.
-func (m *PetMutation) AddAge(f float64) {
-	if m.addage != nil {
-		*m.addage += f
-	} else {
-		m.addage = &f
-	}
+// SetOp allows setting the mutation operation.
+func (m *PCMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// AddedAge returns the value that was added to the "age" field in this mutation.
-func (m *PetMutation) AddedAge() (r float64, exists bool) {
-	v := m.addage
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (PC).
+func (m *PCMutation) Type() string {
+	return m.typ
 }
 
-// ResetAge resets all changes to the "age" field.
-func (m *PetMutation) ResetAge() {
-	m.age = nil
-	m.addage = nil
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PCMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
 }
 
-// SetName sets the "name" field.
-func (m *PetMutation) SetName(s string) {
-	m.name = &s
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PCMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PetMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PCMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown PC field %s", name)
 }
 
-// OldName returns the old "name" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PCMutation) SetField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.Name, nil
+	return fmt.Errorf("unknown PC field %s", name)
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PetMutation) ResetName() {
-	m.name = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PCMutation) AddedFields() []string {
+	return nil
 }
 
-// SetUUID sets the "uuid" field.
-func (m *PetMutation) SetUUID(u uuid.UUID) {
-	m.uuid = &u
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PCMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// UUID returns the value of the "uuid" field in the mutation.
-func (m *PetMutation) UUID() (r uuid.UUID, exists bool) {
-	v := m.uuid
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PCMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown PC numeric field %s", name)
 }
 
-// OldUUID returns the old "uuid" field's value of the Pet entity.
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PCMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PCMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PCMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PC nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PCMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown PC field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PCMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PCMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PCMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PCMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PCMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PCMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PCMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PC unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PCMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PC edge %s", name)
+}
+
+// PetMutation represents an operation that mutates the Pet nodes in the graph.
+type PetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	age           *float64
+	addage        *float64
+	name          *string
+	uuid          *uuid.UUID
+	nickname      *string
+	trained       *bool
+	optional_time *time.Time
+	clearedFields map[string]struct{}
+	team          *string
+	clearedteam   bool
+	owner         *string
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*Pet, error)
+	predicates    []predicate.Pet
+}
+
+var _ ent.Mutation = (*PetMutation)(nil)
+
+// petOption allows management of the mutation configuration using functional options.
+type petOption func(*PetMutation)
+
+// newPetMutation creates new mutation for the Pet entity.
+func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
+	m := &PetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPetID sets the ID field of the mutation.
+func withPetID(id string) petOption {
+	return func(m *PetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pet
+		)
+		m.oldValue = func(ctx context.Context) (*Pet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPet sets the old Pet of the mutation.
+func withPet(node *Pet) petOption {
+	return func(m *PetMutation) {
+		m.oldValue = func(context.Context) (*Pet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PetMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PetMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAge sets the "age" field.
+func (m *PetMutation) SetAge(f float64) {
+	m.age = &f
+	m.addage = nil
+}
+
+// Age returns the value of the "age" field in the mutation.
+func (m *PetMutation) Age() (r float64, exists bool) {
+	v := m.age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAge returns the old "age" field's value of the Pet entity.
 // If the Pet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *PetMutation) OldAge(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAge is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUUID requires an ID field in the mutation")
+		return v, errors.New("OldAge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAge: %w", err)
+	}
+	return oldValue.Age, nil
+}
+
+// AddAge adds f to the "age" field.
+func (m *PetMutation) AddAge(f float64) {
+	if m.addage != nil {
+		*m.addage += f
+	} else {
+		m.addage = &f
+	}
+}
+
+// AddedAge returns the value that was added to the "age" field in this mutation.
+func (m *PetMutation) AddedAge() (r float64, exists bool) {
+	v := m.addage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAge resets all changes to the "age" field.
+func (m *PetMutation) ResetAge() {
+	m.age = nil
+	m.addage = nil
+}
+
+// SetName sets the "name" field.
+func (m *PetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUUID sets the "uuid" field.
+func (m *PetMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
+}
+
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *PetMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUID returns the old "uuid" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	}
+	return oldValue.UUID, nil
+}
+
+// ClearUUID clears the value of the "uuid" field.
+func (m *PetMutation) ClearUUID() {
+	m.uuid = nil
+	m.clearedFields[pet.FieldUUID] = struct{}{}
+}
+
+// UUIDCleared returns if the "uuid" field was cleared in this mutation.
+func (m *PetMutation) UUIDCleared() bool {
+	_, ok := m.clearedFields[pet.FieldUUID]
+	return ok
+}
+
+// ResetUUID resets all changes to the "uuid" field.
+func (m *PetMutation) ResetUUID() {
+	m.uuid = nil
+	delete(m.clearedFields, pet.FieldUUID)
+}
+
+// SetNickname sets the "nickname" field.
+func (m *PetMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *PetMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (m *PetMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[pet.FieldNickname] = struct{}{}
+}
+
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *PetMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[pet.FieldNickname]
+	return ok
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *PetMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, pet.FieldNickname)
+}
+
+// SetTrained sets the "trained" field.
+func (m *PetMutation) SetTrained(b bool) {
+	m.trained = &b
+}
+
+// Trained returns the value of the "trained" field in the mutation.
+func (m *PetMutation) Trained() (r bool, exists bool) {
+	v := m.trained
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrained returns the old "trained" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldTrained(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrained is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrained requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrained: %w", err)
+	}
+	return oldValue.Trained, nil
+}
+
+// ResetTrained resets all changes to the "trained" field.
+func (m *PetMutation) ResetTrained() {
+	m.trained = nil
+}
+
+// SetOptionalTime sets the "optional_time" field.
+func (m *PetMutation) SetOptionalTime(t time.Time) {
+	m.optional_time = &t
+}
+
+// OptionalTime returns the value of the "optional_time" field in the mutation.
+func (m *PetMutation) OptionalTime() (r time.Time, exists bool) {
+	v := m.optional_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptionalTime returns the old "optional_time" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldOptionalTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptionalTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptionalTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptionalTime: %w", err)
+	}
+	return oldValue.OptionalTime, nil
+}
+
+// ClearOptionalTime clears the value of the "optional_time" field.
+func (m *PetMutation) ClearOptionalTime() {
+	m.optional_time = nil
+	m.clearedFields[pet.FieldOptionalTime] = struct{}{}
+}
+
+// OptionalTimeCleared returns if the "optional_time" field was cleared in this mutation.
+func (m *PetMutation) OptionalTimeCleared() bool {
+	_, ok := m.clearedFields[pet.FieldOptionalTime]
+	return ok
+}
+
+// ResetOptionalTime resets all changes to the "optional_time" field.
+func (m *PetMutation) ResetOptionalTime() {
+	m.optional_time = nil
+	delete(m.clearedFields, pet.FieldOptionalTime)
+}
+
+// SetTeamID sets the "team" edge to the User entity by id.
+func (m *PetMutation) SetTeamID(id string) {
+	m.team = &id
+}
+
+// ClearTeam clears the "team" edge to the User entity.
+func (m *PetMutation) ClearTeam() {
+	m.clearedteam = true
+}
+
+// TeamCleared reports if the "team" edge to the User entity was cleared.
+func (m *PetMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamID returns the "team" edge ID in the mutation.
+func (m *PetMutation) TeamID() (id string, exists bool) {
+	if m.team != nil {
+		return *m.team, true
+	}
+	return
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *PetMutation) TeamIDs() (ids []string) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *PetMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *PetMutation) SetOwnerID(id string) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *PetMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *PetMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *PetMutation) OwnerID() (id string, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *PetMutation) OwnerIDs() (ids []string) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *PetMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the PetMutation builder.
+func (m *PetMutation) Where(ps ...predicate.Pet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*dsl.Traversal)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Pet).
+func (m *PetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PetMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.age != nil {
+		fields = append(fields, pet.FieldAge)
+	}
+	if m.name != nil {
+		fields = append(fields, pet.FieldName)
+	}
+	if m.uuid != nil {
+		fields = append(fields, pet.FieldUUID)
+	}
+	if m.nickname != nil {
+		fields = append(fields, pet.FieldNickname)
+	}
+	if m.trained != nil {
+		fields = append(fields, pet.FieldTrained)
+	}
+	if m.optional_time != nil {
+		fields = append(fields, pet.FieldOptionalTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldAge:
+		return m.Age()
+	case pet.FieldName:
+		return m.Name()
+	case pet.FieldUUID:
+		return m.UUID()
+	case pet.FieldNickname:
+		return m.Nickname()
+	case pet.FieldTrained:
+		return m.Trained()
+	case pet.FieldOptionalTime:
+		return m.OptionalTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pet.FieldAge:
+		return m.OldAge(ctx)
+	case pet.FieldName:
+		return m.OldName(ctx)
+	case pet.FieldUUID:
+		return m.OldUUID(ctx)
+	case pet.FieldNickname:
+		return m.OldNickname(ctx)
+	case pet.FieldTrained:
+		return m.OldTrained(ctx)
+	case pet.FieldOptionalTime:
+		return m.OldOptionalTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldAge:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAge(v)
+		return nil
+	case pet.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case pet.FieldUUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUID(v)
+		return nil
+	case pet.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case pet.FieldTrained:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrained(v)
+		return nil
+	case pet.FieldOptionalTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptionalTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PetMutation) AddedFields() []string {
+	var fields []string
+	if m.addage != nil {
+		fields = append(fields, pet.FieldAge)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldAge:
+		return m.AddedAge()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldAge:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAge(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pet.FieldUUID) {
+		fields = append(fields, pet.FieldUUID)
+	}
+	if m.FieldCleared(pet.FieldNickname) {
+		fields = append(fields, pet.FieldNickname)
+	}
+	if m.FieldCleared(pet.FieldOptionalTime) {
+		fields = append(fields, pet.FieldOptionalTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PetMutation) ClearField(name string) error {
+	switch name {
+	case pet.FieldUUID:
+		m.ClearUUID()
+		return nil
+	case pet.FieldNickname:
+		m.ClearNickname()
+		return nil
+	case pet.FieldOptionalTime:
+		m.ClearOptionalTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PetMutation) ResetField(name string) error {
+	switch name {
+	case pet.FieldAge:
+		m.ResetAge()
+		return nil
+	case pet.FieldName:
+		m.ResetName()
+		return nil
+	case pet.FieldUUID:
+		m.ResetUUID()
+		return nil
+	case pet.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case pet.FieldTrained:
+		m.ResetTrained()
+		return nil
+	case pet.FieldOptionalTime:
+		m.ResetOptionalTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, pet.EdgeTeam)
+	}
+	if m.owner != nil {
+		edges = append(edges, pet.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pet.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case pet.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, pet.EdgeTeam)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	if m.clearedowner {
+		edges = append(edges, pet.EdgeOwner)
 	}
-	return oldValue.UUID, nil
+	return edges
 }
 
-// ClearUUID clears the value of the "uuid" field.
-func (m *PetMutation) ClearUUID() {
-	m.uuid = nil
-	m.clearedFields[pet.FieldUUID] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pet.EdgeTeam:
+		return m.clearedteam
+	case pet.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
 }
 
-// UUIDCleared returns if the "uuid" field was cleared in this mutation.
-func (m *PetMutation) UUIDCleared() bool {
-	_, ok := m.clearedFields[pet.FieldUUID]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PetMutation) ClearEdge(name string) error {
+	switch name {
+	case pet.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case pet.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet unique edge %s", name)
 }
 
-// ResetUUID resets all changes to the "uuid" field.
-func (m *PetMutation) ResetUUID() {
-	m.uuid = nil
-	delete(m.clearedFields, pet.FieldUUID)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PetMutation) ResetEdge(name string) error {
+	switch name {
+	case pet.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case pet.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet edge %s", name)
 }
 
-// SetNickname sets the "nickname" field.
-func (m *PetMutation) SetNickname(s string) {
-	m.nickname = &s
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	title          *string
+	secret         *string
+	clearedFields  map[string]struct{}
+	parent         *string
+	clearedparent  bool
+	replies        map[string]struct{}
+	removedreplies map[string]struct{}
+	clearedreplies bool
+	done           bool
+	oldValue       func(context.Context) (*Post, error)
+	predicates     []predicate.Post
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *PetMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
-	if v == nil {
-		return
+var _ ent.Mutation = (*PostMutation)(nil)
+
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
+
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePost,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldNickname returns the old "nickname" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldNickname(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+// withPostID sets the ID field of the mutation.
+func withPostID(id string) postOption {
+	return func(m *PostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Post
+		)
+		m.oldValue = func(ctx context.Context) (*Post, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Post.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+}
+
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Nickname, nil
 }
 
-// ClearNickname clears the value of the "nickname" field.
-func (m *PetMutation) ClearNickname() {
-	m.nickname = nil
-	m.clearedFields[pet.FieldNickname] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// NicknameCleared returns if the "nickname" field was cleared in this mutation.
-func (m *PetMutation) NicknameCleared() bool {
-	_, ok := m.clearedFields[pet.FieldNickname]
-	return ok
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *PetMutation) ResetNickname() {
-	m.nickname = nil
-	delete(m.clearedFields, pet.FieldNickname)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetTrained sets the "trained" field.
-func (m *PetMutation) SetTrained(b bool) {
-	m.trained = &b
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Trained returns the value of the "trained" field in the mutation.
-func (m *PetMutation) Trained() (r bool, exists bool) {
-	v := m.trained
+// SetTitle sets the "title" field.
+func (m *PostMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PostMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrained returns the old "trained" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldTrained(ctx context.Context) (v bool, err error) {
+func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrained is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrained requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrained: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Trained, nil
+	return oldValue.Title, nil
 }
 
-// ResetTrained resets all changes to the "trained" field.
-func (m *PetMutation) ResetTrained() {
-	m.trained = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *PostMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetOptionalTime sets the "optional_time" field.
-func (m *PetMutation) SetOptionalTime(t time.Time) {
-	m.optional_time = &t
+// SetSecret sets the "secret" field.
+func (m *PostMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// OptionalTime returns the value of the "optional_time" field in the mutation.
-func (m *PetMutation) OptionalTime() (r time.Time, exists bool) {
-	v := m.optional_time
+// Secret returns the value of the "secret" field in the mutation.
+func (m *PostMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOptionalTime returns the old "optional_time" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldOptionalTime(ctx context.Context) (v time.Time, err error) {
+func (m *PostMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptionalTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptionalTime requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptionalTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.OptionalTime, nil
+	return oldValue.Secret, nil
 }
 
-// ClearOptionalTime clears the value of the "optional_time" field.
-func (m *PetMutation) ClearOptionalTime() {
-	m.optional_time = nil
-	m.clearedFields[pet.FieldOptionalTime] = struct{}{}
+// ClearSecret clears the value of the "secret" field.
+func (m *PostMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[post.FieldSecret] = struct{}{}
 }
 
-// OptionalTimeCleared returns if the "optional_time" field was cleared in this mutation.
-func (m *PetMutation) OptionalTimeCleared() bool {
-	_, ok := m.clearedFields[pet.FieldOptionalTime]
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *PostMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[post.FieldSecret]
 	return ok
 }
 
-// ResetOptionalTime resets all changes to the "optional_time" field.
-func (m *PetMutation) ResetOptionalTime() {
-	m.optional_time = nil
-	delete(m.clearedFields, pet.FieldOptionalTime)
+// ResetSecret resets all changes to the "secret" field.
+func (m *PostMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, post.FieldSecret)
 }
 
-// SetTeamID sets the "team" edge to the User entity by id.
-func (m *PetMutation) SetTeamID(id string) {
-	m.team = &id
+// SetParentID sets the "parent" edge to the Post entity by id.
+func (m *PostMutation) SetParentID(id string) {
+	m.parent = &id
 }
 
-// ClearTeam clears the "team" edge to the User entity.
-func (m *PetMutation) ClearTeam() {
-	m.clearedteam = true
+// ClearParent clears the "parent" edge to the Post entity.
+func (m *PostMutation) ClearParent() {
+	m.clearedparent = true
 }
 
-// TeamCleared reports if the "team" edge to the User entity was cleared.
-func (m *PetMutation) TeamCleared() bool {
-	return m.clearedteam
+// ParentCleared reports if the "parent" edge to the Post entity was cleared.
+func (m *PostMutation) ParentCleared() bool {
+	return m.clearedparent
 }
 
-// TeamID returns the "team" edge ID in the mutation.
-func (m *PetMutation) TeamID() (id string, exists bool) {
-	if m.team != nil {
-		return *m.team, true
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *PostMutation) ParentID() (id string, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
 	}
 	return
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
+// ParentIDs returns the "parent" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *PetMutation) TeamIDs() (ids []string) {
-	if id := m.team; id != nil {
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *PetMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// ResetParent resets all changes to the "parent" edge.
+func (m *PostMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *PetMutation) SetOwnerID(id string) {
-	m.owner = &id
+// AddReplyIDs adds the "replies" edge to the Post entity by ids.
+func (m *PostMutation) AddReplyIDs(ids ...string) {
+	if m.replies == nil {
+		m.replies = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *PetMutation) ClearOwner() {
-	m.clearedowner = true
+// ClearReplies clears the "replies" edge to the Post entity.
+func (m *PostMutation) ClearReplies() {
+	m.clearedreplies = true
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *PetMutation) OwnerCleared() bool {
-	return m.clearedowner
+// RepliesCleared reports if the "replies" edge to the Post entity was cleared.
+func (m *PostMutation) RepliesCleared() bool {
+	return m.clearedreplies
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *PetMutation) OwnerID() (id string, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// RemoveReplyIDs removes the "replies" edge to the Post entity by IDs.
+func (m *PostMutation) RemoveReplyIDs(ids ...string) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Post entity.
+func (m *PostMutation) RemovedRepliesIDs() (ids []string) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *PetMutation) OwnerIDs() (ids []string) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *PostMutation) RepliesIDs() (ids []string) {
+	for id := range m.replies {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *PetMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetReplies resets all changes to the "replies" edge.
+func (m *PostMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
 }
 
-// Where appends a list predicates to the PetMutation builder.
-func (m *PetMutation) Where(ps ...predicate.Pet) {
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PetMutation) WhereP(ps ...func(*dsl.Traversal)) {
-	p := make([]predicate.Pet, len(ps))
+func (m *PostMutation) WhereP(ps ...func(*dsl.Traversal)) {
+	p := make([]predicate.Post, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -14246,42 +15497,30 @@ func (m *PetMutation) WhereP(ps ...func(*dsl.Traversal)) {
 }
 
 // Op returns the operation name.
-func (m *PetMutation) Op() Op {
+func (m *PostMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PetMutation) SetOp(op Op) {
+func (m *PostMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Pet).
-func (m *PetMutation) Type() string {
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PetMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.age != nil {
-		fields = append(fields, pet.FieldAge)
-	}
-	if m.name != nil {
-		fields = append(fields, pet.FieldName)
-	}
-	if m.uuid != nil {
-		fields = append(fields, pet.FieldUUID)
-	}
-	if m.nickname != nil {
-		fields = append(fields, pet.FieldNickname)
-	}
-	if m.trained != nil {
-		fields = append(fields, pet.FieldTrained)
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.title != nil {
+		fields = append(fields, post.FieldTitle)
 	}
-	if m.optional_time != nil {
-		fields = append(fields, pet.FieldOptionalTime)
+	if m.secret != nil {
+		fields = append(fields, post.FieldSecret)
 	}
 	return fields
 }
@@ -14289,20 +15528,12 @@ func (m *PetMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PetMutation) Field(name string) (ent.Value, bool) {
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pet.FieldAge:
-		return m.Age()
-	case pet.FieldName:
-		return m.Name()
-	case pet.FieldUUID:
-		return m.UUID()
-	case pet.FieldNickname:
-		return m.Nickname()
-	case pet.FieldTrained:
-		return m.Trained()
-	case pet.FieldOptionalTime:
-		return m.OptionalTime()
+	case post.FieldTitle:
+		return m.Title()
+	case post.FieldSecret:
+		return m.Secret()
 	}
 	return nil, false
 }
@@ -14310,268 +15541,203 @@ func (m *PetMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pet.FieldAge:
-		return m.OldAge(ctx)
-	case pet.FieldName:
-		return m.OldName(ctx)
-	case pet.FieldUUID:
-		return m.OldUUID(ctx)
-	case pet.FieldNickname:
-		return m.OldNickname(ctx)
-	case pet.FieldTrained:
-		return m.OldTrained(ctx)
-	case pet.FieldOptionalTime:
-		return m.OldOptionalTime(ctx)
+	case post.FieldTitle:
+		return m.OldTitle(ctx)
+	case post.FieldSecret:
+		return m.OldSecret(ctx)
 	}
-	return nil, fmt.Errorf("unknown Pet field %s", name)
+	return nil, fmt.Errorf("unknown Post field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PetMutation) SetField(name string, value ent.Value) error {
+func (m *PostMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pet.FieldAge:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAge(v)
-		return nil
-	case pet.FieldName:
+	case post.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case pet.FieldUUID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUUID(v)
+		m.SetTitle(v)
 		return nil
-	case pet.FieldNickname:
+	case post.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNickname(v)
-		return nil
-	case pet.FieldTrained:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTrained(v)
-		return nil
-	case pet.FieldOptionalTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOptionalTime(v)
+		m.SetSecret(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PetMutation) AddedFields() []string {
-	var fields []string
-	if m.addage != nil {
-		fields = append(fields, pet.FieldAge)
-	}
-	return fields
+func (m *PostMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case pet.FieldAge:
-		return m.AddedAge()
-	}
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PetMutation) AddField(name string, value ent.Value) error {
+func (m *PostMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case pet.FieldAge:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAge(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Pet numeric field %s", name)
+	return fmt.Errorf("unknown Post numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PetMutation) ClearedFields() []string {
+func (m *PostMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(pet.FieldUUID) {
-		fields = append(fields, pet.FieldUUID)
-	}
-	if m.FieldCleared(pet.FieldNickname) {
-		fields = append(fields, pet.FieldNickname)
-	}
-	if m.FieldCleared(pet.FieldOptionalTime) {
-		fields = append(fields, pet.FieldOptionalTime)
+	if m.FieldCleared(post.FieldSecret) {
+		fields = append(fields, post.FieldSecret)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PetMutation) FieldCleared(name string) bool {
+func (m *PostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PetMutation) ClearField(name string) error {
+func (m *PostMutation) ClearField(name string) error {
 	switch name {
-	case pet.FieldUUID:
-		m.ClearUUID()
-		return nil
-	case pet.FieldNickname:
-		m.ClearNickname()
-		return nil
-	case pet.FieldOptionalTime:
-		m.ClearOptionalTime()
+	case post.FieldSecret:
+		m.ClearSecret()
 		return nil
 	}
-	return fmt.Errorf("unknown Pet nullable field %s", name)
+	return fmt.Errorf("unknown Post nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PetMutation) ResetField(name string) error {
+func (m *PostMutation) ResetField(name string) error {
 	switch name {
-	case pet.FieldAge:
-		m.ResetAge()
-		return nil
-	case pet.FieldName:
-		m.ResetName()
-		return nil
-	case pet.FieldUUID:
-		m.ResetUUID()
+	case post.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case pet.FieldNickname:
-		m.ResetNickname()
-		return nil
-	case pet.FieldTrained:
-		m.ResetTrained()
-		return nil
-	case pet.FieldOptionalTime:
-		m.ResetOptionalTime()
+	case post.FieldSecret:
+		m.ResetSecret()
 		return nil
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PetMutation) AddedEdges() []string {
+func (m *PostMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.team != nil {
-		edges = append(edges, pet.EdgeTeam)
+	if m.parent != nil {
+		edges = append(edges, post.EdgeParent)
 	}
-	if m.owner != nil {
-		edges = append(edges, pet.EdgeOwner)
+	if m.replies != nil {
+		edges = append(edges, post.EdgeReplies)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PetMutation) AddedIDs(name string) []ent.Value {
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case pet.EdgeTeam:
-		if id := m.team; id != nil {
+	case post.EdgeParent:
+		if id := m.parent; id != nil {
 			return []ent.Value{*id}
 		}
-	case pet.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
+	case post.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PetMutation) RemovedEdges() []string {
+func (m *PostMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
+	if m.removedreplies != nil {
+		edges = append(edges, post.EdgeReplies)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PetMutation) ClearedEdges() []string {
+func (m *PostMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedteam {
-		edges = append(edges, pet.EdgeTeam)
+	if m.clearedparent {
+		edges = append(edges, post.EdgeParent)
 	}
-	if m.clearedowner {
-		edges = append(edges, pet.EdgeOwner)
+	if m.clearedreplies {
+		edges = append(edges, post.EdgeReplies)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PetMutation) EdgeCleared(name string) bool {
+func (m *PostMutation) EdgeCleared(name string) bool {
 	switch name {
-	case pet.EdgeTeam:
-		return m.clearedteam
-	case pet.EdgeOwner:
-		return m.clearedowner
+	case post.EdgeParent:
+		return m.clearedparent
+	case post.EdgeReplies:
+		return m.clearedreplies
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PetMutation) ClearEdge(name string) error {
+func (m *PostMutation) ClearEdge(name string) error {
 	switch name {
-	case pet.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case pet.EdgeOwner:
-		m.ClearOwner()
+	case post.EdgeParent:
+		m.ClearParent()
 		return nil
 	}
-	return fmt.Errorf("unknown Pet unique edge %s", name)
+	return fmt.Errorf("unknown Post unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PetMutation) ResetEdge(name string) error {
+func (m *PostMutation) ResetEdge(name string) error {
 	switch name {
-	case pet.EdgeTeam:
-		m.ResetTeam()
+	case post.EdgeParent:
+		m.ResetParent()
 		return nil
-	case pet.EdgeOwner:
-		m.ResetOwner()
+	case post.EdgeReplies:
+		m.ResetReplies()
 		return nil
 	}
-	return fmt.Errorf("unknown Pet edge %s", name)
+	return fmt.Errorf("unknown Post edge %s", name)
 }
 
 // SpecMutation represents an operation that mutates the Spec nodes in the graph.
@@ -14948,6 +16114,8 @@ type TaskMutation struct {
 	order_option    *int
 	addorder_option *int
 	_op             *string
+	version         *int
+	addversion      *int
 	clearedFields   map[string]struct{}
 	done            bool
 	oldValue        func(context.Context) (*Task, error)
@@ -15467,6 +16635,62 @@ func (m *TaskMutation) ResetOp() {
 	m._op = nil
 }
 
+// SetVersion sets the "version" field.
+func (m *TaskMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *TaskMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *TaskMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TaskMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *TaskMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
 // Where appends a list predicates to the TaskMutation builder.
 func (m *TaskMutation) Where(ps ...predicate.Task) {
 	m.predicates = append(m.predicates, ps...)
@@ -15501,7 +16725,7 @@ func (m *TaskMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 9)
 	if m.priority != nil {
 		fields = append(fields, enttask.FieldPriority)
 	}
@@ -15526,6 +16750,9 @@ func (m *TaskMutation) Fields() []string {
 	if m._op != nil {
 		fields = append(fields, enttask.FieldOp)
 	}
+	if m.version != nil {
+		fields = append(fields, enttask.FieldVersion)
+	}
 	return fields
 }
 
@@ -15550,6 +16777,8 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 		return m.OrderOption()
 	case enttask.FieldOp:
 		return m.GetOp()
+	case enttask.FieldVersion:
+		return m.Version()
 	}
 	return nil, false
 }
@@ -15575,6 +16804,8 @@ func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldOrderOption(ctx)
 	case enttask.FieldOp:
 		return m.OldOp(ctx)
+	case enttask.FieldVersion:
+		return m.OldVersion(ctx)
 	}
 	return nil, fmt.Errorf("unknown Task field %s", name)
 }
@@ -15640,6 +16871,13 @@ func (m *TaskMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetOpField(v)
 		return nil
+	case enttask.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Task field %s", name)
 }
@@ -15657,6 +16895,9 @@ func (m *TaskMutation) AddedFields() []string {
 	if m.addorder_option != nil {
 		fields = append(fields, enttask.FieldOrderOption)
 	}
+	if m.addversion != nil {
+		fields = append(fields, enttask.FieldVersion)
+	}
 	return fields
 }
 
@@ -15671,6 +16912,8 @@ func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedOrder()
 	case enttask.FieldOrderOption:
 		return m.AddedOrderOption()
+	case enttask.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -15701,6 +16944,13 @@ func (m *TaskMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddOrderOption(v)
 		return nil
+	case enttask.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Task numeric field %s", name)
 }
@@ -15785,6 +17035,9 @@ func (m *TaskMutation) ResetField(name string) error {
 	case enttask.FieldOp:
 		m.ResetOp()
 		return nil
+	case enttask.FieldVersion:
+		m.ResetVersion()
+		return nil
 	}
 	return fmt.Errorf("unknown Task field %s", name)
 }
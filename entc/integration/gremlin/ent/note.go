@@ -0,0 +1,157 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/entc/integration/gremlin/ent/note"
+)
+
+// Note is the model entity for the Note schema.
+type Note struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NoteQuery when eager-loading is set.
+	Edges NoteEdges `json:"edges"`
+}
+
+// NoteEdges holds the relations/edges for other nodes in the graph.
+type NoteEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Note `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Note `json:"children,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteEdges) ParentOrErr() (*Note, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) ChildrenOrErr() ([]*Note, error) {
+	if e.loadedTypes[1] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// FromResponse scans the gremlin response data into Note.
+func (_m *Note) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scan_m struct {
+		ID     string `json:"id,omitempty"`
+		Tenant string `json:"tenant,omitempty"`
+		Text   string `json:"text,omitempty"`
+		Slug   string `json:"slug,omitempty"`
+	}
+	if err := vmap.Decode(&scan_m); err != nil {
+		return err
+	}
+	_m.ID = scan_m.ID
+	_m.Tenant = scan_m.Tenant
+	_m.Text = scan_m.Text
+	_m.Slug = scan_m.Slug
+	return nil
+}
+
+// QueryParent queries the "parent" edge of the Note entity.
+func (_m *Note) QueryParent() *NoteQuery {
+	return NewNoteClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Note entity.
+func (_m *Note) QueryChildren() *NoteQuery {
+	return NewNoteClient(_m.config).QueryChildren(_m)
+}
+
+// Update returns a builder for updating this Note.
+// Note that you need to call Note.Unwrap() before calling this method if this Note
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Note) Update() *NoteUpdateOne {
+	return NewNoteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Note entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Note) Unwrap() *Note {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Note is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Note) String() string {
+	var builder strings.Builder
+	builder.WriteString("Note(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(_m.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Notes is a parsable slice of Note.
+type Notes []*Note
+
+// FromResponse scans the gremlin response data into Notes.
+func (_m *Notes) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scan_m []struct {
+		ID     string `json:"id,omitempty"`
+		Tenant string `json:"tenant,omitempty"`
+		Text   string `json:"text,omitempty"`
+		Slug   string `json:"slug,omitempty"`
+	}
+	if err := vmap.Decode(&scan_m); err != nil {
+		return err
+	}
+	for _, v := range scan_m {
+		node := &Note{ID: v.ID}
+		node.Tenant = v.Tenant
+		node.Text = v.Text
+		node.Slug = v.Slug
+		*_m = append(*_m, node)
+	}
+	return nil
+}
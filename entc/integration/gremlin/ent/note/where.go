@@ -0,0 +1,422 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package note
+
+import (
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasID(id)
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasID(p.EQ(id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasID(p.NEQ(id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Within(v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Without(v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasID(p.GT(id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasID(p.GTE(id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasID(p.LT(id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasID(p.LTE(id))
+	})
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.EQ(v))
+	})
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EQ(v))
+	})
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.EQ(v))
+	})
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.EQ(v))
+	})
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.NEQ(v))
+	})
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.Within(vs...))
+	})
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.Without(vs...))
+	})
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.GT(v))
+	})
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.GTE(v))
+	})
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.LT(v))
+	})
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.LTE(v))
+	})
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.Containing(v))
+	})
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.StartingWith(v))
+	})
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTenant, p.EndingWith(v))
+	})
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EQ(v))
+	})
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.NEQ(v))
+	})
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Within(vs...))
+	})
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Without(vs...))
+	})
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.GT(v))
+	})
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.GTE(v))
+	})
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.LT(v))
+	})
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.LTE(v))
+	})
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Containing(v))
+	})
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.StartingWith(v))
+	})
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EndingWith(v))
+	})
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.EQ(v))
+	})
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.NEQ(v))
+	})
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.Within(vs...))
+	})
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.Without(vs...))
+	})
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.GT(v))
+	})
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.GTE(v))
+	})
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.LT(v))
+	})
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.LTE(v))
+	})
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.Containing(v))
+	})
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.StartingWith(v))
+	})
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSlug, p.EndingWith(v))
+	})
+}
+
+// SlugIsNil applies the IsNil predicate on the "slug" field.
+func SlugIsNil() predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldSlug)
+	})
+}
+
+// SlugNotNil applies the NotNil predicate on the "slug" field.
+func SlugNotNil() predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldSlug)
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.InE(ParentInverseLabel).InV()
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Note) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		tr := __.OutV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.InE(ParentInverseLabel).Where(tr).InV()
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		t.OutE(ChildrenLabel).OutV()
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Note) predicate.Note {
+	return predicate.Note(func(t *dsl.Traversal) {
+		tr := __.InV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.OutE(ChildrenLabel).Where(tr).OutV()
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Note) predicate.Note {
+	return predicate.Note(func(tr *dsl.Traversal) {
+		trs := make([]any, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.And(trs...))
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Note) predicate.Note {
+	return predicate.Note(func(tr *dsl.Traversal) {
+		trs := make([]any, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.Or(trs...))
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Note) predicate.Note {
+	return predicate.Note(func(tr *dsl.Traversal) {
+		t := __.New()
+		p(t)
+		tr.Where(__.Not(t))
+	})
+}
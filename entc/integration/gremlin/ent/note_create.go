@@ -0,0 +1,197 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+	"entgo.io/ent/entc/integration/gremlin/ent/note"
+)
+
+// NoteCreate is the builder for creating a Note entity.
+type NoteCreate struct {
+	config
+	mutation *NoteMutation
+	hooks    []Hook
+}
+
+// SetTenant sets the "tenant" field.
+func (_c *NoteCreate) SetTenant(v string) *NoteCreate {
+	_c.mutation.SetTenant(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *NoteCreate) SetText(v string) *NoteCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *NoteCreate) SetSlug(v string) *NoteCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_c *NoteCreate) SetNillableSlug(v *string) *NoteCreate {
+	if v != nil {
+		_c.SetSlug(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent" edge to the Note entity by ID.
+func (_c *NoteCreate) SetParentID(id string) *NoteCreate {
+	_c.mutation.SetParentID(id)
+	return _c
+}
+
+// SetNillableParentID sets the "parent" edge to the Note entity by ID if the given value is not nil.
+func (_c *NoteCreate) SetNillableParentID(id *string) *NoteCreate {
+	if id != nil {
+		_c = _c.SetParentID(*id)
+	}
+	return _c
+}
+
+// SetParent sets the "parent" edge to the Note entity.
+func (_c *NoteCreate) SetParent(v *Note) *NoteCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Note entity by IDs.
+func (_c *NoteCreate) AddChildIDs(ids ...string) *NoteCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Note entity.
+func (_c *NoteCreate) AddChildren(v ...*Note) *NoteCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
+// Mutation returns the NoteMutation object of the builder.
+func (_c *NoteCreate) Mutation() *NoteMutation {
+	return _c.mutation
+}
+
+// Save creates the Note in the database.
+func (_c *NoteCreate) Save(ctx context.Context) (*Note, error) {
+	return withHooks(ctx, _c.gremlinSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NoteCreate) SaveX(ctx context.Context) *Note {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NoteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NoteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NoteCreate) check() error {
+	if _, ok := _c.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Note.tenant"`)}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Note.text"`)}
+	}
+	return nil
+}
+
+func (_c *NoteCreate) gremlinSave(ctx context.Context) (*Note, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	res := &gremlin.Response{}
+	query, bindings := _c.gremlin().Query()
+	if err := _c.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	rnode := &Note{config: _c.config}
+	if err := rnode.FromResponse(res); err != nil {
+		return nil, err
+	}
+	_c.mutation.id = &rnode.ID
+	_c.mutation.done = true
+	return rnode, nil
+}
+
+func (_c *NoteCreate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 2)
+	v := g.AddV(note.Label)
+	if value, ok := _c.mutation.Tenant(); ok {
+		v.Property(dsl.Single, note.FieldTenant, value)
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		v.Property(dsl.Single, note.FieldText, value)
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		constraints = append(constraints, &constraint{
+			pred: g.V().Has(note.Label, note.FieldSlug, value).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueField(note.Label, note.FieldSlug, value)),
+		})
+		v.Property(dsl.Single, note.FieldSlug, value)
+	}
+	for _, id := range _c.mutation.ParentIDs() {
+		v.AddE(note.ChildrenLabel).From(g.V(id)).InV()
+	}
+	for _, id := range _c.mutation.ChildrenIDs() {
+		v.AddE(note.ChildrenLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(note.ChildrenLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(note.Label, note.ChildrenLabel, id)),
+		})
+	}
+	if len(constraints) == 0 {
+		return v.ValueMap(true)
+	}
+	tr := constraints[0].pred.Coalesce(constraints[0].test, v.ValueMap(true))
+	for _, cr := range constraints[1:] {
+		tr = cr.pred.Coalesce(cr.test, tr)
+	}
+	return tr
+}
+
+// NoteCreateBulk is the builder for creating many Note entities in bulk.
+type NoteCreateBulk struct {
+	config
+	err      error
+	builders []*NoteCreate
+}
@@ -0,0 +1,556 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/entc/integration/gremlin/ent/note"
+	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
+)
+
+// NoteQuery is the builder for querying Note entities.
+type NoteQuery struct {
+	config
+	ctx          *QueryContext
+	order        []note.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Note
+	withParent   *NoteQuery
+	withChildren *NoteQuery
+	// intermediate query (i.e. traversal path).
+	gremlin *dsl.Traversal
+	path    func(context.Context) (*dsl.Traversal, error)
+}
+
+// Where adds a new predicate for the NoteQuery builder.
+func (_q *NoteQuery) Where(ps ...predicate.Note) *NoteQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *NoteQuery) Limit(limit int) *NoteQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *NoteQuery) Offset(offset int) *NoteQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *NoteQuery) Unique(unique bool) *NoteQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *NoteQuery) Order(o ...note.OrderOption) *NoteQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (_q *NoteQuery) QueryParent() *NoteQuery {
+	query := (&NoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		gremlin := _q.gremlinQuery(ctx)
+		fromU = gremlin.InE(note.ChildrenLabel).OutV()
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (_q *NoteQuery) QueryChildren() *NoteQuery {
+	query := (&NoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		gremlin := _q.gremlinQuery(ctx)
+		fromU = gremlin.OutE(note.ChildrenLabel).InV()
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Note entity from the query.
+// Returns a *NotFoundError when no Note was found.
+func (_q *NoteQuery) First(ctx context.Context) (*Note, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{note.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *NoteQuery) FirstX(ctx context.Context) *Note {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Note ID from the query.
+// Returns a *NotFoundError when no Note ID was found.
+func (_q *NoteQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{note.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *NoteQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Note entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Note entity is found.
+// Returns a *NotFoundError when no Note entities are found.
+func (_q *NoteQuery) Only(ctx context.Context) (*Note, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{note.Label}
+	default:
+		return nil, &NotSingularError{note.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *NoteQuery) OnlyX(ctx context.Context) *Note {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Note ID in the query.
+// Returns a *NotSingularError when more than one Note ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *NoteQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{note.Label}
+	default:
+		err = &NotSingularError{note.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *NoteQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Notes.
+func (_q *NoteQuery) All(ctx context.Context) ([]*Note, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Note, *NoteQuery]()
+	return withInterceptors[[]*Note](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *NoteQuery) AllX(ctx context.Context) []*Note {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Note IDs.
+func (_q *NoteQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(note.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *NoteQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *NoteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*NoteQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *NoteQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *NoteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *NoteQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NoteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *NoteQuery) Clone() *NoteQuery {
+	if _q == nil {
+		return nil
+	}
+	return &NoteQuery{
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]note.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.Note{}, _q.predicates...),
+		withParent:   _q.withParent.Clone(),
+		withChildren: _q.withChildren.Clone(),
+		// clone intermediate query.
+		gremlin: _q.gremlin.Clone(),
+		path:    _q.path,
+	}
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NoteQuery) WithParent(opts ...func(*NoteQuery)) *NoteQuery {
+	query := (&NoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParent = query
+	return _q
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NoteQuery) WithChildren(opts ...func(*NoteQuery)) *NoteQuery {
+	query := (&NoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChildren = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Note.Query().
+//		GroupBy(note.FieldTenant).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *NoteQuery) GroupBy(field string, fields ...string) *NoteGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NoteGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = note.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//	}
+//
+//	client.Note.Query().
+//		Select(note.FieldTenant).
+//		Scan(ctx, &v)
+func (_q *NoteQuery) Select(fields ...string) *NoteSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &NoteSelect{NoteQuery: _q}
+	sbuild.label = note.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NoteSelect configured with the given aggregations.
+func (_q *NoteQuery) Aggregate(fns ...AggregateFunc) *NoteSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *NoteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.gremlin = prev
+	}
+	return nil
+}
+
+func (_q *NoteQuery) gremlinAll(ctx context.Context, hooks ...queryHook) ([]*Note, error) {
+	res := &gremlin.Response{}
+	traversal := _q.gremlinQuery(ctx)
+	if len(_q.ctx.Fields) > 0 {
+		fields := make([]any, len(_q.ctx.Fields))
+		for i, f := range _q.ctx.Fields {
+			fields[i] = f
+		}
+		traversal.ValueMap(fields...)
+	} else {
+		traversal.ValueMap(true)
+	}
+	query, bindings := traversal.Query()
+	if err := _q.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	var _ms Notes
+	if err := _ms.FromResponse(res); err != nil {
+		return nil, err
+	}
+	for i := range _ms {
+		_ms[i].config = _q.config
+	}
+	return _ms, nil
+}
+
+func (_q *NoteQuery) gremlinCount(ctx context.Context) (int, error) {
+	res := &gremlin.Response{}
+	query, bindings := _q.gremlinQuery(ctx).Count().Query()
+	if err := _q.driver.Exec(ctx, query, bindings, res); err != nil {
+		return 0, err
+	}
+	return res.ReadInt()
+}
+
+func (_q *NoteQuery) gremlinQuery(context.Context) *dsl.Traversal {
+	v := g.V().HasLabel(note.Label)
+	if _q.gremlin != nil {
+		v = _q.gremlin.Clone()
+	}
+	for _, p := range _q.predicates {
+		p(v)
+	}
+	if len(_q.order) > 0 {
+		v.Order()
+		for _, p := range _q.order {
+			p(v)
+		}
+	}
+	switch limit, offset := _q.ctx.Limit, _q.ctx.Offset; {
+	case limit != nil && offset != nil:
+		v.Range(*offset, *offset+*limit)
+	case offset != nil:
+		v.Range(*offset, math.MaxInt32)
+	case limit != nil:
+		v.Limit(*limit)
+	}
+	if unique := _q.ctx.Unique; unique == nil || *unique {
+		v.Dedup()
+	}
+	return v
+}
+
+// NoteGroupBy is the group-by builder for Note entities.
+type NoteGroupBy struct {
+	selector
+	build *NoteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ngb *NoteGroupBy) Aggregate(fns ...AggregateFunc) *NoteGroupBy {
+	ngb.fns = append(ngb.fns, fns...)
+	return ngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ngb *NoteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ngb.build.ctx, ent.OpQueryGroupBy)
+	if err := ngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NoteQuery, *NoteGroupBy](ctx, ngb.build, ngb, ngb.build.inters, v)
+}
+
+func (ngb *NoteGroupBy) gremlinScan(ctx context.Context, root *NoteQuery, v any) error {
+	var (
+		trs   []any
+		names []any
+	)
+	for _, fn := range ngb.fns {
+		name, tr := fn("p", "")
+		trs = append(trs, tr)
+		names = append(names, name)
+	}
+	for _, f := range *ngb.flds {
+		names = append(names, f)
+		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
+	}
+	query, bindings := root.gremlinQuery(ctx).Group().
+		By(__.Values(*ngb.flds...).Fold()).
+		By(__.Fold().Match(trs...).Select(names...)).
+		Select(dsl.Values).
+		Next().
+		Query()
+	res := &gremlin.Response{}
+	if err := ngb.build.driver.Exec(ctx, query, bindings, res); err != nil {
+		return err
+	}
+	if len(*ngb.flds)+len(ngb.fns) == 1 {
+		return res.ReadVal(v)
+	}
+	vm, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	return vm.Decode(v)
+}
+
+// NoteSelect is the builder for selecting fields of Note entities.
+type NoteSelect struct {
+	*NoteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ns *NoteSelect) Aggregate(fns ...AggregateFunc) *NoteSelect {
+	ns.fns = append(ns.fns, fns...)
+	return ns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ns *NoteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ns.ctx, ent.OpQuerySelect)
+	if err := ns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NoteQuery, *NoteSelect](ctx, ns.NoteQuery, ns, ns.inters, v)
+}
+
+func (ns *NoteSelect) gremlinScan(ctx context.Context, root *NoteQuery, v any) error {
+	var (
+		res       = &gremlin.Response{}
+		traversal = root.gremlinQuery(ctx)
+	)
+	if fields := ns.ctx.Fields; len(fields) == 1 {
+		if fields[0] != note.FieldID {
+			traversal = traversal.Values(fields...)
+		} else {
+			traversal = traversal.ID()
+		}
+	} else {
+		fields := make([]any, len(ns.ctx.Fields))
+		for i, f := range ns.ctx.Fields {
+			fields[i] = f
+		}
+		traversal = traversal.ValueMap(fields...)
+	}
+	query, bindings := traversal.Query()
+	if err := ns.driver.Exec(ctx, query, bindings, res); err != nil {
+		return err
+	}
+	if len(root.ctx.Fields) == 1 {
+		return res.ReadVal(v)
+	}
+	vm, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	return vm.Decode(v)
+}
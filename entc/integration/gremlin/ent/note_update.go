@@ -0,0 +1,476 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+	"entgo.io/ent/entc/integration/gremlin/ent/note"
+	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
+)
+
+// NoteUpdate is the builder for updating Note entities.
+type NoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NoteMutation
+}
+
+// Where appends a list predicates to the NoteUpdate builder.
+func (_u *NoteUpdate) Where(ps ...predicate.Note) *NoteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *NoteUpdate) SetText(v string) *NoteUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *NoteUpdate) SetNillableText(v *string) *NoteUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *NoteUpdate) SetSlug(v string) *NoteUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *NoteUpdate) SetNillableSlug(v *string) *NoteUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (_u *NoteUpdate) ClearSlug() *NoteUpdate {
+	_u.mutation.ClearSlug()
+	return _u
+}
+
+// SetParentID sets the "parent" edge to the Note entity by ID.
+func (_u *NoteUpdate) SetParentID(id string) *NoteUpdate {
+	_u.mutation.SetParentID(id)
+	return _u
+}
+
+// SetNillableParentID sets the "parent" edge to the Note entity by ID if the given value is not nil.
+func (_u *NoteUpdate) SetNillableParentID(id *string) *NoteUpdate {
+	if id != nil {
+		_u = _u.SetParentID(*id)
+	}
+	return _u
+}
+
+// SetParent sets the "parent" edge to the Note entity.
+func (_u *NoteUpdate) SetParent(v *Note) *NoteUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Note entity by IDs.
+func (_u *NoteUpdate) AddChildIDs(ids ...string) *NoteUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Note entity.
+func (_u *NoteUpdate) AddChildren(v ...*Note) *NoteUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// Mutation returns the NoteMutation object of the builder.
+func (_u *NoteUpdate) Mutation() *NoteMutation {
+	return _u.mutation
+}
+
+// ClearParent clears the "parent" edge to the Note entity.
+func (_u *NoteUpdate) ClearParent() *NoteUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Note entity.
+func (_u *NoteUpdate) ClearChildren() *NoteUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Note entities by IDs.
+func (_u *NoteUpdate) RemoveChildIDs(ids ...string) *NoteUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Note entities.
+func (_u *NoteUpdate) RemoveChildren(v ...*Note) *NoteUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NoteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.gremlinSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NoteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NoteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *NoteUpdate) gremlinSave(ctx context.Context) (int, error) {
+	res := &gremlin.Response{}
+	query, bindings := _u.gremlin().Query()
+	if err := _u.driver.Exec(ctx, query, bindings, res); err != nil {
+		return 0, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return 0, err
+	}
+	_u.mutation.done = true
+	return res.ReadInt()
+}
+
+func (_u *NoteUpdate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 2)
+	v := g.V().HasLabel(note.Label)
+	for _, p := range _u.mutation.predicates {
+		p(v)
+	}
+	var (
+		rv = v.Clone()
+		_  = rv
+
+		trs []*dsl.Traversal
+	)
+	if value, ok := _u.mutation.Text(); ok {
+		v.Property(dsl.Single, note.FieldText, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		constraints = append(constraints, &constraint{
+			pred: g.V().Has(note.Label, note.FieldSlug, value).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueField(note.Label, note.FieldSlug, value)),
+		})
+		v.Property(dsl.Single, note.FieldSlug, value)
+	}
+	var properties []any
+	if _u.mutation.SlugCleared() {
+		properties = append(properties, note.FieldSlug)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	if _u.mutation.ParentCleared() {
+		tr := rv.Clone().InE(note.ChildrenLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range _u.mutation.ParentIDs() {
+		v.AddE(note.ChildrenLabel).From(g.V(id)).InV()
+	}
+	for _, id := range _u.mutation.RemovedChildrenIDs() {
+		tr := rv.Clone().OutE(note.ChildrenLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range _u.mutation.ChildrenIDs() {
+		v.AddE(note.ChildrenLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(note.ChildrenLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(note.Label, note.ChildrenLabel, id)),
+		})
+	}
+	v.Count()
+	if len(constraints) > 0 {
+		constraints = append(constraints, &constraint{
+			pred: rv.Count(),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
+		})
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
+
+// NoteUpdateOne is the builder for updating a single Note entity.
+type NoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NoteMutation
+}
+
+// SetText sets the "text" field.
+func (_u *NoteUpdateOne) SetText(v string) *NoteUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *NoteUpdateOne) SetNillableText(v *string) *NoteUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *NoteUpdateOne) SetSlug(v string) *NoteUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *NoteUpdateOne) SetNillableSlug(v *string) *NoteUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (_u *NoteUpdateOne) ClearSlug() *NoteUpdateOne {
+	_u.mutation.ClearSlug()
+	return _u
+}
+
+// SetParentID sets the "parent" edge to the Note entity by ID.
+func (_u *NoteUpdateOne) SetParentID(id string) *NoteUpdateOne {
+	_u.mutation.SetParentID(id)
+	return _u
+}
+
+// SetNillableParentID sets the "parent" edge to the Note entity by ID if the given value is not nil.
+func (_u *NoteUpdateOne) SetNillableParentID(id *string) *NoteUpdateOne {
+	if id != nil {
+		_u = _u.SetParentID(*id)
+	}
+	return _u
+}
+
+// SetParent sets the "parent" edge to the Note entity.
+func (_u *NoteUpdateOne) SetParent(v *Note) *NoteUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Note entity by IDs.
+func (_u *NoteUpdateOne) AddChildIDs(ids ...string) *NoteUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Note entity.
+func (_u *NoteUpdateOne) AddChildren(v ...*Note) *NoteUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// Mutation returns the NoteMutation object of the builder.
+func (_u *NoteUpdateOne) Mutation() *NoteMutation {
+	return _u.mutation
+}
+
+// ClearParent clears the "parent" edge to the Note entity.
+func (_u *NoteUpdateOne) ClearParent() *NoteUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Note entity.
+func (_u *NoteUpdateOne) ClearChildren() *NoteUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Note entities by IDs.
+func (_u *NoteUpdateOne) RemoveChildIDs(ids ...string) *NoteUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Note entities.
+func (_u *NoteUpdateOne) RemoveChildren(v ...*Note) *NoteUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// Where appends a list predicates to the NoteUpdate builder.
+func (_u *NoteUpdateOne) Where(ps ...predicate.Note) *NoteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NoteUpdateOne) Select(field string, fields ...string) *NoteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Note entity.
+func (_u *NoteUpdateOne) Save(ctx context.Context) (*Note, error) {
+	return withHooks(ctx, _u.gremlinSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NoteUpdateOne) SaveX(ctx context.Context) *Note {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NoteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *NoteUpdateOne) gremlinSave(ctx context.Context) (*Note, error) {
+	res := &gremlin.Response{}
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Note.id" for update`)}
+	}
+	query, bindings := _u.gremlin(id).Query()
+	if err := _u.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	_u.mutation.done = true
+	_m := &Note{config: _u.config}
+	if err := _m.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return _m, nil
+}
+
+func (_u *NoteUpdateOne) gremlin(id string) *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 2)
+	v := g.V(id)
+	var (
+		rv = v.Clone()
+		_  = rv
+
+		trs []*dsl.Traversal
+	)
+	if value, ok := _u.mutation.Text(); ok {
+		v.Property(dsl.Single, note.FieldText, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		constraints = append(constraints, &constraint{
+			pred: g.V().Has(note.Label, note.FieldSlug, value).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueField(note.Label, note.FieldSlug, value)),
+		})
+		v.Property(dsl.Single, note.FieldSlug, value)
+	}
+	var properties []any
+	if _u.mutation.SlugCleared() {
+		properties = append(properties, note.FieldSlug)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	if _u.mutation.ParentCleared() {
+		tr := rv.Clone().InE(note.ChildrenLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range _u.mutation.ParentIDs() {
+		v.AddE(note.ChildrenLabel).From(g.V(id)).InV()
+	}
+	for _, id := range _u.mutation.RemovedChildrenIDs() {
+		tr := rv.Clone().OutE(note.ChildrenLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range _u.mutation.ChildrenIDs() {
+		v.AddE(note.ChildrenLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(note.ChildrenLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(note.Label, note.ChildrenLabel, id)),
+		})
+	}
+	if len(_u.fields) > 0 {
+		fields := make([]any, 0, len(_u.fields)+1)
+		fields = append(fields, true)
+		for _, f := range _u.fields {
+			fields = append(fields, f)
+		}
+		v.ValueMap(fields...)
+	} else {
+		v.ValueMap(true)
+	}
+	if len(constraints) > 0 {
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
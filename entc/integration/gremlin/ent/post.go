@@ -0,0 +1,147 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/entc/integration/gremlin/ent/post"
+)
+
+// Post is the model entity for the Post schema.
+type Post struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostQuery when eager-loading is set.
+	Edges PostEdges `json:"edges"`
+}
+
+// PostEdges holds the relations/edges for other nodes in the graph.
+type PostEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Post `json:"parent,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*Post `json:"replies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) ParentOrErr() (*Post, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RepliesOrErr() ([]*Post, error) {
+	if e.loadedTypes[1] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// FromResponse scans the gremlin response data into Post.
+func (_m *Post) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scan_m struct {
+		ID     string `json:"id,omitempty"`
+		Title  string `json:"title,omitempty"`
+		Secret string `json:"secret,omitempty"`
+	}
+	if err := vmap.Decode(&scan_m); err != nil {
+		return err
+	}
+	_m.ID = scan_m.ID
+	_m.Title = scan_m.Title
+	_m.Secret = scan_m.Secret
+	return nil
+}
+
+// QueryParent queries the "parent" edge of the Post entity.
+func (_m *Post) QueryParent() *PostQuery {
+	return NewPostClient(_m.config).QueryParent(_m)
+}
+
+// QueryReplies queries the "replies" edge of the Post entity.
+func (_m *Post) QueryReplies() *PostQuery {
+	return NewPostClient(_m.config).QueryReplies(_m)
+}
+
+// Update returns a builder for updating this Post.
+// Note that you need to call Post.Unwrap() before calling this method if this Post
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Post) Update() *PostUpdateOne {
+	return NewPostClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Post entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Post) Unwrap() *Post {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Post is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Post) String() string {
+	var builder strings.Builder
+	builder.WriteString("Post(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Posts is a parsable slice of Post.
+type Posts []*Post
+
+// FromResponse scans the gremlin response data into Posts.
+func (_m *Posts) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scan_m []struct {
+		ID     string `json:"id,omitempty"`
+		Title  string `json:"title,omitempty"`
+		Secret string `json:"secret,omitempty"`
+	}
+	if err := vmap.Decode(&scan_m); err != nil {
+		return err
+	}
+	for _, v := range scan_m {
+		node := &Post{ID: v.ID}
+		node.Title = v.Title
+		node.Secret = v.Secret
+		*_m = append(*_m, node)
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package post
+
+import (
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasID(id)
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasID(p.EQ(id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasID(p.NEQ(id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Within(v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Without(v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasID(p.GT(id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasID(p.GTE(id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasID(p.LT(id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasID(p.LTE(id))
+	})
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.EQ(v))
+	})
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.EQ(v))
+	})
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.EQ(v))
+	})
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.NEQ(v))
+	})
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.Within(vs...))
+	})
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.Without(vs...))
+	})
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.GT(v))
+	})
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.GTE(v))
+	})
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.LT(v))
+	})
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.LTE(v))
+	})
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.Containing(v))
+	})
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.StartingWith(v))
+	})
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldTitle, p.EndingWith(v))
+	})
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.EQ(v))
+	})
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.NEQ(v))
+	})
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.Within(vs...))
+	})
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.Without(vs...))
+	})
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.GT(v))
+	})
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.GTE(v))
+	})
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.LT(v))
+	})
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.LTE(v))
+	})
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.Containing(v))
+	})
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.StartingWith(v))
+	})
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSecret, p.EndingWith(v))
+	})
+}
+
+// SecretIsNil applies the IsNil predicate on the "secret" field.
+func SecretIsNil() predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldSecret)
+	})
+}
+
+// SecretNotNil applies the NotNil predicate on the "secret" field.
+func SecretNotNil() predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldSecret)
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.InE(ParentInverseLabel).InV()
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Post) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		tr := __.OutV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.InE(ParentInverseLabel).Where(tr).InV()
+	})
+}
+
+// HasReplies applies the HasEdge predicate on the "replies" edge.
+func HasReplies() predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		t.OutE(RepliesLabel).OutV()
+	})
+}
+
+// HasRepliesWith applies the HasEdge predicate on the "replies" edge with a given conditions (other predicates).
+func HasRepliesWith(preds ...predicate.Post) predicate.Post {
+	return predicate.Post(func(t *dsl.Traversal) {
+		tr := __.InV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.OutE(RepliesLabel).Where(tr).OutV()
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Post) predicate.Post {
+	return predicate.Post(func(tr *dsl.Traversal) {
+		trs := make([]any, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.And(trs...))
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Post) predicate.Post {
+	return predicate.Post(func(tr *dsl.Traversal) {
+		trs := make([]any, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.Or(trs...))
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Post) predicate.Post {
+	return predicate.Post(func(tr *dsl.Traversal) {
+		t := __.New()
+		p(t)
+		tr.Where(__.Not(t))
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+	"entgo.io/ent/entc/integration/gremlin/ent/post"
+)
+
+// PostCreate is the builder for creating a Post entity.
+type PostCreate struct {
+	config
+	mutation *PostMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (_c *PostCreate) SetTitle(v string) *PostCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *PostCreate) SetSecret(v string) *PostCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_c *PostCreate) SetNillableSecret(v *string) *PostCreate {
+	if v != nil {
+		_c.SetSecret(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent" edge to the Post entity by ID.
+func (_c *PostCreate) SetParentID(id string) *PostCreate {
+	_c.mutation.SetParentID(id)
+	return _c
+}
+
+// SetNillableParentID sets the "parent" edge to the Post entity by ID if the given value is not nil.
+func (_c *PostCreate) SetNillableParentID(id *string) *PostCreate {
+	if id != nil {
+		_c = _c.SetParentID(*id)
+	}
+	return _c
+}
+
+// SetParent sets the "parent" edge to the Post entity.
+func (_c *PostCreate) SetParent(v *Post) *PostCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Post entity by IDs.
+func (_c *PostCreate) AddReplyIDs(ids ...string) *PostCreate {
+	_c.mutation.AddReplyIDs(ids...)
+	return _c
+}
+
+// AddReplies adds the "replies" edges to the Post entity.
+func (_c *PostCreate) AddReplies(v ...*Post) *PostCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplyIDs(ids...)
+}
+
+// Mutation returns the PostMutation object of the builder.
+func (_c *PostCreate) Mutation() *PostMutation {
+	return _c.mutation
+}
+
+// Save creates the Post in the database.
+func (_c *PostCreate) Save(ctx context.Context) (*Post, error) {
+	return withHooks(ctx, _c.gremlinSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostCreate) SaveX(ctx context.Context) *Post {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostCreate) check() error {
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Post.title"`)}
+	}
+	return nil
+}
+
+func (_c *PostCreate) gremlinSave(ctx context.Context) (*Post, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	res := &gremlin.Response{}
+	query, bindings := _c.gremlin().Query()
+	if err := _c.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	rnode := &Post{config: _c.config}
+	if err := rnode.FromResponse(res); err != nil {
+		return nil, err
+	}
+	_c.mutation.id = &rnode.ID
+	_c.mutation.done = true
+	return rnode, nil
+}
+
+func (_c *PostCreate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.AddV(post.Label)
+	if value, ok := _c.mutation.Title(); ok {
+		v.Property(dsl.Single, post.FieldTitle, value)
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		v.Property(dsl.Single, post.FieldSecret, value)
+	}
+	for _, id := range _c.mutation.ParentIDs() {
+		v.AddE(post.RepliesLabel).From(g.V(id)).InV()
+	}
+	for _, id := range _c.mutation.RepliesIDs() {
+		v.AddE(post.RepliesLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(post.RepliesLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(post.Label, post.RepliesLabel, id)),
+		})
+	}
+	if len(constraints) == 0 {
+		return v.ValueMap(true)
+	}
+	tr := constraints[0].pred.Coalesce(constraints[0].test, v.ValueMap(true))
+	for _, cr := range constraints[1:] {
+		tr = cr.pred.Coalesce(cr.test, tr)
+	}
+	return tr
+}
+
+// PostCreateBulk is the builder for creating many Post entities in bulk.
+type PostCreateBulk struct {
+	config
+	err      error
+	builders []*PostCreate
+}
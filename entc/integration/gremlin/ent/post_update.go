@@ -0,0 +1,468 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+	"entgo.io/ent/entc/integration/gremlin/ent/post"
+	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
+)
+
+// PostUpdate is the builder for updating Post entities.
+type PostUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostMutation
+}
+
+// Where appends a list predicates to the PostUpdate builder.
+func (_u *PostUpdate) Where(ps ...predicate.Post) *PostUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PostUpdate) SetTitle(v string) *PostUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableTitle(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *PostUpdate) SetSecret(v string) *PostUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableSecret(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (_u *PostUpdate) ClearSecret() *PostUpdate {
+	_u.mutation.ClearSecret()
+	return _u
+}
+
+// SetParentID sets the "parent" edge to the Post entity by ID.
+func (_u *PostUpdate) SetParentID(id string) *PostUpdate {
+	_u.mutation.SetParentID(id)
+	return _u
+}
+
+// SetNillableParentID sets the "parent" edge to the Post entity by ID if the given value is not nil.
+func (_u *PostUpdate) SetNillableParentID(id *string) *PostUpdate {
+	if id != nil {
+		_u = _u.SetParentID(*id)
+	}
+	return _u
+}
+
+// SetParent sets the "parent" edge to the Post entity.
+func (_u *PostUpdate) SetParent(v *Post) *PostUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Post entity by IDs.
+func (_u *PostUpdate) AddReplyIDs(ids ...string) *PostUpdate {
+	_u.mutation.AddReplyIDs(ids...)
+	return _u
+}
+
+// AddReplies adds the "replies" edges to the Post entity.
+func (_u *PostUpdate) AddReplies(v ...*Post) *PostUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplyIDs(ids...)
+}
+
+// Mutation returns the PostMutation object of the builder.
+func (_u *PostUpdate) Mutation() *PostMutation {
+	return _u.mutation
+}
+
+// ClearParent clears the "parent" edge to the Post entity.
+func (_u *PostUpdate) ClearParent() *PostUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearReplies clears all "replies" edges to the Post entity.
+func (_u *PostUpdate) ClearReplies() *PostUpdate {
+	_u.mutation.ClearReplies()
+	return _u
+}
+
+// RemoveReplyIDs removes the "replies" edge to Post entities by IDs.
+func (_u *PostUpdate) RemoveReplyIDs(ids ...string) *PostUpdate {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to Post entities.
+func (_u *PostUpdate) RemoveReplies(v ...*Post) *PostUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PostUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.gremlinSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PostUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PostUpdate) gremlinSave(ctx context.Context) (int, error) {
+	res := &gremlin.Response{}
+	query, bindings := _u.gremlin().Query()
+	if err := _u.driver.Exec(ctx, query, bindings, res); err != nil {
+		return 0, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return 0, err
+	}
+	_u.mutation.done = true
+	return res.ReadInt()
+}
+
+func (_u *PostUpdate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.V().HasLabel(post.Label)
+	for _, p := range _u.mutation.predicates {
+		p(v)
+	}
+	var (
+		rv = v.Clone()
+		_  = rv
+
+		trs []*dsl.Traversal
+	)
+	if value, ok := _u.mutation.Title(); ok {
+		v.Property(dsl.Single, post.FieldTitle, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		v.Property(dsl.Single, post.FieldSecret, value)
+	}
+	var properties []any
+	if _u.mutation.SecretCleared() {
+		properties = append(properties, post.FieldSecret)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	if _u.mutation.ParentCleared() {
+		tr := rv.Clone().InE(post.RepliesLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range _u.mutation.ParentIDs() {
+		v.AddE(post.RepliesLabel).From(g.V(id)).InV()
+	}
+	for _, id := range _u.mutation.RemovedRepliesIDs() {
+		tr := rv.Clone().OutE(post.RepliesLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range _u.mutation.RepliesIDs() {
+		v.AddE(post.RepliesLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(post.RepliesLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(post.Label, post.RepliesLabel, id)),
+		})
+	}
+	v.Count()
+	if len(constraints) > 0 {
+		constraints = append(constraints, &constraint{
+			pred: rv.Count(),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
+		})
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
+
+// PostUpdateOne is the builder for updating a single Post entity.
+type PostUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostMutation
+}
+
+// SetTitle sets the "title" field.
+func (_u *PostUpdateOne) SetTitle(v string) *PostUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableTitle(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *PostUpdateOne) SetSecret(v string) *PostUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableSecret(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (_u *PostUpdateOne) ClearSecret() *PostUpdateOne {
+	_u.mutation.ClearSecret()
+	return _u
+}
+
+// SetParentID sets the "parent" edge to the Post entity by ID.
+func (_u *PostUpdateOne) SetParentID(id string) *PostUpdateOne {
+	_u.mutation.SetParentID(id)
+	return _u
+}
+
+// SetNillableParentID sets the "parent" edge to the Post entity by ID if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableParentID(id *string) *PostUpdateOne {
+	if id != nil {
+		_u = _u.SetParentID(*id)
+	}
+	return _u
+}
+
+// SetParent sets the "parent" edge to the Post entity.
+func (_u *PostUpdateOne) SetParent(v *Post) *PostUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Post entity by IDs.
+func (_u *PostUpdateOne) AddReplyIDs(ids ...string) *PostUpdateOne {
+	_u.mutation.AddReplyIDs(ids...)
+	return _u
+}
+
+// AddReplies adds the "replies" edges to the Post entity.
+func (_u *PostUpdateOne) AddReplies(v ...*Post) *PostUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplyIDs(ids...)
+}
+
+// Mutation returns the PostMutation object of the builder.
+func (_u *PostUpdateOne) Mutation() *PostMutation {
+	return _u.mutation
+}
+
+// ClearParent clears the "parent" edge to the Post entity.
+func (_u *PostUpdateOne) ClearParent() *PostUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearReplies clears all "replies" edges to the Post entity.
+func (_u *PostUpdateOne) ClearReplies() *PostUpdateOne {
+	_u.mutation.ClearReplies()
+	return _u
+}
+
+// RemoveReplyIDs removes the "replies" edge to Post entities by IDs.
+func (_u *PostUpdateOne) RemoveReplyIDs(ids ...string) *PostUpdateOne {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to Post entities.
+func (_u *PostUpdateOne) RemoveReplies(v ...*Post) *PostUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplyIDs(ids...)
+}
+
+// Where appends a list predicates to the PostUpdate builder.
+func (_u *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PostUpdateOne) Select(field string, fields ...string) *PostUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Post entity.
+func (_u *PostUpdateOne) Save(ctx context.Context) (*Post, error) {
+	return withHooks(ctx, _u.gremlinSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostUpdateOne) SaveX(ctx context.Context) *Post {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PostUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PostUpdateOne) gremlinSave(ctx context.Context) (*Post, error) {
+	res := &gremlin.Response{}
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Post.id" for update`)}
+	}
+	query, bindings := _u.gremlin(id).Query()
+	if err := _u.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	_u.mutation.done = true
+	_m := &Post{config: _u.config}
+	if err := _m.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return _m, nil
+}
+
+func (_u *PostUpdateOne) gremlin(id string) *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.V(id)
+	var (
+		rv = v.Clone()
+		_  = rv
+
+		trs []*dsl.Traversal
+	)
+	if value, ok := _u.mutation.Title(); ok {
+		v.Property(dsl.Single, post.FieldTitle, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		v.Property(dsl.Single, post.FieldSecret, value)
+	}
+	var properties []any
+	if _u.mutation.SecretCleared() {
+		properties = append(properties, post.FieldSecret)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	if _u.mutation.ParentCleared() {
+		tr := rv.Clone().InE(post.RepliesLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range _u.mutation.ParentIDs() {
+		v.AddE(post.RepliesLabel).From(g.V(id)).InV()
+	}
+	for _, id := range _u.mutation.RemovedRepliesIDs() {
+		tr := rv.Clone().OutE(post.RepliesLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range _u.mutation.RepliesIDs() {
+		v.AddE(post.RepliesLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(post.RepliesLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(post.Label, post.RepliesLabel, id)),
+		})
+	}
+	if len(_u.fields) > 0 {
+		fields := make([]any, 0, len(_u.fields)+1)
+		fields = append(fields, true)
+		for _, f := range _u.fields {
+			fields = append(fields, f)
+		}
+		v.ValueMap(fields...)
+	} else {
+		v.ValueMap(true)
+	}
+	if len(constraints) > 0 {
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
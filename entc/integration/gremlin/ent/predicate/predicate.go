@@ -63,12 +63,18 @@ type License func(*dsl.Traversal)
 // Node is the predicate function for node builders.
 type Node func(*dsl.Traversal)
 
+// Note is the predicate function for note builders.
+type Note func(*dsl.Traversal)
+
 // PC is the predicate function for pc builders.
 type PC func(*dsl.Traversal)
 
 // Pet is the predicate function for pet builders.
 type Pet func(*dsl.Traversal)
 
+// Post is the predicate function for post builders.
+type Post func(*dsl.Traversal)
+
 // Spec is the predicate function for spec builders.
 type Spec func(*dsl.Traversal)
 
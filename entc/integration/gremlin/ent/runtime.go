@@ -304,6 +304,10 @@ func init() {
 	enttask.DefaultOp = enttaskDescOp.Default.(string)
 	// enttask.OpValidator is a validator for the "op" field. It is called by the builders before save.
 	enttask.OpValidator = enttaskDescOp.Validators[0].(func(string) error)
+	// enttaskDescVersion is the schema descriptor for version field.
+	enttaskDescVersion := enttaskFields[8].Descriptor()
+	// enttask.DefaultVersion holds the default value on creation for the version field.
+	enttask.DefaultVersion = enttaskDescVersion.Default.(int)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -38,6 +38,8 @@ type Task struct {
 	OrderOption int `json:"order_option,omitempty"`
 	// Op holds the value of the "op" field.
 	Op string `json:"op,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 }
 
 // FromResponse scans the gremlin response data into Task.
@@ -56,6 +58,7 @@ func (_m *Task) FromResponse(res *gremlin.Response) error {
 		Order       int                      `json:"order,omitempty"`
 		OrderOption int                      `json:"order_option,omitempty"`
 		Op          string                   `json:"op,omitempty"`
+		Version     int                      `json:"version,omitempty"`
 	}
 	if err := vmap.Decode(&scan_m); err != nil {
 		return err
@@ -70,6 +73,7 @@ func (_m *Task) FromResponse(res *gremlin.Response) error {
 	_m.Order = scan_m.Order
 	_m.OrderOption = scan_m.OrderOption
 	_m.Op = scan_m.Op
+	_m.Version = scan_m.Version
 	return nil
 }
 
@@ -121,6 +125,9 @@ func (_m *Task) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("op=")
 	builder.WriteString(_m.Op)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -144,6 +151,7 @@ func (_m *Tasks) FromResponse(res *gremlin.Response) error {
 		Order       int                      `json:"order,omitempty"`
 		OrderOption int                      `json:"order_option,omitempty"`
 		Op          string                   `json:"op,omitempty"`
+		Version     int                      `json:"version,omitempty"`
 	}
 	if err := vmap.Decode(&scan_m); err != nil {
 		return err
@@ -159,6 +167,7 @@ func (_m *Tasks) FromResponse(res *gremlin.Response) error {
 		node.Order = v.Order
 		node.OrderOption = v.OrderOption
 		node.Op = v.Op
+		node.Version = v.Version
 		*_m = append(*_m, node)
 	}
 	return nil
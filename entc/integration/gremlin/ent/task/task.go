@@ -34,6 +34,8 @@ const (
 	FieldOrderOption = "order_option"
 	// FieldOp holds the string denoting the op field in the database.
 	FieldOp = "op"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 )
 
 var (
@@ -45,6 +47,8 @@ var (
 	DefaultOp string
 	// OpValidator is a validator for the "op" field. It is called by the builders before save.
 	OpValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 )
 
 // OrderOption defines the ordering options for the Task queries.
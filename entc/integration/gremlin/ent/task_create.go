@@ -130,6 +130,20 @@ func (_c *TaskCreate) SetNillableOp(v *string) *TaskCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *TaskCreate) SetVersion(v int) *TaskCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableVersion(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_c *TaskCreate) Mutation() *TaskMutation {
 	return _c.mutation
@@ -177,6 +191,10 @@ func (_c *TaskCreate) defaults() {
 		v := enttask.DefaultOp
 		_c.mutation.SetOpField(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := enttask.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -200,6 +218,9 @@ func (_c *TaskCreate) check() error {
 			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Task.version"`)}
+	}
 	return nil
 }
 
@@ -250,6 +271,9 @@ func (_c *TaskCreate) gremlin() *dsl.Traversal {
 	if value, ok := _c.mutation.GetOp(); ok {
 		v.Property(dsl.Single, enttask.FieldOp, value)
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		v.Property(dsl.Single, enttask.FieldVersion, value)
+	}
 	return v.ValueMap(true)
 }
 
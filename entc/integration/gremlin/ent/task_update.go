@@ -174,6 +174,27 @@ func (_u *TaskUpdate) SetNillableOp(v *string) *TaskUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *TaskUpdate) SetVersion(v int) *TaskUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableVersion(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TaskUpdate) AddVersion(v int) *TaskUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdate) Mutation() *TaskMutation {
 	return _u.mutation
@@ -275,6 +296,12 @@ func (_u *TaskUpdate) gremlin() *dsl.Traversal {
 	if value, ok := _u.mutation.GetOp(); ok {
 		v.Property(dsl.Single, enttask.FieldOp, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		v.Property(dsl.Single, enttask.FieldVersion, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		v.Property(dsl.Single, enttask.FieldVersion, __.Union(__.Values(enttask.FieldVersion), __.Constant(value)).Sum())
+	}
 	var properties []any
 	if _u.mutation.PrioritiesCleared() {
 		properties = append(properties, enttask.FieldPriorities)
@@ -448,6 +475,27 @@ func (_u *TaskUpdateOne) SetNillableOp(v *string) *TaskUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *TaskUpdateOne) SetVersion(v int) *TaskUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableVersion(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TaskUpdateOne) AddVersion(v int) *TaskUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdateOne) Mutation() *TaskMutation {
 	return _u.mutation
@@ -567,6 +615,12 @@ func (_u *TaskUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := _u.mutation.GetOp(); ok {
 		v.Property(dsl.Single, enttask.FieldOp, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		v.Property(dsl.Single, enttask.FieldVersion, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		v.Property(dsl.Single, enttask.FieldVersion, __.Union(__.Values(enttask.FieldVersion), __.Constant(value)).Sum())
+	}
 	var properties []any
 	if _u.mutation.PrioritiesCleared() {
 		properties = append(properties, enttask.FieldPriorities)
@@ -44,10 +44,14 @@ type Tx struct {
 	License *LicenseClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
+	// Note is the client for interacting with the Note builders.
+	Note *NoteClient
 	// PC is the client for interacting with the PC builders.
 	PC *PCClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
+	// Post is the client for interacting with the Post builders.
+	Post *PostClient
 	// Spec is the client for interacting with the Spec builders.
 	Spec *SpecClient
 	// Task is the client for interacting with the Task builders.
@@ -199,8 +203,10 @@ func (tx *Tx) init() {
 	tx.Item = NewItemClient(tx.config)
 	tx.License = NewLicenseClient(tx.config)
 	tx.Node = NewNodeClient(tx.config)
+	tx.Note = NewNoteClient(tx.config)
 	tx.PC = NewPCClient(tx.config)
 	tx.Pet = NewPetClient(tx.config)
+	tx.Post = NewPostClient(tx.config)
 	tx.Spec = NewSpecClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
 	tx.User = NewUserClient(tx.config)
@@ -23,6 +23,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -43,7 +45,9 @@ import (
 	"entgo.io/ent/entc/integration/ent/license"
 	"entgo.io/ent/entc/integration/ent/migrate"
 	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/note"
 	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/post"
 	"entgo.io/ent/entc/integration/ent/schema"
 	"entgo.io/ent/entc/integration/ent/schema/task"
 	enttask "entgo.io/ent/entc/integration/ent/task"
@@ -141,16 +145,25 @@ var (
 		Sanity,
 		NoSchemaChanges,
 		Tx,
+		Savepoint,
+		TxRetry,
 		Lock,
 		Indexes,
 		Types,
 		Clone,
 		EntQL,
 		Paging,
+		Paginate,
+		Iter,
+		EachBatch,
 		Select,
 		Aggregate,
 		Delete,
+		Returning,
 		Upsert,
+		MultiTenancy,
+		SoftDelete,
+		History,
 		Relation,
 		ExecQuery,
 		Predicate,
@@ -171,8 +184,12 @@ var (
 		Sensitive,
 		EagerLoading,
 		NamedEagerLoading,
+		DataLoader,
+		EdgeAggregate,
+		OptimisticLock,
 		Mutation,
 		CreateBulk,
+		BulkLoad,
 		ConstraintChecks,
 		NillableRequired,
 		ExtValueScan,
@@ -327,6 +344,47 @@ func Sanity(t *testing.T, client *ent.Client) {
 	})
 }
 
+func Returning(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	client.Pet.MapCreateBulk([]string{"a", "b", "c"}, func(c *ent.PetCreate, i int) {
+		c.SetName(fmt.Sprintf("pet-%s", []string{"a", "b", "c"}[i])).SetAge(float64(i + 1))
+	}).ExecX(ctx)
+
+	pets := client.Pet.Update().Where(pet.AgeLT(3)).SetTrained(true).SaveReturningX(ctx)
+	require.Len(t, pets, 2)
+	sort.Slice(pets, func(i, j int) bool { return pets[i].Age < pets[j].Age })
+	for i, p := range pets {
+		require.True(t, p.Trained)
+		require.Equal(t, float64(i+1), p.Age)
+		require.Equal(t, fmt.Sprintf("pet-%s", []string{"a", "b"}[i]), p.Name)
+	}
+	pets = client.Pet.Update().Where(pet.Name("pet-c")).AddAge(1).Select(pet.FieldAge).SaveReturningX(ctx)
+	require.Len(t, pets, 1)
+	require.Equal(t, 4.0, pets[0].Age)
+	require.Empty(t, pets[0].Name, "only the selected fields are returned")
+	require.Empty(t, client.Pet.Update().Where(pet.Name("unknown")).SetTrained(true).SaveReturningX(ctx))
+
+	pets = client.Pet.Delete().Where(pet.AgeGT(3)).DeleteReturningX(ctx)
+	require.Len(t, pets, 1)
+	require.Equal(t, "pet-c", pets[0].Name)
+	require.Equal(t, 2, client.Pet.Query().CountX(ctx))
+
+	t.Log("soft-deleted entities are returned with their deletion time")
+	client.Post.Create().SetTitle("post").ExecX(ctx)
+	posts := client.Post.Delete().DeleteReturningX(ctx)
+	require.Len(t, posts, 1)
+	require.NotNil(t, posts[0].DeletedAt)
+
+	t.Log("returned entities are scoped to the tenant")
+	t1, t2 := ent.WithTenant(ctx, "t1"), ent.WithTenant(ctx, "t2")
+	client.Note.Create().SetText("n1").ExecX(t1)
+	client.Note.Create().SetText("n2").ExecX(t2)
+	notes := client.Note.Update().SetText("updated").SaveReturningX(t1)
+	require.Len(t, notes, 1)
+	require.Equal(t, "t1", notes[0].Tenant)
+	require.Equal(t, "n2", client.Note.Query().OnlyX(t2).Text)
+}
+
 func Upsert(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	u := client.User.Create().SetName("Ariel").SetAge(30).SetPhone("0000").SaveX(ctx)
@@ -551,6 +609,160 @@ func Paging(t *testing.T, client *ent.Client) {
 	}
 }
 
+func Paginate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	for i := 1; i <= 10; i++ {
+		c := client.User.Create().SetName(fmt.Sprintf("name-%d", i%3)).SetAge(i % 4)
+		if i%2 == 0 {
+			c.SetNickname(fmt.Sprintf("nick-%d", i%5))
+		}
+		u := c.SaveX(ctx)
+		for j := 0; j < i%3; j++ {
+			client.Pet.Create().SetName(fmt.Sprintf("pet-%d-%d", i, j)).SetOwner(u).ExecX(ctx)
+		}
+	}
+	for _, order := range [][]user.OrderOption{
+		{user.ByAge(sql.OrderDesc())},
+		{user.ByName(), user.ByAge(sql.OrderDesc())},
+		{user.ByNickname()},
+		{user.ByNickname(sql.OrderDesc()), user.ByAge()},
+		{user.ByNickname(sql.OrderNullsFirst())},
+		{user.ByNickname(sql.OrderDesc(), sql.OrderNullsLast())},
+		{user.ByPetsCount(sql.OrderDesc())},
+	} {
+		var (
+			ids    []int
+			after  *ent.Cursor
+			expect = client.User.Query().Order(order...).Order(user.ByID()).IDsX(ctx)
+		)
+		for {
+			page, err := client.User.Query().Order(order...).Paginate(ctx, after, 3)
+			require.NoError(err)
+			require.LessOrEqual(len(page.Nodes), 3)
+			for _, n := range page.Nodes {
+				ids = append(ids, n.ID)
+			}
+			if !page.HasNextPage {
+				break
+			}
+			// Cursors are passed to clients in their encoded form.
+			after, err = ent.DecodeCursor(page.EndCursor.String())
+			require.NoError(err)
+		}
+		require.Equal(expect, ids)
+	}
+
+	page, err := client.User.Query().Where(user.AgeGT(10)).Order(user.ByAge()).Paginate(ctx, nil, 3)
+	require.NoError(err)
+	require.Empty(page.Nodes)
+	require.Nil(page.EndCursor)
+	require.False(page.HasNextPage)
+	_, err = client.User.Query().Paginate(ctx, &ent.Cursor{Values: []any{1, 2}}, 3)
+	require.Error(err, "cursor does not match the ordering terms")
+}
+
+func Iter(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		client.User.Create().SetName(fmt.Sprintf("name-%d", i)).SetAge(i).SaveX(ctx)
+	}
+	var ages []int
+	for u, err := range client.User.Query().Order(ent.Asc(user.FieldAge)).Iter(ctx) {
+		require.NoError(err)
+		ages = append(ages, u.Age)
+	}
+	require.Equal([]int{1, 2, 3, 4, 5}, ages)
+
+	t.Log("stop the iteration early")
+	ages = ages[:0]
+	for u, err := range client.User.Query().Order(ent.Asc(user.FieldAge)).Iter(ctx) {
+		require.NoError(err)
+		if ages = append(ages, u.Age); len(ages) == 2 {
+			break
+		}
+	}
+	require.Equal([]int{1, 2}, ages)
+	// The rows of the stopped iteration were closed.
+	require.Equal(5, client.User.Query().CountX(ctx))
+
+	t.Log("errors are yielded as the last element")
+	var n int
+	for u, err := range client.User.Query().Select("unknown").Iter(ctx) {
+		require.Nil(u)
+		require.Error(err)
+		n++
+	}
+	require.Equal(1, n)
+
+	t.Log("eager-loading is not supported")
+	n = 0
+	for u, err := range client.User.Query().WithPets().Iter(ctx) {
+		require.Nil(u)
+		require.ErrorContains(err, "eager-loading is not supported")
+		n++
+	}
+	require.Equal(1, n)
+}
+
+func EachBatch(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		u := client.User.Create().SetName(fmt.Sprintf("name-%d", i)).SetAge(i).SaveX(ctx)
+		client.Pet.Create().SetName(fmt.Sprintf("pet-%d", i)).SetOwner(u).ExecX(ctx)
+	}
+
+	t.Log("read the users in batches using keyset pagination")
+	var (
+		sizes []int
+		ids   []int
+	)
+	err := client.User.Query().
+		Order(ent.Desc(user.FieldAge)).
+		Offset(1).
+		WithPets().
+		EachBatch(ctx, 2, func(users []*ent.User) error {
+			sizes = append(sizes, len(users))
+			for _, u := range users {
+				ids = append(ids, u.ID)
+				// Eager-loaded edges are loaded for each batch.
+				require.Len(u.Edges.Pets, 1)
+				require.Equal(fmt.Sprintf("pet-%d", u.Age), u.Edges.Pets[0].Name)
+			}
+			return nil
+		})
+	require.NoError(err)
+	require.Equal([]int{2, 2, 1}, sizes, "ordering and offset are ignored")
+	require.Equal(client.User.Query().Order(ent.Asc(user.FieldID)).IDsX(ctx), ids)
+
+	t.Log("stop on the first error")
+	sizes = sizes[:0]
+	errStop := errors.New("stop")
+	err = client.User.Query().EachBatch(ctx, 2, func(users []*ent.User) error {
+		sizes = append(sizes, len(users))
+		return errStop
+	})
+	require.ErrorIs(err, errStop)
+	require.Equal([]int{2}, sizes)
+	require.Error(client.User.Query().EachBatch(ctx, 0, func([]*ent.User) error { return nil }))
+
+	t.Log("non-numeric identifiers")
+	for i := 1; i <= 5; i++ {
+		client.Item.Create().SetText(fmt.Sprintf("item-%d", i)).ExecX(ctx)
+	}
+	var items []string
+	err = client.Item.Query().EachBatch(ctx, 3, func(batch []*ent.Item) error {
+		for _, it := range batch {
+			items = append(items, it.ID)
+		}
+		return nil
+	})
+	require.NoError(err)
+	require.Equal(client.Item.Query().Order(ent.Asc(item.FieldID)).IDsX(ctx), items)
+}
+
 func Select(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	require := require.New(t)
@@ -1698,9 +1910,13 @@ func Tx(t *testing.T, client *ent.Client) {
 		m.On("onRollback", nil).Once()
 		defer m.AssertExpectations(t)
 		tx.OnRollback(m.rHook())
-		_, err = tx.Client().Tx(ctx)
-		require.Error(t, err, "cannot start a transaction within a transaction")
+		n := client.Node.Query().CountX(ctx)
+		nested, err := tx.Client().Tx(ctx)
+		require.NoError(t, err, "transactions are nested using savepoints")
+		nested.Node.Create().ExecX(ctx)
+		require.NoError(t, nested.Commit())
 		require.NoError(t, tx.Rollback())
+		require.Equal(t, n, client.Node.Query().CountX(ctx), "rollback should discard the changes of nested transactions")
 	})
 	t.Run("TxOptions Rollback", func(t *testing.T) {
 		skip(t, "SQLite")
@@ -1742,6 +1958,168 @@ func Tx(t *testing.T, client *ent.Client) {
 	})
 }
 
+func Savepoint(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	t.Run("Commit", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		var m mocker
+		m.On("onCommit", nil).Once()
+		defer m.AssertExpectations(t)
+		a8m := tx.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+		nested, err := tx.Tx(ctx)
+		require.NoError(t, err)
+		nested.OnCommit(func(next ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+				err := next.Commit(ctx, tx)
+				m.onCommit(err)
+				return err
+			})
+		})
+		nested.User.UpdateOneID(a8m.ID).SetAge(31).ExecX(ctx)
+		nested.User.Create().SetName("nati").SetAge(28).ExecX(ctx)
+		require.NoError(t, nested.Commit())
+		m.AssertNotCalled(t, "onCommit", nil)
+		require.Equal(t, 2, tx.User.Query().CountX(ctx), "changes of the nested transaction should be visible to its parent")
+		require.Equal(t, 31, tx.User.GetX(ctx, a8m.ID).Age)
+		require.NoError(t, tx.Commit())
+		require.Equal(t, 2, client.User.Query().CountX(ctx))
+		client.User.Delete().ExecX(ctx)
+	})
+	t.Run("Rollback", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		var m mocker
+		m.On("onRollback", nil).Once()
+		defer m.AssertExpectations(t)
+		a8m := tx.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+		nested, err := tx.Tx(ctx)
+		require.NoError(t, err)
+		nested.OnRollback(m.rHook())
+		nested.User.UpdateOneID(a8m.ID).SetAge(31).ExecX(ctx)
+		nested.User.Create().SetName("nati").SetAge(28).ExecX(ctx)
+		require.NoError(t, nested.Rollback())
+		require.Equal(t, 1, tx.User.Query().CountX(ctx), "rollback should discard only the changes of the nested transaction")
+		require.Equal(t, 30, tx.User.GetX(ctx, a8m.ID).Age)
+		// The transaction can be used after its nested transaction was rolled back.
+		tx.User.Create().SetName("alex").SetAge(25).ExecX(ctx)
+		require.NoError(t, tx.Commit())
+		require.Equal(t, []string{"a8m", "alex"}, client.User.Query().Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx))
+		client.User.Delete().ExecX(ctx)
+	})
+	t.Run("OuterRollback", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		// The hooks of committed nested transactions are executed by the
+		// outer transaction, and commit hooks are not executed on rollback.
+		var m mocker
+		m.On("onRollback", nil).Once()
+		defer m.AssertExpectations(t)
+		nested, err := tx.Tx(ctx)
+		require.NoError(t, err)
+		nested.OnCommit(func(next ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+				err := next.Commit(ctx, tx)
+				m.onCommit(err)
+				return err
+			})
+		})
+		nested.OnRollback(m.rHook())
+		nested.User.Create().SetName("a8m").SetAge(30).ExecX(ctx)
+		require.NoError(t, nested.Commit())
+		m.AssertNotCalled(t, "onRollback", nil)
+		require.NoError(t, tx.Rollback())
+		require.Zero(t, client.User.Query().CountX(ctx), "rollback should discard the changes of committed nested transactions")
+	})
+	t.Run("Deep", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		tx.User.Create().SetName("a8m").SetAge(30).ExecX(ctx)
+		n1, err := tx.Tx(ctx)
+		require.NoError(t, err)
+		n1.User.Create().SetName("nati").SetAge(28).ExecX(ctx)
+		n2, err := n1.Tx(ctx)
+		require.NoError(t, err)
+		n2.User.Create().SetName("alex").SetAge(25).ExecX(ctx)
+		require.NoError(t, n2.Commit())
+		require.NoError(t, n1.Rollback())
+		require.Equal(t, 1, tx.User.Query().CountX(ctx), "rollback should discard the changes of deeper transactions")
+		// Savepoints can be created again after their siblings were completed.
+		n3, err := tx.Tx(ctx)
+		require.NoError(t, err)
+		n3.User.Create().SetName("ariel").SetAge(35).ExecX(ctx)
+		require.NoError(t, n3.Commit())
+		require.NoError(t, tx.Rollback())
+		require.Zero(t, client.User.Query().CountX(ctx), "rollback should discard all changes")
+	})
+}
+
+func TxRetry(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	errConflict := errors.New("conflict")
+	opts := &sql.RetryOptions{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+		Retryable: func(err error) bool {
+			return errors.Is(err, errConflict)
+		},
+	}
+	t.Run("Commit", func(t *testing.T) {
+		var attempts, retries int
+		opts.OnRetry = func(_ context.Context, attempt int, err error, _ time.Duration) {
+			retries++
+			require.Equal(t, retries, attempt)
+			require.ErrorIs(t, err, errConflict)
+		}
+		err := client.WithTx(ctx, opts, func(tx *ent.Tx) error {
+			attempts++
+			tx.User.Create().SetName(fmt.Sprintf("a8m-%d", attempts)).SetAge(30).ExecX(ctx)
+			if attempts < 2 {
+				return errConflict
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, attempts)
+		require.Equal(t, 1, retries)
+		require.Equal(t, []string{"a8m-2"}, client.User.Query().Select(user.FieldName).StringsX(ctx), "failed attempts should be rolled back")
+		client.User.Delete().ExecX(ctx)
+	})
+	t.Run("MaxAttempts", func(t *testing.T) {
+		var attempts int
+		opts.OnRetry = nil
+		err := client.WithTx(ctx, opts, func(tx *ent.Tx) error {
+			attempts++
+			tx.User.Create().SetName("a8m").SetAge(30).ExecX(ctx)
+			return errConflict
+		})
+		require.ErrorIs(t, err, errConflict)
+		require.Equal(t, opts.MaxAttempts, attempts)
+		require.Zero(t, client.User.Query().CountX(ctx))
+	})
+	t.Run("NotRetryable", func(t *testing.T) {
+		var attempts int
+		err := client.WithTx(ctx, opts, func(tx *ent.Tx) error {
+			attempts++
+			tx.User.Create().SetName("a8m").SetAge(30).ExecX(ctx)
+			return errors.New("not retryable")
+		})
+		require.EqualError(t, err, "not retryable")
+		require.Equal(t, 1, attempts)
+		require.Zero(t, client.User.Query().CountX(ctx))
+	})
+	t.Run("Panic", func(t *testing.T) {
+		require.Panics(t, func() {
+			client.WithTx(ctx, nil, func(tx *ent.Tx) error {
+				tx.User.Create().SetName("a8m").SetAge(30).ExecX(ctx)
+				panic("boom")
+			})
+		})
+		require.Zero(t, client.User.Query().CountX(ctx), "panics should roll back the transaction")
+	})
+}
+
 func DefaultValue(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	c1 := client.Card.Create().SetNumber("102030").SetName("Firstname Lastname").SaveX(ctx)
@@ -2262,6 +2640,56 @@ var (
 	_ = []filetype.Type{filetype.TypeJPG, filetype.TypePNG, filetype.TypeSVG}
 )
 
+func BulkLoad(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	// Pets are loaded in multiple chunks in dialects that do not support copying.
+	client.Pet.MapCreateBulk(make([]struct{}, 3000), func(c *ent.PetCreate, i int) {
+		c.SetName(fmt.Sprintf("pet-%d", i)).SetAge(float64(i))
+		if i%2 == 0 {
+			c.SetOwner(a8m)
+		}
+	}).LoadX(ctx)
+	require.Equal(t, 3000, client.Pet.Query().CountX(ctx))
+	require.Equal(t, 1500, a8m.QueryPets().CountX(ctx), "edges that are stored in the table are loaded")
+	require.Equal(t, 2999.0, client.Pet.Query().Where(pet.Name("pet-2999")).OnlyX(ctx).Age)
+
+	t.Log("edges that are stored in other tables")
+	client.User.CreateBulk(
+		client.User.Create().SetName("foo").SetAge(1).AddFriends(a8m),
+		client.User.Create().SetName("bar").SetAge(2).AddFriends(a8m),
+	).LoadX(ctx)
+	require.ElementsMatch(t, []string{"foo", "bar"}, a8m.QueryFriends().Select(user.FieldName).StringsX(ctx))
+
+	t.Log("json and slice fields are inserted")
+	client.Task.MapCreateBulk(make([]struct{}, 2), func(c *ent.TaskCreate, i int) {
+		c.SetName(fmt.Sprintf("task-%d", i)).SetPriorities(map[string]task.Priority{"load": task.PriorityHigh})
+	}).LoadX(ctx)
+	for _, tk := range client.Task.Query().Where(enttask.NameHasPrefix("task-")).AllX(ctx) {
+		require.Equal(t, map[string]task.Priority{"load": task.PriorityHigh}, tk.Priorities)
+	}
+	client.FieldType.MapCreateBulk(make([]struct{}, 2), func(c *ent.FieldTypeCreate, i int) {
+		c.SetInt(i).SetInt8(8).SetInt16(16).SetInt32(32).SetInt64(64).SetStrings([]string{"a", "b"})
+	}).LoadX(ctx)
+	for _, ft := range client.FieldType.Query().AllX(ctx) {
+		require.Equal(t, []string{"a", "b"}, ft.Strings)
+	}
+
+	t.Log("rolled back loads")
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.Pet.CreateBulk(
+		tx.Pet.Create().SetName("tx-1"),
+		tx.Pet.Create().SetName("tx-2"),
+	).LoadX(ctx)
+	require.Equal(t, 2, tx.Pet.Query().Where(pet.NameHasPrefix("tx-")).CountX(ctx))
+	require.NoError(t, tx.Rollback())
+	require.Zero(t, client.Pet.Query().Where(pet.NameHasPrefix("tx-")).CountX(ctx))
+
+	err = client.Pet.CreateBulk(client.Pet.Create()).Load(ctx)
+	require.Error(t, err, "missing required field")
+}
+
 func CreateBulk(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	cards := client.Card.CreateBulk(
@@ -2339,6 +2767,309 @@ func ConstraintChecks(t *testing.T, client *ent.Client) {
 	require.True(t, sqlgraph.IsUniqueConstraintError(err))
 }
 
+func MultiTenancy(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	t1, t2 := ent.WithTenant(ctx, "t1"), ent.WithTenant(ctx, "t2")
+	_, err := client.Note.Query().All(ctx)
+	require.ErrorIs(t, err, ent.ErrMissingTenant)
+	err = client.Note.Create().SetText("text").Exec(ctx)
+	require.ErrorIs(t, err, ent.ErrMissingTenant)
+	err = client.Note.Create().SetText("text").SetTenant("t2").Exec(t1)
+	require.Error(t, err, "tenant field does not match the tenant of the context")
+
+	p1 := client.Note.Create().SetText("p1").SaveX(t1)
+	require.Equal(t, "t1", p1.Tenant)
+	c1 := client.Note.Create().SetText("c1").SetParent(p1).SaveX(t1)
+	p2 := client.Note.Create().SetText("p2").SetSlug("p2").SaveX(t2)
+	client.Note.CreateBulk(
+		client.Note.Create().SetText("c2").SetParent(p2),
+		client.Note.Create().SetText("c3").SetParent(p2),
+	).ExecX(t2)
+
+	// Queries are filtered by the tenant.
+	require.Equal(t, 2, client.Note.Query().CountX(t1))
+	require.Equal(t, 3, client.Note.Query().CountX(t2))
+	require.Equal(t, 5, client.Note.Query().CountX(ent.SkipTenant(ctx)))
+	require.True(t, ent.IsNotFound(func() error { _, err := client.Note.Get(t1, p2.ID); return err }()))
+	require.Equal(t, []string{"c1"}, p1.QueryChildren().Select(note.FieldText).StringsX(t1))
+	require.Zero(t, p2.QueryChildren().CountX(t1))
+	require.Len(t, client.Note.Query().Where(note.ID(p2.ID)).WithChildren().OnlyX(t2).Edges.Children, 2)
+
+	// Edge predicates are filtered by the tenant.
+	require.Equal(t, p1.ID, client.Note.Query().Where(note.HasChildren()).OnlyIDX(t1))
+	require.Equal(t, p2.ID, client.Note.Query().Where(note.HasChildrenWith(note.Text("c2"))).OnlyIDX(t2))
+	require.Equal(t, 2, client.Note.Query().Where(note.HasChildren()).CountX(ent.SkipTenant(ctx)))
+	require.Zero(t, client.Note.Query().Where(note.HasChildrenWith(note.Text("c2"))).CountX(t1))
+	_, err = client.Note.Query().Where(note.HasChildren()).All(ctx)
+	require.ErrorIs(t, err, ent.ErrMissingTenant)
+
+	// Mutations are scoped to the tenant.
+	require.Equal(t, 2, client.Note.Update().SetText("updated").SaveX(t1))
+	require.True(t, ent.IsNotFound(client.Note.UpdateOneID(p2.ID).SetText("updated").Exec(t1)))
+	require.True(t, ent.IsNotFound(client.Note.DeleteOneID(p2.ID).Exec(t1)))
+	require.Equal(t, "p2", client.Note.GetX(t2, p2.ID).Text)
+
+	// Upserts do not update the rows of other tenants.
+	err = client.Note.Create().SetText("upsert").SetSlug("p2").OnConflictColumns(note.FieldSlug).UpdateNewValues().Exec(t1)
+	// The conflicting row belongs to another tenant, and therefore, it is neither updated nor returned.
+	// In MySQL, which does not return the upserted rows, the statement is executed as a no-op.
+	if client.Dialect() != dialect.MySQL {
+		require.ErrorIs(t, err, stdsql.ErrNoRows)
+	}
+	require.Equal(t, 2, client.Note.Query().CountX(t1))
+	require.Equal(t, "p2", client.Note.GetX(t2, p2.ID).Text)
+	client.Note.Create().SetText("upsert").SetSlug("p2").OnConflictColumns(note.FieldSlug).UpdateNewValues().ExecX(t2)
+	require.Equal(t, "upsert", client.Note.GetX(t2, p2.ID).Text)
+
+	// Entities cannot be connected to the entities of other tenants.
+	err = client.Note.UpdateOne(p1).AddChildIDs(p2.ID).Exec(t1)
+	require.ErrorContains(t, err, "do not belong to the tenant")
+	err = client.Note.UpdateOne(c1).SetParentID(p2.ID).Exec(t1)
+	require.ErrorContains(t, err, "do not belong to the tenant")
+	err = client.Note.Create().SetText("c4").SetParentID(p2.ID).Exec(t1)
+	require.ErrorContains(t, err, "do not belong to the tenant")
+	require.Equal(t, p1.ID, c1.QueryParent().OnlyIDX(t1))
+	client.Note.UpdateOne(c1).SetParentID(p2.ID).ExecX(ent.SkipTenant(ctx))
+	require.Equal(t, 3, p2.QueryChildren().CountX(ent.SkipTenant(ctx)))
+}
+
+func SoftDelete(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	p1 := client.Post.Create().SetTitle("p1").SaveX(ctx)
+	r1 := client.Post.Create().SetTitle("r1").SetParent(p1).SaveX(ctx)
+	r2 := client.Post.Create().SetTitle("r2").SetParent(p1).SaveX(ctx)
+	p2 := client.Post.Create().SetTitle("p2").SaveX(ctx)
+
+	client.Post.DeleteOne(r1).ExecX(ctx)
+	require.True(t, ent.IsNotFound(client.Post.DeleteOne(r1).Exec(ctx)), "soft-deleted rows cannot be deleted again")
+	require.Equal(t, 3, client.Post.Query().CountX(ctx))
+	require.Equal(t, 4, client.Post.Query().WithDeleted().CountX(ctx))
+	require.Equal(t, r1.ID, client.Post.Query().OnlyDeleted().OnlyIDX(ctx))
+	require.NotNil(t, client.Post.Query().OnlyDeleted().OnlyX(ctx).DeletedAt)
+	require.True(t, ent.IsNotFound(func() error { _, err := client.Post.Get(ctx, r1.ID); return err }()))
+
+	// Soft-deleted rows are filtered out from traversals, eager-loading and edge predicates.
+	require.Equal(t, []int{r2.ID}, p1.QueryReplies().IDsX(ctx))
+	require.Len(t, client.Post.Query().Where(post.ID(p1.ID)).WithReplies().OnlyX(ctx).Edges.Replies, 1)
+	require.Equal(t, p1.ID, client.Post.Query().Where(post.HasReplies()).OnlyIDX(ctx))
+	require.Zero(t, client.Post.Query().Where(post.HasRepliesWith(post.Title("r1"))).CountX(ctx))
+	client.Post.DeleteOne(p1).ExecX(ctx)
+	require.Zero(t, r2.QueryParent().CountX(ctx))
+	require.Zero(t, client.Post.Query().Where(post.HasParent()).CountX(ctx))
+
+	// Restore soft-deleted rows.
+	client.Post.RestoreOneID(r1.ID).ExecX(ctx)
+	require.Nil(t, client.Post.GetX(ctx, r1.ID).DeletedAt)
+	require.True(t, ent.IsNotFound(client.Post.RestoreOne(p2).Exec(ctx)), "only soft-deleted rows are restored")
+	require.Equal(t, 1, client.Post.Restore().SaveX(ctx))
+	require.Equal(t, 4, client.Post.Query().CountX(ctx))
+	require.Equal(t, 2, p1.QueryReplies().CountX(ctx))
+}
+
+func History(t *testing.T, client *ent.Client) {
+	ctx := ent.WithHistoryActor(context.Background(), "a8m")
+	p := client.Post.Create().SetTitle("v1").SetSecret("s1").SaveX(ctx)
+	created := time.Now()
+	// Some databases store the history time with a precision of seconds.
+	time.Sleep(time.Second)
+	p = p.Update().SetTitle("v2").SetSecret("s2").SaveX(ctx)
+	client.Post.Update().Where(post.ID(p.ID)).SetTitle("v3").ExecX(context.Background())
+
+	changes := p.QueryHistory().AllX(ctx)
+	require.Len(t, changes, 3)
+	require.Equal(t, ent.OpCreate.String(), changes[0].Operation)
+	require.Equal(t, "a8m", *changes[0].Actor)
+	require.Equal(t, p.ID, changes[0].Ref)
+	require.Equal(t, "v1", *changes[0].Title)
+	require.Equal(t, ent.OpUpdateOne.String(), changes[1].Operation)
+	require.ElementsMatch(t, []string{post.FieldTitle, post.FieldSecret}, changes[1].ChangedFields)
+	require.Equal(t, map[string]any{post.FieldTitle: "v1"}, changes[1].OldValues, "values of sensitive fields are not recorded")
+	require.Equal(t, ent.OpUpdate.String(), changes[2].Operation)
+	require.Nil(t, changes[2].Actor)
+	require.Equal(t, map[string]any{post.FieldTitle: "v2"}, changes[2].OldValues)
+	require.Equal(t, "v3", *changes[2].Title)
+
+	// Get the state of the post at a given time.
+	old, err := p.AsOf(ctx, created)
+	require.NoError(t, err)
+	require.Equal(t, "v1", old.Title)
+	_, err = p.AsOf(ctx, created.Add(-time.Hour))
+	require.True(t, ent.IsNotFound(err))
+
+	// Changes that were rolled back are not recorded.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.Post.UpdateOneID(p.ID).SetTitle("v4").ExecX(ctx)
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 3, p.QueryHistory().CountX(ctx))
+
+	client.Post.DeleteOne(p).ExecX(ctx)
+	require.Equal(t, 4, p.QueryHistory().CountX(ctx))
+	_, err = p.AsOf(ctx, time.Now())
+	require.True(t, ent.IsNotFound(err))
+}
+
+func DataLoader(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	var queries atomic.Int32
+	// A client that shares the database of the tested client, and counts its queries.
+	counter := ent.NewClient(ent.Driver(dialect.DebugWithContext(client.Driver(), func(context.Context, ...any) {
+		queries.Add(1)
+	})))
+	for i := 0; i < 5; i++ {
+		u := client.User.Create().SetName(fmt.Sprintf("user-%d", i)).SetAge(i).SaveX(ctx)
+		for j := 0; j < 2; j++ {
+			client.Pet.Create().SetName(fmt.Sprintf("pet-%d-%d", i, j)).SetOwner(u).ExecX(ctx)
+		}
+	}
+	users := counter.User.Query().Order(user.ByID()).AllX(ctx)
+	// fieldKey mimics the per-field contexts that are derived by GraphQL resolvers.
+	type fieldKey struct{}
+	run := func(lctx context.Context, fn func(context.Context, int)) {
+		var wg sync.WaitGroup
+		for i := range users {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				fn(context.WithValue(lctx, fieldKey{}, i), i)
+			}()
+		}
+		wg.Wait()
+	}
+
+	t.Log("edge queries are batched and cached")
+	lctx := ent.WithLoader(ctx, ent.LoaderWait(50*time.Millisecond))
+	pets := make([][]*ent.Pet, len(users))
+	queries.Store(0)
+	run(lctx, func(ctx context.Context, i int) { pets[i] = users[i].QueryPets().AllX(ctx) })
+	require.EqualValues(t, 2, queries.Load(), "nodes and their pets are loaded once")
+	for i := range users {
+		require.Len(t, pets[i], 2)
+		for _, p := range pets[i] {
+			require.True(t, strings.HasPrefix(p.Name, fmt.Sprintf("pet-%d-", i)))
+		}
+	}
+	queries.Store(0)
+	run(lctx, func(ctx context.Context, i int) { require.Len(t, users[i].QueryPets().AllX(ctx), 2) })
+	require.Zero(t, queries.Load(), "results are cached in the loader")
+
+	t.Log("modified queries are not batched")
+	queries.Store(0)
+	run(lctx, func(ctx context.Context, i int) {
+		name := fmt.Sprintf("pet-%d-1", i)
+		require.Equal(t, name, users[i].QueryPets().Where(pet.Name(name)).OnlyX(ctx).Name)
+	})
+	require.EqualValues(t, len(users), queries.Load())
+
+	t.Log("get calls are batched")
+	lctx = ent.WithLoader(ctx, ent.LoaderWait(50*time.Millisecond))
+	queries.Store(0)
+	run(lctx, func(ctx context.Context, i int) { require.Equal(t, users[i].Name, counter.User.GetX(ctx, users[i].ID).Name) })
+	require.EqualValues(t, 1, queries.Load())
+	_, err := counter.User.Get(lctx, -1)
+	require.True(t, ent.IsNotFound(err))
+
+	t.Log("canceled contexts do not wait for their batch")
+	cctx, cancel := context.WithCancel(lctx)
+	cancel()
+	_, err = counter.User.Get(cctx, users[0].ID)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func EdgeAggregate(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nat := client.User.Create().SetName("nati").SetAge(28).AddFriends(a8m).SaveX(ctx)
+	alex := client.User.Create().SetName("alex").SetAge(25).AddFriends(a8m).SaveX(ctx)
+	now := time.Now().UTC().Truncate(time.Second)
+	client.Pet.CreateBulk(
+		client.Pet.Create().SetName("pedro").SetAge(1).SetOptionalTime(now).SetOwner(a8m),
+		client.Pet.Create().SetName("xabi").SetAge(2).SetOptionalTime(now.Add(time.Hour)).SetOwner(a8m),
+		client.Pet.Create().SetName("luna").SetAge(4).SetOptionalTime(now).SetTrained(true).SetOwner(nat),
+	).ExecX(ctx)
+	inf := client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)
+	client.Group.Create().SetName("GitHub").SetExpire(time.Now().Add(time.Hour)).SetInfo(inf).AddUsers(a8m, nat).ExecX(ctx)
+
+	users := client.User.Query().
+		WithPetsCount().
+		WithFriendsCount().
+		WithGroupsCount().
+		WithPetsAggregate(ent.Sum(pet.FieldAge), ent.Max(pet.FieldAge)).
+		Order(ent.Asc(user.FieldName)).
+		AllX(ctx)
+	require.Len(t, users, 3)
+	require.Equal(t, []string{a8m.Name, alex.Name, nat.Name}, []string{users[0].Name, users[1].Name, users[2].Name})
+	for i, c := range []struct{ pets, friends, groups int }{{2, 2, 1}, {0, 1, 0}, {1, 1, 1}} {
+		require.Equal(t, c.pets, users[i].Edges.PetsCount, "count of O2M edges")
+		require.Equal(t, c.friends, users[i].Edges.FriendsCount, "count of M2M bidirectional edges")
+		require.Equal(t, c.groups, users[i].Edges.GroupsCount, "count of M2M edges")
+		require.Nil(t, users[i].Edges.Pets, "neighbors should not be loaded")
+	}
+	require.Equal(t, []any{float64(3), float64(2)}, users[0].Edges.PetsAggregate)
+	require.Equal(t, []any{float64(0), nil}, users[1].Edges.PetsAggregate, "sum of nodes without neighbors should be 0, and max should be nil")
+	require.Equal(t, []any{float64(4), float64(4)}, users[2].Edges.PetsAggregate)
+
+	// Min and Max of non-numeric fields are returned in the type of the field.
+	users = client.User.Query().
+		Where(user.IDIn(a8m.ID, alex.ID)).
+		WithPetsAggregate(ent.Min(pet.FieldName), ent.Max(pet.FieldName), ent.Min(pet.FieldOptionalTime), ent.Max(pet.FieldOptionalTime)).
+		Order(ent.Asc(user.FieldName)).
+		AllX(ctx)
+	require.Len(t, users, 2)
+	agg := users[0].Edges.PetsAggregate
+	require.Len(t, agg, 4)
+	require.Equal(t, "pedro", agg[0])
+	require.Equal(t, "xabi", agg[1])
+	require.True(t, now.Equal(agg[2].(time.Time)), "min time: %v", agg[2])
+	require.True(t, now.Add(time.Hour).Equal(agg[3].(time.Time)), "max time: %v", agg[3])
+	require.Equal(t, []any{nil, nil, nil, nil}, users[1].Edges.PetsAggregate)
+
+	// Count only some of the neighbors, and mix it with eager-loading.
+	u := client.User.Query().
+		Where(user.ID(a8m.ID)).
+		WithPets().
+		WithPetsCount(func(q *ent.PetQuery) {
+			q.Where(pet.AgeGT(1))
+		}).
+		OnlyX(ctx)
+	require.Len(t, u.Edges.Pets, 2)
+	require.Equal(t, 1, u.Edges.PetsCount)
+
+	// Inverse edges.
+	groups := client.Group.Query().WithUsersCount().AllX(ctx)
+	require.Len(t, groups, 1)
+	require.Equal(t, 2, groups[0].Edges.UsersCount)
+}
+
+func OptimisticLock(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	tk := client.Task.Create().SaveX(ctx)
+	require.Equal(t, 1, tk.Version)
+
+	// Entities are updated only if they were not modified since they were loaded.
+	updated := tk.Update().SetOwner("a8m").SaveX(ctx)
+	require.Equal(t, 2, updated.Version)
+	err := tk.Update().SetOwner("nati").Exec(ctx)
+	require.True(t, ent.IsStaleObject(err), "task was modified since it was loaded")
+	require.Equal(t, "a8m", client.Task.GetX(ctx, tk.ID).Owner)
+
+	// UpdateOneID uses the version that was read by the caller, if it was set.
+	err = client.Task.UpdateOneID(tk.ID).SetOwner("nati").SetExpectedVersion(tk.Version).Exec(ctx)
+	require.True(t, ent.IsStaleObject(err), "stale version was set explicitly")
+	require.Equal(t, "a8m", client.Task.GetX(ctx, tk.ID).Owner)
+	updated = client.Task.UpdateOneID(tk.ID).SetOwner("nati").SetExpectedVersion(updated.Version).SaveX(ctx)
+	require.Equal(t, 3, updated.Version)
+	require.Equal(t, "nati", updated.Owner)
+	err = client.Task.UpdateOneID(tk.ID+1000).SetOwner("a8m").SetExpectedVersion(1).Exec(ctx)
+	require.True(t, ent.IsNotFound(err), "missing rows are not reported as stale")
+
+	// Bulk updates increment the versions of the rows, unless skipped.
+	client.Task.Update().Where(enttask.ID(tk.ID)).SetOwner("a8m").ExecX(ctx)
+	require.Equal(t, 4, client.Task.GetX(ctx, tk.ID).Version)
+	client.Task.Update().Where(enttask.ID(tk.ID)).SetOwner("nati").SkipVersion().ExecX(ctx)
+	require.Equal(t, 4, client.Task.GetX(ctx, tk.ID).Version)
+}
+
 func Lock(t *testing.T, client *ent.Client) {
 	skip(t, "SQLite", "MySQL/5", "Maria/10.2")
 	ctx := context.Background()
@@ -2941,4 +3672,10 @@ func drop(t *testing.T, client *ent.Client) {
 	client.FieldType.Delete().ExecX(ctx)
 	client.FileType.Delete().ExecX(ctx)
 	client.ExValueScan.Delete().ExecX(ctx)
+	client.Note.Delete().ExecX(ent.SkipTenant(ctx))
+	// Posts are soft-deleted by their delete builders.
+	query, args := sql.Dialect(client.Dialect()).Delete(post.Table).Query()
+	_, err := client.ExecContext(ctx, query, args...)
+	require.NoError(t, err)
+	client.PostHistory.Delete().ExecX(ctx)
 }
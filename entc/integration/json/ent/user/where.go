@@ -7,7 +7,10 @@
 package user
 
 import (
+	"strconv"
+
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/json/ent/predicate"
 )
 
@@ -190,3 +193,3073 @@ func Or(predicates ...predicate.User) predicate.User {
 func Not(p predicate.User) predicate.User {
 	return predicate.User(sql.NotPredicates(p))
 }
+
+// TPath returns the root of the typed predicates on the values inside the "t" field.
+// The values are accessed using the JSON keys of the Go type of the field. For example:
+//
+//	user.TPath().I().IsNull()
+func TPath() TJSONPath {
+	return TJSONPath{}
+}
+
+// TJSONPath represents the value of the "t" field in its typed predicates.
+type TJSONPath struct {
+	path []string
+}
+
+// I returns the value of the "i" key.
+func (p TJSONPath) I() TIJSONPath {
+	return TIJSONPath{path: append(p.path[:len(p.path):len(p.path)], "i")}
+}
+
+// F returns the value of the "f" key.
+func (p TJSONPath) F() TFJSONPath {
+	return TFJSONPath{path: append(p.path[:len(p.path):len(p.path)], "f")}
+}
+
+// B returns the value of the "b" key.
+func (p TJSONPath) B() TBJSONPath {
+	return TBJSONPath{path: append(p.path[:len(p.path):len(p.path)], "b")}
+}
+
+// S returns the value of the "s" key.
+func (p TJSONPath) S() TSJSONPath {
+	return TSJSONPath{path: append(p.path[:len(p.path):len(p.path)], "s")}
+}
+
+// T returns the value of the "t" key.
+func (p TJSONPath) T() TTJSONPath {
+	return TTJSONPath{path: append(p.path[:len(p.path):len(p.path)], "t")}
+}
+
+// Li returns the value of the "li" key.
+func (p TJSONPath) Li() TLiJSONPath {
+	return TLiJSONPath{path: append(p.path[:len(p.path):len(p.path)], "li")}
+}
+
+// Ls returns the value of the "ls" key.
+func (p TJSONPath) Ls() TLsJSONPath {
+	return TLsJSONPath{path: append(p.path[:len(p.path):len(p.path)], "ls")}
+}
+
+// M returns the value of the "m" key.
+func (p TJSONPath) M() TMJSONPath {
+	return TMJSONPath{path: append(p.path[:len(p.path):len(p.path)], "m")}
+}
+
+// TIJSONPath represents a value inside the "t" field in its typed predicates.
+type TIJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TIJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TIJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TIJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p TIJSONPath) EQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p TIJSONPath) NEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p TIJSONPath) GT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p TIJSONPath) GTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p TIJSONPath) LT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p TIJSONPath) LTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p TIJSONPath) In(vs ...int) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldT), args, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p TIJSONPath) NotIn(vs ...int) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldT), args, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// TFJSONPath represents a value inside the "t" field in its typed predicates.
+type TFJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TFJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TFJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TFJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p TFJSONPath) EQ(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("float")))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p TFJSONPath) NEQ(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("float")))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p TFJSONPath) GT(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("float")))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p TFJSONPath) GTE(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("float")))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p TFJSONPath) LT(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("float")))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p TFJSONPath) LTE(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("float")))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p TFJSONPath) In(vs ...float64) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldT), args, sqljson.Path(p.path...), sqljson.Cast("float")))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p TFJSONPath) NotIn(vs ...float64) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldT), args, sqljson.Path(p.path...), sqljson.Cast("float")))
+	})
+}
+
+// TBJSONPath represents a value inside the "t" field in its typed predicates.
+type TBJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TBJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TBJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TBJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p TBJSONPath) EQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p TBJSONPath) NEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// TSJSONPath represents a value inside the "t" field in its typed predicates.
+type TSJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TSJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TSJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TSJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p TSJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p TSJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p TSJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p TSJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p TSJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p TSJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p TSJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldT), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p TSJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldT), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p TSJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p TSJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p TSJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// TTJSONPath represents a value inside the "t" field in its typed predicates.
+type TTJSONPath struct {
+	path []string
+}
+
+// I returns the value of the "i" key.
+func (p TTJSONPath) I() TIJSONPath {
+	return TIJSONPath{path: append(p.path[:len(p.path):len(p.path)], "i")}
+}
+
+// F returns the value of the "f" key.
+func (p TTJSONPath) F() TFJSONPath {
+	return TFJSONPath{path: append(p.path[:len(p.path):len(p.path)], "f")}
+}
+
+// B returns the value of the "b" key.
+func (p TTJSONPath) B() TBJSONPath {
+	return TBJSONPath{path: append(p.path[:len(p.path):len(p.path)], "b")}
+}
+
+// S returns the value of the "s" key.
+func (p TTJSONPath) S() TSJSONPath {
+	return TSJSONPath{path: append(p.path[:len(p.path):len(p.path)], "s")}
+}
+
+// T returns the value of the "t" key.
+func (p TTJSONPath) T() TTJSONPath {
+	return TTJSONPath{path: append(p.path[:len(p.path):len(p.path)], "t")}
+}
+
+// Li returns the value of the "li" key.
+func (p TTJSONPath) Li() TLiJSONPath {
+	return TLiJSONPath{path: append(p.path[:len(p.path):len(p.path)], "li")}
+}
+
+// Ls returns the value of the "ls" key.
+func (p TTJSONPath) Ls() TLsJSONPath {
+	return TLsJSONPath{path: append(p.path[:len(p.path):len(p.path)], "ls")}
+}
+
+// M returns the value of the "m" key.
+func (p TTJSONPath) M() TMJSONPath {
+	return TMJSONPath{path: append(p.path[:len(p.path):len(p.path)], "m")}
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TTJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TTJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TTJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// TLiJSONPath represents a value inside the "t" field in its typed predicates.
+type TLiJSONPath struct {
+	path []string
+}
+
+// At returns the element at the given index.
+func (p TLiJSONPath) At(i int) TLiElemJSONPath {
+	return TLiElemJSONPath{path: append(p.path[:len(p.path):len(p.path)], "["+strconv.Itoa(i)+"]")}
+}
+
+// LenEQ applies the EQ predicate on the length of the array.
+func (p TLiJSONPath) LenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenNEQ applies the NEQ predicate on the length of the array.
+func (p TLiJSONPath) LenNEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenGT applies the GT predicate on the length of the array.
+func (p TLiJSONPath) LenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenGTE applies the GTE predicate on the length of the array.
+func (p TLiJSONPath) LenGTE(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenLT applies the LT predicate on the length of the array.
+func (p TLiJSONPath) LenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenLTE applies the LTE predicate on the length of the array.
+func (p TLiJSONPath) LenLTE(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the array. i.e. it checks that v is one of its elements.
+func (p TLiJSONPath) Contains(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TLiJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TLiJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TLiJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// TLiElemJSONPath represents a value inside the "t" field in its typed predicates.
+type TLiElemJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TLiElemJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TLiElemJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TLiElemJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p TLiElemJSONPath) EQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p TLiElemJSONPath) NEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p TLiElemJSONPath) GT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p TLiElemJSONPath) GTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p TLiElemJSONPath) LT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p TLiElemJSONPath) LTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p TLiElemJSONPath) In(vs ...int) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldT), args, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p TLiElemJSONPath) NotIn(vs ...int) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldT), args, sqljson.Path(p.path...), sqljson.Cast("bigint")))
+	})
+}
+
+// TLsJSONPath represents a value inside the "t" field in its typed predicates.
+type TLsJSONPath struct {
+	path []string
+}
+
+// At returns the element at the given index.
+func (p TLsJSONPath) At(i int) TLsElemJSONPath {
+	return TLsElemJSONPath{path: append(p.path[:len(p.path):len(p.path)], "["+strconv.Itoa(i)+"]")}
+}
+
+// LenEQ applies the EQ predicate on the length of the array.
+func (p TLsJSONPath) LenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenNEQ applies the NEQ predicate on the length of the array.
+func (p TLsJSONPath) LenNEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenGT applies the GT predicate on the length of the array.
+func (p TLsJSONPath) LenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenGTE applies the GTE predicate on the length of the array.
+func (p TLsJSONPath) LenGTE(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenLT applies the LT predicate on the length of the array.
+func (p TLsJSONPath) LenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenLTE applies the LTE predicate on the length of the array.
+func (p TLsJSONPath) LenLTE(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldT), n, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the array. i.e. it checks that v is one of its elements.
+func (p TLsJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TLsJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TLsJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TLsJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// TLsElemJSONPath represents a value inside the "t" field in its typed predicates.
+type TLsElemJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TLsElemJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TLsElemJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TLsElemJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p TLsElemJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p TLsElemJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p TLsElemJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p TLsElemJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p TLsElemJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p TLsElemJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p TLsElemJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldT), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p TLsElemJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldT), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p TLsElemJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p TLsElemJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p TLsElemJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldT), v, sqljson.Path(p.path...)))
+	})
+}
+
+// TMJSONPath represents a value inside the "t" field in its typed predicates.
+type TMJSONPath struct {
+	path []string
+}
+
+// Key returns the value of the given key.
+func (p TMJSONPath) Key(k string) TMValueJSONPath {
+	return TMValueJSONPath{path: append(p.path[:len(p.path):len(p.path)], k)}
+}
+
+// HasKey applies the HasKey predicate on the given key.
+func (p TMJSONPath) HasKey(k string) predicate.User {
+	return p.Key(k).Exists()
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TMJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TMJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TMJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// TMValueJSONPath represents a value inside the "t" field in its typed predicates.
+type TMValueJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p TMValueJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p TMValueJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p TMValueJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldT), sqljson.Path(p.path...)))
+	})
+}
+
+// URLPath returns the root of the typed predicates on the values inside the "url" field.
+// The values are accessed using the JSON keys of the Go type of the field. For example:
+//
+//	user.URLPath().Scheme().IsNull()
+func URLPath() URLJSONPath {
+	return URLJSONPath{}
+}
+
+// URLJSONPath represents the value of the "url" field in its typed predicates.
+type URLJSONPath struct {
+	path []string
+}
+
+// Scheme returns the value of the "Scheme" key.
+func (p URLJSONPath) Scheme() URLSchemeJSONPath {
+	return URLSchemeJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Scheme")}
+}
+
+// Opaque returns the value of the "Opaque" key.
+func (p URLJSONPath) Opaque() URLOpaqueJSONPath {
+	return URLOpaqueJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Opaque")}
+}
+
+// User returns the value of the "User" key.
+func (p URLJSONPath) User() URLUserJSONPath {
+	return URLUserJSONPath{path: append(p.path[:len(p.path):len(p.path)], "User")}
+}
+
+// Host returns the value of the "Host" key.
+func (p URLJSONPath) Host() URLHostJSONPath {
+	return URLHostJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Host")}
+}
+
+// Path returns the value of the "Path" key.
+func (p URLJSONPath) Path() URLPathJSONPath {
+	return URLPathJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Path")}
+}
+
+// Fragment returns the value of the "Fragment" key.
+func (p URLJSONPath) Fragment() URLFragmentJSONPath {
+	return URLFragmentJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Fragment")}
+}
+
+// RawQuery returns the value of the "RawQuery" key.
+func (p URLJSONPath) RawQuery() URLRawQueryJSONPath {
+	return URLRawQueryJSONPath{path: append(p.path[:len(p.path):len(p.path)], "RawQuery")}
+}
+
+// RawPath returns the value of the "RawPath" key.
+func (p URLJSONPath) RawPath() URLRawPathJSONPath {
+	return URLRawPathJSONPath{path: append(p.path[:len(p.path):len(p.path)], "RawPath")}
+}
+
+// RawFragment returns the value of the "RawFragment" key.
+func (p URLJSONPath) RawFragment() URLRawFragmentJSONPath {
+	return URLRawFragmentJSONPath{path: append(p.path[:len(p.path):len(p.path)], "RawFragment")}
+}
+
+// ForceQuery returns the value of the "ForceQuery" key.
+func (p URLJSONPath) ForceQuery() URLForceQueryJSONPath {
+	return URLForceQueryJSONPath{path: append(p.path[:len(p.path):len(p.path)], "ForceQuery")}
+}
+
+// OmitHost returns the value of the "OmitHost" key.
+func (p URLJSONPath) OmitHost() URLOmitHostJSONPath {
+	return URLOmitHostJSONPath{path: append(p.path[:len(p.path):len(p.path)], "OmitHost")}
+}
+
+// URLSchemeJSONPath represents a value inside the "url" field in its typed predicates.
+type URLSchemeJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLSchemeJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLSchemeJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLSchemeJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLSchemeJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLSchemeJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLSchemeJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLSchemeJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLSchemeJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLSchemeJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLSchemeJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLSchemeJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLSchemeJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLSchemeJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLSchemeJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLOpaqueJSONPath represents a value inside the "url" field in its typed predicates.
+type URLOpaqueJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLOpaqueJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLOpaqueJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLOpaqueJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLOpaqueJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLOpaqueJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLOpaqueJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLOpaqueJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLOpaqueJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLOpaqueJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLOpaqueJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLOpaqueJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLOpaqueJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLOpaqueJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLOpaqueJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLUserJSONPath represents a value inside the "url" field in its typed predicates.
+type URLUserJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLUserJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLUserJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLUserJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// URLHostJSONPath represents a value inside the "url" field in its typed predicates.
+type URLHostJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLHostJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLHostJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLHostJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLHostJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLHostJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLHostJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLHostJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLHostJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLHostJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLHostJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLHostJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLHostJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLHostJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLHostJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLPathJSONPath represents a value inside the "url" field in its typed predicates.
+type URLPathJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLPathJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLPathJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLPathJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLPathJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLPathJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLPathJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLPathJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLPathJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLPathJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLPathJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLPathJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLPathJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLPathJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLPathJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLFragmentJSONPath represents a value inside the "url" field in its typed predicates.
+type URLFragmentJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLFragmentJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLFragmentJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLFragmentJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLFragmentJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLFragmentJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLFragmentJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLFragmentJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLFragmentJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLFragmentJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLFragmentJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLFragmentJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLFragmentJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLFragmentJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLFragmentJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLRawQueryJSONPath represents a value inside the "url" field in its typed predicates.
+type URLRawQueryJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLRawQueryJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLRawQueryJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLRawQueryJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLRawQueryJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLRawQueryJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLRawQueryJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLRawQueryJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLRawQueryJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLRawQueryJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLRawQueryJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLRawQueryJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLRawQueryJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLRawQueryJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLRawQueryJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLRawPathJSONPath represents a value inside the "url" field in its typed predicates.
+type URLRawPathJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLRawPathJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLRawPathJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLRawPathJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLRawPathJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLRawPathJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLRawPathJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLRawPathJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLRawPathJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLRawPathJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLRawPathJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLRawPathJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLRawPathJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLRawPathJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLRawPathJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLRawFragmentJSONPath represents a value inside the "url" field in its typed predicates.
+type URLRawFragmentJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLRawFragmentJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLRawFragmentJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLRawFragmentJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLRawFragmentJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLRawFragmentJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLRawFragmentJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLRawFragmentJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLRawFragmentJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLRawFragmentJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLRawFragmentJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLRawFragmentJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURL), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLRawFragmentJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLRawFragmentJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLRawFragmentJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLForceQueryJSONPath represents a value inside the "url" field in its typed predicates.
+type URLForceQueryJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLForceQueryJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLForceQueryJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLForceQueryJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLForceQueryJSONPath) EQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLForceQueryJSONPath) NEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// URLOmitHostJSONPath represents a value inside the "url" field in its typed predicates.
+type URLOmitHostJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLOmitHostJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLOmitHostJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLOmitHostJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURL), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLOmitHostJSONPath) EQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLOmitHostJSONPath) NEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// URLsPath returns the root of the typed predicates on the values inside the "URLs" field.
+// The values are accessed using the JSON keys of the Go type of the field. For example:
+//
+//	user.URLsPath()
+func URLsPath() URLsJSONPath {
+	return URLsJSONPath{}
+}
+
+// URLsJSONPath represents the value of the "URLs" field in its typed predicates.
+type URLsJSONPath struct {
+	path []string
+}
+
+// At returns the element at the given index.
+func (p URLsJSONPath) At(i int) URLsElemJSONPath {
+	return URLsElemJSONPath{path: append(p.path[:len(p.path):len(p.path)], "["+strconv.Itoa(i)+"]")}
+}
+
+// LenEQ applies the EQ predicate on the length of the array.
+func (p URLsJSONPath) LenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(FieldURLs), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenNEQ applies the NEQ predicate on the length of the array.
+func (p URLsJSONPath) LenNEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenNEQ(s.C(FieldURLs), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenGT applies the GT predicate on the length of the array.
+func (p URLsJSONPath) LenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGT(s.C(FieldURLs), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenGTE applies the GTE predicate on the length of the array.
+func (p URLsJSONPath) LenGTE(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenGTE(s.C(FieldURLs), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenLT applies the LT predicate on the length of the array.
+func (p URLsJSONPath) LenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLT(s.C(FieldURLs), n, sqljson.Path(p.path...)))
+	})
+}
+
+// LenLTE applies the LTE predicate on the length of the array.
+func (p URLsJSONPath) LenLTE(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.LenLTE(s.C(FieldURLs), n, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemJSONPath struct {
+	path []string
+}
+
+// Scheme returns the value of the "Scheme" key.
+func (p URLsElemJSONPath) Scheme() URLsElemSchemeJSONPath {
+	return URLsElemSchemeJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Scheme")}
+}
+
+// Opaque returns the value of the "Opaque" key.
+func (p URLsElemJSONPath) Opaque() URLsElemOpaqueJSONPath {
+	return URLsElemOpaqueJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Opaque")}
+}
+
+// User returns the value of the "User" key.
+func (p URLsElemJSONPath) User() URLsElemUserJSONPath {
+	return URLsElemUserJSONPath{path: append(p.path[:len(p.path):len(p.path)], "User")}
+}
+
+// Host returns the value of the "Host" key.
+func (p URLsElemJSONPath) Host() URLsElemHostJSONPath {
+	return URLsElemHostJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Host")}
+}
+
+// Path returns the value of the "Path" key.
+func (p URLsElemJSONPath) Path() URLsElemPathJSONPath {
+	return URLsElemPathJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Path")}
+}
+
+// Fragment returns the value of the "Fragment" key.
+func (p URLsElemJSONPath) Fragment() URLsElemFragmentJSONPath {
+	return URLsElemFragmentJSONPath{path: append(p.path[:len(p.path):len(p.path)], "Fragment")}
+}
+
+// RawQuery returns the value of the "RawQuery" key.
+func (p URLsElemJSONPath) RawQuery() URLsElemRawQueryJSONPath {
+	return URLsElemRawQueryJSONPath{path: append(p.path[:len(p.path):len(p.path)], "RawQuery")}
+}
+
+// RawPath returns the value of the "RawPath" key.
+func (p URLsElemJSONPath) RawPath() URLsElemRawPathJSONPath {
+	return URLsElemRawPathJSONPath{path: append(p.path[:len(p.path):len(p.path)], "RawPath")}
+}
+
+// RawFragment returns the value of the "RawFragment" key.
+func (p URLsElemJSONPath) RawFragment() URLsElemRawFragmentJSONPath {
+	return URLsElemRawFragmentJSONPath{path: append(p.path[:len(p.path):len(p.path)], "RawFragment")}
+}
+
+// ForceQuery returns the value of the "ForceQuery" key.
+func (p URLsElemJSONPath) ForceQuery() URLsElemForceQueryJSONPath {
+	return URLsElemForceQueryJSONPath{path: append(p.path[:len(p.path):len(p.path)], "ForceQuery")}
+}
+
+// OmitHost returns the value of the "OmitHost" key.
+func (p URLsElemJSONPath) OmitHost() URLsElemOmitHostJSONPath {
+	return URLsElemOmitHostJSONPath{path: append(p.path[:len(p.path):len(p.path)], "OmitHost")}
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemSchemeJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemSchemeJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemSchemeJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemSchemeJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemSchemeJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemSchemeJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemSchemeJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLsElemSchemeJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLsElemSchemeJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLsElemSchemeJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLsElemSchemeJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLsElemSchemeJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLsElemSchemeJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLsElemSchemeJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLsElemSchemeJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLsElemSchemeJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemOpaqueJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemOpaqueJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemOpaqueJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemOpaqueJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemOpaqueJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemOpaqueJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemOpaqueJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLsElemOpaqueJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLsElemOpaqueJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLsElemOpaqueJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLsElemOpaqueJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLsElemOpaqueJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLsElemOpaqueJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLsElemOpaqueJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLsElemOpaqueJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLsElemOpaqueJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemUserJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemUserJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemUserJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemUserJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemUserJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemHostJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemHostJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemHostJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemHostJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemHostJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemHostJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemHostJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLsElemHostJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLsElemHostJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLsElemHostJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLsElemHostJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLsElemHostJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLsElemHostJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLsElemHostJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLsElemHostJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLsElemHostJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemPathJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemPathJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemPathJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemPathJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemPathJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemPathJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemPathJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLsElemPathJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLsElemPathJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLsElemPathJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLsElemPathJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLsElemPathJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLsElemPathJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLsElemPathJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLsElemPathJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLsElemPathJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemFragmentJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemFragmentJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemFragmentJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemFragmentJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemFragmentJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemFragmentJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemFragmentJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLsElemFragmentJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLsElemFragmentJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLsElemFragmentJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLsElemFragmentJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLsElemFragmentJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLsElemFragmentJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLsElemFragmentJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLsElemFragmentJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLsElemFragmentJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemRawQueryJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemRawQueryJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemRawQueryJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemRawQueryJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemRawQueryJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemRawQueryJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemRawQueryJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLsElemRawQueryJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLsElemRawQueryJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLsElemRawQueryJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLsElemRawQueryJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLsElemRawQueryJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLsElemRawQueryJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLsElemRawQueryJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLsElemRawQueryJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLsElemRawQueryJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemRawPathJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemRawPathJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemRawPathJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemRawPathJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemRawPathJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemRawPathJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemRawPathJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLsElemRawPathJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLsElemRawPathJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLsElemRawPathJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLsElemRawPathJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLsElemRawPathJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLsElemRawPathJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLsElemRawPathJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLsElemRawPathJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLsElemRawPathJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemRawFragmentJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemRawFragmentJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemRawFragmentJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemRawFragmentJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemRawFragmentJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemRawFragmentJSONPath) EQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemRawFragmentJSONPath) NEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GT applies the GT predicate on the value.
+func (p URLsElemRawFragmentJSONPath) GT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// GTE applies the GTE predicate on the value.
+func (p URLsElemRawFragmentJSONPath) GTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LT applies the LT predicate on the value.
+func (p URLsElemRawFragmentJSONPath) LT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// LTE applies the LTE predicate on the value.
+func (p URLsElemRawFragmentJSONPath) LTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// In applies the In predicate on the value.
+func (p URLsElemRawFragmentJSONPath) In(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// NotIn applies the NotIn predicate on the value.
+func (p URLsElemRawFragmentJSONPath) NotIn(vs ...string) predicate.User {
+	args := make([]any, len(vs))
+	for i := range vs {
+		args[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNotIn(s.C(FieldURLs), args, sqljson.Path(p.path...)))
+	})
+}
+
+// HasPrefix applies the HasPrefix predicate on the value.
+func (p URLsElemRawFragmentJSONPath) HasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// HasSuffix applies the HasSuffix predicate on the value.
+func (p URLsElemRawFragmentJSONPath) HasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// Contains applies the Contains predicate on the value.
+func (p URLsElemRawFragmentJSONPath) Contains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURLs), v, sqljson.Path(p.path...)))
+	})
+}
+
+// URLsElemForceQueryJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemForceQueryJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemForceQueryJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemForceQueryJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemForceQueryJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemForceQueryJSONPath) EQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemForceQueryJSONPath) NEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// URLsElemOmitHostJSONPath represents a value inside the "URLs" field in its typed predicates.
+type URLsElemOmitHostJSONPath struct {
+	path []string
+}
+
+// Exists applies the HasKey predicate on the value. i.e. it checks that the value exists in the document.
+func (p URLsElemOmitHostJSONPath) Exists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// IsNull applies the ValueIsNull predicate on the value. i.e. it checks that the value is the JSON null literal.
+func (p URLsElemOmitHostJSONPath) IsNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// NotNull applies the ValueIsNotNull predicate on the value. i.e. it checks that the value is not the JSON null literal.
+func (p URLsElemOmitHostJSONPath) NotNull() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueIsNotNull(s.C(FieldURLs), sqljson.Path(p.path...)))
+	})
+}
+
+// EQ applies the EQ predicate on the value.
+func (p URLsElemOmitHostJSONPath) EQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
+
+// NEQ applies the NEQ predicate on the value.
+func (p URLsElemOmitHostJSONPath) NEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURLs), v, sqljson.Path(p.path...), sqljson.Cast("bool")))
+	})
+}
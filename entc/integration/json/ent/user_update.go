@@ -26,9 +26,10 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks     []Hook
-	mutation  *UserMutation
-	modifiers []func(*sql.UpdateBuilder)
+	hooks       []Hook
+	mutation    *UserMutation
+	jsonUpdates []func(*sql.UpdateBuilder)
+	modifiers   []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -301,6 +302,630 @@ func (_u *UserUpdate) check() error {
 	return nil
 }
 
+// SetTPath sets the value at the given path of the "t" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetTPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldT, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveTPath removes the value at the given path of the "t" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveTPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldT, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeT merges the given patch into the "t" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeT(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldT, patch)
+	})
+	return _u
+}
+
+// InsertTPath inserts the given value to the array at the given path of the "t" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertTPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldT, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementTPath adds n to the integer at the given path of the "t" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementTPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldT, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetURLPath sets the value at the given path of the "url" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetURLPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldURL, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveURLPath removes the value at the given path of the "url" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveURLPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldURL, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeURL merges the given patch into the "url" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeURL(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldURL, patch)
+	})
+	return _u
+}
+
+// InsertURLPath inserts the given value to the array at the given path of the "url" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertURLPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldURL, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementURLPath adds n to the integer at the given path of the "url" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementURLPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldURL, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetURLsPath sets the value at the given path of the "URLs" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetURLsPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldURLs, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveURLsPath removes the value at the given path of the "URLs" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveURLsPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldURLs, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeURLs merges the given patch into the "URLs" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeURLs(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldURLs, patch)
+	})
+	return _u
+}
+
+// InsertURLsPath inserts the given value to the array at the given path of the "URLs" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertURLsPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldURLs, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementURLsPath adds n to the integer at the given path of the "URLs" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementURLsPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldURLs, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetRawPath sets the value at the given path of the "raw" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetRawPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldRaw, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveRawPath removes the value at the given path of the "raw" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveRawPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldRaw, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeRaw merges the given patch into the "raw" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeRaw(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldRaw, patch)
+	})
+	return _u
+}
+
+// InsertRawPath inserts the given value to the array at the given path of the "raw" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertRawPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldRaw, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementRawPath adds n to the integer at the given path of the "raw" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementRawPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldRaw, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetDirsPath sets the value at the given path of the "dirs" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetDirsPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldDirs, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveDirsPath removes the value at the given path of the "dirs" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveDirsPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldDirs, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeDirs merges the given patch into the "dirs" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeDirs(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldDirs, patch)
+	})
+	return _u
+}
+
+// InsertDirsPath inserts the given value to the array at the given path of the "dirs" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertDirsPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldDirs, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementDirsPath adds n to the integer at the given path of the "dirs" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementDirsPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldDirs, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetIntsPath sets the value at the given path of the "ints" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetIntsPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldInts, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveIntsPath removes the value at the given path of the "ints" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveIntsPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldInts, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeInts merges the given patch into the "ints" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeInts(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldInts, patch)
+	})
+	return _u
+}
+
+// InsertIntsPath inserts the given value to the array at the given path of the "ints" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertIntsPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldInts, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementIntsPath adds n to the integer at the given path of the "ints" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementIntsPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldInts, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetFloatsPath sets the value at the given path of the "floats" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetFloatsPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldFloats, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveFloatsPath removes the value at the given path of the "floats" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveFloatsPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldFloats, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeFloats merges the given patch into the "floats" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeFloats(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldFloats, patch)
+	})
+	return _u
+}
+
+// InsertFloatsPath inserts the given value to the array at the given path of the "floats" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertFloatsPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldFloats, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementFloatsPath adds n to the integer at the given path of the "floats" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementFloatsPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldFloats, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetStringsPath sets the value at the given path of the "strings" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetStringsPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldStrings, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveStringsPath removes the value at the given path of the "strings" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveStringsPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldStrings, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeStrings merges the given patch into the "strings" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeStrings(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldStrings, patch)
+	})
+	return _u
+}
+
+// InsertStringsPath inserts the given value to the array at the given path of the "strings" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertStringsPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldStrings, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementStringsPath adds n to the integer at the given path of the "strings" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementStringsPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldStrings, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetIntsValidatePath sets the value at the given path of the "ints_validate" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetIntsValidatePath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldIntsValidate, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveIntsValidatePath removes the value at the given path of the "ints_validate" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveIntsValidatePath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldIntsValidate, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeIntsValidate merges the given patch into the "ints_validate" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeIntsValidate(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldIntsValidate, patch)
+	})
+	return _u
+}
+
+// InsertIntsValidatePath inserts the given value to the array at the given path of the "ints_validate" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertIntsValidatePath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldIntsValidate, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementIntsValidatePath adds n to the integer at the given path of the "ints_validate" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementIntsValidatePath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldIntsValidate, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetFloatsValidatePath sets the value at the given path of the "floats_validate" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetFloatsValidatePath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldFloatsValidate, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveFloatsValidatePath removes the value at the given path of the "floats_validate" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveFloatsValidatePath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldFloatsValidate, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeFloatsValidate merges the given patch into the "floats_validate" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeFloatsValidate(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldFloatsValidate, patch)
+	})
+	return _u
+}
+
+// InsertFloatsValidatePath inserts the given value to the array at the given path of the "floats_validate" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertFloatsValidatePath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldFloatsValidate, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementFloatsValidatePath adds n to the integer at the given path of the "floats_validate" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementFloatsValidatePath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldFloatsValidate, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetStringsValidatePath sets the value at the given path of the "strings_validate" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetStringsValidatePath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldStringsValidate, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveStringsValidatePath removes the value at the given path of the "strings_validate" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveStringsValidatePath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldStringsValidate, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeStringsValidate merges the given patch into the "strings_validate" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeStringsValidate(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldStringsValidate, patch)
+	})
+	return _u
+}
+
+// InsertStringsValidatePath inserts the given value to the array at the given path of the "strings_validate" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertStringsValidatePath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldStringsValidate, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementStringsValidatePath adds n to the integer at the given path of the "strings_validate" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementStringsValidatePath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldStringsValidate, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetAddrPath sets the value at the given path of the "addr" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetAddrPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldAddr, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveAddrPath removes the value at the given path of the "addr" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveAddrPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldAddr, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeAddr merges the given patch into the "addr" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeAddr(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldAddr, patch)
+	})
+	return _u
+}
+
+// InsertAddrPath inserts the given value to the array at the given path of the "addr" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertAddrPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldAddr, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementAddrPath adds n to the integer at the given path of the "addr" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementAddrPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldAddr, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetUnknownPath sets the value at the given path of the "unknown" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) SetUnknownPath(path string, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldUnknown, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveUnknownPath removes the value at the given path of the "unknown" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) RemoveUnknownPath(path string) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldUnknown, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeUnknown merges the given patch into the "unknown" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) MergeUnknown(patch any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldUnknown, patch)
+	})
+	return _u
+}
+
+// InsertUnknownPath inserts the given value to the array at the given path of the "unknown" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) InsertUnknownPath(path string, index int, v any) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldUnknown, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementUnknownPath adds n to the integer at the given path of the "unknown" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdate) IncrementUnknownPath(path string, n int) *UserUpdate {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldUnknown, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -439,6 +1064,7 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.UnknownCleared() {
 		_spec.ClearField(user.FieldUnknown, field.TypeJSON)
 	}
+	_spec.AddModifiers(_u.jsonUpdates...)
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -455,10 +1081,11 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields    []string
-	hooks     []Hook
-	mutation  *UserMutation
-	modifiers []func(*sql.UpdateBuilder)
+	fields      []string
+	hooks       []Hook
+	mutation    *UserMutation
+	jsonUpdates []func(*sql.UpdateBuilder)
+	modifiers   []func(*sql.UpdateBuilder)
 }
 
 // SetT sets the "t" field.
@@ -738,6 +1365,630 @@ func (_u *UserUpdateOne) check() error {
 	return nil
 }
 
+// SetTPath sets the value at the given path of the "t" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetTPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldT, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveTPath removes the value at the given path of the "t" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveTPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldT, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeT merges the given patch into the "t" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeT(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldT, patch)
+	})
+	return _u
+}
+
+// InsertTPath inserts the given value to the array at the given path of the "t" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertTPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldT, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementTPath adds n to the integer at the given path of the "t" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementTPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldT, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetURLPath sets the value at the given path of the "url" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetURLPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldURL, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveURLPath removes the value at the given path of the "url" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveURLPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldURL, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeURL merges the given patch into the "url" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeURL(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldURL, patch)
+	})
+	return _u
+}
+
+// InsertURLPath inserts the given value to the array at the given path of the "url" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertURLPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldURL, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementURLPath adds n to the integer at the given path of the "url" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementURLPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldURL, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetURLsPath sets the value at the given path of the "URLs" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetURLsPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldURLs, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveURLsPath removes the value at the given path of the "URLs" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveURLsPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldURLs, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeURLs merges the given patch into the "URLs" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeURLs(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldURLs, patch)
+	})
+	return _u
+}
+
+// InsertURLsPath inserts the given value to the array at the given path of the "URLs" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertURLsPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldURLs, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementURLsPath adds n to the integer at the given path of the "URLs" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementURLsPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldURLs, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetRawPath sets the value at the given path of the "raw" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetRawPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldRaw, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveRawPath removes the value at the given path of the "raw" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveRawPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldRaw, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeRaw merges the given patch into the "raw" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeRaw(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldRaw, patch)
+	})
+	return _u
+}
+
+// InsertRawPath inserts the given value to the array at the given path of the "raw" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertRawPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldRaw, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementRawPath adds n to the integer at the given path of the "raw" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementRawPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldRaw, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetDirsPath sets the value at the given path of the "dirs" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetDirsPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldDirs, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveDirsPath removes the value at the given path of the "dirs" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveDirsPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldDirs, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeDirs merges the given patch into the "dirs" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeDirs(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldDirs, patch)
+	})
+	return _u
+}
+
+// InsertDirsPath inserts the given value to the array at the given path of the "dirs" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertDirsPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldDirs, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementDirsPath adds n to the integer at the given path of the "dirs" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementDirsPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldDirs, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetIntsPath sets the value at the given path of the "ints" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetIntsPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldInts, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveIntsPath removes the value at the given path of the "ints" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveIntsPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldInts, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeInts merges the given patch into the "ints" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeInts(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldInts, patch)
+	})
+	return _u
+}
+
+// InsertIntsPath inserts the given value to the array at the given path of the "ints" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertIntsPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldInts, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementIntsPath adds n to the integer at the given path of the "ints" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementIntsPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldInts, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetFloatsPath sets the value at the given path of the "floats" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetFloatsPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldFloats, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveFloatsPath removes the value at the given path of the "floats" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveFloatsPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldFloats, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeFloats merges the given patch into the "floats" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeFloats(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldFloats, patch)
+	})
+	return _u
+}
+
+// InsertFloatsPath inserts the given value to the array at the given path of the "floats" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertFloatsPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldFloats, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementFloatsPath adds n to the integer at the given path of the "floats" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementFloatsPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldFloats, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetStringsPath sets the value at the given path of the "strings" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetStringsPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldStrings, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveStringsPath removes the value at the given path of the "strings" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveStringsPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldStrings, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeStrings merges the given patch into the "strings" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeStrings(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldStrings, patch)
+	})
+	return _u
+}
+
+// InsertStringsPath inserts the given value to the array at the given path of the "strings" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertStringsPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldStrings, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementStringsPath adds n to the integer at the given path of the "strings" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementStringsPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldStrings, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetIntsValidatePath sets the value at the given path of the "ints_validate" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetIntsValidatePath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldIntsValidate, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveIntsValidatePath removes the value at the given path of the "ints_validate" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveIntsValidatePath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldIntsValidate, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeIntsValidate merges the given patch into the "ints_validate" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeIntsValidate(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldIntsValidate, patch)
+	})
+	return _u
+}
+
+// InsertIntsValidatePath inserts the given value to the array at the given path of the "ints_validate" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertIntsValidatePath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldIntsValidate, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementIntsValidatePath adds n to the integer at the given path of the "ints_validate" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementIntsValidatePath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldIntsValidate, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetFloatsValidatePath sets the value at the given path of the "floats_validate" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetFloatsValidatePath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldFloatsValidate, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveFloatsValidatePath removes the value at the given path of the "floats_validate" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveFloatsValidatePath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldFloatsValidate, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeFloatsValidate merges the given patch into the "floats_validate" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeFloatsValidate(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldFloatsValidate, patch)
+	})
+	return _u
+}
+
+// InsertFloatsValidatePath inserts the given value to the array at the given path of the "floats_validate" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertFloatsValidatePath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldFloatsValidate, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementFloatsValidatePath adds n to the integer at the given path of the "floats_validate" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementFloatsValidatePath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldFloatsValidate, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetStringsValidatePath sets the value at the given path of the "strings_validate" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetStringsValidatePath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldStringsValidate, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveStringsValidatePath removes the value at the given path of the "strings_validate" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveStringsValidatePath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldStringsValidate, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeStringsValidate merges the given patch into the "strings_validate" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeStringsValidate(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldStringsValidate, patch)
+	})
+	return _u
+}
+
+// InsertStringsValidatePath inserts the given value to the array at the given path of the "strings_validate" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertStringsValidatePath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldStringsValidate, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementStringsValidatePath adds n to the integer at the given path of the "strings_validate" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementStringsValidatePath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldStringsValidate, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetAddrPath sets the value at the given path of the "addr" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetAddrPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldAddr, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveAddrPath removes the value at the given path of the "addr" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveAddrPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldAddr, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeAddr merges the given patch into the "addr" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeAddr(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldAddr, patch)
+	})
+	return _u
+}
+
+// InsertAddrPath inserts the given value to the array at the given path of the "addr" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertAddrPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldAddr, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementAddrPath adds n to the integer at the given path of the "addr" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementAddrPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldAddr, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// SetUnknownPath sets the value at the given path of the "unknown" field in place, without rewriting
+// the whole document. The path uses the dot notation (e.g. "a.b[0].c"), and only its last element is
+// created if it is missing. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) SetUnknownPath(path string, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Set(u, user.FieldUnknown, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// RemoveUnknownPath removes the value at the given path of the "unknown" field in place
+// (e.g. an object key or an array element). Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) RemoveUnknownPath(path string) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Remove(u, user.FieldUnknown, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// MergeUnknown merges the given patch into the "unknown" field in place, as defined in RFC 7386
+// (JSON Merge Patch). i.e. objects are merged recursively, and keys with null values are removed.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) MergeUnknown(patch any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Merge(u, user.FieldUnknown, patch)
+	})
+	return _u
+}
+
+// InsertUnknownPath inserts the given value to the array at the given path of the "unknown" field in
+// place, before the element at the given index. An empty path refers to the top-level value of the field.
+// Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) InsertUnknownPath(path string, index int, v any) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Insert(u, user.FieldUnknown, index, v, sqljson.DotPath(path))
+	})
+	return _u
+}
+
+// IncrementUnknownPath adds n to the integer at the given path of the "unknown" field in place.
+// Missing values are treated as 0. Note that, this modification is not visible to the mutation hooks.
+func (_u *UserUpdateOne) IncrementUnknownPath(path string, n int) *UserUpdateOne {
+	_u.jsonUpdates = append(_u.jsonUpdates, func(u *sql.UpdateBuilder) {
+		sqljson.Increment(u, user.FieldUnknown, n, sqljson.DotPath(path))
+	})
+	return _u
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -893,6 +2144,7 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.UnknownCleared() {
 		_spec.ClearField(user.FieldUnknown, field.TypeJSON)
 	}
+	_spec.AddModifiers(_u.jsonUpdates...)
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
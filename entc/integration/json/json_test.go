@@ -19,6 +19,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/json/ent"
 	"entgo.io/ent/entc/integration/json/ent/migrate"
+	"entgo.io/ent/entc/integration/json/ent/predicate"
 	"entgo.io/ent/entc/integration/json/ent/schema"
 	"entgo.io/ent/entc/integration/json/ent/user"
 
@@ -57,6 +58,8 @@ func TestMySQL(t *testing.T) {
 				IntsValidate(t, client)
 				FloatsValidate(t, client)
 				StringsValidate(t, client)
+				JSONUpdate(t, client)
+				JSONPath(t, client)
 				Predicates(t, client)
 				Order(t, client)
 			}
@@ -95,6 +98,8 @@ func TestMaria(t *testing.T) {
 			NetAddr(t, client)
 			RawMessage(t, client)
 			Any(t, client)
+			JSONUpdate(t, client)
+			JSONPath(t, client)
 			Predicates(t, client)
 			Scan(t, client)
 			Order(t, client)
@@ -132,6 +137,8 @@ func TestPostgres(t *testing.T) {
 			NetAddr(t, client)
 			RawMessage(t, client)
 			Any(t, client)
+			JSONUpdate(t, client)
+			JSONPath(t, client)
 			Predicates(t, client)
 			Scan(t, client)
 			Order(t, client)
@@ -158,6 +165,8 @@ func TestSQLite(t *testing.T) {
 	NetAddr(t, client)
 	RawMessage(t, client)
 	Any(t, client)
+	JSONUpdate(t, client)
+	JSONPath(t, client)
 	Predicates(t, client)
 	Scan(t, client)
 	Order(t, client)
@@ -381,6 +390,82 @@ func URLs(t *testing.T, client *ent.Client) {
 	require.Equal(t, u2, usr.URLs[1])
 }
 
+func JSONUpdate(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetT(&schema.T{I: 1, S: "a", Li: []int{1, 2}, Ls: []string{"a"}, M: map[string]any{"k": "v"}}).SaveX(ctx)
+	usr = usr.Update().SetTPath("s", "b").SaveX(ctx)
+	require.Equal(t, "b", usr.T.S)
+	usr = usr.Update().SetTPath("t", schema.T{S: "c"}).SaveX(ctx)
+	require.Equal(t, "c", usr.T.T.S)
+	usr = usr.Update().IncrementTPath("i", 2).SaveX(ctx)
+	require.Equal(t, 3, usr.T.I)
+	usr = usr.Update().IncrementTPath("t.i", 5).SaveX(ctx)
+	require.Equal(t, 5, usr.T.T.I, "missing values should be treated as 0")
+	usr = usr.Update().InsertTPath("li", 0, 0).SaveX(ctx)
+	require.Equal(t, []int{0, 1, 2}, usr.T.Li)
+	usr = usr.Update().InsertTPath("li", 10, 3).SaveX(ctx)
+	require.Equal(t, []int{0, 1, 2, 3}, usr.T.Li, "values should be appended if the index is out of range")
+	usr = usr.Update().RemoveTPath("ls[0]").SaveX(ctx)
+	require.Empty(t, usr.T.Ls)
+	usr = usr.Update().MergeT(map[string]any{"b": true, "f": 1.5, "m": map[string]any{"k": nil, "n": "v"}}).SaveX(ctx)
+	require.True(t, usr.T.B)
+	require.Equal(t, 1.5, usr.T.F)
+	require.Equal(t, map[string]any{"n": "v"}, usr.T.M, "null values should remove their keys")
+	require.Equal(t, 3, usr.T.I, "merge should keep the other keys")
+
+	// Modifications of other fields and rows are not affected.
+	other := client.User.Create().SetT(&schema.T{I: 1}).SaveX(ctx)
+	client.User.Update().Where(user.ID(usr.ID)).IncrementTPath("i", 1).SetTPath("s", "d").ExecX(ctx)
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Equal(t, 4, usr.T.I)
+	require.Equal(t, "d", usr.T.S)
+	require.Equal(t, 1, client.User.GetX(ctx, other.ID).T.I)
+
+	// A NULL column is treated as an empty document.
+	usr = client.User.Create().SaveX(ctx)
+	usr = usr.Update().SetTPath("s", "a").IncrementTPath("i", 1).SaveX(ctx)
+	require.Equal(t, "a", usr.T.S)
+	require.Equal(t, 1, usr.T.I)
+}
+
+func JSONPath(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	client.User.Delete().ExecX(ctx)
+	u, err := url.Parse("https://github.com/ent/ent")
+	require.NoError(t, err)
+	client.User.CreateBulk(
+		client.User.Create().SetURL(u).SetT(&schema.T{I: 1, S: "a8m", B: true, Li: []int{1, 2}, Ls: []string{"a", "b"}, M: map[string]any{"k": "v"}, T: &schema.T{I: 5, S: "nested"}}),
+		client.User.Create().SetT(&schema.T{I: 2, F: 1.5, S: "nati", Li: []int{1}}),
+		client.User.Create(),
+	).ExecX(ctx)
+	for _, tt := range []struct {
+		name  string
+		p     predicate.User
+		count int
+	}{
+		{"EQ", user.TPath().I().EQ(1), 1},
+		{"GT", user.TPath().I().GT(0), 2},
+		{"In", user.TPath().I().In(2, 3), 1},
+		{"Float", user.TPath().F().GTE(1.5), 1},
+		{"Bool", user.TPath().B().EQ(true), 1},
+		{"HasPrefix", user.TPath().S().HasPrefix("na"), 1},
+		{"Nested", user.TPath().T().S().EQ("nested"), 1},
+		{"NestedNumeric", user.TPath().T().I().LT(10), 1},
+		{"Exists", user.TPath().T().Exists(), 1},
+		{"IsNull", user.TPath().Ls().IsNull(), 1},
+		{"LenEQ", user.TPath().Li().LenEQ(2), 1},
+		{"Contains", user.TPath().Li().Contains(2), 1},
+		{"At", user.TPath().Li().At(0).EQ(1), 2},
+		{"HasKey", user.TPath().M().HasKey("k"), 1},
+		{"URL", user.URLPath().Host().EQ("github.com"), 1},
+		{"Not", user.Not(user.TPath().S().EQ("a8m")), 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.count, client.User.Query().Where(tt.p).CountX(ctx))
+		})
+	}
+}
+
 func Predicates(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 
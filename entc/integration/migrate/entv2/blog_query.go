@@ -601,6 +601,9 @@ func (bs *BlogSelect) sqlScan(ctx context.Context, root *BlogQuery, v any) error
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
@@ -521,6 +521,9 @@ func (ms *MediaSelect) sqlScan(ctx context.Context, root *MediaQuery, v any) err
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ms.driver.Query(ctx, query, args, rows); err != nil {
@@ -499,6 +499,9 @@ func (zs *ZooSelect) sqlScan(ctx context.Context, root *ZooQuery, v any) error {
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := zs.driver.Query(ctx, query, args, rows); err != nil {
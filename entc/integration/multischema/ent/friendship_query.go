@@ -705,6 +705,9 @@ func (fs *FriendshipSelect) sqlScan(ctx context.Context, root *FriendshipQuery,
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
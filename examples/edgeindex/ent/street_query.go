@@ -608,6 +608,9 @@ func (ss *StreetSelect) sqlScan(ctx context.Context, root *StreetQuery, v any) e
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := ss.driver.Query(ctx, query, args, rows); err != nil {
@@ -441,6 +441,9 @@ func (cus *CleanUserSelect) sqlScan(ctx context.Context, root *CleanUserQuery, v
 	case n != 0 && len(aggregation) > 0:
 		selector.AppendSelect(aggregation...)
 	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
 	if err := cus.driver.Query(ctx, query, args, rows); err != nil {
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -92,6 +93,7 @@ func JSON(name string, typ any) *jsonBuilder {
 		b.desc.Info.Nillable = true
 		b.desc.Info.PkgPath = pkgPath(t)
 	}
+	b.desc.Info.JSON = jsonType(t, make(map[reflect.Type]bool))
 	return b
 }
 
@@ -998,6 +1000,7 @@ func sb[T sliceType](name string) *sliceBuilder[T] {
 		b.desc.Info.Nillable = true
 		b.desc.Info.PkgPath = pkgPath(t)
 	}
+	b.desc.Info.JSON = jsonType(t, make(map[reflect.Type]bool))
 	return &sliceBuilder[T]{b}
 }
 
@@ -1509,6 +1512,97 @@ func methods(t reflect.Type, rtype *RType) {
 	}
 }
 
+// jsonType returns the structure of the JSON values that are encoded from the given type
+// by the encoding/json package. The seen map holds the struct types that are expanded by
+// the callers, and it is used for stopping the expansion of recursive types.
+func jsonType(t reflect.Type, seen map[reflect.Type]bool) *JSONType {
+	t = indirect(t)
+	switch {
+	// Types that implement encoding.TextMarshaler (e.g. time.Time) are
+	// expected to be encoded as strings, even if they implement json.Marshaler.
+	case t.Implements(textMarshalType), reflect.PtrTo(t).Implements(textMarshalType):
+		return &JSONType{Kind: reflect.String}
+	case t.Implements(jsonMarshalType), reflect.PtrTo(t).Implements(jsonMarshalType):
+		return &JSONType{Kind: reflect.Interface}
+	}
+	switch k := t.Kind(); k {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return &JSONType{Kind: k}
+	case reflect.Slice, reflect.Array:
+		// Byte slices are encoded as base64 strings.
+		if k == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &JSONType{Kind: reflect.String}
+		}
+		return &JSONType{Kind: reflect.Slice, Elem: jsonType(t.Elem(), seen)}
+	case reflect.Map:
+		return &JSONType{Kind: reflect.Map, Elem: jsonType(t.Elem(), seen)}
+	case reflect.Struct:
+		typ := &JSONType{Kind: reflect.Struct}
+		if t.Name() != "" {
+			typ.Ident = t.String()
+		}
+		if seen[t] {
+			return typ
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		typ.Fields = jsonFields(t, seen, make(map[string]bool))
+		return typ
+	default:
+		return &JSONType{Kind: reflect.Interface}
+	}
+}
+
+// jsonFields returns the JSON object keys of the given struct type. Similar to the
+// encoding/json package, the fields of embedded structs are promoted to the parent
+// object, unless the parent object has a key with the same name.
+func jsonFields(t reflect.Type, seen map[reflect.Type]bool, keys map[string]bool) []*JSONField {
+	var (
+		fields   []*JSONField
+		embedded []reflect.Type
+	)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && indirect(f.Type).Kind() == reflect.Struct {
+			embedded = append(embedded, indirect(f.Type))
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if keys[name] {
+			continue
+		}
+		keys[name] = true
+		typ := jsonType(f.Type, seen)
+		// Scalars with the "string" option are encoded as JSON strings.
+		if strings.Contains(","+opts+",", ",string,") && typ.Kind != reflect.Interface && typ.Kind != reflect.Slice &&
+			typ.Kind != reflect.Map && typ.Kind != reflect.Struct {
+			typ.Kind = reflect.String
+		}
+		fields = append(fields, &JSONField{Name: f.Name, Key: name, Type: typ})
+	}
+	for _, e := range embedded {
+		if !seen[e] {
+			seen[e] = true
+			fields = append(fields, jsonFields(e, seen, keys)...)
+			delete(seen, e)
+		}
+	}
+	return fields
+}
+
 func (d *Descriptor) checkDefaultFunc(expectType reflect.Type) {
 	for _, typ := range []reflect.Type{reflect.TypeOf(d.Default), reflect.TypeOf(d.UpdateDefault)} {
 		if typ == nil || typ.Kind() != reflect.Func || d.Err != nil {
@@ -1538,6 +1632,8 @@ var (
 	errorType        = reflect.TypeOf((*error)(nil)).Elem()
 	valueScannerType = reflect.TypeOf((*ValueScanner)(nil)).Elem()
 	validatorType    = reflect.TypeOf((*Validator)(nil)).Elem()
+	jsonMarshalType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalType  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
 )
 
 // ValueScanner is the interface that groups the Value
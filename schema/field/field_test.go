@@ -693,6 +693,62 @@ func TestJSON(t *testing.T) {
 	assert.EqualError(t, fd.Err, "expect a Go value as JSON type but got nil")
 }
 
+type jsonNode struct {
+	Name     string            `json:"name"`
+	Children []*jsonNode       `json:"children,omitempty"`
+	Attrs    map[string]uint16 `json:"attrs"`
+}
+
+type jsonBase struct {
+	ID      int64 `json:"id,string"`
+	Created time.Time
+}
+
+func TestJSON_Structure(t *testing.T) {
+	type T struct {
+		jsonBase
+		Node    jsonNode
+		Data    []byte
+		Score   float64 `json:"score"`
+		Extra   any     `json:"extra"`
+		Skipped string  `json:"-"`
+		private bool
+	}
+	fd := field.JSON("t", &T{}).Descriptor()
+	require.NoError(t, fd.Err)
+	typ := fd.Info.JSON
+	require.NotNil(t, typ)
+	assert.Equal(t, reflect.Struct, typ.Kind)
+	assert.Equal(t, "field_test.T", typ.Ident)
+	keys := make([]string, len(typ.Fields))
+	for i, f := range typ.Fields {
+		keys[i] = f.Key
+	}
+	assert.Equal(t, []string{"Node", "Data", "score", "extra", "id", "Created"}, keys)
+	assert.Equal(t, reflect.String, typ.Fields[1].Type.Kind, "[]byte is encoded as string")
+	assert.Equal(t, reflect.Float64, typ.Fields[2].Type.Kind)
+	assert.Equal(t, reflect.Interface, typ.Fields[3].Type.Kind)
+	assert.Equal(t, reflect.String, typ.Fields[4].Type.Kind, "numbers with the string option are encoded as strings")
+	assert.Equal(t, "ID", typ.Fields[4].Name)
+	assert.Equal(t, reflect.String, typ.Fields[5].Type.Kind, "time.Time implements encoding.TextMarshaler")
+
+	node := typ.Fields[0].Type
+	assert.Equal(t, "field_test.jsonNode", node.Ident)
+	require.Len(t, node.Fields, 3)
+	assert.Equal(t, "children", node.Fields[1].Key)
+	children := node.Fields[1].Type
+	assert.Equal(t, reflect.Slice, children.Kind)
+	assert.Equal(t, node.Ident, children.Elem.Ident)
+	assert.Empty(t, children.Elem.Fields, "recursive types are expanded once")
+	assert.Equal(t, reflect.Map, node.Fields[2].Type.Kind)
+	assert.Equal(t, reflect.Uint16, node.Fields[2].Type.Elem.Kind)
+
+	fd = field.Strings("strings").Descriptor()
+	require.NotNil(t, fd.Info.JSON)
+	assert.Equal(t, reflect.Slice, fd.Info.JSON.Kind)
+	assert.Equal(t, reflect.String, fd.Info.JSON.Elem.Kind)
+}
+
 func TestField_Tag(t *testing.T) {
 	fd := field.Bool("expired").
 		StructTag(`json:"expired,omitempty"`).
@@ -89,6 +89,9 @@ type TypeInfo struct {
 	PkgName  string // local package name.
 	Nillable bool   // slices or pointers.
 	RType    *RType
+	// JSON describes the structure of the JSON documents of JSON fields. Used
+	// by the entc package for generating predicates on the values inside them.
+	JSON *JSONType `json:",omitempty"`
 }
 
 // String returns the string representation of a type.
@@ -189,6 +192,27 @@ var (
 	}
 )
 
+// JSONType describes the structure of a JSON value that is encoded
+// from a Go type by the encoding/json package. Used by the entc package.
+type JSONType struct {
+	// Kind of the JSON value. Objects are described by reflect.Struct (Go structs) or
+	// reflect.Map, arrays by reflect.Slice, and values with a custom JSON encoding or an
+	// unknown structure by reflect.Interface. Scalars are described by their Go kinds.
+	Kind reflect.Kind
+	// Ident identifies named struct types, and it is used for detecting
+	// recursive types, that are expanded only at their first occurrence.
+	Ident  string       `json:",omitempty"`
+	Fields []*JSONField `json:",omitempty"` // keys of struct objects.
+	Elem   *JSONType    `json:",omitempty"` // elements of arrays and values of maps.
+}
+
+// JSONField describes a key of a JSON object that is encoded from a struct field.
+type JSONField struct {
+	Name string // Go name of the struct field.
+	Key  string // JSON key of the struct field.
+	Type *JSONType
+}
+
 // RType holds a serializable reflect.Type information of
 // Go object. Used by the entc package.
 type RType struct {